@@ -46,7 +46,11 @@ type InternalFileInfo struct {
 	// 创建时间
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// 更新时间
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 自定义元数据
+	Metadata map[string]string `protobuf:"bytes,11,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 标签
+	Tags          []string `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -151,6 +155,20 @@ func (x *InternalFileInfo) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *InternalFileInfo) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *InternalFileInfo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 // InternalFileUrlInfo 内部文件URL信息
 type InternalFileUrlInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -852,7 +870,9 @@ type InternalFileDownloadRequest struct {
 	// 自定义下载文件名（可选）
 	DownloadFilename string `protobuf:"bytes,2,opt,name=download_filename,json=downloadFilename,proto3" json:"download_filename,omitempty"`
 	// 要下载的变体ID（可选）
-	VariantId     string `protobuf:"bytes,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	VariantId string `protobuf:"bytes,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	// 图片处理参数（可选），编码到返回的下载URL中，仅对图片文件有效
+	Transform     *InternalImageTransform `protobuf:"bytes,4,opt,name=transform,proto3" json:"transform,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -908,6 +928,186 @@ func (x *InternalFileDownloadRequest) GetVariantId() string {
 	return ""
 }
 
+func (x *InternalFileDownloadRequest) GetTransform() *InternalImageTransform {
+	if x != nil {
+		return x.Transform
+	}
+	return nil
+}
+
+// InternalImageTransform 图片处理参数
+type InternalImageTransform struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 缩放后的宽度（像素），0 表示按高度等比缩放
+	Width int32 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	// 缩放后的高度（像素），0 表示按宽度等比缩放
+	Height int32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// 缩放模式: fit（等比缩放到宽高范围内，默认）、fill（等比缩放后居中裁剪为指定宽高）
+	ResizeMode string `protobuf:"bytes,3,opt,name=resize_mode,json=resizeMode,proto3" json:"resize_mode,omitempty"`
+	// 缩放前裁剪的区域（可选）
+	Crop *InternalImageCrop `protobuf:"bytes,4,opt,name=crop,proto3" json:"crop,omitempty"`
+	// 文字水印（可选）
+	WatermarkText string `protobuf:"bytes,5,opt,name=watermark_text,json=watermarkText,proto3" json:"watermark_text,omitempty"`
+	// 输出格式: webp、avif、jpeg、png，为空时保持原格式
+	Format string `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
+	// 输出质量（1-100），0 表示使用默认质量
+	Quality       int32 `protobuf:"varint,7,opt,name=quality,proto3" json:"quality,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalImageTransform) Reset() {
+	*x = InternalImageTransform{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalImageTransform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalImageTransform) ProtoMessage() {}
+
+func (x *InternalImageTransform) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalImageTransform.ProtoReflect.Descriptor instead.
+func (*InternalImageTransform) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InternalImageTransform) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *InternalImageTransform) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *InternalImageTransform) GetResizeMode() string {
+	if x != nil {
+		return x.ResizeMode
+	}
+	return ""
+}
+
+func (x *InternalImageTransform) GetCrop() *InternalImageCrop {
+	if x != nil {
+		return x.Crop
+	}
+	return nil
+}
+
+func (x *InternalImageTransform) GetWatermarkText() string {
+	if x != nil {
+		return x.WatermarkText
+	}
+	return ""
+}
+
+func (x *InternalImageTransform) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *InternalImageTransform) GetQuality() int32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+// InternalImageCrop 图片裁剪区域（像素）
+type InternalImageCrop struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 左上角横坐标
+	X int32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	// 左上角纵坐标
+	Y int32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	// 宽度
+	Width int32 `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	// 高度
+	Height        int32 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalImageCrop) Reset() {
+	*x = InternalImageCrop{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalImageCrop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalImageCrop) ProtoMessage() {}
+
+func (x *InternalImageCrop) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalImageCrop.ProtoReflect.Descriptor instead.
+func (*InternalImageCrop) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *InternalImageCrop) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *InternalImageCrop) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *InternalImageCrop) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *InternalImageCrop) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
 // InternalGetDownloadUrlsRequest 内部批量获取下载URL请求
 type InternalGetDownloadUrlsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -923,7 +1123,7 @@ type InternalGetDownloadUrlsRequest struct {
 
 func (x *InternalGetDownloadUrlsRequest) Reset() {
 	*x = InternalGetDownloadUrlsRequest{}
-	mi := &file_resource_v1_resource_internal_proto_msgTypes[11]
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -935,7 +1135,7 @@ func (x *InternalGetDownloadUrlsRequest) String() string {
 func (*InternalGetDownloadUrlsRequest) ProtoMessage() {}
 
 func (x *InternalGetDownloadUrlsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_internal_proto_msgTypes[11]
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -948,7 +1148,7 @@ func (x *InternalGetDownloadUrlsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalGetDownloadUrlsRequest.ProtoReflect.Descriptor instead.
 func (*InternalGetDownloadUrlsRequest) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{11}
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *InternalGetDownloadUrlsRequest) GetTenantId() uint32 {
@@ -985,7 +1185,7 @@ type InternalGetDownloadUrlsResponse struct {
 
 func (x *InternalGetDownloadUrlsResponse) Reset() {
 	*x = InternalGetDownloadUrlsResponse{}
-	mi := &file_resource_v1_resource_internal_proto_msgTypes[12]
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -997,7 +1197,7 @@ func (x *InternalGetDownloadUrlsResponse) String() string {
 func (*InternalGetDownloadUrlsResponse) ProtoMessage() {}
 
 func (x *InternalGetDownloadUrlsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_internal_proto_msgTypes[12]
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,7 +1210,7 @@ func (x *InternalGetDownloadUrlsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalGetDownloadUrlsResponse.ProtoReflect.Descriptor instead.
 func (*InternalGetDownloadUrlsResponse) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{12}
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *InternalGetDownloadUrlsResponse) GetResults() map[string]*InternalFileDownloadInfo {
@@ -1042,7 +1242,7 @@ type InternalCheckFileExistsRequest struct {
 
 func (x *InternalCheckFileExistsRequest) Reset() {
 	*x = InternalCheckFileExistsRequest{}
-	mi := &file_resource_v1_resource_internal_proto_msgTypes[13]
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1054,7 +1254,7 @@ func (x *InternalCheckFileExistsRequest) String() string {
 func (*InternalCheckFileExistsRequest) ProtoMessage() {}
 
 func (x *InternalCheckFileExistsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_internal_proto_msgTypes[13]
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,7 +1267,7 @@ func (x *InternalCheckFileExistsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalCheckFileExistsRequest.ProtoReflect.Descriptor instead.
 func (*InternalCheckFileExistsRequest) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{13}
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *InternalCheckFileExistsRequest) GetTenantId() uint32 {
@@ -1104,7 +1304,7 @@ type InternalCheckFileExistsResponse struct {
 
 func (x *InternalCheckFileExistsResponse) Reset() {
 	*x = InternalCheckFileExistsResponse{}
-	mi := &file_resource_v1_resource_internal_proto_msgTypes[14]
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1116,7 +1316,7 @@ func (x *InternalCheckFileExistsResponse) String() string {
 func (*InternalCheckFileExistsResponse) ProtoMessage() {}
 
 func (x *InternalCheckFileExistsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_internal_proto_msgTypes[14]
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1129,7 +1329,7 @@ func (x *InternalCheckFileExistsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalCheckFileExistsResponse.ProtoReflect.Descriptor instead.
 func (*InternalCheckFileExistsResponse) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{14}
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *InternalCheckFileExistsResponse) GetExists() bool {
@@ -1146,30 +1346,32 @@ func (x *InternalCheckFileExistsResponse) GetFile() *InternalFileInfo {
 	return nil
 }
 
-// InternalGetQuotaRequest 内部获取配额请求
-type InternalGetQuotaRequest struct {
+// InternalFilesExistRequest 内部批量检查文件ID是否存在请求
+type InternalFilesExistRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 租户ID（必填）
-	TenantId      uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 文件ID列表（必填，最多100个）
+	FileIds       []string `protobuf:"bytes,2,rep,name=file_ids,json=fileIds,proto3" json:"file_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InternalGetQuotaRequest) Reset() {
-	*x = InternalGetQuotaRequest{}
-	mi := &file_resource_v1_resource_internal_proto_msgTypes[15]
+func (x *InternalFilesExistRequest) Reset() {
+	*x = InternalFilesExistRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InternalGetQuotaRequest) String() string {
+func (x *InternalFilesExistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalGetQuotaRequest) ProtoMessage() {}
+func (*InternalFilesExistRequest) ProtoMessage() {}
 
-func (x *InternalGetQuotaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_internal_proto_msgTypes[15]
+func (x *InternalFilesExistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1180,42 +1382,49 @@ func (x *InternalGetQuotaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalGetQuotaRequest.ProtoReflect.Descriptor instead.
-func (*InternalGetQuotaRequest) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use InternalFilesExistRequest.ProtoReflect.Descriptor instead.
+func (*InternalFilesExistRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *InternalGetQuotaRequest) GetTenantId() uint32 {
+func (x *InternalFilesExistRequest) GetTenantId() uint32 {
 	if x != nil {
 		return x.TenantId
 	}
 	return 0
 }
 
-// InternalGetQuotaResponse 内部获取配额响应
-type InternalGetQuotaResponse struct {
+func (x *InternalFilesExistRequest) GetFileIds() []string {
+	if x != nil {
+		return x.FileIds
+	}
+	return nil
+}
+
+// InternalFilesExistResponse 内部批量检查文件ID是否存在响应
+type InternalFilesExistResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 配额信息
-	Quota         *InternalQuotaInfo `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	// 文件是否存在（file_id -> exists），包含请求中的每个文件ID
+	Exists        map[string]bool `protobuf:"bytes,1,rep,name=exists,proto3" json:"exists,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InternalGetQuotaResponse) Reset() {
-	*x = InternalGetQuotaResponse{}
-	mi := &file_resource_v1_resource_internal_proto_msgTypes[16]
+func (x *InternalFilesExistResponse) Reset() {
+	*x = InternalFilesExistResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InternalGetQuotaResponse) String() string {
+func (x *InternalFilesExistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalGetQuotaResponse) ProtoMessage() {}
+func (*InternalFilesExistResponse) ProtoMessage() {}
 
-func (x *InternalGetQuotaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_internal_proto_msgTypes[16]
+func (x *InternalFilesExistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1226,46 +1435,54 @@ func (x *InternalGetQuotaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalGetQuotaResponse.ProtoReflect.Descriptor instead.
-func (*InternalGetQuotaResponse) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use InternalFilesExistResponse.ProtoReflect.Descriptor instead.
+func (*InternalFilesExistResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *InternalGetQuotaResponse) GetQuota() *InternalQuotaInfo {
+func (x *InternalFilesExistResponse) GetExists() map[string]bool {
 	if x != nil {
-		return x.Quota
+		return x.Exists
 	}
 	return nil
 }
 
-// InternalCheckQuotaRequest 内部检查配额请求
-type InternalCheckQuotaRequest struct {
+// InternalListFilesRequest 内部分页列出文件请求
+type InternalListFilesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 租户ID（必填）
 	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	// 检查类型（必填）：upload, download, storage
-	CheckType string `protobuf:"bytes,2,opt,name=check_type,json=checkType,proto3" json:"check_type,omitempty"`
-	// 预计使用量（字节，用于upload和storage检查）
-	Size          int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// 目录（可选），只返回该目录下的文件
+	Folder string `protobuf:"bytes,2,opt,name=folder,proto3" json:"folder,omitempty"`
+	// 文件大类（可选）：image, video, document, audio, archive, other
+	FileCategory string `protobuf:"bytes,3,opt,name=file_category,json=fileCategory,proto3" json:"file_category,omitempty"`
+	// 文件状态（可选），默认 completed
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// 每页数量（可选），默认50，最大200
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// 分页游标（可选），第一页为空
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// 标签（可选），只返回带有该标签的文件
+	Tag           string `protobuf:"bytes,7,opt,name=tag,proto3" json:"tag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InternalCheckQuotaRequest) Reset() {
-	*x = InternalCheckQuotaRequest{}
-	mi := &file_resource_v1_resource_internal_proto_msgTypes[17]
+func (x *InternalListFilesRequest) Reset() {
+	*x = InternalListFilesRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InternalCheckQuotaRequest) String() string {
+func (x *InternalListFilesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalCheckQuotaRequest) ProtoMessage() {}
+func (*InternalListFilesRequest) ProtoMessage() {}
 
-func (x *InternalCheckQuotaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_internal_proto_msgTypes[17]
+func (x *InternalListFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1276,60 +1493,88 @@ func (x *InternalCheckQuotaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalCheckQuotaRequest.ProtoReflect.Descriptor instead.
-func (*InternalCheckQuotaRequest) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use InternalListFilesRequest.ProtoReflect.Descriptor instead.
+func (*InternalListFilesRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *InternalCheckQuotaRequest) GetTenantId() uint32 {
+func (x *InternalListFilesRequest) GetTenantId() uint32 {
 	if x != nil {
 		return x.TenantId
 	}
 	return 0
 }
 
-func (x *InternalCheckQuotaRequest) GetCheckType() string {
+func (x *InternalListFilesRequest) GetFolder() string {
 	if x != nil {
-		return x.CheckType
+		return x.Folder
 	}
 	return ""
 }
 
-func (x *InternalCheckQuotaRequest) GetSize() int64 {
+func (x *InternalListFilesRequest) GetFileCategory() string {
 	if x != nil {
-		return x.Size
+		return x.FileCategory
+	}
+	return ""
+}
+
+func (x *InternalListFilesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InternalListFilesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
 	}
 	return 0
 }
 
-// InternalCheckQuotaResponse 内部检查配额响应
-type InternalCheckQuotaResponse struct {
+func (x *InternalListFilesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *InternalListFilesRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// InternalListFilesResponse 内部分页列出文件响应
+type InternalListFilesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 是否允许操作
-	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
-	// 不允许的原因（allowed=false时）
-	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	// 当前配额信息
-	Quota         *InternalQuotaInfo `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	// 文件列表
+	Files []*InternalFileInfo `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	// 下一页的游标，为空时表示没有更多数据
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// 符合条件的文件总数
+	Total         int64 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InternalCheckQuotaResponse) Reset() {
-	*x = InternalCheckQuotaResponse{}
-	mi := &file_resource_v1_resource_internal_proto_msgTypes[18]
+func (x *InternalListFilesResponse) Reset() {
+	*x = InternalListFilesResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InternalCheckQuotaResponse) String() string {
+func (x *InternalListFilesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalCheckQuotaResponse) ProtoMessage() {}
+func (*InternalListFilesResponse) ProtoMessage() {}
 
-func (x *InternalCheckQuotaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_v1_resource_internal_proto_msgTypes[18]
+func (x *InternalListFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1340,37 +1585,3503 @@ func (x *InternalCheckQuotaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalCheckQuotaResponse.ProtoReflect.Descriptor instead.
-func (*InternalCheckQuotaResponse) Descriptor() ([]byte, []int) {
-	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use InternalListFilesResponse.ProtoReflect.Descriptor instead.
+func (*InternalListFilesResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *InternalListFilesResponse) GetFiles() []*InternalFileInfo {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *InternalListFilesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *InternalListFilesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// InternalUpdateFileMetadataRequest 内部更新文件元数据请求
+type InternalUpdateFileMetadataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 文件ID（必填）
+	FileId string `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// 新文件名（可选），为空时不修改
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// 合并到自定义元数据的键值（可选），值为空字符串时删除该键
+	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 添加的标签（可选），已存在的标签忽略
+	AddTags []string `protobuf:"bytes,5,rep,name=add_tags,json=addTags,proto3" json:"add_tags,omitempty"`
+	// 删除的标签（可选），不存在的标签忽略
+	RemoveTags    []string `protobuf:"bytes,6,rep,name=remove_tags,json=removeTags,proto3" json:"remove_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalUpdateFileMetadataRequest) Reset() {
+	*x = InternalUpdateFileMetadataRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalUpdateFileMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalUpdateFileMetadataRequest) ProtoMessage() {}
+
+func (x *InternalUpdateFileMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalUpdateFileMetadataRequest.ProtoReflect.Descriptor instead.
+func (*InternalUpdateFileMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *InternalUpdateFileMetadataRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalUpdateFileMetadataRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *InternalUpdateFileMetadataRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *InternalUpdateFileMetadataRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *InternalUpdateFileMetadataRequest) GetAddTags() []string {
+	if x != nil {
+		return x.AddTags
+	}
+	return nil
+}
+
+func (x *InternalUpdateFileMetadataRequest) GetRemoveTags() []string {
+	if x != nil {
+		return x.RemoveTags
+	}
+	return nil
+}
+
+// InternalUpdateFileMetadataResponse 内部更新文件元数据响应
+type InternalUpdateFileMetadataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 更新后的文件信息
+	File          *InternalFileInfo `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalUpdateFileMetadataResponse) Reset() {
+	*x = InternalUpdateFileMetadataResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalUpdateFileMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalUpdateFileMetadataResponse) ProtoMessage() {}
+
+func (x *InternalUpdateFileMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalUpdateFileMetadataResponse.ProtoReflect.Descriptor instead.
+func (*InternalUpdateFileMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InternalUpdateFileMetadataResponse) GetFile() *InternalFileInfo {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+// InternalDeleteFileRequest 内部删除文件请求
+type InternalDeleteFileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 文件ID（必填）
+	FileId string `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// 是否永久删除（可选，默认false为软删除）
+	Permanent     bool `protobuf:"varint,3,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalDeleteFileRequest) Reset() {
+	*x = InternalDeleteFileRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalDeleteFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalDeleteFileRequest) ProtoMessage() {}
+
+func (x *InternalDeleteFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalDeleteFileRequest.ProtoReflect.Descriptor instead.
+func (*InternalDeleteFileRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *InternalDeleteFileRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalDeleteFileRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *InternalDeleteFileRequest) GetPermanent() bool {
+	if x != nil {
+		return x.Permanent
+	}
+	return false
+}
+
+// InternalDeleteFileResponse 内部删除文件响应
+type InternalDeleteFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalDeleteFileResponse) Reset() {
+	*x = InternalDeleteFileResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalDeleteFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalDeleteFileResponse) ProtoMessage() {}
+
+func (x *InternalDeleteFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalDeleteFileResponse.ProtoReflect.Descriptor instead.
+func (*InternalDeleteFileResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{24}
+}
+
+// InternalDeleteResult 单个文件的删除结果
+type InternalDeleteResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否删除成功
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// 错误信息（success=false时）
+	Error         string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalDeleteResult) Reset() {
+	*x = InternalDeleteResult{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalDeleteResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalDeleteResult) ProtoMessage() {}
+
+func (x *InternalDeleteResult) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalDeleteResult.ProtoReflect.Descriptor instead.
+func (*InternalDeleteResult) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *InternalDeleteResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *InternalDeleteResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// InternalBatchDeleteFilesRequest 内部批量删除文件请求
+type InternalBatchDeleteFilesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 文件ID列表（必填，最多100个）
+	FileIds []string `protobuf:"bytes,2,rep,name=file_ids,json=fileIds,proto3" json:"file_ids,omitempty"`
+	// 是否永久删除（可选，默认false为软删除）
+	Permanent     bool `protobuf:"varint,3,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalBatchDeleteFilesRequest) Reset() {
+	*x = InternalBatchDeleteFilesRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalBatchDeleteFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalBatchDeleteFilesRequest) ProtoMessage() {}
+
+func (x *InternalBatchDeleteFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalBatchDeleteFilesRequest.ProtoReflect.Descriptor instead.
+func (*InternalBatchDeleteFilesRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *InternalBatchDeleteFilesRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalBatchDeleteFilesRequest) GetFileIds() []string {
+	if x != nil {
+		return x.FileIds
+	}
+	return nil
+}
+
+func (x *InternalBatchDeleteFilesRequest) GetPermanent() bool {
+	if x != nil {
+		return x.Permanent
+	}
+	return false
+}
+
+// InternalBatchDeleteFilesResponse 内部批量删除文件响应
+type InternalBatchDeleteFilesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// file_id -> 删除结果映射
+	Results       map[string]*InternalDeleteResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalBatchDeleteFilesResponse) Reset() {
+	*x = InternalBatchDeleteFilesResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalBatchDeleteFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalBatchDeleteFilesResponse) ProtoMessage() {}
+
+func (x *InternalBatchDeleteFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalBatchDeleteFilesResponse.ProtoReflect.Descriptor instead.
+func (*InternalBatchDeleteFilesResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *InternalBatchDeleteFilesResponse) GetResults() map[string]*InternalDeleteResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// InternalFolderInfo 内部目录信息
+type InternalFolderInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 目录完整路径，如 products/10086
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// 目录名，路径的最后一段
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 直接包含的文件数
+	FileCount int64 `protobuf:"varint,3,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+	// 直接包含的子目录数
+	FolderCount int64 `protobuf:"varint,4,opt,name=folder_count,json=folderCount,proto3" json:"folder_count,omitempty"`
+	// 创建时间
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalFolderInfo) Reset() {
+	*x = InternalFolderInfo{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalFolderInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalFolderInfo) ProtoMessage() {}
+
+func (x *InternalFolderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalFolderInfo.ProtoReflect.Descriptor instead.
+func (*InternalFolderInfo) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *InternalFolderInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *InternalFolderInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InternalFolderInfo) GetFileCount() int64 {
+	if x != nil {
+		return x.FileCount
+	}
+	return 0
+}
+
+func (x *InternalFolderInfo) GetFolderCount() int64 {
+	if x != nil {
+		return x.FolderCount
+	}
+	return 0
+}
+
+func (x *InternalFolderInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// InternalCreateFolderRequest 内部创建目录请求
+type InternalCreateFolderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 目录路径（必填），以 / 分隔，如 products/10086
+	Path          string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCreateFolderRequest) Reset() {
+	*x = InternalCreateFolderRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCreateFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCreateFolderRequest) ProtoMessage() {}
+
+func (x *InternalCreateFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCreateFolderRequest.ProtoReflect.Descriptor instead.
+func (*InternalCreateFolderRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *InternalCreateFolderRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalCreateFolderRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// InternalCreateFolderResponse 内部创建目录响应
+type InternalCreateFolderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 目录信息
+	Folder        *InternalFolderInfo `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCreateFolderResponse) Reset() {
+	*x = InternalCreateFolderResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCreateFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCreateFolderResponse) ProtoMessage() {}
+
+func (x *InternalCreateFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCreateFolderResponse.ProtoReflect.Descriptor instead.
+func (*InternalCreateFolderResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *InternalCreateFolderResponse) GetFolder() *InternalFolderInfo {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+// InternalListFoldersRequest 内部列出子目录请求
+type InternalListFoldersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 上级目录路径（可选），为空时列出根目录
+	Parent        string `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListFoldersRequest) Reset() {
+	*x = InternalListFoldersRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListFoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListFoldersRequest) ProtoMessage() {}
+
+func (x *InternalListFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListFoldersRequest.ProtoReflect.Descriptor instead.
+func (*InternalListFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *InternalListFoldersRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalListFoldersRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+// InternalListFoldersResponse 内部列出子目录响应
+type InternalListFoldersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 子目录列表，按名称排序
+	Folders       []*InternalFolderInfo `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListFoldersResponse) Reset() {
+	*x = InternalListFoldersResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListFoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListFoldersResponse) ProtoMessage() {}
+
+func (x *InternalListFoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListFoldersResponse.ProtoReflect.Descriptor instead.
+func (*InternalListFoldersResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *InternalListFoldersResponse) GetFolders() []*InternalFolderInfo {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+// InternalDeleteFolderRequest 内部删除目录请求
+type InternalDeleteFolderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 目录路径（必填）
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// 是否同时删除子目录和文件（可选），为 false 且目录不为空时返回 FailedPrecondition
+	Recursive     bool `protobuf:"varint,3,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalDeleteFolderRequest) Reset() {
+	*x = InternalDeleteFolderRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalDeleteFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalDeleteFolderRequest) ProtoMessage() {}
+
+func (x *InternalDeleteFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalDeleteFolderRequest.ProtoReflect.Descriptor instead.
+func (*InternalDeleteFolderRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *InternalDeleteFolderRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalDeleteFolderRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *InternalDeleteFolderRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+// InternalDeleteFolderResponse 内部删除目录响应
+type InternalDeleteFolderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 删除的文件数
+	DeletedFiles  int64 `protobuf:"varint,1,opt,name=deleted_files,json=deletedFiles,proto3" json:"deleted_files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalDeleteFolderResponse) Reset() {
+	*x = InternalDeleteFolderResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalDeleteFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalDeleteFolderResponse) ProtoMessage() {}
+
+func (x *InternalDeleteFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalDeleteFolderResponse.ProtoReflect.Descriptor instead.
+func (*InternalDeleteFolderResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InternalDeleteFolderResponse) GetDeletedFiles() int64 {
+	if x != nil {
+		return x.DeletedFiles
+	}
+	return 0
+}
+
+// InternalGetQuotaRequest 内部获取配额请求
+type InternalGetQuotaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId      uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetQuotaRequest) Reset() {
+	*x = InternalGetQuotaRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetQuotaRequest) ProtoMessage() {}
+
+func (x *InternalGetQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetQuotaRequest.ProtoReflect.Descriptor instead.
+func (*InternalGetQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *InternalGetQuotaRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+// InternalGetQuotaResponse 内部获取配额响应
+type InternalGetQuotaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 配额信息
+	Quota         *InternalQuotaInfo `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetQuotaResponse) Reset() {
+	*x = InternalGetQuotaResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetQuotaResponse) ProtoMessage() {}
+
+func (x *InternalGetQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetQuotaResponse.ProtoReflect.Descriptor instead.
+func (*InternalGetQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *InternalGetQuotaResponse) GetQuota() *InternalQuotaInfo {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// InternalGetUsageRequest 内部获取存储用量请求
+type InternalGetUsageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId      uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetUsageRequest) Reset() {
+	*x = InternalGetUsageRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetUsageRequest) ProtoMessage() {}
+
+func (x *InternalGetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetUsageRequest.ProtoReflect.Descriptor instead.
+func (*InternalGetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *InternalGetUsageRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+// InternalCategoryUsage 单个文件大类的用量
+type InternalCategoryUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 已用存储（字节）
+	StorageUsed int64 `protobuf:"varint,1,opt,name=storage_used,json=storageUsed,proto3" json:"storage_used,omitempty"`
+	// 文件数
+	FileCount     int64 `protobuf:"varint,2,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCategoryUsage) Reset() {
+	*x = InternalCategoryUsage{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCategoryUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCategoryUsage) ProtoMessage() {}
+
+func (x *InternalCategoryUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCategoryUsage.ProtoReflect.Descriptor instead.
+func (*InternalCategoryUsage) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *InternalCategoryUsage) GetStorageUsed() int64 {
+	if x != nil {
+		return x.StorageUsed
+	}
+	return 0
+}
+
+func (x *InternalCategoryUsage) GetFileCount() int64 {
+	if x != nil {
+		return x.FileCount
+	}
+	return 0
+}
+
+// InternalGetUsageResponse 内部获取存储用量响应
+type InternalGetUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 配额信息
+	Quota *InternalQuotaInfo `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	// 按文件大类统计的用量（image, video, document, audio, archive, other -> 用量）
+	Categories    map[string]*InternalCategoryUsage `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetUsageResponse) Reset() {
+	*x = InternalGetUsageResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetUsageResponse) ProtoMessage() {}
+
+func (x *InternalGetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetUsageResponse.ProtoReflect.Descriptor instead.
+func (*InternalGetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *InternalGetUsageResponse) GetQuota() *InternalQuotaInfo {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *InternalGetUsageResponse) GetCategories() map[string]*InternalCategoryUsage {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// InternalCheckQuotaRequest 内部检查配额请求
+type InternalCheckQuotaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 检查类型（必填）：upload, download, storage
+	CheckType string `protobuf:"bytes,2,opt,name=check_type,json=checkType,proto3" json:"check_type,omitempty"`
+	// 预计使用量（字节，用于upload和storage检查）
+	Size          int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCheckQuotaRequest) Reset() {
+	*x = InternalCheckQuotaRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCheckQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCheckQuotaRequest) ProtoMessage() {}
+
+func (x *InternalCheckQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCheckQuotaRequest.ProtoReflect.Descriptor instead.
+func (*InternalCheckQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *InternalCheckQuotaRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalCheckQuotaRequest) GetCheckType() string {
+	if x != nil {
+		return x.CheckType
+	}
+	return ""
+}
+
+func (x *InternalCheckQuotaRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// InternalCheckQuotaResponse 内部检查配额响应
+type InternalCheckQuotaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否允许操作
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// 不允许的原因（allowed=false时）
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 当前配额信息
+	Quota         *InternalQuotaInfo `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCheckQuotaResponse) Reset() {
+	*x = InternalCheckQuotaResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCheckQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCheckQuotaResponse) ProtoMessage() {}
+
+func (x *InternalCheckQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCheckQuotaResponse.ProtoReflect.Descriptor instead.
+func (*InternalCheckQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *InternalCheckQuotaResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *InternalCheckQuotaResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *InternalCheckQuotaResponse) GetQuota() *InternalQuotaInfo {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// InternalGenerateQRCodeRequest 内部生成二维码请求
+type InternalGenerateQRCodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 二维码内容（必填）
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// 图片边长（像素，可选），默认256
+	Size int32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// 中心logo的文件ID（可选）
+	LogoFileId string `protobuf:"bytes,4,opt,name=logo_file_id,json=logoFileId,proto3" json:"logo_file_id,omitempty"`
+	// 容错级别（可选）：L, M, Q, H，默认M；叠加logo时建议使用H
+	ErrorCorrection string `protobuf:"bytes,5,opt,name=error_correction,json=errorCorrection,proto3" json:"error_correction,omitempty"`
+	// URL有效期（秒，可选），默认3600
+	ExpiresIn     int64 `protobuf:"varint,6,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGenerateQRCodeRequest) Reset() {
+	*x = InternalGenerateQRCodeRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGenerateQRCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGenerateQRCodeRequest) ProtoMessage() {}
+
+func (x *InternalGenerateQRCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGenerateQRCodeRequest.ProtoReflect.Descriptor instead.
+func (*InternalGenerateQRCodeRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *InternalGenerateQRCodeRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalGenerateQRCodeRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *InternalGenerateQRCodeRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *InternalGenerateQRCodeRequest) GetLogoFileId() string {
+	if x != nil {
+		return x.LogoFileId
+	}
+	return ""
+}
+
+func (x *InternalGenerateQRCodeRequest) GetErrorCorrection() string {
+	if x != nil {
+		return x.ErrorCorrection
+	}
+	return ""
+}
+
+func (x *InternalGenerateQRCodeRequest) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// InternalGenerateQRCodeResponse 内部生成二维码响应
+type InternalGenerateQRCodeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 生成的二维码文件信息
+	File *InternalFileInfo `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// 二维码访问URL
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// URL过期时间（秒）
+	ExpiresIn     int64 `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGenerateQRCodeResponse) Reset() {
+	*x = InternalGenerateQRCodeResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGenerateQRCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGenerateQRCodeResponse) ProtoMessage() {}
+
+func (x *InternalGenerateQRCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGenerateQRCodeResponse.ProtoReflect.Descriptor instead.
+func (*InternalGenerateQRCodeResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *InternalGenerateQRCodeResponse) GetFile() *InternalFileInfo {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *InternalGenerateQRCodeResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *InternalGenerateQRCodeResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// InternalArchiveInfo 打包下载任务信息
+type InternalArchiveInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 任务ID
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// 任务状态：pending, processing, completed, failed
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// 打包格式：zip, tar.gz
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// 打包文件的下载URL（completed 时）
+	DownloadUrl string `protobuf:"bytes,4,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	// 打包文件大小（字节，completed 时）
+	Size int64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// URL有效期（秒）
+	ExpiresIn int64 `protobuf:"varint,6,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	// 未能打包的文件ID（不存在或无权访问），其余文件正常打包
+	FailedIds []string `protobuf:"bytes,7,rep,name=failed_ids,json=failedIds,proto3" json:"failed_ids,omitempty"`
+	// 失败原因（failed 时）
+	Error         string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalArchiveInfo) Reset() {
+	*x = InternalArchiveInfo{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalArchiveInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalArchiveInfo) ProtoMessage() {}
+
+func (x *InternalArchiveInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalArchiveInfo.ProtoReflect.Descriptor instead.
+func (*InternalArchiveInfo) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *InternalArchiveInfo) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *InternalArchiveInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InternalArchiveInfo) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *InternalArchiveInfo) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *InternalArchiveInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *InternalArchiveInfo) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *InternalArchiveInfo) GetFailedIds() []string {
+	if x != nil {
+		return x.FailedIds
+	}
+	return nil
+}
+
+func (x *InternalArchiveInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// InternalCreateArchiveRequest 内部创建打包下载任务请求
+type InternalCreateArchiveRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 文件ID列表（必填，最多1000个）
+	FileIds []string `protobuf:"bytes,2,rep,name=file_ids,json=fileIds,proto3" json:"file_ids,omitempty"`
+	// 打包格式（可选）：zip, tar.gz，默认zip
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// 打包文件名（可选，不含扩展名），默认为任务ID
+	Filename string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	// URL有效期（秒，可选），默认3600
+	ExpiresIn     int64 `protobuf:"varint,5,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCreateArchiveRequest) Reset() {
+	*x = InternalCreateArchiveRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCreateArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCreateArchiveRequest) ProtoMessage() {}
+
+func (x *InternalCreateArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCreateArchiveRequest.ProtoReflect.Descriptor instead.
+func (*InternalCreateArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *InternalCreateArchiveRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalCreateArchiveRequest) GetFileIds() []string {
+	if x != nil {
+		return x.FileIds
+	}
+	return nil
+}
+
+func (x *InternalCreateArchiveRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *InternalCreateArchiveRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *InternalCreateArchiveRequest) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// InternalCreateArchiveResponse 内部创建打包下载任务响应
+type InternalCreateArchiveResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 任务信息
+	Archive       *InternalArchiveInfo `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCreateArchiveResponse) Reset() {
+	*x = InternalCreateArchiveResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCreateArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCreateArchiveResponse) ProtoMessage() {}
+
+func (x *InternalCreateArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCreateArchiveResponse.ProtoReflect.Descriptor instead.
+func (*InternalCreateArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *InternalCreateArchiveResponse) GetArchive() *InternalArchiveInfo {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+// InternalGetArchiveRequest 内部查询打包下载任务请求
+type InternalGetArchiveRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 任务ID（必填）
+	JobId string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// URL有效期（秒，可选），默认3600
+	ExpiresIn     int64 `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetArchiveRequest) Reset() {
+	*x = InternalGetArchiveRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetArchiveRequest) ProtoMessage() {}
+
+func (x *InternalGetArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetArchiveRequest.ProtoReflect.Descriptor instead.
+func (*InternalGetArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *InternalGetArchiveRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalGetArchiveRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *InternalGetArchiveRequest) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// InternalGetArchiveResponse 内部查询打包下载任务响应
+type InternalGetArchiveResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 任务信息
+	Archive       *InternalArchiveInfo `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetArchiveResponse) Reset() {
+	*x = InternalGetArchiveResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetArchiveResponse) ProtoMessage() {}
+
+func (x *InternalGetArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetArchiveResponse.ProtoReflect.Descriptor instead.
+func (*InternalGetArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *InternalGetArchiveResponse) GetArchive() *InternalArchiveInfo {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+// InternalShareLinkInfo 分享链接信息
+type InternalShareLinkInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 分享ID
+	ShareId string `protobuf:"bytes,1,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	// 文件ID
+	FileId string `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// 分享链接
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// 是否需要访问密码
+	HasPassword bool `protobuf:"varint,4,opt,name=has_password,json=hasPassword,proto3" json:"has_password,omitempty"`
+	// 过期时间
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// 最大下载次数，0表示不限制
+	MaxDownloads int32 `protobuf:"varint,6,opt,name=max_downloads,json=maxDownloads,proto3" json:"max_downloads,omitempty"`
+	// 已下载次数
+	DownloadCount int32 `protobuf:"varint,7,opt,name=download_count,json=downloadCount,proto3" json:"download_count,omitempty"`
+	// 创建时间
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalShareLinkInfo) Reset() {
+	*x = InternalShareLinkInfo{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalShareLinkInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalShareLinkInfo) ProtoMessage() {}
+
+func (x *InternalShareLinkInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalShareLinkInfo.ProtoReflect.Descriptor instead.
+func (*InternalShareLinkInfo) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *InternalShareLinkInfo) GetShareId() string {
+	if x != nil {
+		return x.ShareId
+	}
+	return ""
+}
+
+func (x *InternalShareLinkInfo) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *InternalShareLinkInfo) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *InternalShareLinkInfo) GetHasPassword() bool {
+	if x != nil {
+		return x.HasPassword
+	}
+	return false
+}
+
+func (x *InternalShareLinkInfo) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *InternalShareLinkInfo) GetMaxDownloads() int32 {
+	if x != nil {
+		return x.MaxDownloads
+	}
+	return 0
+}
+
+func (x *InternalShareLinkInfo) GetDownloadCount() int32 {
+	if x != nil {
+		return x.DownloadCount
+	}
+	return 0
+}
+
+func (x *InternalShareLinkInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// InternalCreateShareLinkRequest 内部创建分享链接请求
+type InternalCreateShareLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 文件ID（必填）
+	FileId string `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// 有效期（秒，可选），默认7天，最长30天
+	ExpiresIn int64 `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	// 访问密码（可选），为空时不需要密码
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// 最大下载次数（可选），0表示不限制
+	MaxDownloads  int32 `protobuf:"varint,5,opt,name=max_downloads,json=maxDownloads,proto3" json:"max_downloads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCreateShareLinkRequest) Reset() {
+	*x = InternalCreateShareLinkRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCreateShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCreateShareLinkRequest) ProtoMessage() {}
+
+func (x *InternalCreateShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCreateShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*InternalCreateShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *InternalCreateShareLinkRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalCreateShareLinkRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *InternalCreateShareLinkRequest) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *InternalCreateShareLinkRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *InternalCreateShareLinkRequest) GetMaxDownloads() int32 {
+	if x != nil {
+		return x.MaxDownloads
+	}
+	return 0
+}
+
+// InternalCreateShareLinkResponse 内部创建分享链接响应
+type InternalCreateShareLinkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 分享链接信息
+	Share         *InternalShareLinkInfo `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCreateShareLinkResponse) Reset() {
+	*x = InternalCreateShareLinkResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCreateShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCreateShareLinkResponse) ProtoMessage() {}
+
+func (x *InternalCreateShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCreateShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*InternalCreateShareLinkResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *InternalCreateShareLinkResponse) GetShare() *InternalShareLinkInfo {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+// InternalRevokeShareLinkRequest 内部撤销分享链接请求
+type InternalRevokeShareLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 分享ID（必填）
+	ShareId       string `protobuf:"bytes,2,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalRevokeShareLinkRequest) Reset() {
+	*x = InternalRevokeShareLinkRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalRevokeShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalRevokeShareLinkRequest) ProtoMessage() {}
+
+func (x *InternalRevokeShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalRevokeShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*InternalRevokeShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *InternalRevokeShareLinkRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalRevokeShareLinkRequest) GetShareId() string {
+	if x != nil {
+		return x.ShareId
+	}
+	return ""
+}
+
+// InternalRevokeShareLinkResponse 内部撤销分享链接响应
+type InternalRevokeShareLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalRevokeShareLinkResponse) Reset() {
+	*x = InternalRevokeShareLinkResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalRevokeShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalRevokeShareLinkResponse) ProtoMessage() {}
+
+func (x *InternalRevokeShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalRevokeShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*InternalRevokeShareLinkResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{53}
+}
+
+// InternalScanStatus 文件安全检查结果
+type InternalScanStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 文件ID
+	FileId string `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// 病毒扫描结果：pending, clean, infected, error（扫描失败，资源服务会重新扫描）
+	Antivirus string `protobuf:"bytes,2,opt,name=antivirus,proto3" json:"antivirus,omitempty"`
+	// 内容审核结果：pending, pass, review（等待人工复审）, block, skipped（不需要审核的文件类型）
+	Moderation string `protobuf:"bytes,3,opt,name=moderation,proto3" json:"moderation,omitempty"`
+	// 审核命中的标签（如 porn, politics, ad）
+	Labels []string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty"`
+	// 未通过的原因（infected 或 block 时）
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 最近一次检查完成的时间
+	ScannedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=scanned_at,json=scannedAt,proto3" json:"scanned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalScanStatus) Reset() {
+	*x = InternalScanStatus{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalScanStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalScanStatus) ProtoMessage() {}
+
+func (x *InternalScanStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalScanStatus.ProtoReflect.Descriptor instead.
+func (*InternalScanStatus) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *InternalScanStatus) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *InternalScanStatus) GetAntivirus() string {
+	if x != nil {
+		return x.Antivirus
+	}
+	return ""
+}
+
+func (x *InternalScanStatus) GetModeration() string {
+	if x != nil {
+		return x.Moderation
+	}
+	return ""
+}
+
+func (x *InternalScanStatus) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *InternalScanStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *InternalScanStatus) GetScannedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScannedAt
+	}
+	return nil
+}
+
+// InternalGetScanStatusRequest 内部获取安全检查结果请求
+type InternalGetScanStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 文件ID（必填）
+	FileId        string `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetScanStatusRequest) Reset() {
+	*x = InternalGetScanStatusRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetScanStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetScanStatusRequest) ProtoMessage() {}
+
+func (x *InternalGetScanStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetScanStatusRequest.ProtoReflect.Descriptor instead.
+func (*InternalGetScanStatusRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *InternalGetScanStatusRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalGetScanStatusRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+// InternalGetScanStatusResponse 内部获取安全检查结果响应
+type InternalGetScanStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 安全检查结果
+	Status        *InternalScanStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetScanStatusResponse) Reset() {
+	*x = InternalGetScanStatusResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetScanStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetScanStatusResponse) ProtoMessage() {}
+
+func (x *InternalGetScanStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetScanStatusResponse.ProtoReflect.Descriptor instead.
+func (*InternalGetScanStatusResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *InternalGetScanStatusResponse) GetStatus() *InternalScanStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// InternalUploadFileMeta 上传文件元信息
+type InternalUploadFileMeta struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 原始文件名（必填）
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// MIME类型（可选），为空时根据文件名推断
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// 目录（可选），如 "reports/2025"
+	Folder string `protobuf:"bytes,4,opt,name=folder,proto3" json:"folder,omitempty"`
+	// 是否公开访问
+	IsPublic bool `protobuf:"varint,5,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	// 文件大小（字节，可选），0表示未知，用于提前检查配额
+	Size          int64 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalUploadFileMeta) Reset() {
+	*x = InternalUploadFileMeta{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalUploadFileMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalUploadFileMeta) ProtoMessage() {}
+
+func (x *InternalUploadFileMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalUploadFileMeta.ProtoReflect.Descriptor instead.
+func (*InternalUploadFileMeta) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *InternalUploadFileMeta) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalUploadFileMeta) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *InternalUploadFileMeta) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *InternalUploadFileMeta) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *InternalUploadFileMeta) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *InternalUploadFileMeta) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// InternalUploadFileRequest 内部上传文件请求（流式）
+type InternalUploadFileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 文件元信息，只在第一条消息中设置
+	Meta *InternalUploadFileMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// 文件内容分片
+	Chunk         []byte `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalUploadFileRequest) Reset() {
+	*x = InternalUploadFileRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalUploadFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalUploadFileRequest) ProtoMessage() {}
+
+func (x *InternalUploadFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalUploadFileRequest.ProtoReflect.Descriptor instead.
+func (*InternalUploadFileRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *InternalUploadFileRequest) GetMeta() *InternalUploadFileMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *InternalUploadFileRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// InternalUploadFileResponse 内部上传文件响应
+type InternalUploadFileResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 上传后的文件信息
+	File *InternalFileInfo `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// 文件访问URL
+	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalUploadFileResponse) Reset() {
+	*x = InternalUploadFileResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalUploadFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalUploadFileResponse) ProtoMessage() {}
+
+func (x *InternalUploadFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalUploadFileResponse.ProtoReflect.Descriptor instead.
+func (*InternalUploadFileResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *InternalUploadFileResponse) GetFile() *InternalFileInfo {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *InternalUploadFileResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// InternalCreateUploadUrlRequest 内部创建预签名上传URL请求
+type InternalCreateUploadUrlRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 原始文件名（必填）
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// MIME类型（必填），上传时的 Content-Type 必须与其一致
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// 文件大小（字节，必填），用于检查配额和确认时校验
+	Size int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// 目录（可选）
+	Folder string `protobuf:"bytes,5,opt,name=folder,proto3" json:"folder,omitempty"`
+	// 是否公开访问
+	IsPublic bool `protobuf:"varint,6,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	// URL有效期（秒，可选），默认3600
+	ExpiresIn     int64 `protobuf:"varint,7,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCreateUploadUrlRequest) Reset() {
+	*x = InternalCreateUploadUrlRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCreateUploadUrlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCreateUploadUrlRequest) ProtoMessage() {}
+
+func (x *InternalCreateUploadUrlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCreateUploadUrlRequest.ProtoReflect.Descriptor instead.
+func (*InternalCreateUploadUrlRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *InternalCreateUploadUrlRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalCreateUploadUrlRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *InternalCreateUploadUrlRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *InternalCreateUploadUrlRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *InternalCreateUploadUrlRequest) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *InternalCreateUploadUrlRequest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *InternalCreateUploadUrlRequest) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// InternalCreateUploadUrlResponse 内部创建预签名上传URL响应
+type InternalCreateUploadUrlResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 文件ID，确认上传时使用
+	FileId string `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// 预签名上传URL
+	UploadUrl string `protobuf:"bytes,2,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	// 上传使用的HTTP方法，通常为 PUT
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	// 上传时必须携带的请求头
+	Headers map[string]string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// URL过期时间（秒）
+	ExpiresIn     int64 `protobuf:"varint,5,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCreateUploadUrlResponse) Reset() {
+	*x = InternalCreateUploadUrlResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCreateUploadUrlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCreateUploadUrlResponse) ProtoMessage() {}
+
+func (x *InternalCreateUploadUrlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCreateUploadUrlResponse.ProtoReflect.Descriptor instead.
+func (*InternalCreateUploadUrlResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *InternalCreateUploadUrlResponse) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *InternalCreateUploadUrlResponse) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *InternalCreateUploadUrlResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *InternalCreateUploadUrlResponse) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *InternalCreateUploadUrlResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// InternalConfirmUploadRequest 内部确认上传请求
+type InternalConfirmUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 文件ID（必填）
+	FileId        string `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalConfirmUploadRequest) Reset() {
+	*x = InternalConfirmUploadRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalConfirmUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalConfirmUploadRequest) ProtoMessage() {}
+
+func (x *InternalConfirmUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalConfirmUploadRequest.ProtoReflect.Descriptor instead.
+func (*InternalConfirmUploadRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *InternalConfirmUploadRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalConfirmUploadRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+// InternalConfirmUploadResponse 内部确认上传响应
+type InternalConfirmUploadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 上传完成后的文件信息
+	File *InternalFileInfo `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// 文件访问URL
+	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalConfirmUploadResponse) Reset() {
+	*x = InternalConfirmUploadResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalConfirmUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalConfirmUploadResponse) ProtoMessage() {}
+
+func (x *InternalConfirmUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalConfirmUploadResponse.ProtoReflect.Descriptor instead.
+func (*InternalConfirmUploadResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *InternalConfirmUploadResponse) GetFile() *InternalFileInfo {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *InternalConfirmUploadResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// InternalUploadedPart 已上传的分片
+type InternalUploadedPart struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 分片号，从1开始
+	PartNumber int32 `protobuf:"varint,1,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	// 分片大小（字节）
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// 分片内容的SHA256（十六进制）
+	ChecksumSha256 string `protobuf:"bytes,3,opt,name=checksum_sha256,json=checksumSha256,proto3" json:"checksum_sha256,omitempty"`
+	// 对象存储返回的ETag
+	Etag          string `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalUploadedPart) Reset() {
+	*x = InternalUploadedPart{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalUploadedPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalUploadedPart) ProtoMessage() {}
+
+func (x *InternalUploadedPart) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalUploadedPart.ProtoReflect.Descriptor instead.
+func (*InternalUploadedPart) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *InternalUploadedPart) GetPartNumber() int32 {
+	if x != nil {
+		return x.PartNumber
+	}
+	return 0
+}
+
+func (x *InternalUploadedPart) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *InternalUploadedPart) GetChecksumSha256() string {
+	if x != nil {
+		return x.ChecksumSha256
+	}
+	return ""
+}
+
+func (x *InternalUploadedPart) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// InternalInitMultipartUploadRequest 内部初始化分片上传请求
+type InternalInitMultipartUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 原始文件名（必填）
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// MIME类型（可选）
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// 文件大小（字节，必填）
+	Size int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// 期望的分片大小（字节，可选），服务端会调整到对象存储允许的范围内
+	PartSize int64 `protobuf:"varint,5,opt,name=part_size,json=partSize,proto3" json:"part_size,omitempty"`
+	// 目录（可选）
+	Folder string `protobuf:"bytes,6,opt,name=folder,proto3" json:"folder,omitempty"`
+	// 是否公开访问
+	IsPublic bool `protobuf:"varint,7,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	// 整个文件的SHA256（十六进制，可选），设置后完成上传时校验
+	ChecksumSha256 string `protobuf:"bytes,8,opt,name=checksum_sha256,json=checksumSha256,proto3" json:"checksum_sha256,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InternalInitMultipartUploadRequest) Reset() {
+	*x = InternalInitMultipartUploadRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalInitMultipartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalInitMultipartUploadRequest) ProtoMessage() {}
+
+func (x *InternalInitMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalInitMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*InternalInitMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *InternalInitMultipartUploadRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalInitMultipartUploadRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *InternalInitMultipartUploadRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *InternalInitMultipartUploadRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *InternalInitMultipartUploadRequest) GetPartSize() int64 {
+	if x != nil {
+		return x.PartSize
+	}
+	return 0
+}
+
+func (x *InternalInitMultipartUploadRequest) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *InternalInitMultipartUploadRequest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *InternalInitMultipartUploadRequest) GetChecksumSha256() string {
+	if x != nil {
+		return x.ChecksumSha256
+	}
+	return ""
+}
+
+// InternalInitMultipartUploadResponse 内部初始化分片上传响应
+type InternalInitMultipartUploadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 上传ID
+	UploadId string `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	// 文件ID
+	FileId string `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// 实际使用的分片大小（字节），除最后一个分片外每个分片都必须是这个大小
+	PartSize int64 `protobuf:"varint,3,opt,name=part_size,json=partSize,proto3" json:"part_size,omitempty"`
+	// 分片数量
+	PartCount     int32 `protobuf:"varint,4,opt,name=part_count,json=partCount,proto3" json:"part_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalInitMultipartUploadResponse) Reset() {
+	*x = InternalInitMultipartUploadResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalInitMultipartUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalInitMultipartUploadResponse) ProtoMessage() {}
+
+func (x *InternalInitMultipartUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalInitMultipartUploadResponse.ProtoReflect.Descriptor instead.
+func (*InternalInitMultipartUploadResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *InternalInitMultipartUploadResponse) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *InternalInitMultipartUploadResponse) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *InternalInitMultipartUploadResponse) GetPartSize() int64 {
+	if x != nil {
+		return x.PartSize
+	}
+	return 0
+}
+
+func (x *InternalInitMultipartUploadResponse) GetPartCount() int32 {
+	if x != nil {
+		return x.PartCount
+	}
+	return 0
+}
+
+// InternalUploadPartMeta 上传分片元信息
+type InternalUploadPartMeta struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 上传ID（必填）
+	UploadId string `protobuf:"bytes,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	// 分片号（必填），从1开始
+	PartNumber int32 `protobuf:"varint,3,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	// 分片大小（字节，必填）
+	Size int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// 分片内容的SHA256（十六进制，必填）
+	ChecksumSha256 string `protobuf:"bytes,5,opt,name=checksum_sha256,json=checksumSha256,proto3" json:"checksum_sha256,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InternalUploadPartMeta) Reset() {
+	*x = InternalUploadPartMeta{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalUploadPartMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalUploadPartMeta) ProtoMessage() {}
+
+func (x *InternalUploadPartMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalUploadPartMeta.ProtoReflect.Descriptor instead.
+func (*InternalUploadPartMeta) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *InternalUploadPartMeta) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalUploadPartMeta) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *InternalUploadPartMeta) GetPartNumber() int32 {
+	if x != nil {
+		return x.PartNumber
+	}
+	return 0
+}
+
+func (x *InternalUploadPartMeta) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *InternalUploadPartMeta) GetChecksumSha256() string {
+	if x != nil {
+		return x.ChecksumSha256
+	}
+	return ""
+}
+
+// InternalUploadPartRequest 内部上传分片请求（流式）
+type InternalUploadPartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 分片元信息，只在第一条消息中设置
+	Meta *InternalUploadPartMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// 分片内容
+	Chunk         []byte `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalUploadPartRequest) Reset() {
+	*x = InternalUploadPartRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalUploadPartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalUploadPartRequest) ProtoMessage() {}
+
+func (x *InternalUploadPartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalUploadPartRequest.ProtoReflect.Descriptor instead.
+func (*InternalUploadPartRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *InternalUploadPartRequest) GetMeta() *InternalUploadPartMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *InternalUploadPartRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// InternalUploadPartResponse 内部上传分片响应
+type InternalUploadPartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 服务端确认的分片信息
+	Part          *InternalUploadedPart `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalUploadPartResponse) Reset() {
+	*x = InternalUploadPartResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalUploadPartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalUploadPartResponse) ProtoMessage() {}
+
+func (x *InternalUploadPartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalUploadPartResponse.ProtoReflect.Descriptor instead.
+func (*InternalUploadPartResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *InternalUploadPartResponse) GetPart() *InternalUploadedPart {
+	if x != nil {
+		return x.Part
+	}
+	return nil
 }
 
-func (x *InternalCheckQuotaResponse) GetAllowed() bool {
+// InternalListUploadedPartsRequest 内部查询已上传分片请求
+type InternalListUploadedPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 上传ID（必填）
+	UploadId      string `protobuf:"bytes,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListUploadedPartsRequest) Reset() {
+	*x = InternalListUploadedPartsRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListUploadedPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListUploadedPartsRequest) ProtoMessage() {}
+
+func (x *InternalListUploadedPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[70]
 	if x != nil {
-		return x.Allowed
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *InternalCheckQuotaResponse) GetReason() string {
+// Deprecated: Use InternalListUploadedPartsRequest.ProtoReflect.Descriptor instead.
+func (*InternalListUploadedPartsRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *InternalListUploadedPartsRequest) GetTenantId() uint32 {
 	if x != nil {
-		return x.Reason
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalListUploadedPartsRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
 	}
 	return ""
 }
 
-func (x *InternalCheckQuotaResponse) GetQuota() *InternalQuotaInfo {
+// InternalListUploadedPartsResponse 内部查询已上传分片响应
+type InternalListUploadedPartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 已上传的分片，按分片号排序
+	Parts []*InternalUploadedPart `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	// 文件ID
+	FileId string `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// 分片大小（字节）
+	PartSize int64 `protobuf:"varint,3,opt,name=part_size,json=partSize,proto3" json:"part_size,omitempty"`
+	// 分片数量
+	PartCount     int32 `protobuf:"varint,4,opt,name=part_count,json=partCount,proto3" json:"part_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListUploadedPartsResponse) Reset() {
+	*x = InternalListUploadedPartsResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListUploadedPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListUploadedPartsResponse) ProtoMessage() {}
+
+func (x *InternalListUploadedPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[71]
 	if x != nil {
-		return x.Quota
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListUploadedPartsResponse.ProtoReflect.Descriptor instead.
+func (*InternalListUploadedPartsResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *InternalListUploadedPartsResponse) GetParts() []*InternalUploadedPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *InternalListUploadedPartsResponse) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *InternalListUploadedPartsResponse) GetPartSize() int64 {
+	if x != nil {
+		return x.PartSize
+	}
+	return 0
+}
+
+func (x *InternalListUploadedPartsResponse) GetPartCount() int32 {
+	if x != nil {
+		return x.PartCount
+	}
+	return 0
+}
+
+// InternalCompleteMultipartUploadRequest 内部完成分片上传请求
+type InternalCompleteMultipartUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 上传ID（必填）
+	UploadId string `protobuf:"bytes,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	// 所有分片，按分片号排序
+	Parts         []*InternalUploadedPart `protobuf:"bytes,3,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCompleteMultipartUploadRequest) Reset() {
+	*x = InternalCompleteMultipartUploadRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCompleteMultipartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCompleteMultipartUploadRequest) ProtoMessage() {}
+
+func (x *InternalCompleteMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCompleteMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*InternalCompleteMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *InternalCompleteMultipartUploadRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalCompleteMultipartUploadRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *InternalCompleteMultipartUploadRequest) GetParts() []*InternalUploadedPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+// InternalCompleteMultipartUploadResponse 内部完成分片上传响应
+type InternalCompleteMultipartUploadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 上传完成后的文件信息
+	File *InternalFileInfo `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// 文件访问URL
+	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCompleteMultipartUploadResponse) Reset() {
+	*x = InternalCompleteMultipartUploadResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCompleteMultipartUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCompleteMultipartUploadResponse) ProtoMessage() {}
+
+func (x *InternalCompleteMultipartUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCompleteMultipartUploadResponse.ProtoReflect.Descriptor instead.
+func (*InternalCompleteMultipartUploadResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *InternalCompleteMultipartUploadResponse) GetFile() *InternalFileInfo {
+	if x != nil {
+		return x.File
 	}
 	return nil
 }
 
+func (x *InternalCompleteMultipartUploadResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// InternalAbortMultipartUploadRequest 内部取消分片上传请求
+type InternalAbortMultipartUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantId uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 上传ID（必填）
+	UploadId      string `protobuf:"bytes,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalAbortMultipartUploadRequest) Reset() {
+	*x = InternalAbortMultipartUploadRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalAbortMultipartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalAbortMultipartUploadRequest) ProtoMessage() {}
+
+func (x *InternalAbortMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalAbortMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*InternalAbortMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *InternalAbortMultipartUploadRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InternalAbortMultipartUploadRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+// InternalAbortMultipartUploadResponse 内部取消分片上传响应
+type InternalAbortMultipartUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalAbortMultipartUploadResponse) Reset() {
+	*x = InternalAbortMultipartUploadResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalAbortMultipartUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalAbortMultipartUploadResponse) ProtoMessage() {}
+
+func (x *InternalAbortMultipartUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalAbortMultipartUploadResponse.ProtoReflect.Descriptor instead.
+func (*InternalAbortMultipartUploadResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{75}
+}
+
 var File_resource_v1_resource_internal_proto protoreflect.FileDescriptor
 
 const file_resource_v1_resource_internal_proto_rawDesc = "" +
 	"\n" +
-	"#resource/v1/resource_internal.proto\x12\vresource.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xee\x02\n" +
+	"#resource/v1/resource_internal.proto\x12\vresource.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x88\x04\n" +
 	"\x10InternalFileInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x12\x1a\n" +
@@ -1384,7 +5095,12 @@ const file_resource_v1_resource_internal_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xfc\x02\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12G\n" +
+	"\bmetadata\x18\v \x03(\v2+.resource.v1.InternalFileInfo.MetadataEntryR\bmetadata\x12\x12\n" +
+	"\x04tags\x18\f \x03(\tR\x04tags\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xfc\x02\n" +
 	"\x13InternalFileUrlInfo\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12T\n" +
 	"\fvariant_urls\x18\x02 \x03(\v21.resource.v1.InternalFileUrlInfo.VariantUrlsEntryR\vvariantUrls\x12\x1b\n" +
@@ -1449,12 +5165,27 @@ const file_resource_v1_resource_internal_proto_rawDesc = "" +
 	"expires_in\x18\x02 \x01(\x03R\texpiresIn\x1a\\\n" +
 	"\fResultsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x126\n" +
-	"\x05value\x18\x02 \x01(\v2 .resource.v1.InternalFileUrlInfoR\x05value:\x028\x01\"\x82\x01\n" +
+	"\x05value\x18\x02 \x01(\v2 .resource.v1.InternalFileUrlInfoR\x05value:\x028\x01\"\xc5\x01\n" +
 	"\x1bInternalFileDownloadRequest\x12\x17\n" +
 	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12+\n" +
 	"\x11download_filename\x18\x02 \x01(\tR\x10downloadFilename\x12\x1d\n" +
 	"\n" +
-	"variant_id\x18\x03 \x01(\tR\tvariantId\"\x9c\x01\n" +
+	"variant_id\x18\x03 \x01(\tR\tvariantId\x12A\n" +
+	"\ttransform\x18\x04 \x01(\v2#.resource.v1.InternalImageTransformR\ttransform\"\xf4\x01\n" +
+	"\x16InternalImageTransform\x12\x14\n" +
+	"\x05width\x18\x01 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x05R\x06height\x12\x1f\n" +
+	"\vresize_mode\x18\x03 \x01(\tR\n" +
+	"resizeMode\x122\n" +
+	"\x04crop\x18\x04 \x01(\v2\x1e.resource.v1.InternalImageCropR\x04crop\x12%\n" +
+	"\x0ewatermark_text\x18\x05 \x01(\tR\rwatermarkText\x12\x16\n" +
+	"\x06format\x18\x06 \x01(\tR\x06format\x12\x18\n" +
+	"\aquality\x18\a \x01(\x05R\aquality\"]\n" +
+	"\x11InternalImageCrop\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x05R\x06height\"\x9c\x01\n" +
 	"\x1eInternalGetDownloadUrlsRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12>\n" +
 	"\x05files\x18\x02 \x03(\v2(.resource.v1.InternalFileDownloadRequestR\x05files\x12\x1d\n" +
@@ -1473,11 +5204,100 @@ const file_resource_v1_resource_internal_proto_rawDesc = "" +
 	"\x04size\x18\x03 \x01(\x03R\x04size\"l\n" +
 	"\x1fInternalCheckFileExistsResponse\x12\x16\n" +
 	"\x06exists\x18\x01 \x01(\bR\x06exists\x121\n" +
-	"\x04file\x18\x02 \x01(\v2\x1d.resource.v1.InternalFileInfoR\x04file\"6\n" +
+	"\x04file\x18\x02 \x01(\v2\x1d.resource.v1.InternalFileInfoR\x04file\"S\n" +
+	"\x19InternalFilesExistRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x19\n" +
+	"\bfile_ids\x18\x02 \x03(\tR\afileIds\"\xa4\x01\n" +
+	"\x1aInternalFilesExistResponse\x12K\n" +
+	"\x06exists\x18\x01 \x03(\v23.resource.v1.InternalFilesExistResponse.ExistsEntryR\x06exists\x1a9\n" +
+	"\vExistsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\xda\x01\n" +
+	"\x18InternalListFilesRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x16\n" +
+	"\x06folder\x18\x02 \x01(\tR\x06folder\x12#\n" +
+	"\rfile_category\x18\x03 \x01(\tR\ffileCategory\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12\x10\n" +
+	"\x03tag\x18\a \x01(\tR\x03tag\"\x8e\x01\n" +
+	"\x19InternalListFilesResponse\x123\n" +
+	"\x05files\x18\x01 \x03(\v2\x1d.resource.v1.InternalFileInfoR\x05files\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\"\xc8\x02\n" +
+	"!InternalUpdateFileMetadataRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12X\n" +
+	"\bmetadata\x18\x04 \x03(\v2<.resource.v1.InternalUpdateFileMetadataRequest.MetadataEntryR\bmetadata\x12\x19\n" +
+	"\badd_tags\x18\x05 \x03(\tR\aaddTags\x12\x1f\n" +
+	"\vremove_tags\x18\x06 \x03(\tR\n" +
+	"removeTags\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"W\n" +
+	"\"InternalUpdateFileMetadataResponse\x121\n" +
+	"\x04file\x18\x01 \x01(\v2\x1d.resource.v1.InternalFileInfoR\x04file\"o\n" +
+	"\x19InternalDeleteFileRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x1c\n" +
+	"\tpermanent\x18\x03 \x01(\bR\tpermanent\"\x1c\n" +
+	"\x1aInternalDeleteFileResponse\"F\n" +
+	"\x14InternalDeleteResult\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"w\n" +
+	"\x1fInternalBatchDeleteFilesRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x19\n" +
+	"\bfile_ids\x18\x02 \x03(\tR\afileIds\x12\x1c\n" +
+	"\tpermanent\x18\x03 \x01(\bR\tpermanent\"\xd7\x01\n" +
+	" InternalBatchDeleteFilesResponse\x12T\n" +
+	"\aresults\x18\x01 \x03(\v2:.resource.v1.InternalBatchDeleteFilesResponse.ResultsEntryR\aresults\x1a]\n" +
+	"\fResultsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x127\n" +
+	"\x05value\x18\x02 \x01(\v2!.resource.v1.InternalDeleteResultR\x05value:\x028\x01\"\xb9\x01\n" +
+	"\x12InternalFolderInfo\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"file_count\x18\x03 \x01(\x03R\tfileCount\x12!\n" +
+	"\ffolder_count\x18\x04 \x01(\x03R\vfolderCount\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"N\n" +
+	"\x1bInternalCreateFolderRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"W\n" +
+	"\x1cInternalCreateFolderResponse\x127\n" +
+	"\x06folder\x18\x01 \x01(\v2\x1f.resource.v1.InternalFolderInfoR\x06folder\"Q\n" +
+	"\x1aInternalListFoldersRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x16\n" +
+	"\x06parent\x18\x02 \x01(\tR\x06parent\"X\n" +
+	"\x1bInternalListFoldersResponse\x129\n" +
+	"\afolders\x18\x01 \x03(\v2\x1f.resource.v1.InternalFolderInfoR\afolders\"l\n" +
+	"\x1bInternalDeleteFolderRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1c\n" +
+	"\trecursive\x18\x03 \x01(\bR\trecursive\"C\n" +
+	"\x1cInternalDeleteFolderResponse\x12#\n" +
+	"\rdeleted_files\x18\x01 \x01(\x03R\fdeletedFiles\"6\n" +
 	"\x17InternalGetQuotaRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\rR\btenantId\"P\n" +
 	"\x18InternalGetQuotaResponse\x124\n" +
-	"\x05quota\x18\x01 \x01(\v2\x1e.resource.v1.InternalQuotaInfoR\x05quota\"k\n" +
+	"\x05quota\x18\x01 \x01(\v2\x1e.resource.v1.InternalQuotaInfoR\x05quota\"6\n" +
+	"\x17InternalGetUsageRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\"Y\n" +
+	"\x15InternalCategoryUsage\x12!\n" +
+	"\fstorage_used\x18\x01 \x01(\x03R\vstorageUsed\x12\x1d\n" +
+	"\n" +
+	"file_count\x18\x02 \x01(\x03R\tfileCount\"\x8a\x02\n" +
+	"\x18InternalGetUsageResponse\x124\n" +
+	"\x05quota\x18\x01 \x01(\v2\x1e.resource.v1.InternalQuotaInfoR\x05quota\x12U\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\v25.resource.v1.InternalGetUsageResponse.CategoriesEntryR\n" +
+	"categories\x1aa\n" +
+	"\x0fCategoriesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x128\n" +
+	"\x05value\x18\x02 \x01(\v2\".resource.v1.InternalCategoryUsageR\x05value:\x028\x01\"k\n" +
 	"\x19InternalCheckQuotaRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x1d\n" +
 	"\n" +
@@ -1486,15 +5306,210 @@ const file_resource_v1_resource_internal_proto_rawDesc = "" +
 	"\x1aInternalCheckQuotaResponse\x12\x18\n" +
 	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\x124\n" +
-	"\x05quota\x18\x03 \x01(\v2\x1e.resource.v1.InternalQuotaInfoR\x05quota2\xf6\x05\n" +
+	"\x05quota\x18\x03 \x01(\v2\x1e.resource.v1.InternalQuotaInfoR\x05quota\"\xd6\x01\n" +
+	"\x1dInternalGenerateQRCodeRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\x12 \n" +
+	"\flogo_file_id\x18\x04 \x01(\tR\n" +
+	"logoFileId\x12)\n" +
+	"\x10error_correction\x18\x05 \x01(\tR\x0ferrorCorrection\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x06 \x01(\x03R\texpiresIn\"\x84\x01\n" +
+	"\x1eInternalGenerateQRCodeResponse\x121\n" +
+	"\x04file\x18\x01 \x01(\v2\x1d.resource.v1.InternalFileInfoR\x04file\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"\xe7\x01\n" +
+	"\x13InternalArchiveInfo\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12!\n" +
+	"\fdownload_url\x18\x04 \x01(\tR\vdownloadUrl\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x06 \x01(\x03R\texpiresIn\x12\x1d\n" +
+	"\n" +
+	"failed_ids\x18\a \x03(\tR\tfailedIds\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"\xa9\x01\n" +
+	"\x1cInternalCreateArchiveRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x19\n" +
+	"\bfile_ids\x18\x02 \x03(\tR\afileIds\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x1a\n" +
+	"\bfilename\x18\x04 \x01(\tR\bfilename\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x05 \x01(\x03R\texpiresIn\"[\n" +
+	"\x1dInternalCreateArchiveResponse\x12:\n" +
+	"\aarchive\x18\x01 \x01(\v2 .resource.v1.InternalArchiveInfoR\aarchive\"n\n" +
+	"\x19InternalGetArchiveRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"X\n" +
+	"\x1aInternalGetArchiveResponse\x12:\n" +
+	"\aarchive\x18\x01 \x01(\v2 .resource.v1.InternalArchiveInfoR\aarchive\"\xc2\x02\n" +
+	"\x15InternalShareLinkInfo\x12\x19\n" +
+	"\bshare_id\x18\x01 \x01(\tR\ashareId\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12!\n" +
+	"\fhas_password\x18\x04 \x01(\bR\vhasPassword\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12#\n" +
+	"\rmax_downloads\x18\x06 \x01(\x05R\fmaxDownloads\x12%\n" +
+	"\x0edownload_count\x18\a \x01(\x05R\rdownloadCount\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb6\x01\n" +
+	"\x1eInternalCreateShareLinkRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12#\n" +
+	"\rmax_downloads\x18\x05 \x01(\x05R\fmaxDownloads\"[\n" +
+	"\x1fInternalCreateShareLinkResponse\x128\n" +
+	"\x05share\x18\x01 \x01(\v2\".resource.v1.InternalShareLinkInfoR\x05share\"X\n" +
+	"\x1eInternalRevokeShareLinkRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x19\n" +
+	"\bshare_id\x18\x02 \x01(\tR\ashareId\"!\n" +
+	"\x1fInternalRevokeShareLinkResponse\"\xd6\x01\n" +
+	"\x12InternalScanStatus\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x1c\n" +
+	"\tantivirus\x18\x02 \x01(\tR\tantivirus\x12\x1e\n" +
+	"\n" +
+	"moderation\x18\x03 \x01(\tR\n" +
+	"moderation\x12\x16\n" +
+	"\x06labels\x18\x04 \x03(\tR\x06labels\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"scanned_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tscannedAt\"T\n" +
+	"\x1cInternalGetScanStatusRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\"X\n" +
+	"\x1dInternalGetScanStatusResponse\x127\n" +
+	"\x06status\x18\x01 \x01(\v2\x1f.resource.v1.InternalScanStatusR\x06status\"\xbd\x01\n" +
+	"\x16InternalUploadFileMeta\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x16\n" +
+	"\x06folder\x18\x04 \x01(\tR\x06folder\x12\x1b\n" +
+	"\tis_public\x18\x05 \x01(\bR\bisPublic\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\"j\n" +
+	"\x19InternalUploadFileRequest\x127\n" +
+	"\x04meta\x18\x01 \x01(\v2#.resource.v1.InternalUploadFileMetaR\x04meta\x12\x14\n" +
+	"\x05chunk\x18\x02 \x01(\fR\x05chunk\"a\n" +
+	"\x1aInternalUploadFileResponse\x121\n" +
+	"\x04file\x18\x01 \x01(\v2\x1d.resource.v1.InternalFileInfoR\x04file\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"\xe4\x01\n" +
+	"\x1eInternalCreateUploadUrlRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06folder\x18\x05 \x01(\tR\x06folder\x12\x1b\n" +
+	"\tis_public\x18\x06 \x01(\bR\bisPublic\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\a \x01(\x03R\texpiresIn\"\xa1\x02\n" +
+	"\x1fInternalCreateUploadUrlResponse\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x02 \x01(\tR\tuploadUrl\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12S\n" +
+	"\aheaders\x18\x04 \x03(\v29.resource.v1.InternalCreateUploadUrlResponse.HeadersEntryR\aheaders\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x05 \x01(\x03R\texpiresIn\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"T\n" +
+	"\x1cInternalConfirmUploadRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\"d\n" +
+	"\x1dInternalConfirmUploadResponse\x121\n" +
+	"\x04file\x18\x01 \x01(\v2\x1d.resource.v1.InternalFileInfoR\x04file\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"\x88\x01\n" +
+	"\x14InternalUploadedPart\x12\x1f\n" +
+	"\vpart_number\x18\x01 \x01(\x05R\n" +
+	"partNumber\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12'\n" +
+	"\x0fchecksum_sha256\x18\x03 \x01(\tR\x0echecksumSha256\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"\x8f\x02\n" +
+	"\"InternalInitMultipartUploadRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x1b\n" +
+	"\tpart_size\x18\x05 \x01(\x03R\bpartSize\x12\x16\n" +
+	"\x06folder\x18\x06 \x01(\tR\x06folder\x12\x1b\n" +
+	"\tis_public\x18\a \x01(\bR\bisPublic\x12'\n" +
+	"\x0fchecksum_sha256\x18\b \x01(\tR\x0echecksumSha256\"\x97\x01\n" +
+	"#InternalInitMultipartUploadResponse\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x1b\n" +
+	"\tpart_size\x18\x03 \x01(\x03R\bpartSize\x12\x1d\n" +
+	"\n" +
+	"part_count\x18\x04 \x01(\x05R\tpartCount\"\xb0\x01\n" +
+	"\x16InternalUploadPartMeta\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x1b\n" +
+	"\tupload_id\x18\x02 \x01(\tR\buploadId\x12\x1f\n" +
+	"\vpart_number\x18\x03 \x01(\x05R\n" +
+	"partNumber\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12'\n" +
+	"\x0fchecksum_sha256\x18\x05 \x01(\tR\x0echecksumSha256\"j\n" +
+	"\x19InternalUploadPartRequest\x127\n" +
+	"\x04meta\x18\x01 \x01(\v2#.resource.v1.InternalUploadPartMetaR\x04meta\x12\x14\n" +
+	"\x05chunk\x18\x02 \x01(\fR\x05chunk\"S\n" +
+	"\x1aInternalUploadPartResponse\x125\n" +
+	"\x04part\x18\x01 \x01(\v2!.resource.v1.InternalUploadedPartR\x04part\"\\\n" +
+	" InternalListUploadedPartsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x1b\n" +
+	"\tupload_id\x18\x02 \x01(\tR\buploadId\"\xb1\x01\n" +
+	"!InternalListUploadedPartsResponse\x127\n" +
+	"\x05parts\x18\x01 \x03(\v2!.resource.v1.InternalUploadedPartR\x05parts\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x1b\n" +
+	"\tpart_size\x18\x03 \x01(\x03R\bpartSize\x12\x1d\n" +
+	"\n" +
+	"part_count\x18\x04 \x01(\x05R\tpartCount\"\x9b\x01\n" +
+	"&InternalCompleteMultipartUploadRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x1b\n" +
+	"\tupload_id\x18\x02 \x01(\tR\buploadId\x127\n" +
+	"\x05parts\x18\x03 \x03(\v2!.resource.v1.InternalUploadedPartR\x05parts\"n\n" +
+	"'InternalCompleteMultipartUploadResponse\x121\n" +
+	"\x04file\x18\x01 \x01(\v2\x1d.resource.v1.InternalFileInfoR\x04file\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"_\n" +
+	"#InternalAbortMultipartUploadRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12\x1b\n" +
+	"\tupload_id\x18\x02 \x01(\tR\buploadId\"&\n" +
+	"$InternalAbortMultipartUploadResponse2\xb7\x1a\n" +
 	"\x17ResourceInternalService\x12\\\n" +
 	"\x0fInternalGetFile\x12#.resource.v1.InternalGetFileRequest\x1a$.resource.v1.InternalGetFileResponse\x12_\n" +
 	"\x10InternalGetFiles\x12$.resource.v1.InternalGetFilesRequest\x1a%.resource.v1.InternalGetFilesResponse\x12h\n" +
 	"\x13InternalGetFileUrls\x12'.resource.v1.InternalGetFileUrlsRequest\x1a(.resource.v1.InternalGetFileUrlsResponse\x12t\n" +
 	"\x17InternalGetDownloadUrls\x12+.resource.v1.InternalGetDownloadUrlsRequest\x1a,.resource.v1.InternalGetDownloadUrlsResponse\x12t\n" +
-	"\x17InternalCheckFileExists\x12+.resource.v1.InternalCheckFileExistsRequest\x1a,.resource.v1.InternalCheckFileExistsResponse\x12_\n" +
+	"\x17InternalCheckFileExists\x12+.resource.v1.InternalCheckFileExistsRequest\x1a,.resource.v1.InternalCheckFileExistsResponse\x12e\n" +
+	"\x12InternalFilesExist\x12&.resource.v1.InternalFilesExistRequest\x1a'.resource.v1.InternalFilesExistResponse\x12b\n" +
+	"\x11InternalListFiles\x12%.resource.v1.InternalListFilesRequest\x1a&.resource.v1.InternalListFilesResponse\x12}\n" +
+	"\x1aInternalUpdateFileMetadata\x12..resource.v1.InternalUpdateFileMetadataRequest\x1a/.resource.v1.InternalUpdateFileMetadataResponse\x12e\n" +
+	"\x12InternalDeleteFile\x12&.resource.v1.InternalDeleteFileRequest\x1a'.resource.v1.InternalDeleteFileResponse\x12w\n" +
+	"\x18InternalBatchDeleteFiles\x12,.resource.v1.InternalBatchDeleteFilesRequest\x1a-.resource.v1.InternalBatchDeleteFilesResponse\x12k\n" +
+	"\x14InternalCreateFolder\x12(.resource.v1.InternalCreateFolderRequest\x1a).resource.v1.InternalCreateFolderResponse\x12h\n" +
+	"\x13InternalListFolders\x12'.resource.v1.InternalListFoldersRequest\x1a(.resource.v1.InternalListFoldersResponse\x12k\n" +
+	"\x14InternalDeleteFolder\x12(.resource.v1.InternalDeleteFolderRequest\x1a).resource.v1.InternalDeleteFolderResponse\x12_\n" +
 	"\x10InternalGetQuota\x12$.resource.v1.InternalGetQuotaRequest\x1a%.resource.v1.InternalGetQuotaResponse\x12e\n" +
-	"\x12InternalCheckQuota\x12&.resource.v1.InternalCheckQuotaRequest\x1a'.resource.v1.InternalCheckQuotaResponseB\xb3\x01\n" +
+	"\x12InternalCheckQuota\x12&.resource.v1.InternalCheckQuotaRequest\x1a'.resource.v1.InternalCheckQuotaResponse\x12_\n" +
+	"\x10InternalGetUsage\x12$.resource.v1.InternalGetUsageRequest\x1a%.resource.v1.InternalGetUsageResponse\x12q\n" +
+	"\x16InternalGenerateQRCode\x12*.resource.v1.InternalGenerateQRCodeRequest\x1a+.resource.v1.InternalGenerateQRCodeResponse\x12n\n" +
+	"\x15InternalCreateArchive\x12).resource.v1.InternalCreateArchiveRequest\x1a*.resource.v1.InternalCreateArchiveResponse\x12e\n" +
+	"\x12InternalGetArchive\x12&.resource.v1.InternalGetArchiveRequest\x1a'.resource.v1.InternalGetArchiveResponse\x12t\n" +
+	"\x17InternalCreateShareLink\x12+.resource.v1.InternalCreateShareLinkRequest\x1a,.resource.v1.InternalCreateShareLinkResponse\x12t\n" +
+	"\x17InternalRevokeShareLink\x12+.resource.v1.InternalRevokeShareLinkRequest\x1a,.resource.v1.InternalRevokeShareLinkResponse\x12n\n" +
+	"\x15InternalGetScanStatus\x12).resource.v1.InternalGetScanStatusRequest\x1a*.resource.v1.InternalGetScanStatusResponse\x12g\n" +
+	"\x12InternalUploadFile\x12&.resource.v1.InternalUploadFileRequest\x1a'.resource.v1.InternalUploadFileResponse(\x01\x12t\n" +
+	"\x17InternalCreateUploadUrl\x12+.resource.v1.InternalCreateUploadUrlRequest\x1a,.resource.v1.InternalCreateUploadUrlResponse\x12n\n" +
+	"\x15InternalConfirmUpload\x12).resource.v1.InternalConfirmUploadRequest\x1a*.resource.v1.InternalConfirmUploadResponse\x12\x80\x01\n" +
+	"\x1bInternalInitMultipartUpload\x12/.resource.v1.InternalInitMultipartUploadRequest\x1a0.resource.v1.InternalInitMultipartUploadResponse\x12g\n" +
+	"\x12InternalUploadPart\x12&.resource.v1.InternalUploadPartRequest\x1a'.resource.v1.InternalUploadPartResponse(\x01\x12z\n" +
+	"\x19InternalListUploadedParts\x12-.resource.v1.InternalListUploadedPartsRequest\x1a..resource.v1.InternalListUploadedPartsResponse\x12\x8c\x01\n" +
+	"\x1fInternalCompleteMultipartUpload\x123.resource.v1.InternalCompleteMultipartUploadRequest\x1a4.resource.v1.InternalCompleteMultipartUploadResponse\x12\x83\x01\n" +
+	"\x1cInternalAbortMultipartUpload\x120.resource.v1.InternalAbortMultipartUploadRequest\x1a1.resource.v1.InternalAbortMultipartUploadResponseB\xb3\x01\n" +
 	"\x0fcom.resource.v1B\x15ResourceInternalProtoP\x01Z<github.com/heyinLab/common/api/gen/go/resource/v1;resourcev1\xa2\x02\x03RXX\xaa\x02\vResource.V1\xca\x02\vResource\\V1\xe2\x02\x17Resource\\V1\\GPBMetadata\xea\x02\fResource::V1b\x06proto3"
 
 var (
@@ -1509,67 +5524,208 @@ func file_resource_v1_resource_internal_proto_rawDescGZIP() []byte {
 	return file_resource_v1_resource_internal_proto_rawDescData
 }
 
-var file_resource_v1_resource_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_resource_v1_resource_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
 var file_resource_v1_resource_internal_proto_goTypes = []any{
-	(*InternalFileInfo)(nil),                // 0: resource.v1.InternalFileInfo
-	(*InternalFileUrlInfo)(nil),             // 1: resource.v1.InternalFileUrlInfo
-	(*InternalFileDownloadInfo)(nil),        // 2: resource.v1.InternalFileDownloadInfo
-	(*InternalQuotaInfo)(nil),               // 3: resource.v1.InternalQuotaInfo
-	(*InternalGetFileRequest)(nil),          // 4: resource.v1.InternalGetFileRequest
-	(*InternalGetFileResponse)(nil),         // 5: resource.v1.InternalGetFileResponse
-	(*InternalGetFilesRequest)(nil),         // 6: resource.v1.InternalGetFilesRequest
-	(*InternalGetFilesResponse)(nil),        // 7: resource.v1.InternalGetFilesResponse
-	(*InternalGetFileUrlsRequest)(nil),      // 8: resource.v1.InternalGetFileUrlsRequest
-	(*InternalGetFileUrlsResponse)(nil),     // 9: resource.v1.InternalGetFileUrlsResponse
-	(*InternalFileDownloadRequest)(nil),     // 10: resource.v1.InternalFileDownloadRequest
-	(*InternalGetDownloadUrlsRequest)(nil),  // 11: resource.v1.InternalGetDownloadUrlsRequest
-	(*InternalGetDownloadUrlsResponse)(nil), // 12: resource.v1.InternalGetDownloadUrlsResponse
-	(*InternalCheckFileExistsRequest)(nil),  // 13: resource.v1.InternalCheckFileExistsRequest
-	(*InternalCheckFileExistsResponse)(nil), // 14: resource.v1.InternalCheckFileExistsResponse
-	(*InternalGetQuotaRequest)(nil),         // 15: resource.v1.InternalGetQuotaRequest
-	(*InternalGetQuotaResponse)(nil),        // 16: resource.v1.InternalGetQuotaResponse
-	(*InternalCheckQuotaRequest)(nil),       // 17: resource.v1.InternalCheckQuotaRequest
-	(*InternalCheckQuotaResponse)(nil),      // 18: resource.v1.InternalCheckQuotaResponse
-	nil,                                     // 19: resource.v1.InternalFileUrlInfo.VariantUrlsEntry
-	nil,                                     // 20: resource.v1.InternalGetFilesResponse.FilesEntry
-	nil,                                     // 21: resource.v1.InternalGetFileUrlsResponse.ResultsEntry
-	nil,                                     // 22: resource.v1.InternalGetDownloadUrlsResponse.ResultsEntry
-	(*timestamppb.Timestamp)(nil),           // 23: google.protobuf.Timestamp
+	(*InternalFileInfo)(nil),                        // 0: resource.v1.InternalFileInfo
+	(*InternalFileUrlInfo)(nil),                     // 1: resource.v1.InternalFileUrlInfo
+	(*InternalFileDownloadInfo)(nil),                // 2: resource.v1.InternalFileDownloadInfo
+	(*InternalQuotaInfo)(nil),                       // 3: resource.v1.InternalQuotaInfo
+	(*InternalGetFileRequest)(nil),                  // 4: resource.v1.InternalGetFileRequest
+	(*InternalGetFileResponse)(nil),                 // 5: resource.v1.InternalGetFileResponse
+	(*InternalGetFilesRequest)(nil),                 // 6: resource.v1.InternalGetFilesRequest
+	(*InternalGetFilesResponse)(nil),                // 7: resource.v1.InternalGetFilesResponse
+	(*InternalGetFileUrlsRequest)(nil),              // 8: resource.v1.InternalGetFileUrlsRequest
+	(*InternalGetFileUrlsResponse)(nil),             // 9: resource.v1.InternalGetFileUrlsResponse
+	(*InternalFileDownloadRequest)(nil),             // 10: resource.v1.InternalFileDownloadRequest
+	(*InternalImageTransform)(nil),                  // 11: resource.v1.InternalImageTransform
+	(*InternalImageCrop)(nil),                       // 12: resource.v1.InternalImageCrop
+	(*InternalGetDownloadUrlsRequest)(nil),          // 13: resource.v1.InternalGetDownloadUrlsRequest
+	(*InternalGetDownloadUrlsResponse)(nil),         // 14: resource.v1.InternalGetDownloadUrlsResponse
+	(*InternalCheckFileExistsRequest)(nil),          // 15: resource.v1.InternalCheckFileExistsRequest
+	(*InternalCheckFileExistsResponse)(nil),         // 16: resource.v1.InternalCheckFileExistsResponse
+	(*InternalFilesExistRequest)(nil),               // 17: resource.v1.InternalFilesExistRequest
+	(*InternalFilesExistResponse)(nil),              // 18: resource.v1.InternalFilesExistResponse
+	(*InternalListFilesRequest)(nil),                // 19: resource.v1.InternalListFilesRequest
+	(*InternalListFilesResponse)(nil),               // 20: resource.v1.InternalListFilesResponse
+	(*InternalUpdateFileMetadataRequest)(nil),       // 21: resource.v1.InternalUpdateFileMetadataRequest
+	(*InternalUpdateFileMetadataResponse)(nil),      // 22: resource.v1.InternalUpdateFileMetadataResponse
+	(*InternalDeleteFileRequest)(nil),               // 23: resource.v1.InternalDeleteFileRequest
+	(*InternalDeleteFileResponse)(nil),              // 24: resource.v1.InternalDeleteFileResponse
+	(*InternalDeleteResult)(nil),                    // 25: resource.v1.InternalDeleteResult
+	(*InternalBatchDeleteFilesRequest)(nil),         // 26: resource.v1.InternalBatchDeleteFilesRequest
+	(*InternalBatchDeleteFilesResponse)(nil),        // 27: resource.v1.InternalBatchDeleteFilesResponse
+	(*InternalFolderInfo)(nil),                      // 28: resource.v1.InternalFolderInfo
+	(*InternalCreateFolderRequest)(nil),             // 29: resource.v1.InternalCreateFolderRequest
+	(*InternalCreateFolderResponse)(nil),            // 30: resource.v1.InternalCreateFolderResponse
+	(*InternalListFoldersRequest)(nil),              // 31: resource.v1.InternalListFoldersRequest
+	(*InternalListFoldersResponse)(nil),             // 32: resource.v1.InternalListFoldersResponse
+	(*InternalDeleteFolderRequest)(nil),             // 33: resource.v1.InternalDeleteFolderRequest
+	(*InternalDeleteFolderResponse)(nil),            // 34: resource.v1.InternalDeleteFolderResponse
+	(*InternalGetQuotaRequest)(nil),                 // 35: resource.v1.InternalGetQuotaRequest
+	(*InternalGetQuotaResponse)(nil),                // 36: resource.v1.InternalGetQuotaResponse
+	(*InternalGetUsageRequest)(nil),                 // 37: resource.v1.InternalGetUsageRequest
+	(*InternalCategoryUsage)(nil),                   // 38: resource.v1.InternalCategoryUsage
+	(*InternalGetUsageResponse)(nil),                // 39: resource.v1.InternalGetUsageResponse
+	(*InternalCheckQuotaRequest)(nil),               // 40: resource.v1.InternalCheckQuotaRequest
+	(*InternalCheckQuotaResponse)(nil),              // 41: resource.v1.InternalCheckQuotaResponse
+	(*InternalGenerateQRCodeRequest)(nil),           // 42: resource.v1.InternalGenerateQRCodeRequest
+	(*InternalGenerateQRCodeResponse)(nil),          // 43: resource.v1.InternalGenerateQRCodeResponse
+	(*InternalArchiveInfo)(nil),                     // 44: resource.v1.InternalArchiveInfo
+	(*InternalCreateArchiveRequest)(nil),            // 45: resource.v1.InternalCreateArchiveRequest
+	(*InternalCreateArchiveResponse)(nil),           // 46: resource.v1.InternalCreateArchiveResponse
+	(*InternalGetArchiveRequest)(nil),               // 47: resource.v1.InternalGetArchiveRequest
+	(*InternalGetArchiveResponse)(nil),              // 48: resource.v1.InternalGetArchiveResponse
+	(*InternalShareLinkInfo)(nil),                   // 49: resource.v1.InternalShareLinkInfo
+	(*InternalCreateShareLinkRequest)(nil),          // 50: resource.v1.InternalCreateShareLinkRequest
+	(*InternalCreateShareLinkResponse)(nil),         // 51: resource.v1.InternalCreateShareLinkResponse
+	(*InternalRevokeShareLinkRequest)(nil),          // 52: resource.v1.InternalRevokeShareLinkRequest
+	(*InternalRevokeShareLinkResponse)(nil),         // 53: resource.v1.InternalRevokeShareLinkResponse
+	(*InternalScanStatus)(nil),                      // 54: resource.v1.InternalScanStatus
+	(*InternalGetScanStatusRequest)(nil),            // 55: resource.v1.InternalGetScanStatusRequest
+	(*InternalGetScanStatusResponse)(nil),           // 56: resource.v1.InternalGetScanStatusResponse
+	(*InternalUploadFileMeta)(nil),                  // 57: resource.v1.InternalUploadFileMeta
+	(*InternalUploadFileRequest)(nil),               // 58: resource.v1.InternalUploadFileRequest
+	(*InternalUploadFileResponse)(nil),              // 59: resource.v1.InternalUploadFileResponse
+	(*InternalCreateUploadUrlRequest)(nil),          // 60: resource.v1.InternalCreateUploadUrlRequest
+	(*InternalCreateUploadUrlResponse)(nil),         // 61: resource.v1.InternalCreateUploadUrlResponse
+	(*InternalConfirmUploadRequest)(nil),            // 62: resource.v1.InternalConfirmUploadRequest
+	(*InternalConfirmUploadResponse)(nil),           // 63: resource.v1.InternalConfirmUploadResponse
+	(*InternalUploadedPart)(nil),                    // 64: resource.v1.InternalUploadedPart
+	(*InternalInitMultipartUploadRequest)(nil),      // 65: resource.v1.InternalInitMultipartUploadRequest
+	(*InternalInitMultipartUploadResponse)(nil),     // 66: resource.v1.InternalInitMultipartUploadResponse
+	(*InternalUploadPartMeta)(nil),                  // 67: resource.v1.InternalUploadPartMeta
+	(*InternalUploadPartRequest)(nil),               // 68: resource.v1.InternalUploadPartRequest
+	(*InternalUploadPartResponse)(nil),              // 69: resource.v1.InternalUploadPartResponse
+	(*InternalListUploadedPartsRequest)(nil),        // 70: resource.v1.InternalListUploadedPartsRequest
+	(*InternalListUploadedPartsResponse)(nil),       // 71: resource.v1.InternalListUploadedPartsResponse
+	(*InternalCompleteMultipartUploadRequest)(nil),  // 72: resource.v1.InternalCompleteMultipartUploadRequest
+	(*InternalCompleteMultipartUploadResponse)(nil), // 73: resource.v1.InternalCompleteMultipartUploadResponse
+	(*InternalAbortMultipartUploadRequest)(nil),     // 74: resource.v1.InternalAbortMultipartUploadRequest
+	(*InternalAbortMultipartUploadResponse)(nil),    // 75: resource.v1.InternalAbortMultipartUploadResponse
+	nil,                           // 76: resource.v1.InternalFileInfo.MetadataEntry
+	nil,                           // 77: resource.v1.InternalFileUrlInfo.VariantUrlsEntry
+	nil,                           // 78: resource.v1.InternalGetFilesResponse.FilesEntry
+	nil,                           // 79: resource.v1.InternalGetFileUrlsResponse.ResultsEntry
+	nil,                           // 80: resource.v1.InternalGetDownloadUrlsResponse.ResultsEntry
+	nil,                           // 81: resource.v1.InternalFilesExistResponse.ExistsEntry
+	nil,                           // 82: resource.v1.InternalUpdateFileMetadataRequest.MetadataEntry
+	nil,                           // 83: resource.v1.InternalBatchDeleteFilesResponse.ResultsEntry
+	nil,                           // 84: resource.v1.InternalGetUsageResponse.CategoriesEntry
+	nil,                           // 85: resource.v1.InternalCreateUploadUrlResponse.HeadersEntry
+	(*timestamppb.Timestamp)(nil), // 86: google.protobuf.Timestamp
 }
 var file_resource_v1_resource_internal_proto_depIdxs = []int32{
-	23, // 0: resource.v1.InternalFileInfo.created_at:type_name -> google.protobuf.Timestamp
-	23, // 1: resource.v1.InternalFileInfo.updated_at:type_name -> google.protobuf.Timestamp
-	19, // 2: resource.v1.InternalFileUrlInfo.variant_urls:type_name -> resource.v1.InternalFileUrlInfo.VariantUrlsEntry
-	0,  // 3: resource.v1.InternalGetFileResponse.file:type_name -> resource.v1.InternalFileInfo
-	20, // 4: resource.v1.InternalGetFilesResponse.files:type_name -> resource.v1.InternalGetFilesResponse.FilesEntry
-	21, // 5: resource.v1.InternalGetFileUrlsResponse.results:type_name -> resource.v1.InternalGetFileUrlsResponse.ResultsEntry
-	10, // 6: resource.v1.InternalGetDownloadUrlsRequest.files:type_name -> resource.v1.InternalFileDownloadRequest
-	22, // 7: resource.v1.InternalGetDownloadUrlsResponse.results:type_name -> resource.v1.InternalGetDownloadUrlsResponse.ResultsEntry
-	0,  // 8: resource.v1.InternalCheckFileExistsResponse.file:type_name -> resource.v1.InternalFileInfo
-	3,  // 9: resource.v1.InternalGetQuotaResponse.quota:type_name -> resource.v1.InternalQuotaInfo
-	3,  // 10: resource.v1.InternalCheckQuotaResponse.quota:type_name -> resource.v1.InternalQuotaInfo
-	0,  // 11: resource.v1.InternalGetFilesResponse.FilesEntry.value:type_name -> resource.v1.InternalFileInfo
-	1,  // 12: resource.v1.InternalGetFileUrlsResponse.ResultsEntry.value:type_name -> resource.v1.InternalFileUrlInfo
-	2,  // 13: resource.v1.InternalGetDownloadUrlsResponse.ResultsEntry.value:type_name -> resource.v1.InternalFileDownloadInfo
-	4,  // 14: resource.v1.ResourceInternalService.InternalGetFile:input_type -> resource.v1.InternalGetFileRequest
-	6,  // 15: resource.v1.ResourceInternalService.InternalGetFiles:input_type -> resource.v1.InternalGetFilesRequest
-	8,  // 16: resource.v1.ResourceInternalService.InternalGetFileUrls:input_type -> resource.v1.InternalGetFileUrlsRequest
-	11, // 17: resource.v1.ResourceInternalService.InternalGetDownloadUrls:input_type -> resource.v1.InternalGetDownloadUrlsRequest
-	13, // 18: resource.v1.ResourceInternalService.InternalCheckFileExists:input_type -> resource.v1.InternalCheckFileExistsRequest
-	15, // 19: resource.v1.ResourceInternalService.InternalGetQuota:input_type -> resource.v1.InternalGetQuotaRequest
-	17, // 20: resource.v1.ResourceInternalService.InternalCheckQuota:input_type -> resource.v1.InternalCheckQuotaRequest
-	5,  // 21: resource.v1.ResourceInternalService.InternalGetFile:output_type -> resource.v1.InternalGetFileResponse
-	7,  // 22: resource.v1.ResourceInternalService.InternalGetFiles:output_type -> resource.v1.InternalGetFilesResponse
-	9,  // 23: resource.v1.ResourceInternalService.InternalGetFileUrls:output_type -> resource.v1.InternalGetFileUrlsResponse
-	12, // 24: resource.v1.ResourceInternalService.InternalGetDownloadUrls:output_type -> resource.v1.InternalGetDownloadUrlsResponse
-	14, // 25: resource.v1.ResourceInternalService.InternalCheckFileExists:output_type -> resource.v1.InternalCheckFileExistsResponse
-	16, // 26: resource.v1.ResourceInternalService.InternalGetQuota:output_type -> resource.v1.InternalGetQuotaResponse
-	18, // 27: resource.v1.ResourceInternalService.InternalCheckQuota:output_type -> resource.v1.InternalCheckQuotaResponse
-	21, // [21:28] is the sub-list for method output_type
-	14, // [14:21] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	86, // 0: resource.v1.InternalFileInfo.created_at:type_name -> google.protobuf.Timestamp
+	86, // 1: resource.v1.InternalFileInfo.updated_at:type_name -> google.protobuf.Timestamp
+	76, // 2: resource.v1.InternalFileInfo.metadata:type_name -> resource.v1.InternalFileInfo.MetadataEntry
+	77, // 3: resource.v1.InternalFileUrlInfo.variant_urls:type_name -> resource.v1.InternalFileUrlInfo.VariantUrlsEntry
+	0,  // 4: resource.v1.InternalGetFileResponse.file:type_name -> resource.v1.InternalFileInfo
+	78, // 5: resource.v1.InternalGetFilesResponse.files:type_name -> resource.v1.InternalGetFilesResponse.FilesEntry
+	79, // 6: resource.v1.InternalGetFileUrlsResponse.results:type_name -> resource.v1.InternalGetFileUrlsResponse.ResultsEntry
+	11, // 7: resource.v1.InternalFileDownloadRequest.transform:type_name -> resource.v1.InternalImageTransform
+	12, // 8: resource.v1.InternalImageTransform.crop:type_name -> resource.v1.InternalImageCrop
+	10, // 9: resource.v1.InternalGetDownloadUrlsRequest.files:type_name -> resource.v1.InternalFileDownloadRequest
+	80, // 10: resource.v1.InternalGetDownloadUrlsResponse.results:type_name -> resource.v1.InternalGetDownloadUrlsResponse.ResultsEntry
+	0,  // 11: resource.v1.InternalCheckFileExistsResponse.file:type_name -> resource.v1.InternalFileInfo
+	81, // 12: resource.v1.InternalFilesExistResponse.exists:type_name -> resource.v1.InternalFilesExistResponse.ExistsEntry
+	0,  // 13: resource.v1.InternalListFilesResponse.files:type_name -> resource.v1.InternalFileInfo
+	82, // 14: resource.v1.InternalUpdateFileMetadataRequest.metadata:type_name -> resource.v1.InternalUpdateFileMetadataRequest.MetadataEntry
+	0,  // 15: resource.v1.InternalUpdateFileMetadataResponse.file:type_name -> resource.v1.InternalFileInfo
+	83, // 16: resource.v1.InternalBatchDeleteFilesResponse.results:type_name -> resource.v1.InternalBatchDeleteFilesResponse.ResultsEntry
+	86, // 17: resource.v1.InternalFolderInfo.created_at:type_name -> google.protobuf.Timestamp
+	28, // 18: resource.v1.InternalCreateFolderResponse.folder:type_name -> resource.v1.InternalFolderInfo
+	28, // 19: resource.v1.InternalListFoldersResponse.folders:type_name -> resource.v1.InternalFolderInfo
+	3,  // 20: resource.v1.InternalGetQuotaResponse.quota:type_name -> resource.v1.InternalQuotaInfo
+	3,  // 21: resource.v1.InternalGetUsageResponse.quota:type_name -> resource.v1.InternalQuotaInfo
+	84, // 22: resource.v1.InternalGetUsageResponse.categories:type_name -> resource.v1.InternalGetUsageResponse.CategoriesEntry
+	3,  // 23: resource.v1.InternalCheckQuotaResponse.quota:type_name -> resource.v1.InternalQuotaInfo
+	0,  // 24: resource.v1.InternalGenerateQRCodeResponse.file:type_name -> resource.v1.InternalFileInfo
+	44, // 25: resource.v1.InternalCreateArchiveResponse.archive:type_name -> resource.v1.InternalArchiveInfo
+	44, // 26: resource.v1.InternalGetArchiveResponse.archive:type_name -> resource.v1.InternalArchiveInfo
+	86, // 27: resource.v1.InternalShareLinkInfo.expires_at:type_name -> google.protobuf.Timestamp
+	86, // 28: resource.v1.InternalShareLinkInfo.created_at:type_name -> google.protobuf.Timestamp
+	49, // 29: resource.v1.InternalCreateShareLinkResponse.share:type_name -> resource.v1.InternalShareLinkInfo
+	86, // 30: resource.v1.InternalScanStatus.scanned_at:type_name -> google.protobuf.Timestamp
+	54, // 31: resource.v1.InternalGetScanStatusResponse.status:type_name -> resource.v1.InternalScanStatus
+	57, // 32: resource.v1.InternalUploadFileRequest.meta:type_name -> resource.v1.InternalUploadFileMeta
+	0,  // 33: resource.v1.InternalUploadFileResponse.file:type_name -> resource.v1.InternalFileInfo
+	85, // 34: resource.v1.InternalCreateUploadUrlResponse.headers:type_name -> resource.v1.InternalCreateUploadUrlResponse.HeadersEntry
+	0,  // 35: resource.v1.InternalConfirmUploadResponse.file:type_name -> resource.v1.InternalFileInfo
+	67, // 36: resource.v1.InternalUploadPartRequest.meta:type_name -> resource.v1.InternalUploadPartMeta
+	64, // 37: resource.v1.InternalUploadPartResponse.part:type_name -> resource.v1.InternalUploadedPart
+	64, // 38: resource.v1.InternalListUploadedPartsResponse.parts:type_name -> resource.v1.InternalUploadedPart
+	64, // 39: resource.v1.InternalCompleteMultipartUploadRequest.parts:type_name -> resource.v1.InternalUploadedPart
+	0,  // 40: resource.v1.InternalCompleteMultipartUploadResponse.file:type_name -> resource.v1.InternalFileInfo
+	0,  // 41: resource.v1.InternalGetFilesResponse.FilesEntry.value:type_name -> resource.v1.InternalFileInfo
+	1,  // 42: resource.v1.InternalGetFileUrlsResponse.ResultsEntry.value:type_name -> resource.v1.InternalFileUrlInfo
+	2,  // 43: resource.v1.InternalGetDownloadUrlsResponse.ResultsEntry.value:type_name -> resource.v1.InternalFileDownloadInfo
+	25, // 44: resource.v1.InternalBatchDeleteFilesResponse.ResultsEntry.value:type_name -> resource.v1.InternalDeleteResult
+	38, // 45: resource.v1.InternalGetUsageResponse.CategoriesEntry.value:type_name -> resource.v1.InternalCategoryUsage
+	4,  // 46: resource.v1.ResourceInternalService.InternalGetFile:input_type -> resource.v1.InternalGetFileRequest
+	6,  // 47: resource.v1.ResourceInternalService.InternalGetFiles:input_type -> resource.v1.InternalGetFilesRequest
+	8,  // 48: resource.v1.ResourceInternalService.InternalGetFileUrls:input_type -> resource.v1.InternalGetFileUrlsRequest
+	13, // 49: resource.v1.ResourceInternalService.InternalGetDownloadUrls:input_type -> resource.v1.InternalGetDownloadUrlsRequest
+	15, // 50: resource.v1.ResourceInternalService.InternalCheckFileExists:input_type -> resource.v1.InternalCheckFileExistsRequest
+	17, // 51: resource.v1.ResourceInternalService.InternalFilesExist:input_type -> resource.v1.InternalFilesExistRequest
+	19, // 52: resource.v1.ResourceInternalService.InternalListFiles:input_type -> resource.v1.InternalListFilesRequest
+	21, // 53: resource.v1.ResourceInternalService.InternalUpdateFileMetadata:input_type -> resource.v1.InternalUpdateFileMetadataRequest
+	23, // 54: resource.v1.ResourceInternalService.InternalDeleteFile:input_type -> resource.v1.InternalDeleteFileRequest
+	26, // 55: resource.v1.ResourceInternalService.InternalBatchDeleteFiles:input_type -> resource.v1.InternalBatchDeleteFilesRequest
+	29, // 56: resource.v1.ResourceInternalService.InternalCreateFolder:input_type -> resource.v1.InternalCreateFolderRequest
+	31, // 57: resource.v1.ResourceInternalService.InternalListFolders:input_type -> resource.v1.InternalListFoldersRequest
+	33, // 58: resource.v1.ResourceInternalService.InternalDeleteFolder:input_type -> resource.v1.InternalDeleteFolderRequest
+	35, // 59: resource.v1.ResourceInternalService.InternalGetQuota:input_type -> resource.v1.InternalGetQuotaRequest
+	40, // 60: resource.v1.ResourceInternalService.InternalCheckQuota:input_type -> resource.v1.InternalCheckQuotaRequest
+	37, // 61: resource.v1.ResourceInternalService.InternalGetUsage:input_type -> resource.v1.InternalGetUsageRequest
+	42, // 62: resource.v1.ResourceInternalService.InternalGenerateQRCode:input_type -> resource.v1.InternalGenerateQRCodeRequest
+	45, // 63: resource.v1.ResourceInternalService.InternalCreateArchive:input_type -> resource.v1.InternalCreateArchiveRequest
+	47, // 64: resource.v1.ResourceInternalService.InternalGetArchive:input_type -> resource.v1.InternalGetArchiveRequest
+	50, // 65: resource.v1.ResourceInternalService.InternalCreateShareLink:input_type -> resource.v1.InternalCreateShareLinkRequest
+	52, // 66: resource.v1.ResourceInternalService.InternalRevokeShareLink:input_type -> resource.v1.InternalRevokeShareLinkRequest
+	55, // 67: resource.v1.ResourceInternalService.InternalGetScanStatus:input_type -> resource.v1.InternalGetScanStatusRequest
+	58, // 68: resource.v1.ResourceInternalService.InternalUploadFile:input_type -> resource.v1.InternalUploadFileRequest
+	60, // 69: resource.v1.ResourceInternalService.InternalCreateUploadUrl:input_type -> resource.v1.InternalCreateUploadUrlRequest
+	62, // 70: resource.v1.ResourceInternalService.InternalConfirmUpload:input_type -> resource.v1.InternalConfirmUploadRequest
+	65, // 71: resource.v1.ResourceInternalService.InternalInitMultipartUpload:input_type -> resource.v1.InternalInitMultipartUploadRequest
+	68, // 72: resource.v1.ResourceInternalService.InternalUploadPart:input_type -> resource.v1.InternalUploadPartRequest
+	70, // 73: resource.v1.ResourceInternalService.InternalListUploadedParts:input_type -> resource.v1.InternalListUploadedPartsRequest
+	72, // 74: resource.v1.ResourceInternalService.InternalCompleteMultipartUpload:input_type -> resource.v1.InternalCompleteMultipartUploadRequest
+	74, // 75: resource.v1.ResourceInternalService.InternalAbortMultipartUpload:input_type -> resource.v1.InternalAbortMultipartUploadRequest
+	5,  // 76: resource.v1.ResourceInternalService.InternalGetFile:output_type -> resource.v1.InternalGetFileResponse
+	7,  // 77: resource.v1.ResourceInternalService.InternalGetFiles:output_type -> resource.v1.InternalGetFilesResponse
+	9,  // 78: resource.v1.ResourceInternalService.InternalGetFileUrls:output_type -> resource.v1.InternalGetFileUrlsResponse
+	14, // 79: resource.v1.ResourceInternalService.InternalGetDownloadUrls:output_type -> resource.v1.InternalGetDownloadUrlsResponse
+	16, // 80: resource.v1.ResourceInternalService.InternalCheckFileExists:output_type -> resource.v1.InternalCheckFileExistsResponse
+	18, // 81: resource.v1.ResourceInternalService.InternalFilesExist:output_type -> resource.v1.InternalFilesExistResponse
+	20, // 82: resource.v1.ResourceInternalService.InternalListFiles:output_type -> resource.v1.InternalListFilesResponse
+	22, // 83: resource.v1.ResourceInternalService.InternalUpdateFileMetadata:output_type -> resource.v1.InternalUpdateFileMetadataResponse
+	24, // 84: resource.v1.ResourceInternalService.InternalDeleteFile:output_type -> resource.v1.InternalDeleteFileResponse
+	27, // 85: resource.v1.ResourceInternalService.InternalBatchDeleteFiles:output_type -> resource.v1.InternalBatchDeleteFilesResponse
+	30, // 86: resource.v1.ResourceInternalService.InternalCreateFolder:output_type -> resource.v1.InternalCreateFolderResponse
+	32, // 87: resource.v1.ResourceInternalService.InternalListFolders:output_type -> resource.v1.InternalListFoldersResponse
+	34, // 88: resource.v1.ResourceInternalService.InternalDeleteFolder:output_type -> resource.v1.InternalDeleteFolderResponse
+	36, // 89: resource.v1.ResourceInternalService.InternalGetQuota:output_type -> resource.v1.InternalGetQuotaResponse
+	41, // 90: resource.v1.ResourceInternalService.InternalCheckQuota:output_type -> resource.v1.InternalCheckQuotaResponse
+	39, // 91: resource.v1.ResourceInternalService.InternalGetUsage:output_type -> resource.v1.InternalGetUsageResponse
+	43, // 92: resource.v1.ResourceInternalService.InternalGenerateQRCode:output_type -> resource.v1.InternalGenerateQRCodeResponse
+	46, // 93: resource.v1.ResourceInternalService.InternalCreateArchive:output_type -> resource.v1.InternalCreateArchiveResponse
+	48, // 94: resource.v1.ResourceInternalService.InternalGetArchive:output_type -> resource.v1.InternalGetArchiveResponse
+	51, // 95: resource.v1.ResourceInternalService.InternalCreateShareLink:output_type -> resource.v1.InternalCreateShareLinkResponse
+	53, // 96: resource.v1.ResourceInternalService.InternalRevokeShareLink:output_type -> resource.v1.InternalRevokeShareLinkResponse
+	56, // 97: resource.v1.ResourceInternalService.InternalGetScanStatus:output_type -> resource.v1.InternalGetScanStatusResponse
+	59, // 98: resource.v1.ResourceInternalService.InternalUploadFile:output_type -> resource.v1.InternalUploadFileResponse
+	61, // 99: resource.v1.ResourceInternalService.InternalCreateUploadUrl:output_type -> resource.v1.InternalCreateUploadUrlResponse
+	63, // 100: resource.v1.ResourceInternalService.InternalConfirmUpload:output_type -> resource.v1.InternalConfirmUploadResponse
+	66, // 101: resource.v1.ResourceInternalService.InternalInitMultipartUpload:output_type -> resource.v1.InternalInitMultipartUploadResponse
+	69, // 102: resource.v1.ResourceInternalService.InternalUploadPart:output_type -> resource.v1.InternalUploadPartResponse
+	71, // 103: resource.v1.ResourceInternalService.InternalListUploadedParts:output_type -> resource.v1.InternalListUploadedPartsResponse
+	73, // 104: resource.v1.ResourceInternalService.InternalCompleteMultipartUpload:output_type -> resource.v1.InternalCompleteMultipartUploadResponse
+	75, // 105: resource.v1.ResourceInternalService.InternalAbortMultipartUpload:output_type -> resource.v1.InternalAbortMultipartUploadResponse
+	76, // [76:106] is the sub-list for method output_type
+	46, // [46:76] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_resource_v1_resource_internal_proto_init() }
@@ -1583,7 +5739,7 @@ func file_resource_v1_resource_internal_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_v1_resource_internal_proto_rawDesc), len(file_resource_v1_resource_internal_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   23,
+			NumMessages:   86,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1426,6 +1426,261 @@ var _ interface {
 	ErrorName() string
 } = InternalFileDownloadRequestValidationError{}
 
+// Validate checks the field values on InternalImageTransform with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalImageTransform) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalImageTransform with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalImageTransformMultiError, or nil if none found.
+func (m *InternalImageTransform) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalImageTransform) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	// no validation rules for ResizeMode
+
+	if all {
+		switch v := interface{}(m.GetCrop()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalImageTransformValidationError{
+					field:  "Crop",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalImageTransformValidationError{
+					field:  "Crop",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrop()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalImageTransformValidationError{
+				field:  "Crop",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for WatermarkText
+
+	// no validation rules for Format
+
+	// no validation rules for Quality
+
+	if len(errors) > 0 {
+		return InternalImageTransformMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalImageTransformMultiError is an error wrapping multiple validation
+// errors returned by InternalImageTransform.ValidateAll() if the designated
+// constraints aren't met.
+type InternalImageTransformMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalImageTransformMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalImageTransformMultiError) AllErrors() []error { return m }
+
+// InternalImageTransformValidationError is the validation error returned by
+// InternalImageTransform.Validate if the designated constraints aren't met.
+type InternalImageTransformValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalImageTransformValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalImageTransformValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalImageTransformValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalImageTransformValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalImageTransformValidationError) ErrorName() string {
+	return "InternalImageTransformValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalImageTransformValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalImageTransform.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalImageTransformValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalImageTransformValidationError{}
+
+// Validate checks the field values on InternalImageCrop with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalImageCrop) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalImageCrop with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalImageCropMultiError, or nil if none found.
+func (m *InternalImageCrop) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalImageCrop) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for X
+
+	// no validation rules for Y
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	if len(errors) > 0 {
+		return InternalImageCropMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalImageCropMultiError is an error wrapping multiple validation errors
+// returned by InternalImageCrop.ValidateAll() if the designated constraints
+// aren't met.
+type InternalImageCropMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalImageCropMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalImageCropMultiError) AllErrors() []error { return m }
+
+// InternalImageCropValidationError is the validation error returned by
+// InternalImageCrop.Validate if the designated constraints aren't met.
+type InternalImageCropValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalImageCropValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalImageCropValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalImageCropValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalImageCropValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalImageCropValidationError) ErrorName() string {
+	return "InternalImageCropValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalImageCropValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalImageCrop.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalImageCropValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalImageCropValidationError{}
+
 // Validate checks the field values on InternalGetDownloadUrlsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1961,22 +2216,23 @@ var _ interface {
 	ErrorName() string
 } = InternalCheckFileExistsResponseValidationError{}
 
-// Validate checks the field values on InternalGetQuotaRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InternalGetQuotaRequest) Validate() error {
+// Validate checks the field values on InternalFilesExistRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalFilesExistRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InternalGetQuotaRequest with the
+// ValidateAll checks the field values on InternalFilesExistRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InternalGetQuotaRequestMultiError, or nil if none found.
-func (m *InternalGetQuotaRequest) ValidateAll() error {
+// InternalFilesExistRequestMultiError, or nil if none found.
+func (m *InternalFilesExistRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InternalGetQuotaRequest) validate(all bool) error {
+func (m *InternalFilesExistRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1986,19 +2242,19 @@ func (m *InternalGetQuotaRequest) validate(all bool) error {
 	// no validation rules for TenantId
 
 	if len(errors) > 0 {
-		return InternalGetQuotaRequestMultiError(errors)
+		return InternalFilesExistRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// InternalGetQuotaRequestMultiError is an error wrapping multiple validation
-// errors returned by InternalGetQuotaRequest.ValidateAll() if the designated
-// constraints aren't met.
-type InternalGetQuotaRequestMultiError []error
+// InternalFilesExistRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalFilesExistRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalFilesExistRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InternalGetQuotaRequestMultiError) Error() string {
+func (m InternalFilesExistRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2007,11 +2263,12 @@ func (m InternalGetQuotaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InternalGetQuotaRequestMultiError) AllErrors() []error { return m }
+func (m InternalFilesExistRequestMultiError) AllErrors() []error { return m }
 
-// InternalGetQuotaRequestValidationError is the validation error returned by
-// InternalGetQuotaRequest.Validate if the designated constraints aren't met.
-type InternalGetQuotaRequestValidationError struct {
+// InternalFilesExistRequestValidationError is the validation error returned
+// by InternalFilesExistRequest.Validate if the designated constraints aren't
+// met.
+type InternalFilesExistRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2019,24 +2276,24 @@ type InternalGetQuotaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e InternalGetQuotaRequestValidationError) Field() string { return e.field }
+func (e InternalFilesExistRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InternalGetQuotaRequestValidationError) Reason() string { return e.reason }
+func (e InternalFilesExistRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InternalGetQuotaRequestValidationError) Cause() error { return e.cause }
+func (e InternalFilesExistRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InternalGetQuotaRequestValidationError) Key() bool { return e.key }
+func (e InternalFilesExistRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InternalGetQuotaRequestValidationError) ErrorName() string {
-	return "InternalGetQuotaRequestValidationError"
+func (e InternalFilesExistRequestValidationError) ErrorName() string {
+	return "InternalFilesExistRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InternalGetQuotaRequestValidationError) Error() string {
+func (e InternalFilesExistRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2048,14 +2305,14 @@ func (e InternalGetQuotaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInternalGetQuotaRequest.%s: %s%s",
+		"invalid %sInternalFilesExistRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InternalGetQuotaRequestValidationError{}
+var _ error = InternalFilesExistRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2063,73 +2320,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InternalGetQuotaRequestValidationError{}
+} = InternalFilesExistRequestValidationError{}
 
-// Validate checks the field values on InternalGetQuotaResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InternalGetQuotaResponse) Validate() error {
+// Validate checks the field values on InternalFilesExistResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalFilesExistResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InternalGetQuotaResponse with the
+// ValidateAll checks the field values on InternalFilesExistResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InternalGetQuotaResponseMultiError, or nil if none found.
-func (m *InternalGetQuotaResponse) ValidateAll() error {
+// InternalFilesExistResponseMultiError, or nil if none found.
+func (m *InternalFilesExistResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InternalGetQuotaResponse) validate(all bool) error {
+func (m *InternalFilesExistResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetQuota()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InternalGetQuotaResponseValidationError{
-					field:  "Quota",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, InternalGetQuotaResponseValidationError{
-					field:  "Quota",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return InternalGetQuotaResponseValidationError{
-				field:  "Quota",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Exists
 
 	if len(errors) > 0 {
-		return InternalGetQuotaResponseMultiError(errors)
+		return InternalFilesExistResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// InternalGetQuotaResponseMultiError is an error wrapping multiple validation
-// errors returned by InternalGetQuotaResponse.ValidateAll() if the designated
-// constraints aren't met.
-type InternalGetQuotaResponseMultiError []error
+// InternalFilesExistResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalFilesExistResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalFilesExistResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InternalGetQuotaResponseMultiError) Error() string {
+func (m InternalFilesExistResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2138,11 +2369,12 @@ func (m InternalGetQuotaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InternalGetQuotaResponseMultiError) AllErrors() []error { return m }
+func (m InternalFilesExistResponseMultiError) AllErrors() []error { return m }
 
-// InternalGetQuotaResponseValidationError is the validation error returned by
-// InternalGetQuotaResponse.Validate if the designated constraints aren't met.
-type InternalGetQuotaResponseValidationError struct {
+// InternalFilesExistResponseValidationError is the validation error returned
+// by InternalFilesExistResponse.Validate if the designated constraints aren't
+// met.
+type InternalFilesExistResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2150,24 +2382,24 @@ type InternalGetQuotaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e InternalGetQuotaResponseValidationError) Field() string { return e.field }
+func (e InternalFilesExistResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InternalGetQuotaResponseValidationError) Reason() string { return e.reason }
+func (e InternalFilesExistResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InternalGetQuotaResponseValidationError) Cause() error { return e.cause }
+func (e InternalFilesExistResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InternalGetQuotaResponseValidationError) Key() bool { return e.key }
+func (e InternalFilesExistResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InternalGetQuotaResponseValidationError) ErrorName() string {
-	return "InternalGetQuotaResponseValidationError"
+func (e InternalFilesExistResponseValidationError) ErrorName() string {
+	return "InternalFilesExistResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InternalGetQuotaResponseValidationError) Error() string {
+func (e InternalFilesExistResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2179,14 +2411,14 @@ func (e InternalGetQuotaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInternalGetQuotaResponse.%s: %s%s",
+		"invalid %sInternalFilesExistResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InternalGetQuotaResponseValidationError{}
+var _ error = InternalFilesExistResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2194,24 +2426,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InternalGetQuotaResponseValidationError{}
+} = InternalFilesExistResponseValidationError{}
 
-// Validate checks the field values on InternalCheckQuotaRequest with the rules
+// Validate checks the field values on InternalListFilesRequest with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InternalCheckQuotaRequest) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalListFilesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InternalCheckQuotaRequest with the
+// ValidateAll checks the field values on InternalListFilesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InternalCheckQuotaRequestMultiError, or nil if none found.
-func (m *InternalCheckQuotaRequest) ValidateAll() error {
+// InternalListFilesRequestMultiError, or nil if none found.
+func (m *InternalListFilesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InternalCheckQuotaRequest) validate(all bool) error {
+func (m *InternalListFilesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2220,24 +2453,30 @@ func (m *InternalCheckQuotaRequest) validate(all bool) error {
 
 	// no validation rules for TenantId
 
-	// no validation rules for CheckType
+	// no validation rules for Folder
 
-	// no validation rules for Size
+	// no validation rules for FileCategory
+
+	// no validation rules for Status
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
 
 	if len(errors) > 0 {
-		return InternalCheckQuotaRequestMultiError(errors)
+		return InternalListFilesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// InternalCheckQuotaRequestMultiError is an error wrapping multiple validation
-// errors returned by InternalCheckQuotaRequest.ValidateAll() if the
-// designated constraints aren't met.
-type InternalCheckQuotaRequestMultiError []error
+// InternalListFilesRequestMultiError is an error wrapping multiple validation
+// errors returned by InternalListFilesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalListFilesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InternalCheckQuotaRequestMultiError) Error() string {
+func (m InternalListFilesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2246,11 +2485,11 @@ func (m InternalCheckQuotaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InternalCheckQuotaRequestMultiError) AllErrors() []error { return m }
+func (m InternalListFilesRequestMultiError) AllErrors() []error { return m }
 
-// InternalCheckQuotaRequestValidationError is the validation error returned by
-// InternalCheckQuotaRequest.Validate if the designated constraints aren't met.
-type InternalCheckQuotaRequestValidationError struct {
+// InternalListFilesRequestValidationError is the validation error returned by
+// InternalListFilesRequest.Validate if the designated constraints aren't met.
+type InternalListFilesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2258,24 +2497,24 @@ type InternalCheckQuotaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e InternalCheckQuotaRequestValidationError) Field() string { return e.field }
+func (e InternalListFilesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InternalCheckQuotaRequestValidationError) Reason() string { return e.reason }
+func (e InternalListFilesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InternalCheckQuotaRequestValidationError) Cause() error { return e.cause }
+func (e InternalListFilesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InternalCheckQuotaRequestValidationError) Key() bool { return e.key }
+func (e InternalListFilesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InternalCheckQuotaRequestValidationError) ErrorName() string {
-	return "InternalCheckQuotaRequestValidationError"
+func (e InternalListFilesRequestValidationError) ErrorName() string {
+	return "InternalListFilesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InternalCheckQuotaRequestValidationError) Error() string {
+func (e InternalListFilesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2287,14 +2526,14 @@ func (e InternalCheckQuotaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInternalCheckQuotaRequest.%s: %s%s",
+		"invalid %sInternalListFilesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InternalCheckQuotaRequestValidationError{}
+var _ error = InternalListFilesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2302,77 +2541,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InternalCheckQuotaRequestValidationError{}
+} = InternalListFilesRequestValidationError{}
 
-// Validate checks the field values on InternalCheckQuotaResponse with the
+// Validate checks the field values on InternalListFilesResponse with the
 // rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InternalCheckQuotaResponse) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalListFilesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InternalCheckQuotaResponse with the
+// ValidateAll checks the field values on InternalListFilesResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InternalCheckQuotaResponseMultiError, or nil if none found.
-func (m *InternalCheckQuotaResponse) ValidateAll() error {
+// InternalListFilesResponseMultiError, or nil if none found.
+func (m *InternalListFilesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InternalCheckQuotaResponse) validate(all bool) error {
+func (m *InternalListFilesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Allowed
-
-	// no validation rules for Reason
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetQuota()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InternalCheckQuotaResponseValidationError{
-					field:  "Quota",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalListFilesResponseValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalListFilesResponseValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, InternalCheckQuotaResponseValidationError{
-					field:  "Quota",
+				return InternalListFilesResponseValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return InternalCheckQuotaResponseValidationError{
-				field:  "Quota",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for NextPageToken
+
+	// no validation rules for Total
+
 	if len(errors) > 0 {
-		return InternalCheckQuotaResponseMultiError(errors)
+		return InternalListFilesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// InternalCheckQuotaResponseMultiError is an error wrapping multiple
-// validation errors returned by InternalCheckQuotaResponse.ValidateAll() if
+// InternalListFilesResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalListFilesResponse.ValidateAll() if
 // the designated constraints aren't met.
-type InternalCheckQuotaResponseMultiError []error
+type InternalListFilesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InternalCheckQuotaResponseMultiError) Error() string {
+func (m InternalListFilesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2381,11 +2626,12 @@ func (m InternalCheckQuotaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InternalCheckQuotaResponseMultiError) AllErrors() []error { return m }
+func (m InternalListFilesResponseMultiError) AllErrors() []error { return m }
 
-// InternalCheckQuotaResponseValidationError is the validation error returned
-// by InternalCheckQuotaResponse.Validate if the designated constraints aren't met.
-type InternalCheckQuotaResponseValidationError struct {
+// InternalListFilesResponseValidationError is the validation error returned
+// by InternalListFilesResponse.Validate if the designated constraints aren't
+// met.
+type InternalListFilesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2393,24 +2639,24 @@ type InternalCheckQuotaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e InternalCheckQuotaResponseValidationError) Field() string { return e.field }
+func (e InternalListFilesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InternalCheckQuotaResponseValidationError) Reason() string { return e.reason }
+func (e InternalListFilesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InternalCheckQuotaResponseValidationError) Cause() error { return e.cause }
+func (e InternalListFilesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InternalCheckQuotaResponseValidationError) Key() bool { return e.key }
+func (e InternalListFilesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InternalCheckQuotaResponseValidationError) ErrorName() string {
-	return "InternalCheckQuotaResponseValidationError"
+func (e InternalListFilesResponseValidationError) ErrorName() string {
+	return "InternalListFilesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InternalCheckQuotaResponseValidationError) Error() string {
+func (e InternalListFilesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2422,14 +2668,14 @@ func (e InternalCheckQuotaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInternalCheckQuotaResponse.%s: %s%s",
+		"invalid %sInternalListFilesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InternalCheckQuotaResponseValidationError{}
+var _ error = InternalListFilesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2437,4 +2683,6748 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InternalCheckQuotaResponseValidationError{}
+} = InternalListFilesResponseValidationError{}
+
+// Validate checks the field values on InternalUpdateFileMetadataRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalUpdateFileMetadataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalUpdateFileMetadataRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalUpdateFileMetadataRequestMultiError, or nil if none found.
+func (m *InternalUpdateFileMetadataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalUpdateFileMetadataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for FileId
+
+	// no validation rules for Filename
+
+	// no validation rules for Metadata
+
+	if len(errors) > 0 {
+		return InternalUpdateFileMetadataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalUpdateFileMetadataRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalUpdateFileMetadataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalUpdateFileMetadataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalUpdateFileMetadataRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalUpdateFileMetadataRequestMultiError) AllErrors() []error { return m }
+
+// InternalUpdateFileMetadataRequestValidationError is the validation error
+// returned by InternalUpdateFileMetadataRequest.Validate if the designated
+// constraints aren't met.
+type InternalUpdateFileMetadataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalUpdateFileMetadataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalUpdateFileMetadataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalUpdateFileMetadataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalUpdateFileMetadataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalUpdateFileMetadataRequestValidationError) ErrorName() string {
+	return "InternalUpdateFileMetadataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalUpdateFileMetadataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalUpdateFileMetadataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalUpdateFileMetadataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalUpdateFileMetadataRequestValidationError{}
+
+// Validate checks the field values on InternalUpdateFileMetadataResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalUpdateFileMetadataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalUpdateFileMetadataResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalUpdateFileMetadataResponseMultiError, or nil if none found.
+func (m *InternalUpdateFileMetadataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalUpdateFileMetadataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalUpdateFileMetadataResponseValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalUpdateFileMetadataResponseValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalUpdateFileMetadataResponseValidationError{
+				field:  "File",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalUpdateFileMetadataResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalUpdateFileMetadataResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalUpdateFileMetadataResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalUpdateFileMetadataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalUpdateFileMetadataResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalUpdateFileMetadataResponseMultiError) AllErrors() []error { return m }
+
+// InternalUpdateFileMetadataResponseValidationError is the validation error
+// returned by InternalUpdateFileMetadataResponse.Validate if the designated
+// constraints aren't met.
+type InternalUpdateFileMetadataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalUpdateFileMetadataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalUpdateFileMetadataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalUpdateFileMetadataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalUpdateFileMetadataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalUpdateFileMetadataResponseValidationError) ErrorName() string {
+	return "InternalUpdateFileMetadataResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalUpdateFileMetadataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalUpdateFileMetadataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalUpdateFileMetadataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalUpdateFileMetadataResponseValidationError{}
+
+// Validate checks the field values on InternalDeleteFileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalDeleteFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalDeleteFileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalDeleteFileRequestMultiError, or nil if none found.
+func (m *InternalDeleteFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalDeleteFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for FileId
+
+	// no validation rules for Permanent
+
+	if len(errors) > 0 {
+		return InternalDeleteFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalDeleteFileRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalDeleteFileRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalDeleteFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalDeleteFileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalDeleteFileRequestMultiError) AllErrors() []error { return m }
+
+// InternalDeleteFileRequestValidationError is the validation error returned
+// by InternalDeleteFileRequest.Validate if the designated constraints aren't
+// met.
+type InternalDeleteFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalDeleteFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalDeleteFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalDeleteFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalDeleteFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalDeleteFileRequestValidationError) ErrorName() string {
+	return "InternalDeleteFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalDeleteFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalDeleteFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalDeleteFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalDeleteFileRequestValidationError{}
+
+// Validate checks the field values on InternalDeleteFileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalDeleteFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalDeleteFileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalDeleteFileResponseMultiError, or nil if none found.
+func (m *InternalDeleteFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalDeleteFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return InternalDeleteFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalDeleteFileResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalDeleteFileResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalDeleteFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalDeleteFileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalDeleteFileResponseMultiError) AllErrors() []error { return m }
+
+// InternalDeleteFileResponseValidationError is the validation error returned
+// by InternalDeleteFileResponse.Validate if the designated constraints aren't
+// met.
+type InternalDeleteFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalDeleteFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalDeleteFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalDeleteFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalDeleteFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalDeleteFileResponseValidationError) ErrorName() string {
+	return "InternalDeleteFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalDeleteFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalDeleteFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalDeleteFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalDeleteFileResponseValidationError{}
+
+// Validate checks the field values on InternalDeleteResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalDeleteResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalDeleteResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalDeleteResultMultiError, or nil if none found.
+func (m *InternalDeleteResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalDeleteResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return InternalDeleteResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalDeleteResultMultiError is an error wrapping multiple validation
+// errors returned by InternalDeleteResult.ValidateAll() if the designated
+// constraints aren't met.
+type InternalDeleteResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalDeleteResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalDeleteResultMultiError) AllErrors() []error { return m }
+
+// InternalDeleteResultValidationError is the validation error returned by
+// InternalDeleteResult.Validate if the designated constraints aren't met.
+type InternalDeleteResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalDeleteResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalDeleteResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalDeleteResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalDeleteResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalDeleteResultValidationError) ErrorName() string {
+	return "InternalDeleteResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalDeleteResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalDeleteResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalDeleteResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalDeleteResultValidationError{}
+
+// Validate checks the field values on InternalBatchDeleteFilesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalBatchDeleteFilesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalBatchDeleteFilesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalBatchDeleteFilesRequestMultiError, or nil if none found.
+func (m *InternalBatchDeleteFilesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalBatchDeleteFilesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for Permanent
+
+	if len(errors) > 0 {
+		return InternalBatchDeleteFilesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalBatchDeleteFilesRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalBatchDeleteFilesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type InternalBatchDeleteFilesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalBatchDeleteFilesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalBatchDeleteFilesRequestMultiError) AllErrors() []error { return m }
+
+// InternalBatchDeleteFilesRequestValidationError is the validation error
+// returned by InternalBatchDeleteFilesRequest.Validate if the designated
+// constraints aren't met.
+type InternalBatchDeleteFilesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalBatchDeleteFilesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalBatchDeleteFilesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalBatchDeleteFilesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalBatchDeleteFilesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalBatchDeleteFilesRequestValidationError) ErrorName() string {
+	return "InternalBatchDeleteFilesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalBatchDeleteFilesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalBatchDeleteFilesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalBatchDeleteFilesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalBatchDeleteFilesRequestValidationError{}
+
+// Validate checks the field values on InternalBatchDeleteFilesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalBatchDeleteFilesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalBatchDeleteFilesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalBatchDeleteFilesResponseMultiError, or nil if none found.
+func (m *InternalBatchDeleteFilesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalBatchDeleteFilesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{
+		sorted_keys := make([]string, len(m.GetResults()))
+		i := 0
+		for key := range m.GetResults() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetResults()[key]
+			_ = val
+
+			// no validation rules for Results[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, InternalBatchDeleteFilesResponseValidationError{
+							field:  fmt.Sprintf("Results[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, InternalBatchDeleteFilesResponseValidationError{
+							field:  fmt.Sprintf("Results[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return InternalBatchDeleteFilesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalBatchDeleteFilesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalBatchDeleteFilesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalBatchDeleteFilesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalBatchDeleteFilesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalBatchDeleteFilesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalBatchDeleteFilesResponseMultiError) AllErrors() []error { return m }
+
+// InternalBatchDeleteFilesResponseValidationError is the validation error
+// returned by InternalBatchDeleteFilesResponse.Validate if the designated
+// constraints aren't met.
+type InternalBatchDeleteFilesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalBatchDeleteFilesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalBatchDeleteFilesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalBatchDeleteFilesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalBatchDeleteFilesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalBatchDeleteFilesResponseValidationError) ErrorName() string {
+	return "InternalBatchDeleteFilesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalBatchDeleteFilesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalBatchDeleteFilesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalBatchDeleteFilesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalBatchDeleteFilesResponseValidationError{}
+
+// Validate checks the field values on InternalFolderInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalFolderInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalFolderInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalFolderInfoMultiError, or nil if none found.
+func (m *InternalFolderInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalFolderInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	// no validation rules for Name
+
+	// no validation rules for FileCount
+
+	// no validation rules for FolderCount
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalFolderInfoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalFolderInfoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalFolderInfoValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalFolderInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalFolderInfoMultiError is an error wrapping multiple validation
+// errors returned by InternalFolderInfo.ValidateAll() if the designated
+// constraints aren't met.
+type InternalFolderInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalFolderInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalFolderInfoMultiError) AllErrors() []error { return m }
+
+// InternalFolderInfoValidationError is the validation error returned by
+// InternalFolderInfo.Validate if the designated constraints aren't met.
+type InternalFolderInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalFolderInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalFolderInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalFolderInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalFolderInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalFolderInfoValidationError) ErrorName() string {
+	return "InternalFolderInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalFolderInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalFolderInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalFolderInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalFolderInfoValidationError{}
+
+// Validate checks the field values on InternalCreateFolderRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalCreateFolderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCreateFolderRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalCreateFolderRequestMultiError, or nil if none found.
+func (m *InternalCreateFolderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCreateFolderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for Path
+
+	if len(errors) > 0 {
+		return InternalCreateFolderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCreateFolderRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalCreateFolderRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalCreateFolderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCreateFolderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCreateFolderRequestMultiError) AllErrors() []error { return m }
+
+// InternalCreateFolderRequestValidationError is the validation error returned
+// by InternalCreateFolderRequest.Validate if the designated constraints
+// aren't met.
+type InternalCreateFolderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCreateFolderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCreateFolderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCreateFolderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCreateFolderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCreateFolderRequestValidationError) ErrorName() string {
+	return "InternalCreateFolderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCreateFolderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCreateFolderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCreateFolderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCreateFolderRequestValidationError{}
+
+// Validate checks the field values on InternalCreateFolderResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalCreateFolderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCreateFolderResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalCreateFolderResponseMultiError, or nil if none found.
+func (m *InternalCreateFolderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCreateFolderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFolder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalCreateFolderResponseValidationError{
+					field:  "Folder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalCreateFolderResponseValidationError{
+					field:  "Folder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFolder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalCreateFolderResponseValidationError{
+				field:  "Folder",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalCreateFolderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCreateFolderResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalCreateFolderResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalCreateFolderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCreateFolderResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCreateFolderResponseMultiError) AllErrors() []error { return m }
+
+// InternalCreateFolderResponseValidationError is the validation error
+// returned by InternalCreateFolderResponse.Validate if the designated
+// constraints aren't met.
+type InternalCreateFolderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCreateFolderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCreateFolderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCreateFolderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCreateFolderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCreateFolderResponseValidationError) ErrorName() string {
+	return "InternalCreateFolderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCreateFolderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCreateFolderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCreateFolderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCreateFolderResponseValidationError{}
+
+// Validate checks the field values on InternalListFoldersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalListFoldersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListFoldersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalListFoldersRequestMultiError, or nil if none found.
+func (m *InternalListFoldersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListFoldersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for Parent
+
+	if len(errors) > 0 {
+		return InternalListFoldersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListFoldersRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalListFoldersRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalListFoldersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListFoldersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListFoldersRequestMultiError) AllErrors() []error { return m }
+
+// InternalListFoldersRequestValidationError is the validation error returned
+// by InternalListFoldersRequest.Validate if the designated constraints aren't
+// met.
+type InternalListFoldersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListFoldersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListFoldersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListFoldersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListFoldersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListFoldersRequestValidationError) ErrorName() string {
+	return "InternalListFoldersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListFoldersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListFoldersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListFoldersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListFoldersRequestValidationError{}
+
+// Validate checks the field values on InternalListFoldersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalListFoldersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListFoldersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalListFoldersResponseMultiError, or nil if none found.
+func (m *InternalListFoldersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListFoldersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFolders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalListFoldersResponseValidationError{
+						field:  fmt.Sprintf("Folders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalListFoldersResponseValidationError{
+						field:  fmt.Sprintf("Folders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalListFoldersResponseValidationError{
+					field:  fmt.Sprintf("Folders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalListFoldersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListFoldersResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalListFoldersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalListFoldersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListFoldersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListFoldersResponseMultiError) AllErrors() []error { return m }
+
+// InternalListFoldersResponseValidationError is the validation error returned
+// by InternalListFoldersResponse.Validate if the designated constraints
+// aren't met.
+type InternalListFoldersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListFoldersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListFoldersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListFoldersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListFoldersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListFoldersResponseValidationError) ErrorName() string {
+	return "InternalListFoldersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListFoldersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListFoldersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListFoldersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListFoldersResponseValidationError{}
+
+// Validate checks the field values on InternalDeleteFolderRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalDeleteFolderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalDeleteFolderRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalDeleteFolderRequestMultiError, or nil if none found.
+func (m *InternalDeleteFolderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalDeleteFolderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for Path
+
+	// no validation rules for Recursive
+
+	if len(errors) > 0 {
+		return InternalDeleteFolderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalDeleteFolderRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalDeleteFolderRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalDeleteFolderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalDeleteFolderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalDeleteFolderRequestMultiError) AllErrors() []error { return m }
+
+// InternalDeleteFolderRequestValidationError is the validation error returned
+// by InternalDeleteFolderRequest.Validate if the designated constraints
+// aren't met.
+type InternalDeleteFolderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalDeleteFolderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalDeleteFolderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalDeleteFolderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalDeleteFolderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalDeleteFolderRequestValidationError) ErrorName() string {
+	return "InternalDeleteFolderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalDeleteFolderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalDeleteFolderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalDeleteFolderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalDeleteFolderRequestValidationError{}
+
+// Validate checks the field values on InternalDeleteFolderResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalDeleteFolderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalDeleteFolderResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalDeleteFolderResponseMultiError, or nil if none found.
+func (m *InternalDeleteFolderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalDeleteFolderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeletedFiles
+
+	if len(errors) > 0 {
+		return InternalDeleteFolderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalDeleteFolderResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalDeleteFolderResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalDeleteFolderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalDeleteFolderResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalDeleteFolderResponseMultiError) AllErrors() []error { return m }
+
+// InternalDeleteFolderResponseValidationError is the validation error
+// returned by InternalDeleteFolderResponse.Validate if the designated
+// constraints aren't met.
+type InternalDeleteFolderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalDeleteFolderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalDeleteFolderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalDeleteFolderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalDeleteFolderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalDeleteFolderResponseValidationError) ErrorName() string {
+	return "InternalDeleteFolderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalDeleteFolderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalDeleteFolderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalDeleteFolderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalDeleteFolderResponseValidationError{}
+
+// Validate checks the field values on InternalGetQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalGetQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetQuotaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalGetQuotaRequestMultiError, or nil if none found.
+func (m *InternalGetQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	if len(errors) > 0 {
+		return InternalGetQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetQuotaRequestMultiError is an error wrapping multiple validation
+// errors returned by InternalGetQuotaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalGetQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetQuotaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetQuotaRequestMultiError) AllErrors() []error { return m }
+
+// InternalGetQuotaRequestValidationError is the validation error returned by
+// InternalGetQuotaRequest.Validate if the designated constraints aren't met.
+type InternalGetQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetQuotaRequestValidationError) ErrorName() string {
+	return "InternalGetQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetQuotaRequestValidationError{}
+
+// Validate checks the field values on InternalGetQuotaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalGetQuotaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetQuotaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalGetQuotaResponseMultiError, or nil if none found.
+func (m *InternalGetQuotaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetQuotaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalGetQuotaResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalGetQuotaResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalGetQuotaResponseValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalGetQuotaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetQuotaResponseMultiError is an error wrapping multiple validation
+// errors returned by InternalGetQuotaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalGetQuotaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetQuotaResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetQuotaResponseMultiError) AllErrors() []error { return m }
+
+// InternalGetQuotaResponseValidationError is the validation error returned by
+// InternalGetQuotaResponse.Validate if the designated constraints aren't met.
+type InternalGetQuotaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetQuotaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetQuotaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetQuotaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetQuotaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetQuotaResponseValidationError) ErrorName() string {
+	return "InternalGetQuotaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetQuotaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetQuotaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetQuotaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetQuotaResponseValidationError{}
+
+// Validate checks the field values on InternalGetUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalGetUsageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetUsageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalGetUsageRequestMultiError, or nil if none found.
+func (m *InternalGetUsageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetUsageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	if len(errors) > 0 {
+		return InternalGetUsageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetUsageRequestMultiError is an error wrapping multiple validation
+// errors returned by InternalGetUsageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalGetUsageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetUsageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetUsageRequestMultiError) AllErrors() []error { return m }
+
+// InternalGetUsageRequestValidationError is the validation error returned by
+// InternalGetUsageRequest.Validate if the designated constraints aren't met.
+type InternalGetUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetUsageRequestValidationError) ErrorName() string {
+	return "InternalGetUsageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetUsageRequestValidationError{}
+
+// Validate checks the field values on InternalCategoryUsage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalCategoryUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCategoryUsage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalCategoryUsageMultiError, or nil if none found.
+func (m *InternalCategoryUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCategoryUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StorageUsed
+
+	// no validation rules for FileCount
+
+	if len(errors) > 0 {
+		return InternalCategoryUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCategoryUsageMultiError is an error wrapping multiple validation
+// errors returned by InternalCategoryUsage.ValidateAll() if the designated
+// constraints aren't met.
+type InternalCategoryUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCategoryUsageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCategoryUsageMultiError) AllErrors() []error { return m }
+
+// InternalCategoryUsageValidationError is the validation error returned by
+// InternalCategoryUsage.Validate if the designated constraints aren't met.
+type InternalCategoryUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCategoryUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCategoryUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCategoryUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCategoryUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCategoryUsageValidationError) ErrorName() string {
+	return "InternalCategoryUsageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCategoryUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCategoryUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCategoryUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCategoryUsageValidationError{}
+
+// Validate checks the field values on InternalGetUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalGetUsageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetUsageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalGetUsageResponseMultiError, or nil if none found.
+func (m *InternalGetUsageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetUsageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalGetUsageResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalGetUsageResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalGetUsageResponseValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetCategories()))
+		i := 0
+		for key := range m.GetCategories() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetCategories()[key]
+			_ = val
+
+			// no validation rules for Categories[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, InternalGetUsageResponseValidationError{
+							field:  fmt.Sprintf("Categories[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, InternalGetUsageResponseValidationError{
+							field:  fmt.Sprintf("Categories[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return InternalGetUsageResponseValidationError{
+						field:  fmt.Sprintf("Categories[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalGetUsageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetUsageResponseMultiError is an error wrapping multiple validation
+// errors returned by InternalGetUsageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalGetUsageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetUsageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetUsageResponseMultiError) AllErrors() []error { return m }
+
+// InternalGetUsageResponseValidationError is the validation error returned by
+// InternalGetUsageResponse.Validate if the designated constraints aren't met.
+type InternalGetUsageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetUsageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetUsageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetUsageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetUsageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetUsageResponseValidationError) ErrorName() string {
+	return "InternalGetUsageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetUsageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetUsageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetUsageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetUsageResponseValidationError{}
+
+// Validate checks the field values on InternalCheckQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalCheckQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCheckQuotaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalCheckQuotaRequestMultiError, or nil if none found.
+func (m *InternalCheckQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCheckQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for CheckType
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return InternalCheckQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCheckQuotaRequestMultiError is an error wrapping multiple validation
+// errors returned by InternalCheckQuotaRequest.ValidateAll() if the
+// designated constraints aren't met.
+type InternalCheckQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCheckQuotaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCheckQuotaRequestMultiError) AllErrors() []error { return m }
+
+// InternalCheckQuotaRequestValidationError is the validation error returned by
+// InternalCheckQuotaRequest.Validate if the designated constraints aren't met.
+type InternalCheckQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCheckQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCheckQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCheckQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCheckQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCheckQuotaRequestValidationError) ErrorName() string {
+	return "InternalCheckQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCheckQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCheckQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCheckQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCheckQuotaRequestValidationError{}
+
+// Validate checks the field values on InternalCheckQuotaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalCheckQuotaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCheckQuotaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalCheckQuotaResponseMultiError, or nil if none found.
+func (m *InternalCheckQuotaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCheckQuotaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Allowed
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalCheckQuotaResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalCheckQuotaResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalCheckQuotaResponseValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalCheckQuotaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCheckQuotaResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalCheckQuotaResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalCheckQuotaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCheckQuotaResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCheckQuotaResponseMultiError) AllErrors() []error { return m }
+
+// InternalCheckQuotaResponseValidationError is the validation error returned
+// by InternalCheckQuotaResponse.Validate if the designated constraints aren't met.
+type InternalCheckQuotaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCheckQuotaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCheckQuotaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCheckQuotaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCheckQuotaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCheckQuotaResponseValidationError) ErrorName() string {
+	return "InternalCheckQuotaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCheckQuotaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCheckQuotaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCheckQuotaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCheckQuotaResponseValidationError{}
+
+// Validate checks the field values on InternalGenerateQRCodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalGenerateQRCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGenerateQRCodeRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalGenerateQRCodeRequestMultiError, or nil if none found.
+func (m *InternalGenerateQRCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGenerateQRCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for Content
+
+	// no validation rules for Size
+
+	// no validation rules for LogoFileId
+
+	// no validation rules for ErrorCorrection
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return InternalGenerateQRCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGenerateQRCodeRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalGenerateQRCodeRequest.ValidateAll()
+// if the designated constraints aren't met.
+type InternalGenerateQRCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGenerateQRCodeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGenerateQRCodeRequestMultiError) AllErrors() []error { return m }
+
+// InternalGenerateQRCodeRequestValidationError is the validation error
+// returned by InternalGenerateQRCodeRequest.Validate if the designated
+// constraints aren't met.
+type InternalGenerateQRCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGenerateQRCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGenerateQRCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGenerateQRCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGenerateQRCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGenerateQRCodeRequestValidationError) ErrorName() string {
+	return "InternalGenerateQRCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGenerateQRCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGenerateQRCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGenerateQRCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGenerateQRCodeRequestValidationError{}
+
+// Validate checks the field values on InternalGenerateQRCodeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalGenerateQRCodeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGenerateQRCodeResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalGenerateQRCodeResponseMultiError, or nil if none found.
+func (m *InternalGenerateQRCodeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGenerateQRCodeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalGenerateQRCodeResponseValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalGenerateQRCodeResponseValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalGenerateQRCodeResponseValidationError{
+				field:  "File",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Url
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return InternalGenerateQRCodeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGenerateQRCodeResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalGenerateQRCodeResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalGenerateQRCodeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGenerateQRCodeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGenerateQRCodeResponseMultiError) AllErrors() []error { return m }
+
+// InternalGenerateQRCodeResponseValidationError is the validation error
+// returned by InternalGenerateQRCodeResponse.Validate if the designated
+// constraints aren't met.
+type InternalGenerateQRCodeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGenerateQRCodeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGenerateQRCodeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGenerateQRCodeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGenerateQRCodeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGenerateQRCodeResponseValidationError) ErrorName() string {
+	return "InternalGenerateQRCodeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGenerateQRCodeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGenerateQRCodeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGenerateQRCodeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGenerateQRCodeResponseValidationError{}
+
+// Validate checks the field values on InternalArchiveInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalArchiveInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalArchiveInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalArchiveInfoMultiError, or nil if none found.
+func (m *InternalArchiveInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalArchiveInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	// no validation rules for Status
+
+	// no validation rules for Format
+
+	// no validation rules for DownloadUrl
+
+	// no validation rules for Size
+
+	// no validation rules for ExpiresIn
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return InternalArchiveInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalArchiveInfoMultiError is an error wrapping multiple validation
+// errors returned by InternalArchiveInfo.ValidateAll() if the designated
+// constraints aren't met.
+type InternalArchiveInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalArchiveInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalArchiveInfoMultiError) AllErrors() []error { return m }
+
+// InternalArchiveInfoValidationError is the validation error returned by
+// InternalArchiveInfo.Validate if the designated constraints aren't met.
+type InternalArchiveInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalArchiveInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalArchiveInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalArchiveInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalArchiveInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalArchiveInfoValidationError) ErrorName() string {
+	return "InternalArchiveInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalArchiveInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalArchiveInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalArchiveInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalArchiveInfoValidationError{}
+
+// Validate checks the field values on InternalCreateArchiveRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalCreateArchiveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCreateArchiveRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalCreateArchiveRequestMultiError, or nil if none found.
+func (m *InternalCreateArchiveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCreateArchiveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for Format
+
+	// no validation rules for Filename
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return InternalCreateArchiveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCreateArchiveRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalCreateArchiveRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalCreateArchiveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCreateArchiveRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCreateArchiveRequestMultiError) AllErrors() []error { return m }
+
+// InternalCreateArchiveRequestValidationError is the validation error
+// returned by InternalCreateArchiveRequest.Validate if the designated
+// constraints aren't met.
+type InternalCreateArchiveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCreateArchiveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCreateArchiveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCreateArchiveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCreateArchiveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCreateArchiveRequestValidationError) ErrorName() string {
+	return "InternalCreateArchiveRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCreateArchiveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCreateArchiveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCreateArchiveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCreateArchiveRequestValidationError{}
+
+// Validate checks the field values on InternalCreateArchiveResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalCreateArchiveResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCreateArchiveResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalCreateArchiveResponseMultiError, or nil if none found.
+func (m *InternalCreateArchiveResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCreateArchiveResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArchive()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalCreateArchiveResponseValidationError{
+					field:  "Archive",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalCreateArchiveResponseValidationError{
+					field:  "Archive",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArchive()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalCreateArchiveResponseValidationError{
+				field:  "Archive",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalCreateArchiveResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCreateArchiveResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalCreateArchiveResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalCreateArchiveResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCreateArchiveResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCreateArchiveResponseMultiError) AllErrors() []error { return m }
+
+// InternalCreateArchiveResponseValidationError is the validation error
+// returned by InternalCreateArchiveResponse.Validate if the designated
+// constraints aren't met.
+type InternalCreateArchiveResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCreateArchiveResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCreateArchiveResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCreateArchiveResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCreateArchiveResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCreateArchiveResponseValidationError) ErrorName() string {
+	return "InternalCreateArchiveResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCreateArchiveResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCreateArchiveResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCreateArchiveResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCreateArchiveResponseValidationError{}
+
+// Validate checks the field values on InternalGetArchiveRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalGetArchiveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetArchiveRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalGetArchiveRequestMultiError, or nil if none found.
+func (m *InternalGetArchiveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetArchiveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for JobId
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return InternalGetArchiveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetArchiveRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalGetArchiveRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalGetArchiveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetArchiveRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetArchiveRequestMultiError) AllErrors() []error { return m }
+
+// InternalGetArchiveRequestValidationError is the validation error returned
+// by InternalGetArchiveRequest.Validate if the designated constraints aren't
+// met.
+type InternalGetArchiveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetArchiveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetArchiveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetArchiveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetArchiveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetArchiveRequestValidationError) ErrorName() string {
+	return "InternalGetArchiveRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetArchiveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetArchiveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetArchiveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetArchiveRequestValidationError{}
+
+// Validate checks the field values on InternalGetArchiveResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalGetArchiveResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetArchiveResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalGetArchiveResponseMultiError, or nil if none found.
+func (m *InternalGetArchiveResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetArchiveResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArchive()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalGetArchiveResponseValidationError{
+					field:  "Archive",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalGetArchiveResponseValidationError{
+					field:  "Archive",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArchive()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalGetArchiveResponseValidationError{
+				field:  "Archive",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalGetArchiveResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetArchiveResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalGetArchiveResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalGetArchiveResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetArchiveResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetArchiveResponseMultiError) AllErrors() []error { return m }
+
+// InternalGetArchiveResponseValidationError is the validation error returned
+// by InternalGetArchiveResponse.Validate if the designated constraints aren't
+// met.
+type InternalGetArchiveResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetArchiveResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetArchiveResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetArchiveResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetArchiveResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetArchiveResponseValidationError) ErrorName() string {
+	return "InternalGetArchiveResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetArchiveResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetArchiveResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetArchiveResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetArchiveResponseValidationError{}
+
+// Validate checks the field values on InternalShareLinkInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalShareLinkInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalShareLinkInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalShareLinkInfoMultiError, or nil if none found.
+func (m *InternalShareLinkInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalShareLinkInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShareId
+
+	// no validation rules for FileId
+
+	// no validation rules for Url
+
+	// no validation rules for HasPassword
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalShareLinkInfoValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalShareLinkInfoValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalShareLinkInfoValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MaxDownloads
+
+	// no validation rules for DownloadCount
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalShareLinkInfoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalShareLinkInfoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalShareLinkInfoValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalShareLinkInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalShareLinkInfoMultiError is an error wrapping multiple validation
+// errors returned by InternalShareLinkInfo.ValidateAll() if the designated
+// constraints aren't met.
+type InternalShareLinkInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalShareLinkInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalShareLinkInfoMultiError) AllErrors() []error { return m }
+
+// InternalShareLinkInfoValidationError is the validation error returned by
+// InternalShareLinkInfo.Validate if the designated constraints aren't met.
+type InternalShareLinkInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalShareLinkInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalShareLinkInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalShareLinkInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalShareLinkInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalShareLinkInfoValidationError) ErrorName() string {
+	return "InternalShareLinkInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalShareLinkInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalShareLinkInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalShareLinkInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalShareLinkInfoValidationError{}
+
+// Validate checks the field values on InternalCreateShareLinkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalCreateShareLinkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCreateShareLinkRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalCreateShareLinkRequestMultiError, or nil if none found.
+func (m *InternalCreateShareLinkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCreateShareLinkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for FileId
+
+	// no validation rules for ExpiresIn
+
+	// no validation rules for Password
+
+	// no validation rules for MaxDownloads
+
+	if len(errors) > 0 {
+		return InternalCreateShareLinkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCreateShareLinkRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalCreateShareLinkRequest.ValidateAll()
+// if the designated constraints aren't met.
+type InternalCreateShareLinkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCreateShareLinkRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCreateShareLinkRequestMultiError) AllErrors() []error { return m }
+
+// InternalCreateShareLinkRequestValidationError is the validation error
+// returned by InternalCreateShareLinkRequest.Validate if the designated
+// constraints aren't met.
+type InternalCreateShareLinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCreateShareLinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCreateShareLinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCreateShareLinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCreateShareLinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCreateShareLinkRequestValidationError) ErrorName() string {
+	return "InternalCreateShareLinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCreateShareLinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCreateShareLinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCreateShareLinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCreateShareLinkRequestValidationError{}
+
+// Validate checks the field values on InternalCreateShareLinkResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalCreateShareLinkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCreateShareLinkResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalCreateShareLinkResponseMultiError, or nil if none found.
+func (m *InternalCreateShareLinkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCreateShareLinkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetShare()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalCreateShareLinkResponseValidationError{
+					field:  "Share",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalCreateShareLinkResponseValidationError{
+					field:  "Share",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetShare()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalCreateShareLinkResponseValidationError{
+				field:  "Share",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalCreateShareLinkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCreateShareLinkResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalCreateShareLinkResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalCreateShareLinkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCreateShareLinkResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCreateShareLinkResponseMultiError) AllErrors() []error { return m }
+
+// InternalCreateShareLinkResponseValidationError is the validation error
+// returned by InternalCreateShareLinkResponse.Validate if the designated
+// constraints aren't met.
+type InternalCreateShareLinkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCreateShareLinkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCreateShareLinkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCreateShareLinkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCreateShareLinkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCreateShareLinkResponseValidationError) ErrorName() string {
+	return "InternalCreateShareLinkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCreateShareLinkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCreateShareLinkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCreateShareLinkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCreateShareLinkResponseValidationError{}
+
+// Validate checks the field values on InternalRevokeShareLinkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalRevokeShareLinkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalRevokeShareLinkRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalRevokeShareLinkRequestMultiError, or nil if none found.
+func (m *InternalRevokeShareLinkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalRevokeShareLinkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for ShareId
+
+	if len(errors) > 0 {
+		return InternalRevokeShareLinkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalRevokeShareLinkRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalRevokeShareLinkRequest.ValidateAll()
+// if the designated constraints aren't met.
+type InternalRevokeShareLinkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalRevokeShareLinkRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalRevokeShareLinkRequestMultiError) AllErrors() []error { return m }
+
+// InternalRevokeShareLinkRequestValidationError is the validation error
+// returned by InternalRevokeShareLinkRequest.Validate if the designated
+// constraints aren't met.
+type InternalRevokeShareLinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalRevokeShareLinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalRevokeShareLinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalRevokeShareLinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalRevokeShareLinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalRevokeShareLinkRequestValidationError) ErrorName() string {
+	return "InternalRevokeShareLinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalRevokeShareLinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalRevokeShareLinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalRevokeShareLinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalRevokeShareLinkRequestValidationError{}
+
+// Validate checks the field values on InternalRevokeShareLinkResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalRevokeShareLinkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalRevokeShareLinkResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalRevokeShareLinkResponseMultiError, or nil if none found.
+func (m *InternalRevokeShareLinkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalRevokeShareLinkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return InternalRevokeShareLinkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalRevokeShareLinkResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalRevokeShareLinkResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalRevokeShareLinkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalRevokeShareLinkResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalRevokeShareLinkResponseMultiError) AllErrors() []error { return m }
+
+// InternalRevokeShareLinkResponseValidationError is the validation error
+// returned by InternalRevokeShareLinkResponse.Validate if the designated
+// constraints aren't met.
+type InternalRevokeShareLinkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalRevokeShareLinkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalRevokeShareLinkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalRevokeShareLinkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalRevokeShareLinkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalRevokeShareLinkResponseValidationError) ErrorName() string {
+	return "InternalRevokeShareLinkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalRevokeShareLinkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalRevokeShareLinkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalRevokeShareLinkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalRevokeShareLinkResponseValidationError{}
+
+// Validate checks the field values on InternalScanStatus with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalScanStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalScanStatus with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalScanStatusMultiError, or nil if none found.
+func (m *InternalScanStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalScanStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileId
+
+	// no validation rules for Antivirus
+
+	// no validation rules for Moderation
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetScannedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalScanStatusValidationError{
+					field:  "ScannedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalScanStatusValidationError{
+					field:  "ScannedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScannedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalScanStatusValidationError{
+				field:  "ScannedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalScanStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalScanStatusMultiError is an error wrapping multiple validation
+// errors returned by InternalScanStatus.ValidateAll() if the designated
+// constraints aren't met.
+type InternalScanStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalScanStatusMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalScanStatusMultiError) AllErrors() []error { return m }
+
+// InternalScanStatusValidationError is the validation error returned by
+// InternalScanStatus.Validate if the designated constraints aren't met.
+type InternalScanStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalScanStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalScanStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalScanStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalScanStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalScanStatusValidationError) ErrorName() string {
+	return "InternalScanStatusValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalScanStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalScanStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalScanStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalScanStatusValidationError{}
+
+// Validate checks the field values on InternalGetScanStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalGetScanStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetScanStatusRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalGetScanStatusRequestMultiError, or nil if none found.
+func (m *InternalGetScanStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetScanStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for FileId
+
+	if len(errors) > 0 {
+		return InternalGetScanStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetScanStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalGetScanStatusRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalGetScanStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetScanStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetScanStatusRequestMultiError) AllErrors() []error { return m }
+
+// InternalGetScanStatusRequestValidationError is the validation error
+// returned by InternalGetScanStatusRequest.Validate if the designated
+// constraints aren't met.
+type InternalGetScanStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetScanStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetScanStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetScanStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetScanStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetScanStatusRequestValidationError) ErrorName() string {
+	return "InternalGetScanStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetScanStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetScanStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetScanStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetScanStatusRequestValidationError{}
+
+// Validate checks the field values on InternalGetScanStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalGetScanStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetScanStatusResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalGetScanStatusResponseMultiError, or nil if none found.
+func (m *InternalGetScanStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetScanStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalGetScanStatusResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalGetScanStatusResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalGetScanStatusResponseValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalGetScanStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetScanStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalGetScanStatusResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalGetScanStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetScanStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetScanStatusResponseMultiError) AllErrors() []error { return m }
+
+// InternalGetScanStatusResponseValidationError is the validation error
+// returned by InternalGetScanStatusResponse.Validate if the designated
+// constraints aren't met.
+type InternalGetScanStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetScanStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetScanStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetScanStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetScanStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetScanStatusResponseValidationError) ErrorName() string {
+	return "InternalGetScanStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetScanStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetScanStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetScanStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetScanStatusResponseValidationError{}
+
+// Validate checks the field values on InternalUploadFileMeta with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalUploadFileMeta) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalUploadFileMeta with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalUploadFileMetaMultiError, or nil if none found.
+func (m *InternalUploadFileMeta) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalUploadFileMeta) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for Filename
+
+	// no validation rules for ContentType
+
+	// no validation rules for Folder
+
+	// no validation rules for IsPublic
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return InternalUploadFileMetaMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalUploadFileMetaMultiError is an error wrapping multiple validation
+// errors returned by InternalUploadFileMeta.ValidateAll() if the designated
+// constraints aren't met.
+type InternalUploadFileMetaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalUploadFileMetaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalUploadFileMetaMultiError) AllErrors() []error { return m }
+
+// InternalUploadFileMetaValidationError is the validation error returned by
+// InternalUploadFileMeta.Validate if the designated constraints aren't met.
+type InternalUploadFileMetaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalUploadFileMetaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalUploadFileMetaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalUploadFileMetaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalUploadFileMetaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalUploadFileMetaValidationError) ErrorName() string {
+	return "InternalUploadFileMetaValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalUploadFileMetaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalUploadFileMeta.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalUploadFileMetaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalUploadFileMetaValidationError{}
+
+// Validate checks the field values on InternalUploadFileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalUploadFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalUploadFileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalUploadFileRequestMultiError, or nil if none found.
+func (m *InternalUploadFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalUploadFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalUploadFileRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalUploadFileRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalUploadFileRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Chunk
+
+	if len(errors) > 0 {
+		return InternalUploadFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalUploadFileRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalUploadFileRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalUploadFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalUploadFileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalUploadFileRequestMultiError) AllErrors() []error { return m }
+
+// InternalUploadFileRequestValidationError is the validation error returned
+// by InternalUploadFileRequest.Validate if the designated constraints aren't
+// met.
+type InternalUploadFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalUploadFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalUploadFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalUploadFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalUploadFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalUploadFileRequestValidationError) ErrorName() string {
+	return "InternalUploadFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalUploadFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalUploadFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalUploadFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalUploadFileRequestValidationError{}
+
+// Validate checks the field values on InternalUploadFileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalUploadFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalUploadFileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalUploadFileResponseMultiError, or nil if none found.
+func (m *InternalUploadFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalUploadFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalUploadFileResponseValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalUploadFileResponseValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalUploadFileResponseValidationError{
+				field:  "File",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Url
+
+	if len(errors) > 0 {
+		return InternalUploadFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalUploadFileResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalUploadFileResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalUploadFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalUploadFileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalUploadFileResponseMultiError) AllErrors() []error { return m }
+
+// InternalUploadFileResponseValidationError is the validation error returned
+// by InternalUploadFileResponse.Validate if the designated constraints aren't
+// met.
+type InternalUploadFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalUploadFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalUploadFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalUploadFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalUploadFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalUploadFileResponseValidationError) ErrorName() string {
+	return "InternalUploadFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalUploadFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalUploadFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalUploadFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalUploadFileResponseValidationError{}
+
+// Validate checks the field values on InternalCreateUploadUrlRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalCreateUploadUrlRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCreateUploadUrlRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalCreateUploadUrlRequestMultiError, or nil if none found.
+func (m *InternalCreateUploadUrlRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCreateUploadUrlRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for Filename
+
+	// no validation rules for ContentType
+
+	// no validation rules for Size
+
+	// no validation rules for Folder
+
+	// no validation rules for IsPublic
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return InternalCreateUploadUrlRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCreateUploadUrlRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalCreateUploadUrlRequest.ValidateAll()
+// if the designated constraints aren't met.
+type InternalCreateUploadUrlRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCreateUploadUrlRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCreateUploadUrlRequestMultiError) AllErrors() []error { return m }
+
+// InternalCreateUploadUrlRequestValidationError is the validation error
+// returned by InternalCreateUploadUrlRequest.Validate if the designated
+// constraints aren't met.
+type InternalCreateUploadUrlRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCreateUploadUrlRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCreateUploadUrlRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCreateUploadUrlRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCreateUploadUrlRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCreateUploadUrlRequestValidationError) ErrorName() string {
+	return "InternalCreateUploadUrlRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCreateUploadUrlRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCreateUploadUrlRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCreateUploadUrlRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCreateUploadUrlRequestValidationError{}
+
+// Validate checks the field values on InternalCreateUploadUrlResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalCreateUploadUrlResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCreateUploadUrlResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalCreateUploadUrlResponseMultiError, or nil if none found.
+func (m *InternalCreateUploadUrlResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCreateUploadUrlResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileId
+
+	// no validation rules for UploadUrl
+
+	// no validation rules for Method
+
+	// no validation rules for Headers
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return InternalCreateUploadUrlResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCreateUploadUrlResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalCreateUploadUrlResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalCreateUploadUrlResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCreateUploadUrlResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCreateUploadUrlResponseMultiError) AllErrors() []error { return m }
+
+// InternalCreateUploadUrlResponseValidationError is the validation error
+// returned by InternalCreateUploadUrlResponse.Validate if the designated
+// constraints aren't met.
+type InternalCreateUploadUrlResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCreateUploadUrlResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCreateUploadUrlResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCreateUploadUrlResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCreateUploadUrlResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCreateUploadUrlResponseValidationError) ErrorName() string {
+	return "InternalCreateUploadUrlResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCreateUploadUrlResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCreateUploadUrlResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCreateUploadUrlResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCreateUploadUrlResponseValidationError{}
+
+// Validate checks the field values on InternalConfirmUploadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalConfirmUploadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalConfirmUploadRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalConfirmUploadRequestMultiError, or nil if none found.
+func (m *InternalConfirmUploadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalConfirmUploadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for FileId
+
+	if len(errors) > 0 {
+		return InternalConfirmUploadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalConfirmUploadRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalConfirmUploadRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalConfirmUploadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalConfirmUploadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalConfirmUploadRequestMultiError) AllErrors() []error { return m }
+
+// InternalConfirmUploadRequestValidationError is the validation error
+// returned by InternalConfirmUploadRequest.Validate if the designated
+// constraints aren't met.
+type InternalConfirmUploadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalConfirmUploadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalConfirmUploadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalConfirmUploadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalConfirmUploadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalConfirmUploadRequestValidationError) ErrorName() string {
+	return "InternalConfirmUploadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalConfirmUploadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalConfirmUploadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalConfirmUploadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalConfirmUploadRequestValidationError{}
+
+// Validate checks the field values on InternalConfirmUploadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalConfirmUploadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalConfirmUploadResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalConfirmUploadResponseMultiError, or nil if none found.
+func (m *InternalConfirmUploadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalConfirmUploadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalConfirmUploadResponseValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalConfirmUploadResponseValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalConfirmUploadResponseValidationError{
+				field:  "File",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Url
+
+	if len(errors) > 0 {
+		return InternalConfirmUploadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalConfirmUploadResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalConfirmUploadResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalConfirmUploadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalConfirmUploadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalConfirmUploadResponseMultiError) AllErrors() []error { return m }
+
+// InternalConfirmUploadResponseValidationError is the validation error
+// returned by InternalConfirmUploadResponse.Validate if the designated
+// constraints aren't met.
+type InternalConfirmUploadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalConfirmUploadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalConfirmUploadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalConfirmUploadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalConfirmUploadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalConfirmUploadResponseValidationError) ErrorName() string {
+	return "InternalConfirmUploadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalConfirmUploadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalConfirmUploadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalConfirmUploadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalConfirmUploadResponseValidationError{}
+
+// Validate checks the field values on InternalUploadedPart with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalUploadedPart) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalUploadedPart with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalUploadedPartMultiError, or nil if none found.
+func (m *InternalUploadedPart) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalUploadedPart) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PartNumber
+
+	// no validation rules for Size
+
+	// no validation rules for ChecksumSha256
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return InternalUploadedPartMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalUploadedPartMultiError is an error wrapping multiple validation
+// errors returned by InternalUploadedPart.ValidateAll() if the designated
+// constraints aren't met.
+type InternalUploadedPartMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalUploadedPartMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalUploadedPartMultiError) AllErrors() []error { return m }
+
+// InternalUploadedPartValidationError is the validation error returned by
+// InternalUploadedPart.Validate if the designated constraints aren't met.
+type InternalUploadedPartValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalUploadedPartValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalUploadedPartValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalUploadedPartValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalUploadedPartValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalUploadedPartValidationError) ErrorName() string {
+	return "InternalUploadedPartValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalUploadedPartValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalUploadedPart.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalUploadedPartValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalUploadedPartValidationError{}
+
+// Validate checks the field values on InternalInitMultipartUploadRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalInitMultipartUploadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalInitMultipartUploadRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalInitMultipartUploadRequestMultiError, or nil if none found.
+func (m *InternalInitMultipartUploadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalInitMultipartUploadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for Filename
+
+	// no validation rules for ContentType
+
+	// no validation rules for Size
+
+	// no validation rules for PartSize
+
+	// no validation rules for Folder
+
+	// no validation rules for IsPublic
+
+	// no validation rules for ChecksumSha256
+
+	if len(errors) > 0 {
+		return InternalInitMultipartUploadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalInitMultipartUploadRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalInitMultipartUploadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalInitMultipartUploadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalInitMultipartUploadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalInitMultipartUploadRequestMultiError) AllErrors() []error { return m }
+
+// InternalInitMultipartUploadRequestValidationError is the validation error
+// returned by InternalInitMultipartUploadRequest.Validate if the designated
+// constraints aren't met.
+type InternalInitMultipartUploadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalInitMultipartUploadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalInitMultipartUploadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalInitMultipartUploadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalInitMultipartUploadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalInitMultipartUploadRequestValidationError) ErrorName() string {
+	return "InternalInitMultipartUploadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalInitMultipartUploadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalInitMultipartUploadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalInitMultipartUploadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalInitMultipartUploadRequestValidationError{}
+
+// Validate checks the field values on InternalInitMultipartUploadResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *InternalInitMultipartUploadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalInitMultipartUploadResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalInitMultipartUploadResponseMultiError, or nil if none found.
+func (m *InternalInitMultipartUploadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalInitMultipartUploadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UploadId
+
+	// no validation rules for FileId
+
+	// no validation rules for PartSize
+
+	// no validation rules for PartCount
+
+	if len(errors) > 0 {
+		return InternalInitMultipartUploadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalInitMultipartUploadResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalInitMultipartUploadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalInitMultipartUploadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalInitMultipartUploadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalInitMultipartUploadResponseMultiError) AllErrors() []error { return m }
+
+// InternalInitMultipartUploadResponseValidationError is the validation error
+// returned by InternalInitMultipartUploadResponse.Validate if the designated
+// constraints aren't met.
+type InternalInitMultipartUploadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalInitMultipartUploadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalInitMultipartUploadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalInitMultipartUploadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalInitMultipartUploadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalInitMultipartUploadResponseValidationError) ErrorName() string {
+	return "InternalInitMultipartUploadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalInitMultipartUploadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalInitMultipartUploadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalInitMultipartUploadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalInitMultipartUploadResponseValidationError{}
+
+// Validate checks the field values on InternalUploadPartMeta with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalUploadPartMeta) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalUploadPartMeta with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalUploadPartMetaMultiError, or nil if none found.
+func (m *InternalUploadPartMeta) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalUploadPartMeta) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for UploadId
+
+	// no validation rules for PartNumber
+
+	// no validation rules for Size
+
+	// no validation rules for ChecksumSha256
+
+	if len(errors) > 0 {
+		return InternalUploadPartMetaMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalUploadPartMetaMultiError is an error wrapping multiple validation
+// errors returned by InternalUploadPartMeta.ValidateAll() if the designated
+// constraints aren't met.
+type InternalUploadPartMetaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalUploadPartMetaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalUploadPartMetaMultiError) AllErrors() []error { return m }
+
+// InternalUploadPartMetaValidationError is the validation error returned by
+// InternalUploadPartMeta.Validate if the designated constraints aren't met.
+type InternalUploadPartMetaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalUploadPartMetaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalUploadPartMetaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalUploadPartMetaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalUploadPartMetaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalUploadPartMetaValidationError) ErrorName() string {
+	return "InternalUploadPartMetaValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalUploadPartMetaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalUploadPartMeta.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalUploadPartMetaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalUploadPartMetaValidationError{}
+
+// Validate checks the field values on InternalUploadPartRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalUploadPartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalUploadPartRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalUploadPartRequestMultiError, or nil if none found.
+func (m *InternalUploadPartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalUploadPartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalUploadPartRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalUploadPartRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalUploadPartRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Chunk
+
+	if len(errors) > 0 {
+		return InternalUploadPartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalUploadPartRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalUploadPartRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalUploadPartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalUploadPartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalUploadPartRequestMultiError) AllErrors() []error { return m }
+
+// InternalUploadPartRequestValidationError is the validation error returned
+// by InternalUploadPartRequest.Validate if the designated constraints aren't
+// met.
+type InternalUploadPartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalUploadPartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalUploadPartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalUploadPartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalUploadPartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalUploadPartRequestValidationError) ErrorName() string {
+	return "InternalUploadPartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalUploadPartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalUploadPartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalUploadPartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalUploadPartRequestValidationError{}
+
+// Validate checks the field values on InternalUploadPartResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *InternalUploadPartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalUploadPartResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalUploadPartResponseMultiError, or nil if none found.
+func (m *InternalUploadPartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalUploadPartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalUploadPartResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalUploadPartResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalUploadPartResponseValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalUploadPartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalUploadPartResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalUploadPartResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalUploadPartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalUploadPartResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalUploadPartResponseMultiError) AllErrors() []error { return m }
+
+// InternalUploadPartResponseValidationError is the validation error returned
+// by InternalUploadPartResponse.Validate if the designated constraints aren't
+// met.
+type InternalUploadPartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalUploadPartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalUploadPartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalUploadPartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalUploadPartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalUploadPartResponseValidationError) ErrorName() string {
+	return "InternalUploadPartResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalUploadPartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalUploadPartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalUploadPartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalUploadPartResponseValidationError{}
+
+// Validate checks the field values on InternalListUploadedPartsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalListUploadedPartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListUploadedPartsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalListUploadedPartsRequestMultiError, or nil if none found.
+func (m *InternalListUploadedPartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListUploadedPartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for UploadId
+
+	if len(errors) > 0 {
+		return InternalListUploadedPartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListUploadedPartsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalListUploadedPartsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalListUploadedPartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListUploadedPartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListUploadedPartsRequestMultiError) AllErrors() []error { return m }
+
+// InternalListUploadedPartsRequestValidationError is the validation error
+// returned by InternalListUploadedPartsRequest.Validate if the designated
+// constraints aren't met.
+type InternalListUploadedPartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListUploadedPartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListUploadedPartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListUploadedPartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListUploadedPartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListUploadedPartsRequestValidationError) ErrorName() string {
+	return "InternalListUploadedPartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListUploadedPartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListUploadedPartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListUploadedPartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListUploadedPartsRequestValidationError{}
+
+// Validate checks the field values on InternalListUploadedPartsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalListUploadedPartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListUploadedPartsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalListUploadedPartsResponseMultiError, or nil if none found.
+func (m *InternalListUploadedPartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListUploadedPartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetParts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalListUploadedPartsResponseValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalListUploadedPartsResponseValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalListUploadedPartsResponseValidationError{
+					field:  fmt.Sprintf("Parts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for FileId
+
+	// no validation rules for PartSize
+
+	// no validation rules for PartCount
+
+	if len(errors) > 0 {
+		return InternalListUploadedPartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListUploadedPartsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalListUploadedPartsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalListUploadedPartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListUploadedPartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListUploadedPartsResponseMultiError) AllErrors() []error { return m }
+
+// InternalListUploadedPartsResponseValidationError is the validation error
+// returned by InternalListUploadedPartsResponse.Validate if the designated
+// constraints aren't met.
+type InternalListUploadedPartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListUploadedPartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListUploadedPartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListUploadedPartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListUploadedPartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListUploadedPartsResponseValidationError) ErrorName() string {
+	return "InternalListUploadedPartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListUploadedPartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListUploadedPartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListUploadedPartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListUploadedPartsResponseValidationError{}
+
+// Validate checks the field values on InternalCompleteMultipartUploadRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *InternalCompleteMultipartUploadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// InternalCompleteMultipartUploadRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// InternalCompleteMultipartUploadRequestMultiError, or nil if none found.
+func (m *InternalCompleteMultipartUploadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCompleteMultipartUploadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for UploadId
+
+	for idx, item := range m.GetParts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalCompleteMultipartUploadRequestValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalCompleteMultipartUploadRequestValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalCompleteMultipartUploadRequestValidationError{
+					field:  fmt.Sprintf("Parts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalCompleteMultipartUploadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCompleteMultipartUploadRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// InternalCompleteMultipartUploadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalCompleteMultipartUploadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCompleteMultipartUploadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCompleteMultipartUploadRequestMultiError) AllErrors() []error { return m }
+
+// InternalCompleteMultipartUploadRequestValidationError is the validation
+// error returned by InternalCompleteMultipartUploadRequest.Validate if the
+// designated constraints aren't met.
+type InternalCompleteMultipartUploadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCompleteMultipartUploadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCompleteMultipartUploadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCompleteMultipartUploadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCompleteMultipartUploadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCompleteMultipartUploadRequestValidationError) ErrorName() string {
+	return "InternalCompleteMultipartUploadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCompleteMultipartUploadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCompleteMultipartUploadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCompleteMultipartUploadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCompleteMultipartUploadRequestValidationError{}
+
+// Validate checks the field values on InternalCompleteMultipartUploadResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *InternalCompleteMultipartUploadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// InternalCompleteMultipartUploadResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// InternalCompleteMultipartUploadResponseMultiError, or nil if none found.
+func (m *InternalCompleteMultipartUploadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCompleteMultipartUploadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalCompleteMultipartUploadResponseValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalCompleteMultipartUploadResponseValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalCompleteMultipartUploadResponseValidationError{
+				field:  "File",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Url
+
+	if len(errors) > 0 {
+		return InternalCompleteMultipartUploadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCompleteMultipartUploadResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// InternalCompleteMultipartUploadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalCompleteMultipartUploadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCompleteMultipartUploadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCompleteMultipartUploadResponseMultiError) AllErrors() []error { return m }
+
+// InternalCompleteMultipartUploadResponseValidationError is the validation
+// error returned by InternalCompleteMultipartUploadResponse.Validate if the
+// designated constraints aren't met.
+type InternalCompleteMultipartUploadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCompleteMultipartUploadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCompleteMultipartUploadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCompleteMultipartUploadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCompleteMultipartUploadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCompleteMultipartUploadResponseValidationError) ErrorName() string {
+	return "InternalCompleteMultipartUploadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCompleteMultipartUploadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCompleteMultipartUploadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCompleteMultipartUploadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCompleteMultipartUploadResponseValidationError{}
+
+// Validate checks the field values on InternalAbortMultipartUploadRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *InternalAbortMultipartUploadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalAbortMultipartUploadRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalAbortMultipartUploadRequestMultiError, or nil if none found.
+func (m *InternalAbortMultipartUploadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalAbortMultipartUploadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for UploadId
+
+	if len(errors) > 0 {
+		return InternalAbortMultipartUploadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalAbortMultipartUploadRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalAbortMultipartUploadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalAbortMultipartUploadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalAbortMultipartUploadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalAbortMultipartUploadRequestMultiError) AllErrors() []error { return m }
+
+// InternalAbortMultipartUploadRequestValidationError is the validation error
+// returned by InternalAbortMultipartUploadRequest.Validate if the designated
+// constraints aren't met.
+type InternalAbortMultipartUploadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalAbortMultipartUploadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalAbortMultipartUploadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalAbortMultipartUploadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalAbortMultipartUploadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalAbortMultipartUploadRequestValidationError) ErrorName() string {
+	return "InternalAbortMultipartUploadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalAbortMultipartUploadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalAbortMultipartUploadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalAbortMultipartUploadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalAbortMultipartUploadRequestValidationError{}
+
+// Validate checks the field values on InternalAbortMultipartUploadResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *InternalAbortMultipartUploadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalAbortMultipartUploadResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalAbortMultipartUploadResponseMultiError, or nil if none found.
+func (m *InternalAbortMultipartUploadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalAbortMultipartUploadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return InternalAbortMultipartUploadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalAbortMultipartUploadResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// InternalAbortMultipartUploadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalAbortMultipartUploadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalAbortMultipartUploadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalAbortMultipartUploadResponseMultiError) AllErrors() []error { return m }
+
+// InternalAbortMultipartUploadResponseValidationError is the validation error
+// returned by InternalAbortMultipartUploadResponse.Validate if the designated
+// constraints aren't met.
+type InternalAbortMultipartUploadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalAbortMultipartUploadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalAbortMultipartUploadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalAbortMultipartUploadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalAbortMultipartUploadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalAbortMultipartUploadResponseValidationError) ErrorName() string {
+	return "InternalAbortMultipartUploadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalAbortMultipartUploadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalAbortMultipartUploadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalAbortMultipartUploadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalAbortMultipartUploadResponseValidationError{}
@@ -19,13 +19,36 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ResourceInternalService_InternalGetFile_FullMethodName         = "/resource.v1.ResourceInternalService/InternalGetFile"
-	ResourceInternalService_InternalGetFiles_FullMethodName        = "/resource.v1.ResourceInternalService/InternalGetFiles"
-	ResourceInternalService_InternalGetFileUrls_FullMethodName     = "/resource.v1.ResourceInternalService/InternalGetFileUrls"
-	ResourceInternalService_InternalGetDownloadUrls_FullMethodName = "/resource.v1.ResourceInternalService/InternalGetDownloadUrls"
-	ResourceInternalService_InternalCheckFileExists_FullMethodName = "/resource.v1.ResourceInternalService/InternalCheckFileExists"
-	ResourceInternalService_InternalGetQuota_FullMethodName        = "/resource.v1.ResourceInternalService/InternalGetQuota"
-	ResourceInternalService_InternalCheckQuota_FullMethodName      = "/resource.v1.ResourceInternalService/InternalCheckQuota"
+	ResourceInternalService_InternalGetFile_FullMethodName                 = "/resource.v1.ResourceInternalService/InternalGetFile"
+	ResourceInternalService_InternalGetFiles_FullMethodName                = "/resource.v1.ResourceInternalService/InternalGetFiles"
+	ResourceInternalService_InternalGetFileUrls_FullMethodName             = "/resource.v1.ResourceInternalService/InternalGetFileUrls"
+	ResourceInternalService_InternalGetDownloadUrls_FullMethodName         = "/resource.v1.ResourceInternalService/InternalGetDownloadUrls"
+	ResourceInternalService_InternalCheckFileExists_FullMethodName         = "/resource.v1.ResourceInternalService/InternalCheckFileExists"
+	ResourceInternalService_InternalFilesExist_FullMethodName              = "/resource.v1.ResourceInternalService/InternalFilesExist"
+	ResourceInternalService_InternalListFiles_FullMethodName               = "/resource.v1.ResourceInternalService/InternalListFiles"
+	ResourceInternalService_InternalUpdateFileMetadata_FullMethodName      = "/resource.v1.ResourceInternalService/InternalUpdateFileMetadata"
+	ResourceInternalService_InternalDeleteFile_FullMethodName              = "/resource.v1.ResourceInternalService/InternalDeleteFile"
+	ResourceInternalService_InternalBatchDeleteFiles_FullMethodName        = "/resource.v1.ResourceInternalService/InternalBatchDeleteFiles"
+	ResourceInternalService_InternalCreateFolder_FullMethodName            = "/resource.v1.ResourceInternalService/InternalCreateFolder"
+	ResourceInternalService_InternalListFolders_FullMethodName             = "/resource.v1.ResourceInternalService/InternalListFolders"
+	ResourceInternalService_InternalDeleteFolder_FullMethodName            = "/resource.v1.ResourceInternalService/InternalDeleteFolder"
+	ResourceInternalService_InternalGetQuota_FullMethodName                = "/resource.v1.ResourceInternalService/InternalGetQuota"
+	ResourceInternalService_InternalCheckQuota_FullMethodName              = "/resource.v1.ResourceInternalService/InternalCheckQuota"
+	ResourceInternalService_InternalGetUsage_FullMethodName                = "/resource.v1.ResourceInternalService/InternalGetUsage"
+	ResourceInternalService_InternalGenerateQRCode_FullMethodName          = "/resource.v1.ResourceInternalService/InternalGenerateQRCode"
+	ResourceInternalService_InternalCreateArchive_FullMethodName           = "/resource.v1.ResourceInternalService/InternalCreateArchive"
+	ResourceInternalService_InternalGetArchive_FullMethodName              = "/resource.v1.ResourceInternalService/InternalGetArchive"
+	ResourceInternalService_InternalCreateShareLink_FullMethodName         = "/resource.v1.ResourceInternalService/InternalCreateShareLink"
+	ResourceInternalService_InternalRevokeShareLink_FullMethodName         = "/resource.v1.ResourceInternalService/InternalRevokeShareLink"
+	ResourceInternalService_InternalGetScanStatus_FullMethodName           = "/resource.v1.ResourceInternalService/InternalGetScanStatus"
+	ResourceInternalService_InternalUploadFile_FullMethodName              = "/resource.v1.ResourceInternalService/InternalUploadFile"
+	ResourceInternalService_InternalCreateUploadUrl_FullMethodName         = "/resource.v1.ResourceInternalService/InternalCreateUploadUrl"
+	ResourceInternalService_InternalConfirmUpload_FullMethodName           = "/resource.v1.ResourceInternalService/InternalConfirmUpload"
+	ResourceInternalService_InternalInitMultipartUpload_FullMethodName     = "/resource.v1.ResourceInternalService/InternalInitMultipartUpload"
+	ResourceInternalService_InternalUploadPart_FullMethodName              = "/resource.v1.ResourceInternalService/InternalUploadPart"
+	ResourceInternalService_InternalListUploadedParts_FullMethodName       = "/resource.v1.ResourceInternalService/InternalListUploadedParts"
+	ResourceInternalService_InternalCompleteMultipartUpload_FullMethodName = "/resource.v1.ResourceInternalService/InternalCompleteMultipartUpload"
+	ResourceInternalService_InternalAbortMultipartUpload_FullMethodName    = "/resource.v1.ResourceInternalService/InternalAbortMultipartUpload"
 )
 
 // ResourceInternalServiceClient is the client API for ResourceInternalService service.
@@ -85,6 +108,60 @@ type ResourceInternalServiceClient interface {
 	// - 验证业务数据关联的文件是否有效
 	// - 秒传检查
 	InternalCheckFileExists(ctx context.Context, in *InternalCheckFileExistsRequest, opts ...grpc.CallOption) (*InternalCheckFileExistsResponse, error)
+	// InternalFilesExist 批量检查文件ID是否存在（内部接口）
+	//
+	// 只返回是否存在，不查询文件信息和生成URL，已删除的文件视为不存在
+	//
+	// 使用场景：
+	// - 校验用户提交的文件ID列表是否都属于该租户
+	InternalFilesExist(ctx context.Context, in *InternalFilesExistRequest, opts ...grpc.CallOption) (*InternalFilesExistResponse, error)
+	// InternalListFiles 分页列出文件（内部接口）
+	//
+	// 按创建时间倒序返回，使用游标分页：将响应中的 next_page_token 作为下一次请求的 page_token，
+	// next_page_token 为空时表示没有更多数据
+	//
+	// 使用场景：
+	// - 批处理任务遍历租户的所有文件
+	// - 管理后台按目录浏览文件
+	InternalListFiles(ctx context.Context, in *InternalListFilesRequest, opts ...grpc.CallOption) (*InternalListFilesResponse, error)
+	// InternalUpdateFileMetadata 更新文件元数据（内部接口）
+	//
+	// 修改文件名、自定义元数据和标签，只修改请求中设置的部分
+	//
+	// 使用场景：
+	// - 商品服务记录图片对应的商品ID和用途
+	// - 内容服务给文章配图打标签，之后按标签查询
+	InternalUpdateFileMetadata(ctx context.Context, in *InternalUpdateFileMetadataRequest, opts ...grpc.CallOption) (*InternalUpdateFileMetadataResponse, error)
+	// InternalDeleteFile 删除文件（内部接口）
+	//
+	// 默认软删除（状态改为 deleted，可恢复，到期后由资源服务清理），
+	// permanent=true 时立即删除对象存储中的文件并释放配额
+	//
+	// 使用场景：
+	// - 商品服务删除商品时清理商品图片
+	// - 用户服务更换头像后删除旧头像
+	InternalDeleteFile(ctx context.Context, in *InternalDeleteFileRequest, opts ...grpc.CallOption) (*InternalDeleteFileResponse, error)
+	// InternalBatchDeleteFiles 批量删除文件（内部接口）
+	//
+	// 逐个删除文件，单个文件失败不影响其他文件，结果中返回每个文件的删除状态
+	//
+	// 使用场景：
+	// - 内容服务删除文章时清理所有配图
+	// - 租户注销时批量清理文件
+	InternalBatchDeleteFiles(ctx context.Context, in *InternalBatchDeleteFilesRequest, opts ...grpc.CallOption) (*InternalBatchDeleteFilesResponse, error)
+	// InternalCreateFolder 创建目录（内部接口）
+	//
+	// 自动创建不存在的上级目录，目录已存在时直接返回
+	//
+	// 使用场景：
+	// - 业务服务按业务对象组织上传的文件，如 products/10086
+	InternalCreateFolder(ctx context.Context, in *InternalCreateFolderRequest, opts ...grpc.CallOption) (*InternalCreateFolderResponse, error)
+	// InternalListFolders 列出子目录（内部接口）
+	InternalListFolders(ctx context.Context, in *InternalListFoldersRequest, opts ...grpc.CallOption) (*InternalListFoldersResponse, error)
+	// InternalDeleteFolder 删除目录（内部接口）
+	//
+	// 目录不为空时需要设置 recursive，其中的文件按软删除处理
+	InternalDeleteFolder(ctx context.Context, in *InternalDeleteFolderRequest, opts ...grpc.CallOption) (*InternalDeleteFolderResponse, error)
 	// InternalGetQuota 获取租户配额（内部接口）
 	//
 	// 用于其他微服务获取租户配额信息
@@ -102,6 +179,98 @@ type ResourceInternalServiceClient interface {
 	// - 其他服务在触发上传前预检查配额
 	// - 批量操作前检查是否有足够配额
 	InternalCheckQuota(ctx context.Context, in *InternalCheckQuotaRequest, opts ...grpc.CallOption) (*InternalCheckQuotaResponse, error)
+	// InternalGetUsage 获取租户存储用量统计（内部接口）
+	//
+	// 返回配额信息和按文件大类统计的存储用量，用量只统计已上传完成的文件
+	//
+	// 使用场景：
+	// - 计费服务按存储用量计费
+	// - 配额告警邮件展示用量和配额
+	InternalGetUsage(ctx context.Context, in *InternalGetUsageRequest, opts ...grpc.CallOption) (*InternalGetUsageResponse, error)
+	// InternalGenerateQRCode 生成二维码图片（内部接口）
+	//
+	// 由资源服务生成二维码图片并存储为文件，返回文件信息和访问URL
+	//
+	// 使用场景：
+	// - 邀请链接二维码
+	// - 设备绑定二维码
+	InternalGenerateQRCode(ctx context.Context, in *InternalGenerateQRCodeRequest, opts ...grpc.CallOption) (*InternalGenerateQRCodeResponse, error)
+	// InternalCreateArchive 创建打包下载任务（内部接口）
+	//
+	// 资源服务异步将多个文件打包为 zip 或 tar.gz，完成后通过 InternalGetArchive 获取下载URL；
+	// 文件较少时可能直接完成，响应中即包含下载URL
+	//
+	// 使用场景：
+	// - 工单、邮件的"下载全部附件"
+	// - 相册批量下载
+	InternalCreateArchive(ctx context.Context, in *InternalCreateArchiveRequest, opts ...grpc.CallOption) (*InternalCreateArchiveResponse, error)
+	// InternalGetArchive 查询打包下载任务状态（内部接口）
+	//
+	// 任务完成后返回打包文件的下载URL，每次查询都会生成新的URL
+	InternalGetArchive(ctx context.Context, in *InternalGetArchiveRequest, opts ...grpc.CallOption) (*InternalGetArchiveResponse, error)
+	// InternalCreateShareLink 创建私有文件的分享链接（内部接口）
+	//
+	// 分享链接指向资源服务的分享页面，不暴露预签名URL；可以设置有效期、访问密码和最大下载次数
+	//
+	// 使用场景：
+	// - 网盘、相册分享给外部用户
+	// - 工单附件分享给未登录的客户
+	InternalCreateShareLink(ctx context.Context, in *InternalCreateShareLinkRequest, opts ...grpc.CallOption) (*InternalCreateShareLinkResponse, error)
+	// InternalRevokeShareLink 撤销分享链接（内部接口）
+	//
+	// 撤销后链接立即失效，已撤销的链接再次撤销不会报错
+	InternalRevokeShareLink(ctx context.Context, in *InternalRevokeShareLinkRequest, opts ...grpc.CallOption) (*InternalRevokeShareLinkResponse, error)
+	// InternalGetScanStatus 获取文件的病毒扫描和内容审核结果（内部接口）
+	//
+	// 文件上传完成后资源服务异步进行病毒扫描和内容审核（图片、视频、文本），
+	// 结果可能需要数秒到数分钟，人工复审的文件会更久
+	//
+	// 使用场景：
+	// - 商品图片、用户头像审核通过后再发布
+	// - 附件扫描通过后再允许其他用户下载
+	InternalGetScanStatus(ctx context.Context, in *InternalGetScanStatusRequest, opts ...grpc.CallOption) (*InternalGetScanStatusResponse, error)
+	// InternalUploadFile 上传文件（内部接口，客户端流）
+	//
+	// 第一条消息携带文件元信息，之后的消息依次携带文件内容分片，
+	// 客户端关闭发送后服务端保存文件并返回文件信息
+	//
+	// 使用场景：
+	// - 其他微服务上传生成的报表、导出文件
+	// - 从第三方拉取的图片转存
+	InternalUploadFile(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[InternalUploadFileRequest, InternalUploadFileResponse], error)
+	// InternalCreateUploadUrl 创建预签名上传URL（内部接口）
+	//
+	// 资源服务创建状态为 init 的文件记录，并返回对象存储的预签名上传URL，
+	// 浏览器直接上传到对象存储后调用 InternalConfirmUpload 完成上传
+	//
+	// 使用场景：
+	// - 前端直传大文件，不经过业务服务中转
+	InternalCreateUploadUrl(ctx context.Context, in *InternalCreateUploadUrlRequest, opts ...grpc.CallOption) (*InternalCreateUploadUrlResponse, error)
+	// InternalConfirmUpload 确认预签名上传完成（内部接口）
+	//
+	// 资源服务校验对象存储中的文件（存在性、大小），将文件状态更新为 completed
+	InternalConfirmUpload(ctx context.Context, in *InternalConfirmUploadRequest, opts ...grpc.CallOption) (*InternalConfirmUploadResponse, error)
+	// InternalInitMultipartUpload 初始化分片上传（内部接口）
+	//
+	// 用于上传几百MB以上的大文件（如视频），返回上传ID和服务端确定的分片大小
+	InternalInitMultipartUpload(ctx context.Context, in *InternalInitMultipartUploadRequest, opts ...grpc.CallOption) (*InternalInitMultipartUploadResponse, error)
+	// InternalUploadPart 上传一个分片（内部接口，客户端流）
+	//
+	// 第一条消息携带分片元信息，之后的消息依次携带分片内容。
+	// 服务端校验分片的 SHA256，不一致时返回 DataLoss；同一分片可以重复上传，以最后一次为准
+	InternalUploadPart(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[InternalUploadPartRequest, InternalUploadPartResponse], error)
+	// InternalListUploadedParts 查询已上传的分片（内部接口）
+	//
+	// 用于断点续传，跳过已经上传成功的分片
+	InternalListUploadedParts(ctx context.Context, in *InternalListUploadedPartsRequest, opts ...grpc.CallOption) (*InternalListUploadedPartsResponse, error)
+	// InternalCompleteMultipartUpload 完成分片上传（内部接口）
+	//
+	// 服务端按分片号合并分片，校验分片列表和整个文件的 SHA256 后将文件标记为已完成
+	InternalCompleteMultipartUpload(ctx context.Context, in *InternalCompleteMultipartUploadRequest, opts ...grpc.CallOption) (*InternalCompleteMultipartUploadResponse, error)
+	// InternalAbortMultipartUpload 取消分片上传（内部接口）
+	//
+	// 删除已上传的分片，未完成也未取消的上传由资源服务定期清理
+	InternalAbortMultipartUpload(ctx context.Context, in *InternalAbortMultipartUploadRequest, opts ...grpc.CallOption) (*InternalAbortMultipartUploadResponse, error)
 }
 
 type resourceInternalServiceClient struct {
@@ -162,6 +331,86 @@ func (c *resourceInternalServiceClient) InternalCheckFileExists(ctx context.Cont
 	return out, nil
 }
 
+func (c *resourceInternalServiceClient) InternalFilesExist(ctx context.Context, in *InternalFilesExistRequest, opts ...grpc.CallOption) (*InternalFilesExistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalFilesExistResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalFilesExist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalListFiles(ctx context.Context, in *InternalListFilesRequest, opts ...grpc.CallOption) (*InternalListFilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalListFilesResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalListFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalUpdateFileMetadata(ctx context.Context, in *InternalUpdateFileMetadataRequest, opts ...grpc.CallOption) (*InternalUpdateFileMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalUpdateFileMetadataResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalUpdateFileMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalDeleteFile(ctx context.Context, in *InternalDeleteFileRequest, opts ...grpc.CallOption) (*InternalDeleteFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalDeleteFileResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalDeleteFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalBatchDeleteFiles(ctx context.Context, in *InternalBatchDeleteFilesRequest, opts ...grpc.CallOption) (*InternalBatchDeleteFilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalBatchDeleteFilesResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalBatchDeleteFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalCreateFolder(ctx context.Context, in *InternalCreateFolderRequest, opts ...grpc.CallOption) (*InternalCreateFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalCreateFolderResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalCreateFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalListFolders(ctx context.Context, in *InternalListFoldersRequest, opts ...grpc.CallOption) (*InternalListFoldersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalListFoldersResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalListFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalDeleteFolder(ctx context.Context, in *InternalDeleteFolderRequest, opts ...grpc.CallOption) (*InternalDeleteFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalDeleteFolderResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalDeleteFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resourceInternalServiceClient) InternalGetQuota(ctx context.Context, in *InternalGetQuotaRequest, opts ...grpc.CallOption) (*InternalGetQuotaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InternalGetQuotaResponse)
@@ -182,6 +431,162 @@ func (c *resourceInternalServiceClient) InternalCheckQuota(ctx context.Context,
 	return out, nil
 }
 
+func (c *resourceInternalServiceClient) InternalGetUsage(ctx context.Context, in *InternalGetUsageRequest, opts ...grpc.CallOption) (*InternalGetUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalGetUsageResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalGetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalGenerateQRCode(ctx context.Context, in *InternalGenerateQRCodeRequest, opts ...grpc.CallOption) (*InternalGenerateQRCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalGenerateQRCodeResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalGenerateQRCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalCreateArchive(ctx context.Context, in *InternalCreateArchiveRequest, opts ...grpc.CallOption) (*InternalCreateArchiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalCreateArchiveResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalCreateArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalGetArchive(ctx context.Context, in *InternalGetArchiveRequest, opts ...grpc.CallOption) (*InternalGetArchiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalGetArchiveResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalGetArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalCreateShareLink(ctx context.Context, in *InternalCreateShareLinkRequest, opts ...grpc.CallOption) (*InternalCreateShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalCreateShareLinkResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalCreateShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalRevokeShareLink(ctx context.Context, in *InternalRevokeShareLinkRequest, opts ...grpc.CallOption) (*InternalRevokeShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalRevokeShareLinkResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalRevokeShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalGetScanStatus(ctx context.Context, in *InternalGetScanStatusRequest, opts ...grpc.CallOption) (*InternalGetScanStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalGetScanStatusResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalGetScanStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalUploadFile(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[InternalUploadFileRequest, InternalUploadFileResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResourceInternalService_ServiceDesc.Streams[0], ResourceInternalService_InternalUploadFile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[InternalUploadFileRequest, InternalUploadFileResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceInternalService_InternalUploadFileClient = grpc.ClientStreamingClient[InternalUploadFileRequest, InternalUploadFileResponse]
+
+func (c *resourceInternalServiceClient) InternalCreateUploadUrl(ctx context.Context, in *InternalCreateUploadUrlRequest, opts ...grpc.CallOption) (*InternalCreateUploadUrlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalCreateUploadUrlResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalCreateUploadUrl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalConfirmUpload(ctx context.Context, in *InternalConfirmUploadRequest, opts ...grpc.CallOption) (*InternalConfirmUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalConfirmUploadResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalConfirmUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalInitMultipartUpload(ctx context.Context, in *InternalInitMultipartUploadRequest, opts ...grpc.CallOption) (*InternalInitMultipartUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalInitMultipartUploadResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalInitMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalUploadPart(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[InternalUploadPartRequest, InternalUploadPartResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResourceInternalService_ServiceDesc.Streams[1], ResourceInternalService_InternalUploadPart_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[InternalUploadPartRequest, InternalUploadPartResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceInternalService_InternalUploadPartClient = grpc.ClientStreamingClient[InternalUploadPartRequest, InternalUploadPartResponse]
+
+func (c *resourceInternalServiceClient) InternalListUploadedParts(ctx context.Context, in *InternalListUploadedPartsRequest, opts ...grpc.CallOption) (*InternalListUploadedPartsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalListUploadedPartsResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalListUploadedParts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalCompleteMultipartUpload(ctx context.Context, in *InternalCompleteMultipartUploadRequest, opts ...grpc.CallOption) (*InternalCompleteMultipartUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalCompleteMultipartUploadResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalCompleteMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalAbortMultipartUpload(ctx context.Context, in *InternalAbortMultipartUploadRequest, opts ...grpc.CallOption) (*InternalAbortMultipartUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalAbortMultipartUploadResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalAbortMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ResourceInternalServiceServer is the server API for ResourceInternalService service.
 // All implementations must embed UnimplementedResourceInternalServiceServer
 // for forward compatibility.
@@ -239,6 +644,60 @@ type ResourceInternalServiceServer interface {
 	// - 验证业务数据关联的文件是否有效
 	// - 秒传检查
 	InternalCheckFileExists(context.Context, *InternalCheckFileExistsRequest) (*InternalCheckFileExistsResponse, error)
+	// InternalFilesExist 批量检查文件ID是否存在（内部接口）
+	//
+	// 只返回是否存在，不查询文件信息和生成URL，已删除的文件视为不存在
+	//
+	// 使用场景：
+	// - 校验用户提交的文件ID列表是否都属于该租户
+	InternalFilesExist(context.Context, *InternalFilesExistRequest) (*InternalFilesExistResponse, error)
+	// InternalListFiles 分页列出文件（内部接口）
+	//
+	// 按创建时间倒序返回，使用游标分页：将响应中的 next_page_token 作为下一次请求的 page_token，
+	// next_page_token 为空时表示没有更多数据
+	//
+	// 使用场景：
+	// - 批处理任务遍历租户的所有文件
+	// - 管理后台按目录浏览文件
+	InternalListFiles(context.Context, *InternalListFilesRequest) (*InternalListFilesResponse, error)
+	// InternalUpdateFileMetadata 更新文件元数据（内部接口）
+	//
+	// 修改文件名、自定义元数据和标签，只修改请求中设置的部分
+	//
+	// 使用场景：
+	// - 商品服务记录图片对应的商品ID和用途
+	// - 内容服务给文章配图打标签，之后按标签查询
+	InternalUpdateFileMetadata(context.Context, *InternalUpdateFileMetadataRequest) (*InternalUpdateFileMetadataResponse, error)
+	// InternalDeleteFile 删除文件（内部接口）
+	//
+	// 默认软删除（状态改为 deleted，可恢复，到期后由资源服务清理），
+	// permanent=true 时立即删除对象存储中的文件并释放配额
+	//
+	// 使用场景：
+	// - 商品服务删除商品时清理商品图片
+	// - 用户服务更换头像后删除旧头像
+	InternalDeleteFile(context.Context, *InternalDeleteFileRequest) (*InternalDeleteFileResponse, error)
+	// InternalBatchDeleteFiles 批量删除文件（内部接口）
+	//
+	// 逐个删除文件，单个文件失败不影响其他文件，结果中返回每个文件的删除状态
+	//
+	// 使用场景：
+	// - 内容服务删除文章时清理所有配图
+	// - 租户注销时批量清理文件
+	InternalBatchDeleteFiles(context.Context, *InternalBatchDeleteFilesRequest) (*InternalBatchDeleteFilesResponse, error)
+	// InternalCreateFolder 创建目录（内部接口）
+	//
+	// 自动创建不存在的上级目录，目录已存在时直接返回
+	//
+	// 使用场景：
+	// - 业务服务按业务对象组织上传的文件，如 products/10086
+	InternalCreateFolder(context.Context, *InternalCreateFolderRequest) (*InternalCreateFolderResponse, error)
+	// InternalListFolders 列出子目录（内部接口）
+	InternalListFolders(context.Context, *InternalListFoldersRequest) (*InternalListFoldersResponse, error)
+	// InternalDeleteFolder 删除目录（内部接口）
+	//
+	// 目录不为空时需要设置 recursive，其中的文件按软删除处理
+	InternalDeleteFolder(context.Context, *InternalDeleteFolderRequest) (*InternalDeleteFolderResponse, error)
 	// InternalGetQuota 获取租户配额（内部接口）
 	//
 	// 用于其他微服务获取租户配额信息
@@ -256,37 +715,198 @@ type ResourceInternalServiceServer interface {
 	// - 其他服务在触发上传前预检查配额
 	// - 批量操作前检查是否有足够配额
 	InternalCheckQuota(context.Context, *InternalCheckQuotaRequest) (*InternalCheckQuotaResponse, error)
-	mustEmbedUnimplementedResourceInternalServiceServer()
-}
-
-// UnimplementedResourceInternalServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedResourceInternalServiceServer struct{}
-
-func (UnimplementedResourceInternalServiceServer) InternalGetFile(context.Context, *InternalGetFileRequest) (*InternalGetFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InternalGetFile not implemented")
-}
-func (UnimplementedResourceInternalServiceServer) InternalGetFiles(context.Context, *InternalGetFilesRequest) (*InternalGetFilesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InternalGetFiles not implemented")
-}
-func (UnimplementedResourceInternalServiceServer) InternalGetFileUrls(context.Context, *InternalGetFileUrlsRequest) (*InternalGetFileUrlsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InternalGetFileUrls not implemented")
-}
-func (UnimplementedResourceInternalServiceServer) InternalGetDownloadUrls(context.Context, *InternalGetDownloadUrlsRequest) (*InternalGetDownloadUrlsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InternalGetDownloadUrls not implemented")
-}
-func (UnimplementedResourceInternalServiceServer) InternalCheckFileExists(context.Context, *InternalCheckFileExistsRequest) (*InternalCheckFileExistsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InternalCheckFileExists not implemented")
-}
-func (UnimplementedResourceInternalServiceServer) InternalGetQuota(context.Context, *InternalGetQuotaRequest) (*InternalGetQuotaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InternalGetQuota not implemented")
-}
+	// InternalGetUsage 获取租户存储用量统计（内部接口）
+	//
+	// 返回配额信息和按文件大类统计的存储用量，用量只统计已上传完成的文件
+	//
+	// 使用场景：
+	// - 计费服务按存储用量计费
+	// - 配额告警邮件展示用量和配额
+	InternalGetUsage(context.Context, *InternalGetUsageRequest) (*InternalGetUsageResponse, error)
+	// InternalGenerateQRCode 生成二维码图片（内部接口）
+	//
+	// 由资源服务生成二维码图片并存储为文件，返回文件信息和访问URL
+	//
+	// 使用场景：
+	// - 邀请链接二维码
+	// - 设备绑定二维码
+	InternalGenerateQRCode(context.Context, *InternalGenerateQRCodeRequest) (*InternalGenerateQRCodeResponse, error)
+	// InternalCreateArchive 创建打包下载任务（内部接口）
+	//
+	// 资源服务异步将多个文件打包为 zip 或 tar.gz，完成后通过 InternalGetArchive 获取下载URL；
+	// 文件较少时可能直接完成，响应中即包含下载URL
+	//
+	// 使用场景：
+	// - 工单、邮件的"下载全部附件"
+	// - 相册批量下载
+	InternalCreateArchive(context.Context, *InternalCreateArchiveRequest) (*InternalCreateArchiveResponse, error)
+	// InternalGetArchive 查询打包下载任务状态（内部接口）
+	//
+	// 任务完成后返回打包文件的下载URL，每次查询都会生成新的URL
+	InternalGetArchive(context.Context, *InternalGetArchiveRequest) (*InternalGetArchiveResponse, error)
+	// InternalCreateShareLink 创建私有文件的分享链接（内部接口）
+	//
+	// 分享链接指向资源服务的分享页面，不暴露预签名URL；可以设置有效期、访问密码和最大下载次数
+	//
+	// 使用场景：
+	// - 网盘、相册分享给外部用户
+	// - 工单附件分享给未登录的客户
+	InternalCreateShareLink(context.Context, *InternalCreateShareLinkRequest) (*InternalCreateShareLinkResponse, error)
+	// InternalRevokeShareLink 撤销分享链接（内部接口）
+	//
+	// 撤销后链接立即失效，已撤销的链接再次撤销不会报错
+	InternalRevokeShareLink(context.Context, *InternalRevokeShareLinkRequest) (*InternalRevokeShareLinkResponse, error)
+	// InternalGetScanStatus 获取文件的病毒扫描和内容审核结果（内部接口）
+	//
+	// 文件上传完成后资源服务异步进行病毒扫描和内容审核（图片、视频、文本），
+	// 结果可能需要数秒到数分钟，人工复审的文件会更久
+	//
+	// 使用场景：
+	// - 商品图片、用户头像审核通过后再发布
+	// - 附件扫描通过后再允许其他用户下载
+	InternalGetScanStatus(context.Context, *InternalGetScanStatusRequest) (*InternalGetScanStatusResponse, error)
+	// InternalUploadFile 上传文件（内部接口，客户端流）
+	//
+	// 第一条消息携带文件元信息，之后的消息依次携带文件内容分片，
+	// 客户端关闭发送后服务端保存文件并返回文件信息
+	//
+	// 使用场景：
+	// - 其他微服务上传生成的报表、导出文件
+	// - 从第三方拉取的图片转存
+	InternalUploadFile(grpc.ClientStreamingServer[InternalUploadFileRequest, InternalUploadFileResponse]) error
+	// InternalCreateUploadUrl 创建预签名上传URL（内部接口）
+	//
+	// 资源服务创建状态为 init 的文件记录，并返回对象存储的预签名上传URL，
+	// 浏览器直接上传到对象存储后调用 InternalConfirmUpload 完成上传
+	//
+	// 使用场景：
+	// - 前端直传大文件，不经过业务服务中转
+	InternalCreateUploadUrl(context.Context, *InternalCreateUploadUrlRequest) (*InternalCreateUploadUrlResponse, error)
+	// InternalConfirmUpload 确认预签名上传完成（内部接口）
+	//
+	// 资源服务校验对象存储中的文件（存在性、大小），将文件状态更新为 completed
+	InternalConfirmUpload(context.Context, *InternalConfirmUploadRequest) (*InternalConfirmUploadResponse, error)
+	// InternalInitMultipartUpload 初始化分片上传（内部接口）
+	//
+	// 用于上传几百MB以上的大文件（如视频），返回上传ID和服务端确定的分片大小
+	InternalInitMultipartUpload(context.Context, *InternalInitMultipartUploadRequest) (*InternalInitMultipartUploadResponse, error)
+	// InternalUploadPart 上传一个分片（内部接口，客户端流）
+	//
+	// 第一条消息携带分片元信息，之后的消息依次携带分片内容。
+	// 服务端校验分片的 SHA256，不一致时返回 DataLoss；同一分片可以重复上传，以最后一次为准
+	InternalUploadPart(grpc.ClientStreamingServer[InternalUploadPartRequest, InternalUploadPartResponse]) error
+	// InternalListUploadedParts 查询已上传的分片（内部接口）
+	//
+	// 用于断点续传，跳过已经上传成功的分片
+	InternalListUploadedParts(context.Context, *InternalListUploadedPartsRequest) (*InternalListUploadedPartsResponse, error)
+	// InternalCompleteMultipartUpload 完成分片上传（内部接口）
+	//
+	// 服务端按分片号合并分片，校验分片列表和整个文件的 SHA256 后将文件标记为已完成
+	InternalCompleteMultipartUpload(context.Context, *InternalCompleteMultipartUploadRequest) (*InternalCompleteMultipartUploadResponse, error)
+	// InternalAbortMultipartUpload 取消分片上传（内部接口）
+	//
+	// 删除已上传的分片，未完成也未取消的上传由资源服务定期清理
+	InternalAbortMultipartUpload(context.Context, *InternalAbortMultipartUploadRequest) (*InternalAbortMultipartUploadResponse, error)
+	mustEmbedUnimplementedResourceInternalServiceServer()
+}
+
+// UnimplementedResourceInternalServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedResourceInternalServiceServer struct{}
+
+func (UnimplementedResourceInternalServiceServer) InternalGetFile(context.Context, *InternalGetFileRequest) (*InternalGetFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalGetFile not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalGetFiles(context.Context, *InternalGetFilesRequest) (*InternalGetFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalGetFiles not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalGetFileUrls(context.Context, *InternalGetFileUrlsRequest) (*InternalGetFileUrlsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalGetFileUrls not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalGetDownloadUrls(context.Context, *InternalGetDownloadUrlsRequest) (*InternalGetDownloadUrlsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalGetDownloadUrls not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalCheckFileExists(context.Context, *InternalCheckFileExistsRequest) (*InternalCheckFileExistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalCheckFileExists not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalFilesExist(context.Context, *InternalFilesExistRequest) (*InternalFilesExistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalFilesExist not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalListFiles(context.Context, *InternalListFilesRequest) (*InternalListFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalListFiles not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalUpdateFileMetadata(context.Context, *InternalUpdateFileMetadataRequest) (*InternalUpdateFileMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalUpdateFileMetadata not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalDeleteFile(context.Context, *InternalDeleteFileRequest) (*InternalDeleteFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalDeleteFile not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalBatchDeleteFiles(context.Context, *InternalBatchDeleteFilesRequest) (*InternalBatchDeleteFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalBatchDeleteFiles not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalCreateFolder(context.Context, *InternalCreateFolderRequest) (*InternalCreateFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalCreateFolder not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalListFolders(context.Context, *InternalListFoldersRequest) (*InternalListFoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalListFolders not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalDeleteFolder(context.Context, *InternalDeleteFolderRequest) (*InternalDeleteFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalDeleteFolder not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalGetQuota(context.Context, *InternalGetQuotaRequest) (*InternalGetQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalGetQuota not implemented")
+}
 func (UnimplementedResourceInternalServiceServer) InternalCheckQuota(context.Context, *InternalCheckQuotaRequest) (*InternalCheckQuotaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InternalCheckQuota not implemented")
 }
+func (UnimplementedResourceInternalServiceServer) InternalGetUsage(context.Context, *InternalGetUsageRequest) (*InternalGetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalGetUsage not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalGenerateQRCode(context.Context, *InternalGenerateQRCodeRequest) (*InternalGenerateQRCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalGenerateQRCode not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalCreateArchive(context.Context, *InternalCreateArchiveRequest) (*InternalCreateArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalCreateArchive not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalGetArchive(context.Context, *InternalGetArchiveRequest) (*InternalGetArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalGetArchive not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalCreateShareLink(context.Context, *InternalCreateShareLinkRequest) (*InternalCreateShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalCreateShareLink not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalRevokeShareLink(context.Context, *InternalRevokeShareLinkRequest) (*InternalRevokeShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalRevokeShareLink not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalGetScanStatus(context.Context, *InternalGetScanStatusRequest) (*InternalGetScanStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalGetScanStatus not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalUploadFile(grpc.ClientStreamingServer[InternalUploadFileRequest, InternalUploadFileResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method InternalUploadFile not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalCreateUploadUrl(context.Context, *InternalCreateUploadUrlRequest) (*InternalCreateUploadUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalCreateUploadUrl not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalConfirmUpload(context.Context, *InternalConfirmUploadRequest) (*InternalConfirmUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalConfirmUpload not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalInitMultipartUpload(context.Context, *InternalInitMultipartUploadRequest) (*InternalInitMultipartUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalInitMultipartUpload not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalUploadPart(grpc.ClientStreamingServer[InternalUploadPartRequest, InternalUploadPartResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method InternalUploadPart not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalListUploadedParts(context.Context, *InternalListUploadedPartsRequest) (*InternalListUploadedPartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalListUploadedParts not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalCompleteMultipartUpload(context.Context, *InternalCompleteMultipartUploadRequest) (*InternalCompleteMultipartUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalCompleteMultipartUpload not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalAbortMultipartUpload(context.Context, *InternalAbortMultipartUploadRequest) (*InternalAbortMultipartUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalAbortMultipartUpload not implemented")
+}
 func (UnimplementedResourceInternalServiceServer) mustEmbedUnimplementedResourceInternalServiceServer() {
 }
 func (UnimplementedResourceInternalServiceServer) testEmbeddedByValue() {}
@@ -399,6 +1019,150 @@ func _ResourceInternalService_InternalCheckFileExists_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourceInternalService_InternalFilesExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalFilesExistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalFilesExist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalFilesExist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalFilesExist(ctx, req.(*InternalFilesExistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalListFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalListFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalListFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalListFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalListFiles(ctx, req.(*InternalListFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalUpdateFileMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalUpdateFileMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalUpdateFileMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalUpdateFileMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalUpdateFileMetadata(ctx, req.(*InternalUpdateFileMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalDeleteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalDeleteFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalDeleteFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalDeleteFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalDeleteFile(ctx, req.(*InternalDeleteFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalBatchDeleteFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalBatchDeleteFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalBatchDeleteFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalBatchDeleteFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalBatchDeleteFiles(ctx, req.(*InternalBatchDeleteFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalCreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalCreateFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalCreateFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalCreateFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalCreateFolder(ctx, req.(*InternalCreateFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalListFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalListFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalListFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalListFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalListFolders(ctx, req.(*InternalListFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalDeleteFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalDeleteFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalDeleteFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalDeleteFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalDeleteFolder(ctx, req.(*InternalDeleteFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ResourceInternalService_InternalGetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InternalGetQuotaRequest)
 	if err := dec(in); err != nil {
@@ -435,6 +1199,254 @@ func _ResourceInternalService_InternalCheckQuota_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourceInternalService_InternalGetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalGetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalGetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalGetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalGetUsage(ctx, req.(*InternalGetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalGenerateQRCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalGenerateQRCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalGenerateQRCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalGenerateQRCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalGenerateQRCode(ctx, req.(*InternalGenerateQRCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalCreateArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalCreateArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalCreateArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalCreateArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalCreateArchive(ctx, req.(*InternalCreateArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalGetArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalGetArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalGetArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalGetArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalGetArchive(ctx, req.(*InternalGetArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalCreateShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalCreateShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalCreateShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalCreateShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalCreateShareLink(ctx, req.(*InternalCreateShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalRevokeShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalRevokeShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalRevokeShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalRevokeShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalRevokeShareLink(ctx, req.(*InternalRevokeShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalGetScanStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalGetScanStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalGetScanStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalGetScanStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalGetScanStatus(ctx, req.(*InternalGetScanStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalUploadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ResourceInternalServiceServer).InternalUploadFile(&grpc.GenericServerStream[InternalUploadFileRequest, InternalUploadFileResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceInternalService_InternalUploadFileServer = grpc.ClientStreamingServer[InternalUploadFileRequest, InternalUploadFileResponse]
+
+func _ResourceInternalService_InternalCreateUploadUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalCreateUploadUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalCreateUploadUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalCreateUploadUrl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalCreateUploadUrl(ctx, req.(*InternalCreateUploadUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalConfirmUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalConfirmUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalConfirmUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalConfirmUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalConfirmUpload(ctx, req.(*InternalConfirmUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalInitMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalInitMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalInitMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalInitMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalInitMultipartUpload(ctx, req.(*InternalInitMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalUploadPart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ResourceInternalServiceServer).InternalUploadPart(&grpc.GenericServerStream[InternalUploadPartRequest, InternalUploadPartResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceInternalService_InternalUploadPartServer = grpc.ClientStreamingServer[InternalUploadPartRequest, InternalUploadPartResponse]
+
+func _ResourceInternalService_InternalListUploadedParts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalListUploadedPartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalListUploadedParts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalListUploadedParts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalListUploadedParts(ctx, req.(*InternalListUploadedPartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalCompleteMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalCompleteMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalCompleteMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalCompleteMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalCompleteMultipartUpload(ctx, req.(*InternalCompleteMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalAbortMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalAbortMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalAbortMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalAbortMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalAbortMultipartUpload(ctx, req.(*InternalAbortMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ResourceInternalService_ServiceDesc is the grpc.ServiceDesc for ResourceInternalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -462,6 +1474,38 @@ var ResourceInternalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InternalCheckFileExists",
 			Handler:    _ResourceInternalService_InternalCheckFileExists_Handler,
 		},
+		{
+			MethodName: "InternalFilesExist",
+			Handler:    _ResourceInternalService_InternalFilesExist_Handler,
+		},
+		{
+			MethodName: "InternalListFiles",
+			Handler:    _ResourceInternalService_InternalListFiles_Handler,
+		},
+		{
+			MethodName: "InternalUpdateFileMetadata",
+			Handler:    _ResourceInternalService_InternalUpdateFileMetadata_Handler,
+		},
+		{
+			MethodName: "InternalDeleteFile",
+			Handler:    _ResourceInternalService_InternalDeleteFile_Handler,
+		},
+		{
+			MethodName: "InternalBatchDeleteFiles",
+			Handler:    _ResourceInternalService_InternalBatchDeleteFiles_Handler,
+		},
+		{
+			MethodName: "InternalCreateFolder",
+			Handler:    _ResourceInternalService_InternalCreateFolder_Handler,
+		},
+		{
+			MethodName: "InternalListFolders",
+			Handler:    _ResourceInternalService_InternalListFolders_Handler,
+		},
+		{
+			MethodName: "InternalDeleteFolder",
+			Handler:    _ResourceInternalService_InternalDeleteFolder_Handler,
+		},
 		{
 			MethodName: "InternalGetQuota",
 			Handler:    _ResourceInternalService_InternalGetQuota_Handler,
@@ -470,7 +1514,70 @@ var ResourceInternalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InternalCheckQuota",
 			Handler:    _ResourceInternalService_InternalCheckQuota_Handler,
 		},
+		{
+			MethodName: "InternalGetUsage",
+			Handler:    _ResourceInternalService_InternalGetUsage_Handler,
+		},
+		{
+			MethodName: "InternalGenerateQRCode",
+			Handler:    _ResourceInternalService_InternalGenerateQRCode_Handler,
+		},
+		{
+			MethodName: "InternalCreateArchive",
+			Handler:    _ResourceInternalService_InternalCreateArchive_Handler,
+		},
+		{
+			MethodName: "InternalGetArchive",
+			Handler:    _ResourceInternalService_InternalGetArchive_Handler,
+		},
+		{
+			MethodName: "InternalCreateShareLink",
+			Handler:    _ResourceInternalService_InternalCreateShareLink_Handler,
+		},
+		{
+			MethodName: "InternalRevokeShareLink",
+			Handler:    _ResourceInternalService_InternalRevokeShareLink_Handler,
+		},
+		{
+			MethodName: "InternalGetScanStatus",
+			Handler:    _ResourceInternalService_InternalGetScanStatus_Handler,
+		},
+		{
+			MethodName: "InternalCreateUploadUrl",
+			Handler:    _ResourceInternalService_InternalCreateUploadUrl_Handler,
+		},
+		{
+			MethodName: "InternalConfirmUpload",
+			Handler:    _ResourceInternalService_InternalConfirmUpload_Handler,
+		},
+		{
+			MethodName: "InternalInitMultipartUpload",
+			Handler:    _ResourceInternalService_InternalInitMultipartUpload_Handler,
+		},
+		{
+			MethodName: "InternalListUploadedParts",
+			Handler:    _ResourceInternalService_InternalListUploadedParts_Handler,
+		},
+		{
+			MethodName: "InternalCompleteMultipartUpload",
+			Handler:    _ResourceInternalService_InternalCompleteMultipartUpload_Handler,
+		},
+		{
+			MethodName: "InternalAbortMultipartUpload",
+			Handler:    _ResourceInternalService_InternalAbortMultipartUpload_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "InternalUploadFile",
+			Handler:       _ResourceInternalService_InternalUploadFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "InternalUploadPart",
+			Handler:       _ResourceInternalService_InternalUploadPart_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "resource/v1/resource_internal.proto",
 }
@@ -0,0 +1,75 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"sync/atomic"
+)
+
+// AdminServer 管理端口
+//
+// 提供以下接口，实现了 transport.Server 但不会注册到注册中心:
+//   - /healthz: 进程存活检查，始终返回 200
+//   - /readyz: 就绪检查，启动完成后返回 200，开始关闭后返回 503
+//   - /debug/pprof/: 性能分析
+type AdminServer struct {
+	server *http.Server
+	mux    *http.ServeMux
+	ready  atomic.Bool
+}
+
+// NewAdminServer 创建管理端口
+func NewAdminServer(addr string) *AdminServer {
+	s := &AdminServer{mux: http.NewServeMux()}
+	s.server = &http.Server{Addr: addr, Handler: s.mux}
+
+	s.mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+	s.mux.HandleFunc("/readyz", func(w http.ResponseWriter, _ *http.Request) {
+		if !s.ready.Load() {
+			http.Error(w, "not ready", http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+	s.mux.HandleFunc("/debug/pprof/", pprof.Index)
+	s.mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	s.mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	s.mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	s.mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	return s
+}
+
+// Handle 注册额外的管理接口，如 /metrics
+func (s *AdminServer) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
+// SetReady 设置就绪状态
+func (s *AdminServer) SetReady(ready bool) {
+	s.ready.Store(ready)
+}
+
+// Start 启动管理端口
+func (s *AdminServer) Start(ctx context.Context) error {
+	lis, err := net.Listen("tcp", s.server.Addr)
+	if err != nil {
+		return err
+	}
+	s.server.BaseContext = func(net.Listener) context.Context { return ctx }
+	if err := s.server.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Stop 停止管理端口
+func (s *AdminServer) Stop(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
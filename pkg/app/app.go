@@ -0,0 +1,247 @@
+// Package app 组装服务启动所需的公共组件
+//
+// 根据一份 YAML 配置创建日志、链路追踪、指标、注册中心、管理端口、
+// 标准中间件链以及优雅关闭逻辑，避免每个服务重复编写 main.go 的装配代码。
+//
+// 使用示例:
+//
+//	cfg, err := app.LoadConfig("configs/config.yaml")
+//	if err != nil {
+//	    panic(err)
+//	}
+//	a, err := app.New(cfg, app.WithMiddleware(auth.Server(true)))
+//	if err != nil {
+//	    panic(err)
+//	}
+//	v1.RegisterUserServiceServer(a.GRPC, userService)
+//	v1.RegisterUserServiceHTTPServer(a.HTTP, userService)
+//	if err := a.Run(); err != nil {
+//	    panic(err)
+//	}
+package app
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/logging"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/heyinLab/common/pkg/middleware/recovery"
+	"github.com/heyinLab/common/pkg/middleware/requestid"
+	commonTracing "github.com/heyinLab/common/pkg/middleware/tracing"
+)
+
+// App 装配完成的服务组件
+//
+// 调用方在 HTTP/GRPC 上注册业务服务后调用 Run，或通过 Build 获取 kratos.App 自行控制
+type App struct {
+	Config *Config
+	Logger log.Logger
+
+	// HTTP 业务 HTTP 服务，未配置地址时为 nil
+	HTTP *http.Server
+	// GRPC 业务 gRPC 服务，未配置地址时为 nil
+	GRPC *grpc.Server
+	// Admin 管理端口，未配置地址时为 nil
+	Admin *AdminServer
+
+	// Registrar 服务注册器，未配置注册中心时为 nil
+	Registrar registry.Registrar
+	// Discovery 服务发现，未配置注册中心时为 nil
+	Discovery registry.Discovery
+
+	options     *options
+	middlewares []middleware.Middleware
+	cleanups    []func(context.Context) error
+}
+
+// New 根据配置组装服务组件
+//
+// 参数:
+//   - cfg: 服务配置
+//   - opts: 可选项，如额外的中间件、自定义日志
+//
+// 返回:
+//   - *App: 装配完成的服务组件
+//   - error: 初始化失败时返回错误，已初始化的组件会被释放
+func New(cfg *Config, opts ...Option) (*App, error) {
+	if cfg == nil {
+		return nil, errors.New("配置不能为空")
+	}
+	cfg.setDefaults()
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	a := &App{Config: cfg, options: o}
+	if err := a.init(); err != nil {
+		_ = a.cleanup(context.Background())
+		return nil, err
+	}
+	return a, nil
+}
+
+// init 按依赖顺序初始化各组件
+func (a *App) init() error {
+	a.Logger = a.newLogger()
+	log.SetLogger(a.Logger)
+
+	if err := a.initTracing(); err != nil {
+		return err
+	}
+
+	a.middlewares = append(a.standardMiddlewares(), a.options.middlewares...)
+
+	if err := a.initRegistry(); err != nil {
+		return err
+	}
+
+	a.initServers()
+	return nil
+}
+
+// newLogger 创建带服务信息和链路信息的日志
+func (a *App) newLogger() log.Logger {
+	logger := a.options.logger
+	if logger == nil {
+		logger = log.NewStdLogger(os.Stdout)
+	}
+	logger = log.With(logger,
+		"ts", log.DefaultTimestamp,
+		"caller", log.DefaultCaller,
+		"service.id", a.Config.ID,
+		"service.name", a.Config.Name,
+		"service.version", a.Config.Version,
+		"trace.id", tracing.TraceID(),
+		"span.id", tracing.SpanID(),
+		"request.id", requestid.Valuer(),
+	)
+	return log.NewFilter(logger, log.FilterLevel(log.ParseLevel(a.Config.Log.Level)))
+}
+
+// standardMiddlewares 标准中间件链: recovery -> request id -> tracing -> logging -> metrics
+func (a *App) standardMiddlewares() []middleware.Middleware {
+	chain := []middleware.Middleware{
+		recovery.Recovery(recovery.WithLogger(a.Logger)),
+		requestid.Server(),
+		commonTracing.Server(),
+		logging.Server(a.Logger),
+	}
+	if a.Config.Metrics.Enabled {
+		chain = append(chain, a.metricsMiddleware())
+	}
+	return chain
+}
+
+// initServers 创建业务服务和管理端口
+func (a *App) initServers() {
+	if c := a.Config.Server.HTTP; c.Addr != "" {
+		opts := []http.ServerOption{
+			http.Address(c.Addr),
+			http.Middleware(a.middlewares...),
+		}
+		if c.Timeout > 0 {
+			opts = append(opts, http.Timeout(c.Timeout))
+		}
+		a.HTTP = http.NewServer(append(opts, a.options.httpOptions...)...)
+	}
+
+	if c := a.Config.Server.GRPC; c.Addr != "" {
+		opts := []grpc.ServerOption{
+			grpc.Address(c.Addr),
+			grpc.Middleware(a.middlewares...),
+		}
+		if c.Timeout > 0 {
+			opts = append(opts, grpc.Timeout(c.Timeout))
+		}
+		a.GRPC = grpc.NewServer(append(opts, a.options.grpcOptions...)...)
+	}
+
+	if a.Config.Admin.Addr != "" {
+		a.Admin = NewAdminServer(a.Config.Admin.Addr)
+	}
+}
+
+// Middlewares 返回服务端中间件链，用于自行创建的其他服务
+func (a *App) Middlewares() []middleware.Middleware {
+	return a.middlewares
+}
+
+// OnStop 注册关闭时执行的清理函数，按注册的逆序执行
+func (a *App) OnStop(fn func(context.Context) error) {
+	a.cleanups = append(a.cleanups, fn)
+}
+
+// Build 创建 kratos.App
+//
+// 额外的 kratos 选项会追加在默认选项之后，可覆盖默认行为
+func (a *App) Build(opts ...kratos.Option) *kratos.App {
+	var servers []transport.Server
+	if a.HTTP != nil {
+		servers = append(servers, a.HTTP)
+	}
+	if a.GRPC != nil {
+		servers = append(servers, a.GRPC)
+	}
+	if a.Admin != nil {
+		servers = append(servers, a.Admin)
+	}
+
+	kopts := []kratos.Option{
+		kratos.ID(a.Config.ID),
+		kratos.Name(a.Config.Name),
+		kratos.Version(a.Config.Version),
+		kratos.Metadata(a.Config.Metadata),
+		kratos.Logger(a.Logger),
+		kratos.Server(servers...),
+		kratos.StopTimeout(a.Config.StopTimeout),
+		kratos.AfterStart(func(context.Context) error {
+			if a.Admin != nil {
+				a.Admin.SetReady(true)
+			}
+			return nil
+		}),
+		kratos.BeforeStop(func(context.Context) error {
+			// 先摘除就绪状态，让负载均衡停止转发新请求
+			if a.Admin != nil {
+				a.Admin.SetReady(false)
+			}
+			return nil
+		}),
+		kratos.AfterStop(a.cleanup),
+	}
+	if a.Registrar != nil {
+		kopts = append(kopts, kratos.Registrar(a.Registrar))
+	}
+	return kratos.New(append(kopts, opts...)...)
+}
+
+// Run 创建并运行 kratos.App，直到收到退出信号
+func (a *App) Run() error {
+	return a.Build().Run()
+}
+
+// cleanup 逆序执行清理函数
+func (a *App) cleanup(ctx context.Context) error {
+	var errs []error
+	for i := len(a.cleanups) - 1; i >= 0; i-- {
+		if err := a.cleanups[i](ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	a.cleanups = nil
+	return errors.Join(errs...)
+}
@@ -0,0 +1,113 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestParseConfig(t *testing.T) {
+	t.Setenv("TEST_GRPC_ADDR", "127.0.0.1:9000")
+
+	cfg, err := ParseConfig([]byte(`
+name: demo
+server:
+  grpc:
+    addr: ${TEST_GRPC_ADDR}
+    timeout: 3s
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, "demo", cfg.Name)
+	assert.Equal(t, "127.0.0.1:9000", cfg.Server.GRPC.Addr)
+	assert.Equal(t, 3*time.Second, cfg.Server.GRPC.Timeout)
+	assert.Equal(t, "info", cfg.Log.Level)
+	assert.Equal(t, float64(1), cfg.Trace.SampleRatio)
+	assert.Equal(t, 30*time.Second, cfg.StopTimeout)
+	assert.NotEmpty(t, cfg.ID)
+
+	_, err = ParseConfig([]byte("name: demo"))
+	assert.Error(t, err)
+
+	_, err = ParseConfig([]byte("server:\n  http:\n    addr: :8000"))
+	assert.Error(t, err)
+}
+
+func TestNew(t *testing.T) {
+	a, err := New(&Config{
+		Name:    "demo",
+		Version: "v1.0.0",
+		Server: ServerConfig{
+			HTTP: TransportConfig{Addr: "127.0.0.1:0"},
+		},
+		Admin:   AdminConfig{Addr: "127.0.0.1:0"},
+		Metrics: MetricsConfig{Enabled: true},
+	}, WithLogger(log.DefaultLogger))
+	assert.NoError(t, err)
+	assert.NotNil(t, a.HTTP)
+	assert.Nil(t, a.GRPC)
+	assert.NotNil(t, a.Admin)
+	assert.Nil(t, a.Registrar)
+	assert.Len(t, a.Middlewares(), 5)
+
+	var stopped bool
+	a.OnStop(func(context.Context) error {
+		stopped = true
+		return nil
+	})
+	assert.NotNil(t, a.Build())
+	assert.NoError(t, a.cleanup(context.Background()))
+	assert.True(t, stopped)
+}
+
+func TestNew_Metrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	a, err := New(&Config{
+		Name:    "demo",
+		Server:  ServerConfig{HTTP: TransportConfig{Addr: "127.0.0.1:0"}},
+		Metrics: MetricsConfig{Enabled: true},
+	}, WithLogger(log.DefaultLogger), WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))))
+	require.NoError(t, err)
+
+	// 指标中间件位于标准中间件链的最后
+	chain := a.Middlewares()
+	_, err = chain[len(chain)-1](func(context.Context, interface{}) (interface{}, error) {
+		return "ok", nil
+	})(context.Background(), nil)
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	var names []string
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			names = append(names, m.Name)
+		}
+	}
+	assert.Contains(t, names, "server_requests_code_total")
+	assert.Contains(t, names, "server_requests_seconds")
+}
+
+func TestAdminServer(t *testing.T) {
+	s := NewAdminServer("127.0.0.1:0")
+
+	get := func(path string) int {
+		rec := httptest.NewRecorder()
+		s.mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusOK, get("/healthz"))
+	assert.Equal(t, http.StatusServiceUnavailable, get("/readyz"))
+
+	s.SetReady(true)
+	assert.Equal(t, http.StatusOK, get("/readyz"))
+	assert.Equal(t, http.StatusOK, get("/debug/pprof/"))
+}
@@ -0,0 +1,156 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config 服务启动配置，对应一个 YAML 文件
+//
+// 配置示例:
+//
+//	name: resource-service
+//	version: v1.2.0
+//	log:
+//	  level: info
+//	server:
+//	  http:
+//	    addr: 0.0.0.0:8000
+//	    timeout: 5s
+//	  grpc:
+//	    addr: 0.0.0.0:9000
+//	    timeout: 5s
+//	admin:
+//	  addr: 0.0.0.0:9100
+//	registry:
+//	  consul:
+//	    addr: ${CONSUL_ADDR}
+//	    tags: [v1]
+//	trace:
+//	  endpoint: otel-collector:4317
+//	  sample_ratio: 0.1
+//	metrics:
+//	  enabled: true
+//	stop_timeout: 30s
+type Config struct {
+	Name        string            `yaml:"name"`         // 服务名称
+	Version     string            `yaml:"version"`      // 服务版本
+	ID          string            `yaml:"id"`           // 实例ID，默认使用主机名
+	Metadata    map[string]string `yaml:"metadata"`     // 注册到注册中心的元数据
+	Log         LogConfig         `yaml:"log"`          // 日志配置
+	Server      ServerConfig      `yaml:"server"`       // 业务服务配置
+	Admin       AdminConfig       `yaml:"admin"`        // 管理端口配置
+	Registry    RegistryConfig    `yaml:"registry"`     // 注册中心配置
+	Trace       TraceConfig       `yaml:"trace"`        // 链路追踪配置
+	Metrics     MetricsConfig     `yaml:"metrics"`      // 指标配置
+	StopTimeout time.Duration     `yaml:"stop_timeout"` // 优雅关闭的最长等待时间
+}
+
+// LogConfig 日志配置
+type LogConfig struct {
+	Level string `yaml:"level"` // 日志级别: debug/info/warn/error，默认 info
+}
+
+// ServerConfig 业务服务配置，地址为空时不启动对应的服务
+type ServerConfig struct {
+	HTTP TransportConfig `yaml:"http"`
+	GRPC TransportConfig `yaml:"grpc"`
+}
+
+// TransportConfig 传输层配置
+type TransportConfig struct {
+	Addr    string        `yaml:"addr"`    // 监听地址
+	Timeout time.Duration `yaml:"timeout"` // 请求超时时间
+}
+
+// AdminConfig 管理端口配置
+//
+// 管理端口提供 /healthz、/readyz 和 /debug/pprof/，不会注册到注册中心
+type AdminConfig struct {
+	Addr string `yaml:"addr"` // 监听地址，为空时不启动
+}
+
+// RegistryConfig 注册中心配置
+type RegistryConfig struct {
+	Consul ConsulConfig `yaml:"consul"`
+}
+
+// ConsulConfig Consul 配置
+type ConsulConfig struct {
+	Addr string   `yaml:"addr"` // Consul 地址，为空时不注册
+	Tags []string `yaml:"tags"` // 服务标签
+}
+
+// TraceConfig 链路追踪配置
+type TraceConfig struct {
+	Endpoint    string  `yaml:"endpoint"`     // OTLP gRPC 地址，为空时不上报
+	Insecure    bool    `yaml:"insecure"`     // 不使用 TLS 连接
+	SampleRatio float64 `yaml:"sample_ratio"` // 采样率 0~1，默认 1
+}
+
+// MetricsConfig 指标配置
+type MetricsConfig struct {
+	Enabled bool `yaml:"enabled"` // 是否启用请求指标中间件
+}
+
+// LoadConfig 从 YAML 文件加载配置，支持 ${ENV} 形式的环境变量
+//
+// 参数:
+//   - path: 配置文件路径
+//
+// 返回:
+//   - *Config: 配置
+//   - error: 读取或解析失败时返回错误
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("读取配置文件失败: %w", err)
+	}
+	return ParseConfig(data)
+}
+
+// ParseConfig 解析 YAML 配置，支持 ${ENV} 形式的环境变量
+func ParseConfig(data []byte) (*Config, error) {
+	cfg := &Config{}
+	if err := yaml.Unmarshal([]byte(os.ExpandEnv(string(data))), cfg); err != nil {
+		return nil, fmt.Errorf("解析配置文件失败: %w", err)
+	}
+	cfg.setDefaults()
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Validate 验证配置
+func (c *Config) Validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("服务名称不能为空")
+	}
+	if c.Server.HTTP.Addr == "" && c.Server.GRPC.Addr == "" {
+		return fmt.Errorf("至少需要配置一个 HTTP 或 gRPC 地址")
+	}
+	if c.Trace.SampleRatio < 0 || c.Trace.SampleRatio > 1 {
+		return fmt.Errorf("采样率必须在 0~1 之间")
+	}
+	return nil
+}
+
+// setDefaults 设置默认值
+func (c *Config) setDefaults() {
+	if c.ID == "" {
+		c.ID, _ = os.Hostname()
+	}
+	if c.Log.Level == "" {
+		c.Log.Level = "info"
+	}
+	if c.Trace.SampleRatio == 0 {
+		c.Trace.SampleRatio = 1
+	}
+	if c.StopTimeout == 0 {
+		c.StopTimeout = 30 * time.Second
+	}
+}
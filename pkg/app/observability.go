@@ -0,0 +1,38 @@
+package app
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/heyinLab/common/pkg/middleware/metrics"
+	"github.com/heyinLab/common/pkg/middleware/tracing"
+)
+
+// initTracing 初始化链路追踪，未配置上报地址时只传播上下文不上报
+func (a *App) initTracing() error {
+	c := a.Config.Trace
+	shutdown, err := tracing.Setup(context.Background(), tracing.Config{
+		ServiceName:    a.Config.Name,
+		ServiceVersion: a.Config.Version,
+		InstanceID:     a.Config.ID,
+		Endpoint:       c.Endpoint,
+		Insecure:       c.Insecure,
+		SampleRatio:    c.SampleRatio,
+	})
+	if err != nil {
+		return err
+	}
+
+	// 关闭时刷新未上报的 span
+	a.OnStop(shutdown)
+	return nil
+}
+
+// metricsMiddleware 创建请求指标中间件，指标定义见 pkg/middleware/metrics
+func (a *App) metricsMiddleware() middleware.Middleware {
+	var opts []metrics.Option
+	if a.options.meterProvider != nil {
+		opts = append(opts, metrics.WithMeterProvider(a.options.meterProvider))
+	}
+	return metrics.Server(opts...)
+}
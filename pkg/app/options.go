@@ -0,0 +1,57 @@
+package app
+
+import (
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Option 启动选项
+type Option func(*options)
+
+type options struct {
+	logger        log.Logger
+	middlewares   []middleware.Middleware
+	httpOptions   []http.ServerOption
+	grpcOptions   []grpc.ServerOption
+	meterProvider metric.MeterProvider
+}
+
+// WithLogger 使用自定义日志输出，默认输出到标准输出
+func WithLogger(logger log.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// WithMiddleware 在标准中间件链之后追加中间件，如鉴权
+func WithMiddleware(m ...middleware.Middleware) Option {
+	return func(o *options) {
+		o.middlewares = append(o.middlewares, m...)
+	}
+}
+
+// WithHTTPOptions 追加 HTTP 服务选项
+func WithHTTPOptions(opts ...http.ServerOption) Option {
+	return func(o *options) {
+		o.httpOptions = append(o.httpOptions, opts...)
+	}
+}
+
+// WithGRPCOptions 追加 gRPC 服务选项
+func WithGRPCOptions(opts ...grpc.ServerOption) Option {
+	return func(o *options) {
+		o.grpcOptions = append(o.grpcOptions, opts...)
+	}
+}
+
+// WithMeterProvider 设置指标的 MeterProvider，如 Prometheus 导出器
+//
+// 未设置时使用 otel 全局的 MeterProvider
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(o *options) {
+		o.meterProvider = mp
+	}
+}
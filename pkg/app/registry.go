@@ -0,0 +1,22 @@
+package app
+
+import (
+	"github.com/heyinLab/common/pkg/common"
+)
+
+// initRegistry 初始化服务注册与发现
+func (a *App) initRegistry() error {
+	c := a.Config.Registry.Consul
+	if c.Addr == "" {
+		return nil
+	}
+
+	discovery, err := common.NewConsulDiscovery(c.Addr)
+	if err != nil {
+		return err
+	}
+
+	a.Registrar = common.NewConsulRegistrar(c.Addr, c.Tags)
+	a.Discovery = discovery
+	return nil
+}
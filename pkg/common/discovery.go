@@ -0,0 +1,109 @@
+package common
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/registry"
+	consulAPI "github.com/hashicorp/consul/api"
+
+	consul "github.com/go-kratos/kratos/contrib/registry/consul/v2"
+)
+
+// 服务发现类型
+const (
+	DiscoveryConsul = "consul" // 内置的 Consul 服务发现
+	DiscoveryEtcd   = "etcd"   // 导入 pkg/common/discovery/etcd 后可用
+	DiscoveryNacos  = "nacos"  // 导入 pkg/common/discovery/nacos 后可用
+)
+
+// DiscoveryFactory 根据注册中心地址创建服务发现实例
+type DiscoveryFactory func(addr string) (registry.Discovery, error)
+
+var (
+	discoveryMu        sync.RWMutex
+	discoveryFactories = map[string]DiscoveryFactory{
+		DiscoveryConsul: NewConsulDiscovery,
+	}
+)
+
+// RegisterDiscovery 注册服务发现类型，同名类型会被覆盖
+//
+// 内置 Consul；etcd 和 Nacos 在子包中实现，导入子包时自动注册，
+// 不使用的服务不会引入 etcd 客户端等依赖。之后通过 NewDiscovery 按配置中的类型创建，
+// 资源服务等客户端即可在不同集群中使用同一份代码。其他注册中心也可以用同样的方式注册。
+//
+// 使用示例:
+//
+//	import (
+//	    _ "github.com/heyinLab/common/pkg/common/discovery/etcd"
+//	    _ "github.com/heyinLab/common/pkg/common/discovery/nacos"
+//	)
+//
+//	common.RegisterDiscovery("zookeeper", func(addr string) (registry.Discovery, error) {
+//	    return newZookeeperDiscovery(addr)
+//	})
+func RegisterDiscovery(kind string, factory DiscoveryFactory) {
+	if factory == nil {
+		panic(fmt.Sprintf("服务发现类型 %s 的创建函数不能为空", kind))
+	}
+	discoveryMu.Lock()
+	defer discoveryMu.Unlock()
+	discoveryFactories[kind] = factory
+}
+
+// NewDiscovery 按类型创建服务发现实例
+//
+// 参数:
+//   - kind: 服务发现类型，如 "consul"、"etcd"、"nacos" 或通过 RegisterDiscovery 注册的类型
+//   - addr: 注册中心地址
+//
+// 返回:
+//   - registry.Discovery: 服务发现实例
+//   - error: 类型未注册或创建失败时的错误信息
+//
+// 使用示例:
+//
+//	discovery, err := common.NewDiscovery(conf.Registry.Kind, conf.Registry.Addr)
+//	if err != nil {
+//	    return err
+//	}
+//	client, err := resource.NewResourceClientWithDiscovery(resource.DefaultConfig(), discovery)
+func NewDiscovery(kind, addr string) (registry.Discovery, error) {
+	discoveryMu.RLock()
+	factory, ok := discoveryFactories[kind]
+	discoveryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("未注册的服务发现类型: %s，已注册: %v", kind, DiscoveryKinds())
+	}
+
+	discovery, err := factory(addr)
+	if err != nil {
+		return nil, fmt.Errorf("创建 %s 服务发现失败: addr=%s: %w", kind, addr, err)
+	}
+	return discovery, nil
+}
+
+// DiscoveryKinds 返回已注册的服务发现类型
+func DiscoveryKinds() []string {
+	discoveryMu.RLock()
+	defer discoveryMu.RUnlock()
+	kinds := make([]string, 0, len(discoveryFactories))
+	for kind := range discoveryFactories {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// NewConsulDiscovery 创建 Consul 服务发现
+func NewConsulDiscovery(addr string) (registry.Discovery, error) {
+	c := consulAPI.DefaultConfig()
+	c.Address = addr
+	cli, err := consulAPI.NewClient(c)
+	if err != nil {
+		return nil, fmt.Errorf("Consul 客户端初始化失败: %w", err)
+	}
+	return consul.New(cli), nil
+}
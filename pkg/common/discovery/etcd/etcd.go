@@ -0,0 +1,164 @@
+// Package etcd 基于 etcd 的服务发现
+//
+// 与 kratos 的 etcd 注册器（github.com/go-kratos/kratos/contrib/registry/etcd/v2）使用相同的存储格式:
+// 实例以 JSON 保存在 /microservices/{服务名}/{实例ID} 下，因此可以发现由 kratos etcd 注册器注册的服务。
+//
+// 导入本包即注册 "etcd" 类型，之后通过 common.NewDiscovery 按配置创建:
+//
+//	import _ "github.com/heyinLab/common/pkg/common/discovery/etcd"
+//
+//	discovery, err := common.NewDiscovery("etcd", "10.0.0.1:2379,10.0.0.2:2379")
+//	if err != nil {
+//	    return err
+//	}
+//	client, err := resource.NewResourceClientWithDiscovery(resource.DefaultConfig(), discovery)
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/heyinLab/common/pkg/common"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// DefaultNamespace kratos etcd 注册器默认的 key 前缀
+const DefaultNamespace = "/microservices"
+
+// dialTimeout 连接 etcd 的超时时间
+const dialTimeout = 5 * time.Second
+
+func init() {
+	common.RegisterDiscovery(common.DiscoveryEtcd, func(addr string) (registry.Discovery, error) {
+		return NewDiscovery(addr)
+	})
+}
+
+// Option 服务发现选项
+type Option func(*Discovery)
+
+// WithNamespace 设置 key 前缀，需要与注册方一致，默认 DefaultNamespace
+func WithNamespace(namespace string) Option {
+	return func(d *Discovery) {
+		d.namespace = strings.TrimSuffix(namespace, "/")
+	}
+}
+
+// Discovery etcd 服务发现
+type Discovery struct {
+	client    *clientv3.Client
+	namespace string
+}
+
+var _ registry.Discovery = (*Discovery)(nil)
+
+// NewDiscovery 连接 etcd 并创建服务发现，addr 为逗号分隔的 etcd 地址
+func NewDiscovery(addr string, opts ...Option) (*Discovery, error) {
+	var endpoints []string
+	for _, ep := range strings.Split(addr, ",") {
+		if ep = strings.TrimSpace(ep); ep != "" {
+			endpoints = append(endpoints, ep)
+		}
+	}
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("etcd 地址不能为空")
+	}
+
+	client, err := clientv3.New(clientv3.Config{Endpoints: endpoints, DialTimeout: dialTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("etcd 客户端初始化失败: %w", err)
+	}
+	return New(client, opts...), nil
+}
+
+// New 使用已有的 etcd 客户端创建服务发现，客户端由调用方关闭
+func New(client *clientv3.Client, opts ...Option) *Discovery {
+	d := &Discovery{client: client, namespace: DefaultNamespace}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// GetService 返回服务当前的所有实例
+func (d *Discovery) GetService(ctx context.Context, name string) ([]*registry.ServiceInstance, error) {
+	resp, err := d.client.Get(ctx, d.prefix(name), clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	values := make([][]byte, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		values = append(values, kv.Value)
+	}
+	return decodeInstances(name, values), nil
+}
+
+// Watch 监听服务实例变化，第一次调用 Next 立即返回当前实例
+func (d *Discovery) Watch(ctx context.Context, name string) (registry.Watcher, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	return &watcher{
+		discovery: d,
+		name:      name,
+		ctx:       ctx,
+		cancel:    cancel,
+		events:    d.client.Watch(ctx, d.prefix(name), clientv3.WithPrefix()),
+		first:     true,
+	}, nil
+}
+
+// prefix 服务实例的 key 前缀，以 / 结尾避免匹配到同前缀的其他服务
+func (d *Discovery) prefix(name string) string {
+	return d.namespace + "/" + name + "/"
+}
+
+// decodeInstances 解析实例 JSON，跳过无法解析或不属于该服务的值
+func decodeInstances(name string, values [][]byte) []*registry.ServiceInstance {
+	instances := make([]*registry.ServiceInstance, 0, len(values))
+	for _, v := range values {
+		var si registry.ServiceInstance
+		if err := json.Unmarshal(v, &si); err != nil || si.Name != name {
+			continue
+		}
+		instances = append(instances, &si)
+	}
+	return instances
+}
+
+// watcher etcd 实例变化监听，收到任意变化时重新读取全部实例
+type watcher struct {
+	discovery *Discovery
+	name      string
+	ctx       context.Context
+	cancel    context.CancelFunc
+	events    clientv3.WatchChan
+	first     bool
+}
+
+func (w *watcher) Next() ([]*registry.ServiceInstance, error) {
+	if w.first {
+		w.first = false
+		return w.discovery.GetService(w.ctx, w.name)
+	}
+
+	select {
+	case <-w.ctx.Done():
+		return nil, w.ctx.Err()
+	case resp, ok := <-w.events:
+		if !ok {
+			return nil, fmt.Errorf("etcd 监听已关闭: service=%s", w.name)
+		}
+		if err := resp.Err(); err != nil {
+			return nil, err
+		}
+		return w.discovery.GetService(w.ctx, w.name)
+	}
+}
+
+func (w *watcher) Stop() error {
+	w.cancel()
+	return nil
+}
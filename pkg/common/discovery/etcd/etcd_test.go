@@ -0,0 +1,38 @@
+package etcd
+
+import (
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/heyinLab/common/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeInstances(t *testing.T) {
+	instances := decodeInstances("user-service", [][]byte{
+		[]byte(`{"id":"1","name":"user-service","version":"v1","metadata":{"region":"cn-east"},"endpoints":["grpc://10.0.0.1:9000"]}`),
+		[]byte(`{"id":"2","name":"user-service-admin","endpoints":["grpc://10.0.0.2:9000"]}`),
+		[]byte(`not json`),
+	})
+	assert.Equal(t, []*registry.ServiceInstance{{
+		ID:        "1",
+		Name:      "user-service",
+		Version:   "v1",
+		Metadata:  map[string]string{"region": "cn-east"},
+		Endpoints: []string{"grpc://10.0.0.1:9000"},
+	}}, instances)
+}
+
+func TestNewDiscovery(t *testing.T) {
+	_, err := NewDiscovery(" , ")
+	assert.Error(t, err)
+
+	// 创建客户端时不会立即连接
+	d, err := NewDiscovery("127.0.0.1:2379", WithNamespace("/services/"))
+	require.NoError(t, err)
+	t.Cleanup(func() { d.client.Close() })
+	assert.Equal(t, "/services/user-service/", d.prefix("user-service"))
+
+	assert.Contains(t, common.DiscoveryKinds(), common.DiscoveryEtcd)
+}
@@ -0,0 +1,244 @@
+// Package nacos 基于 Nacos Open API 的服务发现
+//
+// 通过 HTTP 查询 Nacos 的实例列表（/nacos/v1/ns/instance/list），不依赖 Nacos SDK。
+// 实例的解析方式与 kratos 的 Nacos 注册器（github.com/go-kratos/kratos/contrib/registry/nacos/v2）一致:
+// 注册器按协议把服务注册为 {服务名}.{协议}，如 user-service.grpc，协议写在实例元数据的 kind 中，
+// 因此调用方使用 discovery:///user-service.grpc 作为地址。
+//
+// Nacos 没有开放长连接推送接口，Watch 按 PollInterval 轮询，实例变化时返回。
+//
+// 导入本包即注册 "nacos" 类型，之后通过 common.NewDiscovery 按配置创建:
+//
+//	import _ "github.com/heyinLab/common/pkg/common/discovery/nacos"
+//
+//	discovery, err := common.NewDiscovery("nacos", "127.0.0.1:8848")
+//	if err != nil {
+//	    return err
+//	}
+//	conn, err := grpc.DialInsecure(ctx,
+//	    grpc.WithEndpoint("discovery:///user-service.grpc"),
+//	    grpc.WithDiscovery(discovery),
+//	)
+package nacos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/heyinLab/common/pkg/common"
+)
+
+const (
+	// DefaultGroup Nacos 默认分组
+	DefaultGroup = "DEFAULT_GROUP"
+	// DefaultKind 实例元数据中没有 kind 时使用的协议
+	DefaultKind = "grpc"
+	// DefaultPollInterval Watch 默认的轮询间隔
+	DefaultPollInterval = 5 * time.Second
+)
+
+func init() {
+	common.RegisterDiscovery(common.DiscoveryNacos, func(addr string) (registry.Discovery, error) {
+		return NewDiscovery(addr)
+	})
+}
+
+// Option 服务发现选项
+type Option func(*Discovery)
+
+// WithGroup 设置分组，默认 DefaultGroup
+func WithGroup(group string) Option {
+	return func(d *Discovery) {
+		d.group = group
+	}
+}
+
+// WithNamespace 设置命名空间ID，默认 public
+func WithNamespace(namespace string) Option {
+	return func(d *Discovery) {
+		d.namespace = namespace
+	}
+}
+
+// WithDefaultKind 设置实例元数据中没有 kind 时使用的协议，默认 DefaultKind
+func WithDefaultKind(kind string) Option {
+	return func(d *Discovery) {
+		d.kind = kind
+	}
+}
+
+// WithPollInterval 设置 Watch 的轮询间隔，默认 DefaultPollInterval
+func WithPollInterval(interval time.Duration) Option {
+	return func(d *Discovery) {
+		d.pollInterval = interval
+	}
+}
+
+// WithHTTPClient 设置访问 Nacos 使用的 HTTP 客户端，默认超时 5 秒
+func WithHTTPClient(client *http.Client) Option {
+	return func(d *Discovery) {
+		d.client = client
+	}
+}
+
+// Discovery Nacos 服务发现
+type Discovery struct {
+	baseURL      string
+	group        string
+	namespace    string
+	kind         string
+	pollInterval time.Duration
+	client       *http.Client
+}
+
+var _ registry.Discovery = (*Discovery)(nil)
+
+// NewDiscovery 创建 Nacos 服务发现
+//
+// addr 为 Nacos 地址，如 "127.0.0.1:8848" 或 "https://nacos.example.com"，未指定协议时使用 http
+func NewDiscovery(addr string, opts ...Option) (*Discovery, error) {
+	addr = strings.TrimSpace(addr)
+	if addr == "" {
+		return nil, fmt.Errorf("Nacos 地址不能为空")
+	}
+	if !strings.Contains(addr, "://") {
+		addr = "http://" + addr
+	}
+	u, err := url.Parse(addr)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("无效的 Nacos 地址: %s", addr)
+	}
+
+	d := &Discovery{
+		baseURL:      strings.TrimSuffix(u.String(), "/"),
+		group:        DefaultGroup,
+		kind:         DefaultKind,
+		pollInterval: DefaultPollInterval,
+		client:       &http.Client{Timeout: 5 * time.Second},
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d, nil
+}
+
+// instanceList 实例列表接口的响应
+type instanceList struct {
+	Hosts []struct {
+		InstanceID string            `json:"instanceId"`
+		IP         string            `json:"ip"`
+		Port       int               `json:"port"`
+		Healthy    bool              `json:"healthy"`
+		Enabled    bool              `json:"enabled"`
+		Metadata   map[string]string `json:"metadata"`
+	} `json:"hosts"`
+}
+
+// GetService 返回服务当前健康且启用的实例
+func (d *Discovery) GetService(ctx context.Context, name string) ([]*registry.ServiceInstance, error) {
+	query := url.Values{
+		"serviceName": {name},
+		"groupName":   {d.group},
+		"healthyOnly": {"true"},
+	}
+	if d.namespace != "" {
+		query.Set("namespaceId", d.namespace)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.baseURL+"/nacos/v1/ns/instance/list?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("查询 Nacos 实例失败: service=%s: %w", name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("查询 Nacos 实例失败: service=%s, status=%d", name, resp.StatusCode)
+	}
+
+	var list instanceList
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, fmt.Errorf("解析 Nacos 实例列表失败: service=%s: %w", name, err)
+	}
+
+	instances := make([]*registry.ServiceInstance, 0, len(list.Hosts))
+	for _, h := range list.Hosts {
+		if !h.Healthy || !h.Enabled {
+			continue
+		}
+		kind := d.kind
+		if k, ok := h.Metadata["kind"]; ok && k != "" {
+			kind = k
+		}
+		instances = append(instances, &registry.ServiceInstance{
+			ID:        h.InstanceID,
+			Name:      name,
+			Version:   h.Metadata["version"],
+			Metadata:  h.Metadata,
+			Endpoints: []string{fmt.Sprintf("%s://%s", kind, net.JoinHostPort(h.IP, strconv.Itoa(h.Port)))},
+		})
+	}
+	sort.Slice(instances, func(i, j int) bool { return instances[i].Endpoints[0] < instances[j].Endpoints[0] })
+	return instances, nil
+}
+
+// Watch 轮询服务实例，第一次调用 Next 立即返回当前实例，之后在实例变化时返回
+func (d *Discovery) Watch(ctx context.Context, name string) (registry.Watcher, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	return &watcher{discovery: d, name: name, ctx: ctx, cancel: cancel}, nil
+}
+
+// watcher 轮询实现的实例变化监听
+type watcher struct {
+	discovery *Discovery
+	name      string
+	ctx       context.Context
+	cancel    context.CancelFunc
+	last      []*registry.ServiceInstance
+	started   bool
+}
+
+func (w *watcher) Next() ([]*registry.ServiceInstance, error) {
+	if !w.started {
+		w.started = true
+		instances, err := w.discovery.GetService(w.ctx, w.name)
+		if err != nil {
+			return nil, err
+		}
+		w.last = instances
+		return instances, nil
+	}
+
+	ticker := time.NewTicker(w.discovery.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.ctx.Done():
+			return nil, w.ctx.Err()
+		case <-ticker.C:
+		}
+		// 查询失败时保留上一次的实例，等待下一次轮询
+		instances, err := w.discovery.GetService(w.ctx, w.name)
+		if err != nil || reflect.DeepEqual(instances, w.last) {
+			continue
+		}
+		w.last = instances
+		return instances, nil
+	}
+}
+
+func (w *watcher) Stop() error {
+	w.cancel()
+	return nil
+}
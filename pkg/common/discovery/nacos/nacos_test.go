@@ -0,0 +1,79 @@
+package nacos
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/heyinLab/common/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscovery(t *testing.T) {
+	var version atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/nacos/v1/ns/instance/list", r.URL.Path)
+		assert.Equal(t, "user-service.grpc", r.URL.Query().Get("serviceName"))
+		assert.Equal(t, "prod", r.URL.Query().Get("groupName"))
+		assert.Equal(t, "ns-1", r.URL.Query().Get("namespaceId"))
+
+		hosts := `{"instanceId":"a","ip":"10.0.0.1","port":9000,"healthy":true,"enabled":true,"metadata":{"kind":"grpc","version":"v1"}},
+		  {"instanceId":"b","ip":"10.0.0.2","port":9000,"healthy":false,"enabled":true,"metadata":{}},
+		  {"instanceId":"c","ip":"10.0.0.3","port":9000,"healthy":true,"enabled":false,"metadata":{}}`
+		if version.Load() > 0 {
+			hosts += `,{"instanceId":"d","ip":"10.0.0.4","port":8000,"healthy":true,"enabled":true,"metadata":{"kind":"http"}}`
+		}
+		w.Write([]byte(`{"hosts":[` + hosts + `]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	d, err := NewDiscovery(srv.URL, WithGroup("prod"), WithNamespace("ns-1"), WithPollInterval(10*time.Millisecond))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// 只返回健康且启用的实例
+	instances, err := d.GetService(ctx, "user-service.grpc")
+	require.NoError(t, err)
+	require.Len(t, instances, 1)
+	assert.Equal(t, "a", instances[0].ID)
+	assert.Equal(t, "v1", instances[0].Version)
+	assert.Equal(t, []string{"grpc://10.0.0.1:9000"}, instances[0].Endpoints)
+
+	w, err := d.Watch(ctx, "user-service.grpc")
+	require.NoError(t, err)
+	t.Cleanup(func() { w.Stop() })
+
+	instances, err = w.Next()
+	require.NoError(t, err)
+	assert.Len(t, instances, 1)
+
+	// 实例变化后返回新的实例列表
+	version.Store(1)
+	instances, err = w.Next()
+	require.NoError(t, err)
+	require.Len(t, instances, 2)
+	assert.Equal(t, []string{"http://10.0.0.4:8000"}, instances[1].Endpoints)
+
+	// Stop 后 Next 返回
+	require.NoError(t, w.Stop())
+	_, err = w.Next()
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestNewDiscovery(t *testing.T) {
+	d, err := NewDiscovery("127.0.0.1:8848")
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:8848", d.baseURL)
+
+	_, err = NewDiscovery("")
+	assert.Error(t, err)
+
+	// 导入包时注册到 common
+	assert.Contains(t, common.DiscoveryKinds(), common.DiscoveryNacos)
+	_, err = common.NewDiscovery(common.DiscoveryNacos, "127.0.0.1:8848")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,55 @@
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubDiscovery 测试用的服务发现，记录创建时的地址
+type stubDiscovery struct {
+	registry.Discovery
+	addr string
+}
+
+func TestNewDiscovery(t *testing.T) {
+	_, err := NewDiscovery("unknown", "127.0.0.1:1")
+	assert.ErrorContains(t, err, "未注册的服务发现类型: unknown")
+
+	RegisterDiscovery("stub", func(addr string) (registry.Discovery, error) {
+		if addr == "" {
+			return nil, errors.New("empty addr")
+		}
+		return &stubDiscovery{addr: addr}, nil
+	})
+	t.Cleanup(func() {
+		discoveryMu.Lock()
+		delete(discoveryFactories, "stub")
+		discoveryMu.Unlock()
+	})
+
+	d, err := NewDiscovery("stub", "10.0.0.1:2379")
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1:2379", d.(*stubDiscovery).addr)
+
+	_, err = NewDiscovery("stub", "")
+	assert.ErrorContains(t, err, "创建 stub 服务发现失败")
+
+	assert.Equal(t, []string{DiscoveryConsul, "stub"}, DiscoveryKinds())
+}
+
+func TestNewDiscovery_Consul(t *testing.T) {
+	d, err := NewDiscovery(DiscoveryConsul, "127.0.0.1:8500")
+	require.NoError(t, err)
+	assert.NotNil(t, d)
+}
+
+func TestRegisterDiscovery_NilFactory(t *testing.T) {
+	assert.PanicsWithValue(t, "服务发现类型 broken 的创建函数不能为空", func() {
+		RegisterDiscovery("broken", nil)
+	})
+	assert.NotContains(t, DiscoveryKinds(), "broken")
+}
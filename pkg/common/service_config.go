@@ -1,8 +1,17 @@
 package common
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"math/rand/v2"
+	"os"
+	"slices"
 	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -22,6 +31,249 @@ type ServiceConfig struct {
 
 	// Timeout 请求超时时间
 	Timeout time.Duration
+
+	// Retry 重试策略，nil 表示不重试
+	Retry *RetryPolicy
+
+	// CircuitBreaker 熔断策略，nil 表示不熔断
+	CircuitBreaker *CircuitBreakerPolicy
+
+	// Hedge 只读批量查询的对冲请求策略，nil 表示不发送对冲请求
+	Hedge *HedgePolicy
+
+	// Cache 很少变化的查询结果（如文件元数据）的本地缓存策略，nil 表示不缓存
+	Cache *CachePolicy
+
+	// TLS 传输层安全配置，nil 表示使用明文连接
+	TLS *TLSConfig
+
+	// MeterProvider 客户端调用指标的 MeterProvider，nil 时使用 otel 全局的 MeterProvider
+	MeterProvider metric.MeterProvider
+
+	// Keepalive 连接保活配置，nil 表示不主动发送 ping
+	Keepalive *KeepaliveConfig
+
+	// MaxRecvMsgSize 单条响应消息的最大字节数，0 表示使用 gRPC 默认值（4MB）
+	MaxRecvMsgSize int
+
+	// MaxSendMsgSize 单条请求消息的最大字节数，0 表示使用 gRPC 默认值（不限制）
+	MaxSendMsgSize int
+
+	// InitialWindowSize 单个流的初始窗口大小（字节），0 表示使用 gRPC 默认值（64KB，并自动调整）
+	InitialWindowSize int32
+
+	// InitialConnWindowSize 连接的初始窗口大小（字节），0 表示使用 gRPC 默认值
+	InitialConnWindowSize int32
+
+	// Compression 为 true 时使用 gzip 压缩请求消息，服务端会用同样的方式压缩响应
+	Compression bool
+
+	// LazyConnect 为 true 时创建客户端不建立连接，第一次调用时才连接
+	LazyConnect bool
+
+	// WaitForReady 创建客户端时等待连接就绪的最长时间，超时则创建失败；0 表示不等待
+	WaitForReady time.Duration
+
+	// Middlewares 额外的 kratos 客户端中间件，在内置的恢复、指标、熔断和重试之后执行（每次重试都会执行）
+	Middlewares []middleware.Middleware
+
+	// DialOptions 额外的 gRPC 连接选项，在内置选项之后应用，可以覆盖内置选项
+	DialOptions []grpc.DialOption
+}
+
+// KeepaliveConfig 客户端连接保活配置
+//
+// 负载均衡器通常会断开一段时间没有数据的空闲连接，定期发送 HTTP/2 ping 可以保持连接。
+// 服务端需要通过 keepalive.EnforcementPolicy 允许同样频率的 ping（gRPC 默认要求间隔不小于5分钟，
+// 且空闲时不允许 ping），否则服务端会以 too_many_pings 关闭连接
+type KeepaliveConfig struct {
+	// Time 连接空闲多久后发送 ping，最小10s
+	Time time.Duration
+
+	// Timeout 发送 ping 后等待响应的时间，超时则关闭连接，默认20s
+	Timeout time.Duration
+
+	// PermitWithoutStream 没有进行中的请求时是否也发送 ping
+	PermitWithoutStream bool
+}
+
+// TLSConfig 客户端 TLS 配置
+//
+// 只设置 CAFile 时为单向 TLS，同时设置 CertFile 和 KeyFile 时为双向 TLS（mTLS）
+type TLSConfig struct {
+	// CAFile 校验服务端证书的 CA 证书文件（PEM，可包含多个证书），为空时使用系统根证书
+	CAFile string
+
+	// CertFile 客户端证书文件（PEM），mTLS 时必填
+	CertFile string
+
+	// KeyFile 客户端私钥文件（PEM），mTLS 时必填
+	KeyFile string
+
+	// ServerName 覆盖校验证书时使用的服务端名称，
+	// 通过服务发现或 IP 直连时证书中的域名与连接地址不一致时设置
+	ServerName string
+}
+
+// Load 读取证书文件，生成 tls.Config
+func (c *TLSConfig) Load() (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: c.ServerName,
+	}
+
+	if c.CAFile != "" {
+		pem, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("读取CA证书失败: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("CA证书文件中没有有效的证书: %s", c.CAFile)
+		}
+		config.RootCAs = pool
+	}
+
+	if c.CertFile != "" || c.KeyFile != "" {
+		if c.CertFile == "" || c.KeyFile == "" {
+			return nil, fmt.Errorf("客户端证书和私钥必须同时设置")
+		}
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("读取客户端证书失败: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// RetryPolicy 客户端重试策略
+//
+// 只重试 Unavailable 和 DeadlineExceeded 错误，且只重试幂等的方法；
+// 所有尝试共用一次请求的 Timeout
+type RetryPolicy struct {
+	// MaxAttempts 最大尝试次数（包括第一次），小于等于1表示不重试
+	MaxAttempts int
+
+	// InitialBackoff 第一次重试前的等待时间，之后每次翻倍
+	InitialBackoff time.Duration
+
+	// MaxBackoff 重试等待时间上限
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy 返回默认的重试策略
+//
+// 默认配置:
+//   - MaxAttempts: 3
+//   - InitialBackoff: 100ms
+//   - MaxBackoff: 1s
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+	}
+}
+
+// CircuitBreakerPolicy 客户端熔断策略（Google SRE 自适应熔断）
+//
+// 按方法统计最近 Window 内的请求，服务端错误（Internal、Unavailable、超时）
+// 使成功率低于 Success 且请求数超过 Request 时，按比例直接在本地拒绝请求，
+// 不再等待完整的超时时间；服务恢复后拒绝比例随成功率回升自动下降
+type CircuitBreakerPolicy struct {
+	// Success 成功率阈值，默认0.6，越小越不容易触发熔断
+	Success float64
+
+	// Request 触发熔断的最小请求数，默认100
+	Request int64
+
+	// Window 统计窗口，默认3s
+	Window time.Duration
+}
+
+// DefaultCircuitBreakerPolicy 返回默认的熔断策略
+//
+// 默认配置:
+//   - Success: 0.6
+//   - Request: 100
+//   - Window: 3s
+func DefaultCircuitBreakerPolicy() *CircuitBreakerPolicy {
+	return &CircuitBreakerPolicy{
+		Success: 0.6,
+		Request: 100,
+		Window:  3 * time.Second,
+	}
+}
+
+// Backoff 返回第 attempt 次重试（从1开始）前的等待时间，带 ±20% 抖动
+func (p *RetryPolicy) Backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	jitter := time.Duration(rand.Int64N(int64(backoff)/5*2+1)) - backoff/5
+	return backoff + jitter
+}
+
+// HedgePolicy 对冲请求策略
+//
+// 请求超过等待时间仍未返回时，向服务再发送一次相同的请求（负载均衡通常会选择另一个实例），
+// 使用先返回的结果并取消另一个请求，用少量额外请求降低个别慢实例造成的长尾延迟
+type HedgePolicy struct {
+	// Delay 发送对冲请求前的等待时间；设置了 Percentile 时在延迟样本不足前使用
+	Delay time.Duration
+
+	// Percentile 按最近成功请求延迟的分位数（如 0.95）决定等待时间，0 表示固定使用 Delay
+	Percentile float64
+
+	// MinDelay 等待时间的下限，避免服务整体很快时也频繁发送对冲请求
+	MinDelay time.Duration
+}
+
+// DefaultHedgePolicy 返回默认的对冲请求策略
+//
+// 默认配置:
+//   - Delay: 100ms
+//   - Percentile: 0.95
+//   - MinDelay: 10ms
+func DefaultHedgePolicy() *HedgePolicy {
+	return &HedgePolicy{
+		Delay:      100 * time.Millisecond,
+		Percentile: 0.95,
+		MinDelay:   10 * time.Millisecond,
+	}
+}
+
+// CachePolicy 客户端本地缓存策略
+//
+// 缓存在当前进程内，通过本客户端执行的修改和删除会使对应的缓存失效；
+// 其他服务做的修改在 TTL 到期前不可见，TTL 应按业务可接受的延迟设置
+type CachePolicy struct {
+	// TTL 缓存有效期
+	TTL time.Duration
+
+	// MaxEntries 最多缓存的条目数，超出时淘汰最早过期的条目；0 表示不限制
+	MaxEntries int
+}
+
+// DefaultCachePolicy 返回默认的本地缓存策略
+//
+// 默认配置:
+//   - TTL: 1分钟
+//   - MaxEntries: 10000
+func DefaultCachePolicy() *CachePolicy {
+	return &CachePolicy{
+		TTL:        time.Minute,
+		MaxEntries: 10000,
+	}
 }
 
 // NewServiceConfig 创建新的服务配置
@@ -47,6 +299,12 @@ func (c *ServiceConfig) Validate() error {
 	if c.Timeout <= 0 {
 		c.Timeout = DefaultTimeout
 	}
+	if c.LazyConnect && c.WaitForReady > 0 {
+		return fmt.Errorf("延迟连接和等待连接就绪不能同时开启")
+	}
+	if c.Cache != nil && c.Cache.TTL <= 0 {
+		return fmt.Errorf("缓存有效期必须大于0")
+	}
 	return nil
 }
 
@@ -76,11 +334,156 @@ func (c *ServiceConfig) WithTimeout(timeout time.Duration) *ServiceConfig {
 	return c
 }
 
+// WithRetry 设置重试策略，传入 nil 关闭重试
+func (c *ServiceConfig) WithRetry(policy *RetryPolicy) *ServiceConfig {
+	c.Retry = policy
+	return c
+}
+
+// WithCircuitBreaker 设置熔断策略，传入 nil 关闭熔断
+func (c *ServiceConfig) WithCircuitBreaker(policy *CircuitBreakerPolicy) *ServiceConfig {
+	c.CircuitBreaker = policy
+	return c
+}
+
+// WithHedge 设置对冲请求策略，传入 nil 关闭对冲请求
+func (c *ServiceConfig) WithHedge(policy *HedgePolicy) *ServiceConfig {
+	c.Hedge = policy
+	return c
+}
+
+// WithCache 设置本地缓存策略，传入 nil 关闭缓存
+func (c *ServiceConfig) WithCache(policy *CachePolicy) *ServiceConfig {
+	c.Cache = policy
+	return c
+}
+
+// WithTLS 设置 TLS 配置，传入 nil 使用明文连接
+//
+// 示例:
+//   - 单向 TLS: &TLSConfig{CAFile: "/etc/certs/ca.pem"}
+//   - mTLS: &TLSConfig{CAFile: "/etc/certs/ca.pem", CertFile: "/etc/certs/client.pem", KeyFile: "/etc/certs/client-key.pem"}
+func (c *ServiceConfig) WithTLS(config *TLSConfig) *ServiceConfig {
+	c.TLS = config
+	return c
+}
+
+// WithMeterProvider 设置客户端调用指标的 MeterProvider
+//
+// 使用 otel 的 Prometheus 导出器时，指标注册到导出器指定的 Registry 上
+func (c *ServiceConfig) WithMeterProvider(mp metric.MeterProvider) *ServiceConfig {
+	c.MeterProvider = mp
+	return c
+}
+
+// WithKeepalive 设置连接保活配置，传入 nil 关闭主动保活
+func (c *ServiceConfig) WithKeepalive(config *KeepaliveConfig) *ServiceConfig {
+	c.Keepalive = config
+	return c
+}
+
+// WithMaxMsgSize 设置收发消息的最大字节数
+//
+// 参数:
+//   - recv: 单条响应消息的最大字节数，0 表示使用默认值
+//   - send: 单条请求消息的最大字节数，0 表示使用默认值
+func (c *ServiceConfig) WithMaxMsgSize(recv, send int) *ServiceConfig {
+	c.MaxRecvMsgSize = recv
+	c.MaxSendMsgSize = send
+	return c
+}
+
+// WithWindowSize 设置流和连接的初始窗口大小，高延迟链路上传输大消息时调大可以提高吞吐
+//
+// 参数:
+//   - stream: 单个流的初始窗口大小（字节），0 表示使用默认值
+//   - conn: 连接的初始窗口大小（字节），0 表示使用默认值
+func (c *ServiceConfig) WithWindowSize(stream, conn int32) *ServiceConfig {
+	c.InitialWindowSize = stream
+	c.InitialConnWindowSize = conn
+	return c
+}
+
+// WithCompression 设置是否使用 gzip 压缩消息
+//
+// 批量获取URL等响应较大且重复内容多的调用压缩效果明显，适合跨可用区等带宽受限的链路；
+// 服务端需要注册 gzip 压缩器（导入 google.golang.org/grpc/encoding/gzip）
+func (c *ServiceConfig) WithCompression(enable bool) *ServiceConfig {
+	c.Compression = enable
+	return c
+}
+
+// WithLazyConnect 设置是否延迟连接
+//
+// 开启后创建客户端时不建立连接，地址错误等问题在第一次调用时才返回，
+// 适合依赖的服务可能晚于当前服务启动的场景
+func (c *ServiceConfig) WithLazyConnect(lazy bool) *ServiceConfig {
+	c.LazyConnect = lazy
+	return c
+}
+
+// WithWaitForReady 设置创建客户端时等待连接就绪的最长时间，0 表示不等待
+//
+// 设置后地址错误、服务不可用等问题在启动时即可发现，不能与延迟连接同时开启
+func (c *ServiceConfig) WithWaitForReady(timeout time.Duration) *ServiceConfig {
+	c.WaitForReady = timeout
+	return c
+}
+
+// WithMiddleware 追加客户端中间件，如鉴权、日志或故障注入
+//
+// 中间件按传入顺序执行，位于内置中间件的内层，重试时每次尝试都会执行
+func (c *ServiceConfig) WithMiddleware(m ...middleware.Middleware) *ServiceConfig {
+	c.Middlewares = append(c.Middlewares, m...)
+	return c
+}
+
+// WithDialOptions 追加 gRPC 连接选项，如自定义拦截器或 stats.Handler
+func (c *ServiceConfig) WithDialOptions(opts ...grpc.DialOption) *ServiceConfig {
+	c.DialOptions = append(c.DialOptions, opts...)
+	return c
+}
+
 // Copy 创建配置的副本
 func (c *ServiceConfig) Copy() *ServiceConfig {
-	return &ServiceConfig{
-		Endpoint:    c.Endpoint,
-		ServiceName: c.ServiceName,
-		Timeout:     c.Timeout,
+	cp := &ServiceConfig{
+		Endpoint:              c.Endpoint,
+		ServiceName:           c.ServiceName,
+		Timeout:               c.Timeout,
+		MeterProvider:         c.MeterProvider,
+		MaxRecvMsgSize:        c.MaxRecvMsgSize,
+		MaxSendMsgSize:        c.MaxSendMsgSize,
+		InitialWindowSize:     c.InitialWindowSize,
+		InitialConnWindowSize: c.InitialConnWindowSize,
+		Compression:           c.Compression,
+		LazyConnect:           c.LazyConnect,
+		WaitForReady:          c.WaitForReady,
+		Middlewares:           slices.Clone(c.Middlewares),
+		DialOptions:           slices.Clone(c.DialOptions),
+	}
+	if c.Retry != nil {
+		retry := *c.Retry
+		cp.Retry = &retry
+	}
+	if c.CircuitBreaker != nil {
+		breaker := *c.CircuitBreaker
+		cp.CircuitBreaker = &breaker
+	}
+	if c.Hedge != nil {
+		hedge := *c.Hedge
+		cp.Hedge = &hedge
+	}
+	if c.Cache != nil {
+		cache := *c.Cache
+		cp.Cache = &cache
+	}
+	if c.TLS != nil {
+		tlsConfig := *c.TLS
+		cp.TLS = &tlsConfig
+	}
+	if c.Keepalive != nil {
+		keepalive := *c.Keepalive
+		cp.Keepalive = &keepalive
 	}
+	return cp
 }
\ No newline at end of file
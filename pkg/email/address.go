@@ -0,0 +1,220 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"strings"
+)
+
+var (
+	// ErrInvalidAddress 邮箱地址格式错误
+	ErrInvalidAddress = errors.New("invalid email address")
+	// ErrNoMailServer 邮箱域名没有可以接收邮件的服务器
+	ErrNoMailServer = errors.New("email domain has no mail server")
+	// ErrDisposableAddress 一次性邮箱地址
+	ErrDisposableAddress = errors.New("disposable email address")
+)
+
+// disposableDomains 常见的一次性邮箱域名
+var disposableDomains = map[string]struct{}{
+	"10minutemail.com":  {},
+	"dispostable.com":   {},
+	"fakeinbox.com":     {},
+	"getnada.com":       {},
+	"guerrillamail.com": {},
+	"mailinator.com":    {},
+	"maildrop.cc":       {},
+	"sharklasers.com":   {},
+	"temp-mail.org":     {},
+	"throwawaymail.com": {},
+	"trashmail.com":     {},
+	"yopmail.com":       {},
+}
+
+// Resolver 域名解析接口，*net.Resolver 实现了该接口
+type Resolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// ValidateOption 地址校验选项
+type ValidateOption func(*validateOptions)
+
+type validateOptions struct {
+	resolver          Resolver
+	checkDisposable   bool
+	disposableDomains []string
+}
+
+// WithMXCheck 校验域名是否有 MX 记录，没有 MX 记录时按 RFC 5321 检查 A/AAAA 记录
+//
+// resolver 为 nil 时使用 net.DefaultResolver。DNS 查询临时失败时不视为地址无效。
+func WithMXCheck(resolver Resolver) ValidateOption {
+	return func(o *validateOptions) {
+		if resolver == nil {
+			resolver = net.DefaultResolver
+		}
+		o.resolver = resolver
+	}
+}
+
+// WithDisposableCheck 拒绝一次性邮箱地址，extra 为内置列表之外需要拒绝的域名
+func WithDisposableCheck(extra ...string) ValidateOption {
+	return func(o *validateOptions) {
+		o.checkDisposable = true
+		o.disposableDomains = append(o.disposableDomains, extra...)
+	}
+}
+
+// ValidateAddress 校验收件人邮箱地址
+//
+// 默认只做语法校验：地址必须是不带显示名称的 RFC 5322 addr-spec，
+// 域名至少包含两级且每一级都是合法的主机名标签。
+// MX 记录和一次性邮箱检查需要通过选项开启。
+//
+// 参数:
+//   - ctx: 上下文，用于 DNS 查询
+//   - addr: 邮箱地址
+//   - opts: 校验选项
+//
+// 返回:
+//   - error: 校验失败时返回包装了 ErrInvalidAddress、ErrNoMailServer 或 ErrDisposableAddress 的错误
+//
+// 使用示例:
+//
+//	err := email.ValidateAddress(ctx, "user@example.com",
+//	    email.WithMXCheck(nil),
+//	    email.WithDisposableCheck(),
+//	)
+//	if errors.Is(err, email.ErrDisposableAddress) {
+//	    return errors.New("不支持一次性邮箱")
+//	}
+func ValidateAddress(ctx context.Context, addr string, opts ...ValidateOption) error {
+	var o validateOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	domain, err := parseAddress(addr)
+	if err != nil {
+		return err
+	}
+
+	if o.checkDisposable && isDisposableDomain(domain, o.disposableDomains) {
+		return fmt.Errorf("%w: %s", ErrDisposableAddress, addr)
+	}
+
+	if o.resolver != nil {
+		return checkMailServer(ctx, o.resolver, addr, domain)
+	}
+	return nil
+}
+
+// parseAddress 校验地址语法并返回小写的域名
+func parseAddress(addr string) (string, error) {
+	parsed, err := mail.ParseAddress(addr)
+	if err != nil || parsed.Name != "" || parsed.Address != addr {
+		return "", fmt.Errorf("%w: %q", ErrInvalidAddress, addr)
+	}
+
+	at := strings.LastIndexByte(addr, '@')
+	local, domain := addr[:at], strings.ToLower(addr[at+1:])
+	if len(local) > 64 || !validDomain(domain) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidAddress, addr)
+	}
+	return domain, nil
+}
+
+// validDomain 校验域名，非 ASCII 字符视为国际化域名中的合法字符
+func validDomain(domain string) bool {
+	if len(domain) > 253 {
+		return false
+	}
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, r := range label {
+			if r < 0x80 && !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isDisposableDomain 判断域名或其上级域名是否属于一次性邮箱
+func isDisposableDomain(domain string, extra []string) bool {
+	for d := domain; ; {
+		if _, ok := disposableDomains[d]; ok {
+			return true
+		}
+		for _, e := range extra {
+			if strings.EqualFold(d, e) {
+				return true
+			}
+		}
+		i := strings.IndexByte(d, '.')
+		if i < 0 {
+			return false
+		}
+		d = d[i+1:]
+	}
+}
+
+// checkMailServer 检查域名的 MX 记录，没有 MX 记录时回退到 A/AAAA 记录
+func checkMailServer(ctx context.Context, resolver Resolver, addr, domain string) error {
+	mxs, err := resolver.LookupMX(ctx, domain)
+	if err == nil {
+		// RFC 7505: 单条 "." 记录表示该域名不接收邮件
+		if len(mxs) == 1 && (mxs[0].Host == "." || mxs[0].Host == "") {
+			return fmt.Errorf("%w: %s", ErrNoMailServer, addr)
+		}
+		if len(mxs) > 0 {
+			return nil
+		}
+	} else if !isNotFound(err) {
+		return nil
+	}
+
+	if _, err := resolver.LookupHost(ctx, domain); err != nil {
+		if isNotFound(err) {
+			return fmt.Errorf("%w: %s", ErrNoMailServer, addr)
+		}
+	}
+	return nil
+}
+
+// isNotFound 判断 DNS 查询是否明确返回了记录不存在
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+// splitFrom 拆分发件人的显示名称和地址，支持 "名称 <地址>" 和纯地址，无法解析时原样作为地址返回
+func splitFrom(from string) (name, addr string) {
+	parsed, err := mail.ParseAddress(from)
+	if err != nil {
+		return "", from
+	}
+	return parsed.Name, parsed.Address
+}
+
+// formatFrom 格式化 From 邮件头，非 ASCII 的显示名称按 RFC 2047 编码
+func formatFrom(from string) (string, error) {
+	parsed, err := mail.ParseAddress(from)
+	if err != nil {
+		return "", fmt.Errorf("invalid from address %q: %w", from, err)
+	}
+	if parsed.Name == "" {
+		return parsed.Address, nil
+	}
+	return parsed.String(), nil
+}
@@ -0,0 +1,99 @@
+package email
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeResolver struct {
+	mx    map[string][]*net.MX
+	hosts map[string][]string
+}
+
+func (r *fakeResolver) LookupMX(_ context.Context, name string) ([]*net.MX, error) {
+	if mx, ok := r.mx[name]; ok {
+		return mx, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (r *fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	if addrs, ok := r.hosts[host]; ok {
+		return addrs, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+func TestValidateAddress_Syntax(t *testing.T) {
+	ctx := context.Background()
+	valid := []string{
+		"user@example.com",
+		"first.last+tag@mail.example.co",
+		"用户@例子.中国",
+	}
+	for _, addr := range valid {
+		assert.NoError(t, ValidateAddress(ctx, addr), addr)
+	}
+
+	invalid := []string{
+		"",
+		"user",
+		"user@",
+		"@example.com",
+		"user@localhost",
+		"user@-example.com",
+		"user@example..com",
+		"user@exa_mple.com",
+		"User <user@example.com>",
+		" user@example.com",
+		"a@b@example.com",
+	}
+	for _, addr := range invalid {
+		assert.ErrorIs(t, ValidateAddress(ctx, addr), ErrInvalidAddress, addr)
+	}
+}
+
+func TestValidateAddress_Disposable(t *testing.T) {
+	ctx := context.Background()
+	assert.NoError(t, ValidateAddress(ctx, "user@mailinator.com"))
+	assert.ErrorIs(t, ValidateAddress(ctx, "user@Mailinator.com", WithDisposableCheck()), ErrDisposableAddress)
+	assert.ErrorIs(t, ValidateAddress(ctx, "user@eu.yopmail.com", WithDisposableCheck()), ErrDisposableAddress)
+	assert.ErrorIs(t, ValidateAddress(ctx, "user@burner.test", WithDisposableCheck("burner.test")), ErrDisposableAddress)
+	assert.NoError(t, ValidateAddress(ctx, "user@example.com", WithDisposableCheck()))
+}
+
+func TestValidateAddress_MX(t *testing.T) {
+	ctx := context.Background()
+	resolver := &fakeResolver{
+		mx: map[string][]*net.MX{
+			"example.com": {{Host: "mx.example.com.", Pref: 10}},
+			"null.com":    {{Host: ".", Pref: 0}},
+		},
+		hosts: map[string][]string{"a-only.com": {"192.0.2.1"}},
+	}
+	check := WithMXCheck(resolver)
+
+	assert.NoError(t, ValidateAddress(ctx, "user@example.com", check))
+	assert.NoError(t, ValidateAddress(ctx, "user@a-only.com", check))
+	assert.ErrorIs(t, ValidateAddress(ctx, "user@null.com", check), ErrNoMailServer)
+	assert.ErrorIs(t, ValidateAddress(ctx, "user@missing.com", check), ErrNoMailServer)
+}
+
+func TestService_ValidatesRecipients(t *testing.T) {
+	capture := NewCaptureProvider("noreply@example.com")
+	svc := NewServiceWithProvider(&Config{Validation: ValidationConfig{RejectDisposable: true}}, capture)
+
+	_, err := svc.SendPasswordResetEmail(context.Background(), &PasswordResetEmailRequest{
+		To: "not-an-address", UserName: "张三", ResetLink: "https://example.com/reset",
+	})
+	assert.ErrorIs(t, err, ErrInvalidAddress)
+
+	_, err = svc.SendPasswordResetEmail(context.Background(), &PasswordResetEmailRequest{
+		To: "user@mailinator.com", UserName: "张三", ResetLink: "https://example.com/reset",
+	})
+	assert.ErrorIs(t, err, ErrDisposableAddress)
+	assert.Empty(t, capture.Messages())
+}
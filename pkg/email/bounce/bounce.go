@@ -0,0 +1,108 @@
+// Package bounce 处理邮件退信与投诉
+//
+// 从服务商 Webhook 或退信邮箱（IMAP）接收退信通知，区分硬退信、软退信与投诉，
+// 并维护发送前需要检查的抑制列表（suppression list）。
+//
+// 使用示例:
+//
+//	store := bounce.NewMemoryStore()
+//	processor := bounce.NewProcessor(store,
+//	    bounce.WithHook(func(ctx context.Context, e *bounce.Event) error {
+//	        // 同步用户邮箱状态
+//	        return userRepo.MarkEmailInvalid(ctx, e.Recipient)
+//	    }),
+//	)
+//
+//	// 退信邮箱轮询
+//	poller := bounce.NewIMAPPoller(&bounce.IMAPConfig{...}, processor)
+//	go poller.Run(ctx)
+//
+//	// 发送前检查
+//	if err := processor.Allow(ctx, to); err != nil {
+//	    return err
+//	}
+package bounce
+
+import (
+	"strings"
+	"time"
+)
+
+// EventType 退信事件类型
+type EventType string
+
+const (
+	EventTypeHardBounce EventType = "hard_bounce" // 硬退信：地址不存在、域名无效等永久性失败
+	EventTypeSoftBounce EventType = "soft_bounce" // 软退信：邮箱已满、临时拒收等暂时性失败
+	EventTypeComplaint  EventType = "complaint"   // 投诉：收件人标记为垃圾邮件
+	EventTypeDelivered  EventType = "delivered"   // 投递成功：清除软退信计数
+)
+
+// Event 退信/投诉事件
+type Event struct {
+	// Type 事件类型
+	Type EventType `json:"type"`
+	// Recipient 收件人地址
+	Recipient string `json:"recipient"`
+	// Status DSN 状态码，如 5.1.1
+	Status string `json:"status,omitempty"`
+	// Diagnostic 服务器返回的诊断信息
+	Diagnostic string `json:"diagnostic,omitempty"`
+	// Source 事件来源，如 imap、ses、sendgrid
+	Source string `json:"source,omitempty"`
+	// MessageID 原始邮件的 Message-ID（如果能获取到）
+	MessageID string `json:"message_id,omitempty"`
+	// OccurredAt 事件发生时间
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// Classify 根据 DSN 状态码和诊断信息判断退信类型
+//
+// 规则:
+//   - 5.x.x 为硬退信，但邮箱已满（5.2.2）和消息过大（5.3.4）视为软退信
+//   - 4.x.x 为软退信
+//   - 缺少状态码时根据诊断信息中的 SMTP 应答码判断
+//   - 无法判断时按软退信处理，避免误伤有效地址
+func Classify(status, diagnostic string) EventType {
+	status = strings.TrimSpace(status)
+	if status == "" {
+		status = smtpCodeFromDiagnostic(diagnostic)
+	}
+
+	switch {
+	case status == "5.2.2", status == "5.3.4":
+		return EventTypeSoftBounce
+	case strings.HasPrefix(status, "5"):
+		return EventTypeHardBounce
+	default:
+		return EventTypeSoftBounce
+	}
+}
+
+// smtpCodeFromDiagnostic 从诊断信息中提取状态码，如 "smtp; 550 5.1.1 user unknown"
+func smtpCodeFromDiagnostic(diagnostic string) string {
+	diagnostic = strings.TrimSpace(diagnostic)
+	if i := strings.Index(diagnostic, ";"); i >= 0 {
+		diagnostic = strings.TrimSpace(diagnostic[i+1:])
+	}
+
+	fields := strings.Fields(diagnostic)
+	// 优先使用增强状态码
+	for _, f := range fields {
+		if len(f) >= 5 && (f[0] == '4' || f[0] == '5') && f[1] == '.' {
+			return f
+		}
+	}
+	if len(fields) > 0 && len(fields[0]) == 3 && (fields[0][0] == '4' || fields[0][0] == '5') {
+		return fields[0][:1] + ".0.0"
+	}
+	return ""
+}
+
+// normalizeAddress 统一地址格式，作为抑制列表的键
+func normalizeAddress(addr string) string {
+	addr = strings.TrimSpace(addr)
+	addr = strings.TrimPrefix(addr, "<")
+	addr = strings.TrimSuffix(addr, ">")
+	return strings.ToLower(addr)
+}
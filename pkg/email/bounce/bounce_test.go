@@ -0,0 +1,172 @@
+package bounce
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const dsnMessage = "From: MAILER-DAEMON@example.com\r\n" +
+	"To: bounce@example.com\r\n" +
+	"Date: Mon, 06 Jan 2025 10:00:00 +0800\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"Content-Type: multipart/report; report-type=delivery-status; boundary=\"b1\"\r\n" +
+	"\r\n" +
+	"--b1\r\n" +
+	"Content-Type: text/plain\r\n" +
+	"\r\n" +
+	"Delivery failed.\r\n" +
+	"--b1\r\n" +
+	"Content-Type: message/delivery-status\r\n" +
+	"\r\n" +
+	"Reporting-MTA: dns; mx.example.com\r\n" +
+	"\r\n" +
+	"Final-Recipient: rfc822; Missing@Example.com\r\n" +
+	"Action: failed\r\n" +
+	"Status: 5.1.1\r\n" +
+	"Diagnostic-Code: smtp; 550 5.1.1 user unknown\r\n" +
+	"\r\n" +
+	"Final-Recipient: rfc822; full@example.com\r\n" +
+	"Action: failed\r\n" +
+	"Status: 5.2.2\r\n" +
+	"\r\n" +
+	"Final-Recipient: rfc822; ok@example.com\r\n" +
+	"Action: delivered\r\n" +
+	"Status: 2.0.0\r\n" +
+	"--b1\r\n" +
+	"Content-Type: text/rfc822-headers\r\n" +
+	"\r\n" +
+	"Message-ID: <abc@example.com>\r\n" +
+	"To: missing@example.com\r\n" +
+	"--b1--\r\n"
+
+const arfMessage = "From: fbl@isp.example\r\n" +
+	"Content-Type: multipart/report; report-type=feedback-report; boundary=\"b2\"\r\n" +
+	"\r\n" +
+	"--b2\r\n" +
+	"Content-Type: message/feedback-report\r\n" +
+	"\r\n" +
+	"Feedback-Type: abuse\r\n" +
+	"User-Agent: FBL/1.0\r\n" +
+	"Version: 1\r\n" +
+	"--b2\r\n" +
+	"Content-Type: message/rfc822\r\n" +
+	"\r\n" +
+	"Message-ID: <xyz@example.com>\r\n" +
+	"To: User <user@example.com>\r\n" +
+	"\r\n" +
+	"hello\r\n" +
+	"--b2--\r\n"
+
+func TestClassify(t *testing.T) {
+	assert.Equal(t, EventTypeHardBounce, Classify("5.1.1", ""))
+	assert.Equal(t, EventTypeSoftBounce, Classify("5.2.2", ""))
+	assert.Equal(t, EventTypeSoftBounce, Classify("4.4.1", ""))
+	assert.Equal(t, EventTypeHardBounce, Classify("", "smtp; 550 5.1.1 user unknown"))
+	assert.Equal(t, EventTypeHardBounce, Classify("", "smtp; 550 no such user"))
+	assert.Equal(t, EventTypeSoftBounce, Classify("", "smtp; 421 try again later"))
+	assert.Equal(t, EventTypeSoftBounce, Classify("", ""))
+}
+
+func TestParseMessage_DSN(t *testing.T) {
+	events, err := ParseMessage(strings.NewReader(dsnMessage))
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+
+	assert.Equal(t, EventTypeHardBounce, events[0].Type)
+	assert.Equal(t, "missing@example.com", events[0].Recipient)
+	assert.Equal(t, "5.1.1", events[0].Status)
+	assert.Equal(t, "<abc@example.com>", events[0].MessageID)
+	assert.Equal(t, 2025, events[0].OccurredAt.Year())
+
+	assert.Equal(t, EventTypeSoftBounce, events[1].Type)
+	assert.Equal(t, "full@example.com", events[1].Recipient)
+}
+
+func TestParseMessage_ARF(t *testing.T) {
+	events, err := ParseMessage(strings.NewReader(arfMessage))
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, EventTypeComplaint, events[0].Type)
+	assert.Equal(t, "user@example.com", events[0].Recipient)
+	assert.Equal(t, "abuse", events[0].Diagnostic)
+}
+
+func TestParseMessage_NotReport(t *testing.T) {
+	_, err := ParseMessage(strings.NewReader("Subject: hi\r\nContent-Type: text/plain\r\n\r\nhello\r\n"))
+	assert.ErrorIs(t, err, ErrNotReport)
+}
+
+func TestProcessor(t *testing.T) {
+	ctx := context.Background()
+	var synced []string
+	p := NewProcessor(NewMemoryStore(),
+		WithSoftBounceLimit(2),
+		WithHook(func(_ context.Context, e *Event) error {
+			synced = append(synced, e.Recipient)
+			return nil
+		}),
+	)
+
+	assert.NoError(t, p.Handle(ctx,
+		&Event{Type: EventTypeHardBounce, Recipient: "Hard@Example.com"},
+		&Event{Type: EventTypeSoftBounce, Recipient: "soft@example.com"},
+	))
+	assert.ErrorIs(t, p.Allow(ctx, "hard@example.com"), ErrSuppressed)
+	assert.NoError(t, p.Allow(ctx, "soft@example.com"))
+
+	assert.NoError(t, p.Handle(ctx, &Event{Status: "4.2.2", Recipient: "soft@example.com"}))
+	assert.ErrorIs(t, p.Allow(ctx, "soft@example.com"), ErrSuppressed)
+
+	assert.Equal(t, []string{"Hard@Example.com", "soft@example.com", "soft@example.com"}, synced)
+}
+
+func TestProcessor_SoftBounceWindow(t *testing.T) {
+	ctx := context.Background()
+	var synced []EventType
+	p := NewProcessor(NewMemoryStore(),
+		WithSoftBounceLimit(2),
+		WithSoftBounceWindow(24*time.Hour),
+		WithHook(func(_ context.Context, e *Event) error {
+			synced = append(synced, e.Type)
+			return nil
+		}),
+	)
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	soft := func(addr string, at time.Time) {
+		assert.NoError(t, p.Handle(ctx, &Event{Type: EventTypeSoftBounce, Recipient: addr, OccurredAt: at}))
+	}
+
+	// 超出窗口的软退信重新计数
+	soft("a@example.com", start)
+	soft("a@example.com", start.Add(25*time.Hour))
+	assert.NoError(t, p.Allow(ctx, "a@example.com"))
+	soft("a@example.com", start.Add(26*time.Hour))
+	assert.ErrorIs(t, p.Allow(ctx, "a@example.com"), ErrSuppressed)
+
+	// 加入抑制列表后清除计数
+	p.mu.Lock()
+	assert.NotContains(t, p.softBounces, "a@example.com")
+	p.mu.Unlock()
+
+	// 投递成功清除计数，不调用钩子
+	soft("b@example.com", start)
+	assert.NoError(t, p.Handle(ctx, &Event{Type: EventTypeDelivered, Recipient: "B@example.com", OccurredAt: start.Add(time.Hour)}))
+	soft("b@example.com", start.Add(2*time.Hour))
+	assert.NoError(t, p.Allow(ctx, "b@example.com"))
+	assert.NotContains(t, synced, EventTypeDelivered)
+
+	// 过期的计数被清理
+	soft("c@example.com", start.Add(72*time.Hour))
+	p.mu.Lock()
+	assert.NotContains(t, p.softBounces, "b@example.com")
+	p.mu.Unlock()
+
+	// 未设置时间的事件按收到的时间计数，不修改调用方的事件
+	e := &Event{Type: EventTypeSoftBounce, Recipient: "d@example.com"}
+	assert.NoError(t, p.Handle(ctx, e))
+	assert.True(t, e.OccurredAt.IsZero())
+}
@@ -0,0 +1,197 @@
+package bounce
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// ErrNotReport 邮件不是退信报告（DSN）或投诉报告（ARF）
+var ErrNotReport = errors.New("message is not a delivery status or feedback report")
+
+// ParseMessage 解析退信邮箱中的一封邮件
+//
+// 支持 RFC 3464 投递状态通知（multipart/report; report-type=delivery-status）
+// 和 RFC 5965 投诉反馈报告（multipart/report; report-type=feedback-report）。
+// 一封 DSN 可能包含多个收件人，因此返回多个事件；投递成功或延迟的通知不会产生事件。
+func ParseMessage(r io.Reader) ([]*Event, error) {
+	msg, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, fmt.Errorf("read message: %w", err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/report" || params["boundary"] == "" {
+		return nil, ErrNotReport
+	}
+
+	occurredAt, err := msg.Header.Date()
+	if err != nil {
+		occurredAt = time.Now()
+	}
+
+	var (
+		reportType = strings.ToLower(params["report-type"])
+		events     []*Event
+		messageID  string
+		original   *mail.Header
+	)
+
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read report part: %w", err)
+		}
+
+		partType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		switch partType {
+		case "message/delivery-status":
+			evs, err := parseDeliveryStatus(part)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, evs...)
+		case "message/feedback-report":
+			ev, err := parseFeedbackReport(part)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, ev)
+		case "message/rfc822", "text/rfc822-headers", "message/rfc822-headers":
+			if h, err := readEmbeddedHeader(part); err == nil {
+				original = h
+				messageID = h.Get("Message-ID")
+			}
+		}
+	}
+
+	if reportType != "delivery-status" && reportType != "feedback-report" && len(events) == 0 {
+		return nil, ErrNotReport
+	}
+
+	for _, e := range events {
+		e.Source = "imap"
+		e.MessageID = messageID
+		e.OccurredAt = occurredAt
+		// 投诉报告可能不包含收件人，从原始邮件的收件人中获取
+		if e.Recipient == "" && original != nil {
+			if addr, err := mail.ParseAddress(original.Get("To")); err == nil {
+				e.Recipient = addr.Address
+			}
+		}
+	}
+	return events, nil
+}
+
+// parseDeliveryStatus 解析 message/delivery-status 部分
+//
+// 第一组字段是报文级别信息，之后每组字段对应一个收件人
+func parseDeliveryStatus(r io.Reader) ([]*Event, error) {
+	tp := textproto.NewReader(bufio.NewReader(r))
+
+	// 报文级别字段，不需要
+	if _, err := tp.ReadMIMEHeader(); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("read per-message fields: %w", err)
+	}
+
+	var events []*Event
+	for {
+		h, err := tp.ReadMIMEHeader()
+		if len(h) > 0 {
+			if ev := recipientEvent(h); ev != nil {
+				events = append(events, ev)
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read per-recipient fields: %w", err)
+		}
+	}
+	return events, nil
+}
+
+// recipientEvent 将单个收件人的 DSN 字段转换为事件
+func recipientEvent(h textproto.MIMEHeader) *Event {
+	action := strings.ToLower(strings.TrimSpace(h.Get("Action")))
+	if action != "failed" && action != "delayed" {
+		// delivered / relayed / expanded 不是退信
+		return nil
+	}
+
+	recipient := addressField(h.Get("Final-Recipient"))
+	if recipient == "" {
+		recipient = addressField(h.Get("Original-Recipient"))
+	}
+	if recipient == "" {
+		return nil
+	}
+
+	status := strings.TrimSpace(h.Get("Status"))
+	diagnostic := strings.TrimSpace(h.Get("Diagnostic-Code"))
+
+	eventType := Classify(status, diagnostic)
+	if action == "delayed" {
+		eventType = EventTypeSoftBounce
+	}
+
+	return &Event{
+		Type:       eventType,
+		Recipient:  recipient,
+		Status:     status,
+		Diagnostic: diagnostic,
+	}
+}
+
+// parseFeedbackReport 解析 message/feedback-report 部分
+func parseFeedbackReport(r io.Reader) (*Event, error) {
+	tp := textproto.NewReader(bufio.NewReader(r))
+	h, err := tp.ReadMIMEHeader()
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("read feedback report: %w", err)
+	}
+	return &Event{
+		Type:       EventTypeComplaint,
+		Recipient:  addressField(h.Get("Original-Rcpt-To")),
+		Diagnostic: strings.TrimSpace(h.Get("Feedback-Type")),
+	}, nil
+}
+
+// readEmbeddedHeader 读取附带的原始邮件头
+func readEmbeddedHeader(r io.Reader) (*mail.Header, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	// 只有邮件头时补一个空行，保证 ReadMessage 可以解析
+	if !bytes.Contains(data, []byte("\r\n\r\n")) && !bytes.Contains(data, []byte("\n\n")) {
+		data = append(data, '\n', '\n')
+	}
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return &msg.Header, nil
+}
+
+// addressField 解析 "rfc822; user@example.com" 形式的地址字段
+func addressField(v string) string {
+	v = strings.TrimSpace(v)
+	if i := strings.Index(v, ";"); i >= 0 {
+		v = v[i+1:]
+	}
+	return normalizeAddress(v)
+}
@@ -0,0 +1,294 @@
+package bounce
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IMAPConfig 退信邮箱配置
+type IMAPConfig struct {
+	// Addr IMAP 服务器地址，如 imap.example.com:993
+	Addr string
+	// Username 登录用户名
+	Username string
+	// Password 登录密码或授权码
+	Password string
+	// Mailbox 邮箱文件夹，默认 INBOX
+	Mailbox string
+	// UseTLS 是否使用隐式 TLS（993 端口）
+	UseTLS bool
+	// Interval 轮询间隔，默认 5 分钟
+	Interval time.Duration
+	// Timeout 单次轮询的超时时间，默认 1 分钟
+	Timeout time.Duration
+	// DeleteProcessed 处理后删除邮件，默认只标记为已读
+	DeleteProcessed bool
+	// OnError 轮询出错时的回调，为 nil 时忽略错误继续轮询
+	OnError func(err error)
+}
+
+// IMAPPoller 定期从退信邮箱拉取未读邮件并交给处理器
+type IMAPPoller struct {
+	config    *IMAPConfig
+	processor *Processor
+}
+
+// NewIMAPPoller 创建退信邮箱轮询器
+func NewIMAPPoller(config *IMAPConfig, processor *Processor) *IMAPPoller {
+	cfg := *config
+	if cfg.Mailbox == "" {
+		cfg.Mailbox = "INBOX"
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = 5 * time.Minute
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = time.Minute
+	}
+	return &IMAPPoller{config: &cfg, processor: processor}
+}
+
+// Run 按配置的间隔轮询，直到 ctx 被取消
+func (p *IMAPPoller) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.PollOnce(ctx); err != nil && p.config.OnError != nil {
+			p.config.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// PollOnce 拉取并处理一次未读邮件，返回处理的退信事件数量
+//
+// 非退信报告的邮件也会被标记为已读，避免重复拉取
+func (p *IMAPPoller) PollOnce(ctx context.Context) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.config.Timeout)
+	defer cancel()
+
+	c, err := dialIMAP(ctx, p.config)
+	if err != nil {
+		return 0, err
+	}
+	defer c.close()
+
+	if _, err := c.command("LOGIN %s %s", quote(p.config.Username), quote(p.config.Password)); err != nil {
+		return 0, fmt.Errorf("imap login: %w", err)
+	}
+	if _, err := c.command("SELECT %s", quote(p.config.Mailbox)); err != nil {
+		return 0, fmt.Errorf("imap select %s: %w", p.config.Mailbox, err)
+	}
+
+	resp, err := c.command("UID SEARCH UNSEEN")
+	if err != nil {
+		return 0, fmt.Errorf("imap search: %w", err)
+	}
+	uids := parseSearch(resp.lines)
+
+	flags := `\Seen`
+	if p.config.DeleteProcessed {
+		flags = `\Seen \Deleted`
+	}
+
+	var (
+		total int
+		errs  []error
+	)
+	for _, uid := range uids {
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+
+		resp, err := c.command("UID FETCH %s BODY.PEEK[]", uid)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("imap fetch %s: %w", uid, err))
+			continue
+		}
+		if len(resp.literals) == 0 {
+			continue
+		}
+
+		events, err := ParseMessage(bytes.NewReader(resp.literals[0]))
+		if err != nil && !errors.Is(err, ErrNotReport) {
+			errs = append(errs, fmt.Errorf("parse message %s: %w", uid, err))
+			continue
+		}
+		if err := p.processor.Handle(ctx, events...); err != nil {
+			// 保持未读，下次轮询重试
+			errs = append(errs, err)
+			continue
+		}
+		total += len(events)
+
+		if _, err := c.command("UID STORE %s +FLAGS.SILENT (%s)", uid, flags); err != nil {
+			errs = append(errs, fmt.Errorf("imap store %s: %w", uid, err))
+		}
+	}
+
+	if p.config.DeleteProcessed {
+		if _, err := c.command("EXPUNGE"); err != nil {
+			errs = append(errs, fmt.Errorf("imap expunge: %w", err))
+		}
+	}
+	_, _ = c.command("LOGOUT")
+
+	return total, errors.Join(errs...)
+}
+
+// ========== 内部函数 ==========
+
+// imapConn 最小化的 IMAP4rev1 客户端，只实现轮询需要的命令
+type imapConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+	tag  int
+}
+
+// imapResponse 命令的未标记响应
+type imapResponse struct {
+	lines    []string
+	literals [][]byte
+}
+
+func dialIMAP(ctx context.Context, cfg *IMAPConfig) (*imapConn, error) {
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", cfg.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("imap dial %s: %w", cfg.Addr, err)
+	}
+	if cfg.UseTLS {
+		host, _, _ := net.SplitHostPort(cfg.Addr)
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: host})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("imap tls handshake: %w", err)
+		}
+		conn = tlsConn
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	c := &imapConn{conn: conn, r: bufio.NewReader(conn)}
+	greeting, _, err := c.readLine()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("imap greeting: %w", err)
+	}
+	if !strings.HasPrefix(greeting, "* OK") && !strings.HasPrefix(greeting, "* PREAUTH") {
+		conn.Close()
+		return nil, fmt.Errorf("imap greeting: %s", greeting)
+	}
+	return c, nil
+}
+
+func (c *imapConn) close() {
+	c.conn.Close()
+}
+
+// command 发送命令并读取响应，直到收到对应标签的完成响应
+func (c *imapConn) command(format string, args ...interface{}) (*imapResponse, error) {
+	c.tag++
+	tag := "a" + strconv.Itoa(c.tag)
+	if _, err := fmt.Fprintf(c.conn, "%s %s\r\n", tag, fmt.Sprintf(format, args...)); err != nil {
+		return nil, err
+	}
+
+	resp := &imapResponse{}
+	for {
+		line, literals, err := c.readLine()
+		if err != nil {
+			return nil, err
+		}
+		resp.literals = append(resp.literals, literals...)
+
+		if strings.HasPrefix(line, tag+" ") {
+			status := strings.TrimPrefix(line, tag+" ")
+			if strings.HasPrefix(status, "OK") {
+				return resp, nil
+			}
+			return nil, errors.New(status)
+		}
+		resp.lines = append(resp.lines, line)
+	}
+}
+
+// readLine 读取一行响应，行内的字面量 {n} 会被读出并以占位符替换
+func (c *imapConn) readLine() (string, [][]byte, error) {
+	var (
+		sb       strings.Builder
+		literals [][]byte
+	)
+	for {
+		line, err := c.r.ReadString('\n')
+		if err != nil {
+			return "", nil, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		n, ok := literalSize(line)
+		if !ok {
+			sb.WriteString(line)
+			return sb.String(), literals, nil
+		}
+
+		sb.WriteString(line[:strings.LastIndex(line, "{")])
+		sb.WriteString("{literal}")
+		buf := make([]byte, n)
+		if _, err := io.ReadFull(c.r, buf); err != nil {
+			return "", nil, err
+		}
+		literals = append(literals, buf)
+	}
+}
+
+// literalSize 判断行尾是否为字面量标记 {n}
+func literalSize(line string) (int, bool) {
+	if !strings.HasSuffix(line, "}") {
+		return 0, false
+	}
+	i := strings.LastIndex(line, "{")
+	if i < 0 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(line[i+1 : len(line)-1])
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	return n, true
+}
+
+// parseSearch 解析 "* SEARCH 1 2 3" 响应
+func parseSearch(lines []string) []string {
+	var uids []string
+	for _, line := range lines {
+		if !strings.HasPrefix(line, "* SEARCH") {
+			continue
+		}
+		uids = append(uids, strings.Fields(strings.TrimPrefix(line, "* SEARCH"))...)
+	}
+	return uids
+}
+
+// quote 将参数转为 IMAP 引号字符串
+func quote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
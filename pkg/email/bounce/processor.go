@@ -0,0 +1,208 @@
+package bounce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultSoftBounceLimit 默认软退信阈值，连续软退信达到该次数后加入抑制列表
+const DefaultSoftBounceLimit = 3
+
+// DefaultSoftBounceWindow 默认软退信计数窗口，距第一次软退信超过该时间后重新计数
+const DefaultSoftBounceWindow = 7 * 24 * time.Hour
+
+// ErrSuppressed 收件人在抑制列表中
+var ErrSuppressed = errors.New("recipient is suppressed")
+
+// Hook 事件处理钩子，用于同步用户邮箱状态等业务逻辑
+type Hook func(ctx context.Context, e *Event) error
+
+// ProcessorOption 处理器选项
+type ProcessorOption func(*Processor)
+
+// WithSoftBounceLimit 设置软退信阈值，<=0 表示软退信从不加入抑制列表
+func WithSoftBounceLimit(limit int) ProcessorOption {
+	return func(p *Processor) {
+		p.softBounceLimit = limit
+	}
+}
+
+// WithSoftBounceWindow 设置软退信计数窗口，<=0 表示计数不过期
+//
+// 窗口内的软退信达到阈值才加入抑制列表，偶发的临时故障不会在数月内累积成抑制
+func WithSoftBounceWindow(window time.Duration) ProcessorOption {
+	return func(p *Processor) {
+		p.softBounceWindow = window
+	}
+}
+
+// WithHook 注册事件处理钩子，按注册顺序执行
+func WithHook(hook Hook) ProcessorOption {
+	return func(p *Processor) {
+		p.hooks = append(p.hooks, hook)
+	}
+}
+
+// Processor 退信事件处理器
+//
+// 硬退信和投诉立即加入抑制列表；软退信在计数窗口内达到阈值后加入抑制列表。
+// 地址加入抑制列表或投递成功时清除其软退信计数
+type Processor struct {
+	store            SuppressionStore
+	softBounceLimit  int
+	softBounceWindow time.Duration
+	hooks            []Hook
+
+	mu          sync.Mutex
+	softBounces map[string]*softBounceCount
+	lastSweep   time.Time
+}
+
+// softBounceCount 地址在当前窗口内的软退信次数
+type softBounceCount struct {
+	count int
+	since time.Time // 窗口内第一次软退信的时间
+}
+
+// NewProcessor 创建退信事件处理器
+func NewProcessor(store SuppressionStore, opts ...ProcessorOption) *Processor {
+	if store == nil {
+		store = NewMemoryStore()
+	}
+	p := &Processor{
+		store:            store,
+		softBounceLimit:  DefaultSoftBounceLimit,
+		softBounceWindow: DefaultSoftBounceWindow,
+		softBounces:      make(map[string]*softBounceCount),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Store 返回处理器使用的抑制列表
+func (p *Processor) Store() SuppressionStore {
+	return p.store
+}
+
+// Handle 处理退信事件
+//
+// 投递成功事件只清除软退信计数，不调用钩子。
+// 单个事件失败不会中断后续事件的处理，所有错误合并后返回
+func (p *Processor) Handle(ctx context.Context, events ...*Event) error {
+	var errs []error
+	for _, e := range events {
+		if e == nil || e.Recipient == "" {
+			continue
+		}
+		if err := p.handle(ctx, e); err != nil {
+			errs = append(errs, fmt.Errorf("handle %s for %s: %w", e.Type, e.Recipient, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (p *Processor) handle(ctx context.Context, e *Event) error {
+	// 不修改调用方事件的时间，未设置时按收到的时间计数
+	occurredAt := e.OccurredAt
+	if occurredAt.IsZero() {
+		occurredAt = time.Now()
+	}
+	if e.Type == "" {
+		e.Type = Classify(e.Status, e.Diagnostic)
+	}
+
+	addr := normalizeAddress(e.Recipient)
+
+	switch e.Type {
+	case EventTypeDelivered:
+		p.ResetSoftBounces(addr)
+		return nil
+	case EventTypeHardBounce:
+		if err := p.suppress(ctx, addr, ReasonHardBounce); err != nil {
+			return err
+		}
+	case EventTypeComplaint:
+		if err := p.suppress(ctx, addr, ReasonComplaint); err != nil {
+			return err
+		}
+	case EventTypeSoftBounce:
+		if p.softBounceLimit > 0 && p.countSoftBounce(addr, occurredAt) >= p.softBounceLimit {
+			if err := p.suppress(ctx, addr, ReasonSoftBounce); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, hook := range p.hooks {
+		if err := hook(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// suppress 将地址加入抑制列表并清除软退信计数
+func (p *Processor) suppress(ctx context.Context, addr, reason string) error {
+	if err := p.store.Add(ctx, addr, reason); err != nil {
+		return err
+	}
+	p.ResetSoftBounces(addr)
+	return nil
+}
+
+// countSoftBounce 累加并返回地址在计数窗口内的软退信次数，at 为软退信发生的时间
+func (p *Processor) countSoftBounce(addr string, at time.Time) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.sweepSoftBounces(at)
+	c, ok := p.softBounces[addr]
+	if !ok || p.expired(c, at) {
+		c = &softBounceCount{since: at}
+		p.softBounces[addr] = c
+	}
+	c.count++
+	return c.count
+}
+
+// expired 判断计数是否已超出窗口
+func (p *Processor) expired(c *softBounceCount, at time.Time) bool {
+	return p.softBounceWindow > 0 && at.Sub(c.since) > p.softBounceWindow
+}
+
+// sweepSoftBounces 每个窗口清理一次过期的计数，避免不再退信的地址一直占用内存，调用方持有锁
+func (p *Processor) sweepSoftBounces(now time.Time) {
+	if p.softBounceWindow <= 0 || now.Sub(p.lastSweep) < p.softBounceWindow {
+		return
+	}
+	p.lastSweep = now
+	for addr, c := range p.softBounces {
+		if p.expired(c, now) {
+			delete(p.softBounces, addr)
+		}
+	}
+}
+
+// ResetSoftBounces 清除地址的软退信计数，处理投递成功事件时自动调用
+func (p *Processor) ResetSoftBounces(addr string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.softBounces, normalizeAddress(addr))
+}
+
+// Allow 发送前检查收件人，被抑制时返回 ErrSuppressed
+func (p *Processor) Allow(ctx context.Context, addr string) error {
+	suppressed, err := p.store.IsSuppressed(ctx, addr)
+	if err != nil {
+		return fmt.Errorf("check suppression list: %w", err)
+	}
+	if suppressed {
+		return fmt.Errorf("%w: %s", ErrSuppressed, addr)
+	}
+	return nil
+}
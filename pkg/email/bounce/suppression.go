@@ -0,0 +1,90 @@
+package bounce
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// 抑制原因
+const (
+	ReasonHardBounce  = "hard_bounce" // 硬退信
+	ReasonSoftBounce  = "soft_bounce" // 软退信次数超过阈值
+	ReasonComplaint   = "complaint"   // 投诉
+	ReasonUnsubscribe = "unsubscribe" // 退订
+	ReasonManual      = "manual"      // 人工添加
+)
+
+// SuppressionStore 抑制列表存储
+//
+// 被抑制的地址在发送前会被拒绝，实现需要保证并发安全
+type SuppressionStore interface {
+	// IsSuppressed 检查地址是否被抑制
+	IsSuppressed(ctx context.Context, addr string) (bool, error)
+	// Add 将地址加入抑制列表
+	Add(ctx context.Context, addr string, reason string) error
+	// Remove 将地址移出抑制列表（如用户更正邮箱后）
+	Remove(ctx context.Context, addr string) error
+}
+
+// SuppressionEntry 抑制列表条目
+type SuppressionEntry struct {
+	Address   string
+	Reason    string
+	CreatedAt time.Time
+}
+
+// MemoryStore 基于内存的抑制列表，适用于单实例部署和测试
+type MemoryStore struct {
+	mu      sync.RWMutex
+	entries map[string]*SuppressionEntry
+}
+
+// NewMemoryStore 创建内存抑制列表
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries: make(map[string]*SuppressionEntry),
+	}
+}
+
+// IsSuppressed 检查地址是否被抑制
+func (s *MemoryStore) IsSuppressed(_ context.Context, addr string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.entries[normalizeAddress(addr)]
+	return ok, nil
+}
+
+// Add 将地址加入抑制列表，已存在时保留最早的记录
+func (s *MemoryStore) Add(_ context.Context, addr string, reason string) error {
+	key := normalizeAddress(addr)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.entries[key]; !ok {
+		s.entries[key] = &SuppressionEntry{
+			Address:   key,
+			Reason:    reason,
+			CreatedAt: time.Now(),
+		}
+	}
+	return nil
+}
+
+// Remove 将地址移出抑制列表
+func (s *MemoryStore) Remove(_ context.Context, addr string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, normalizeAddress(addr))
+	return nil
+}
+
+// Entries 返回当前所有条目的副本
+func (s *MemoryStore) Entries() []SuppressionEntry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	entries := make([]SuppressionEntry, 0, len(s.entries))
+	for _, e := range s.entries {
+		entries = append(entries, *e)
+	}
+	return entries
+}
@@ -0,0 +1,80 @@
+package bounce
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// maxWebhookBody Webhook 请求体大小上限
+const maxWebhookBody = 1 << 20
+
+// WebhookParser 将服务商的 Webhook 请求解析为退信事件
+//
+// 不同服务商的回调格式各不相同，由调用方按需实现；签名校验也应在这里完成
+type WebhookParser func(r *http.Request) ([]*Event, error)
+
+// NewWebhookHandler 创建接收退信回调的 HTTP 处理器
+//
+// parser 为 nil 时使用 ParseJSONWebhook 解析通用 JSON 格式。
+// 解析失败返回 400，处理失败返回 500，以便服务商按其策略重试。
+//
+// 使用示例:
+//
+//	mux.Handle("/webhooks/email/bounce", bounce.NewWebhookHandler(processor, nil))
+func NewWebhookHandler(p *Processor, parser WebhookParser) http.Handler {
+	if parser == nil {
+		parser = ParseJSONWebhook
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxWebhookBody)
+		events, err := parser(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if err := p.Handle(r.Context(), events...); err != nil {
+			http.Error(w, "failed to process events", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// ParseJSONWebhook 解析通用 JSON 格式的回调
+//
+// 请求体可以是单个 Event 或 Event 数组，未设置 type 时根据 status 和 diagnostic 自动分类
+func ParseJSONWebhook(r *http.Request) ([]*Event, error) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read body: %w", err)
+	}
+
+	var events []*Event
+	if len(data) > 0 && data[0] == '[' {
+		if err := json.Unmarshal(data, &events); err != nil {
+			return nil, fmt.Errorf("decode events: %w", err)
+		}
+	} else {
+		var e Event
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, fmt.Errorf("decode event: %w", err)
+		}
+		events = append(events, &e)
+	}
+
+	for _, e := range events {
+		if e.Source == "" {
+			e.Source = "webhook"
+		}
+	}
+	return events, nil
+}
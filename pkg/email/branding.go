@@ -0,0 +1,126 @@
+package email
+
+import (
+	"context"
+)
+
+// Branding 邮件品牌配置，渲染时通过 .Branding 注入模板，由公共的页眉页脚使用
+//
+// 未设置的字段使用默认值。颜色值在 <style> 中输出，html/template 会过滤不安全的 CSS。
+//
+// 使用示例:
+//
+//	branding:
+//	  logo_url: "https://cdn.heyin.com/logo.png"
+//	  footer_text: "禾音科技 · 让协作更简单"
+//	  primary_color: "#1f6feb"
+type Branding struct {
+	LogoURL         string `yaml:"logo_url" json:"logo_url"`                 // 页眉 Logo 地址，为空时不显示
+	FooterText      string `yaml:"footer_text" json:"footer_text"`           // 页脚说明文字，默认"此邮件由系统自动发送，请勿回复。"
+	PrimaryColor    string `yaml:"primary_color" json:"primary_color"`       // 主色，用于主按钮和强调文字，默认 #007bff
+	BackgroundColor string `yaml:"background_color" json:"background_color"` // 页面背景色，默认 #f4f4f7
+	TextColor       string `yaml:"text_color" json:"text_color"`             // 正文文字颜色，默认 #333333
+}
+
+// defaultBranding 内置模板的默认品牌配置
+var defaultBranding = Branding{
+	FooterText:      "此邮件由系统自动发送，请勿回复。",
+	PrimaryColor:    "#007bff",
+	BackgroundColor: "#f4f4f7",
+	TextColor:       "#333333",
+}
+
+// merge 用 fallback 填充未设置的字段
+func (b Branding) merge(fallback Branding) Branding {
+	if b.LogoURL == "" {
+		b.LogoURL = fallback.LogoURL
+	}
+	if b.FooterText == "" {
+		b.FooterText = fallback.FooterText
+	}
+	if b.PrimaryColor == "" {
+		b.PrimaryColor = fallback.PrimaryColor
+	}
+	if b.BackgroundColor == "" {
+		b.BackgroundColor = fallback.BackgroundColor
+	}
+	if b.TextColor == "" {
+		b.TextColor = fallback.TextColor
+	}
+	return b
+}
+
+// BrandingResolver 租户品牌配置解析
+//
+// 每次渲染模板邮件前都会调用，实现方需要自行缓存数据库等查询结果。
+type BrandingResolver interface {
+	// ResolveBranding 返回租户的品牌配置，租户没有自定义配置时返回 nil, nil
+	ResolveBranding(ctx context.Context, tenantID uint32) (*Branding, error)
+}
+
+// WithBranding 设置默认品牌配置，未设置的字段使用内置默认值
+func WithBranding(branding Branding) TemplateManagerOption {
+	return func(tm *TemplateManager) {
+		tm.branding = branding.merge(defaultBranding)
+	}
+}
+
+// withBranding 返回注入了品牌配置的模板数据，不修改调用方的 map
+//
+// 数据中已有 Branding 时以其为准，未设置的字段使用模板管理器的默认配置
+func (tm *TemplateManager) withBranding(data map[string]interface{}) map[string]interface{} {
+	branding := tm.branding
+	switch b := data["Branding"].(type) {
+	case Branding:
+		branding = b.merge(tm.branding)
+	case *Branding:
+		if b != nil {
+			branding = b.merge(tm.branding)
+		}
+	}
+
+	merged := make(map[string]interface{}, len(data)+1)
+	for k, v := range data {
+		merged[k] = v
+	}
+	merged["Branding"] = branding
+	return merged
+}
+
+// SetBrandingResolver 设置租户品牌配置解析，发送模板邮件时按上下文中的租户ID（见 WithTenantID）选择品牌配置
+//
+// 使用示例:
+//
+//	sender.SetBrandingResolver(resolver)
+//	ctx = email.WithTenantID(ctx, tenantID)
+//	_, err := sender.SendPasswordResetEmail(ctx, to, userName, resetLink, "1小时") // 使用租户的 Logo 和配色
+func (s *Sender) SetBrandingResolver(resolver BrandingResolver) {
+	s.branding = resolver
+}
+
+// resolveBranding 把租户品牌配置放入模板数据，解析失败时记录日志并使用默认配置
+func (s *Sender) resolveBranding(ctx context.Context, data map[string]interface{}) map[string]interface{} {
+	tenantID := TenantIDFromContext(ctx)
+	if s.branding == nil || tenantID == 0 {
+		return data
+	}
+	if _, ok := data["Branding"]; ok {
+		return data
+	}
+
+	branding, err := s.branding.ResolveBranding(ctx, tenantID)
+	if err != nil {
+		s.logger.WithContext(ctx).Warnf("解析租户品牌配置失败，使用默认配置: tenant_id=%d, error=%v", tenantID, err)
+		return data
+	}
+	if branding == nil {
+		return data
+	}
+
+	merged := make(map[string]interface{}, len(data)+1)
+	for k, v := range data {
+		merged[k] = v
+	}
+	merged["Branding"] = *branding
+	return merged
+}
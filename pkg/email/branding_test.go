@@ -0,0 +1,102 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBranding_Defaults(t *testing.T) {
+	tm := NewTemplateManager()
+	_, body, err := tm.Render(PasswordResetData{UserName: "张三", ResetLink: "https://example.com/r", ExpireTime: "1小时"})
+	assert.NoError(t, err)
+	assert.Contains(t, body, "background-color: #f4f4f7")
+	assert.Contains(t, body, "此邮件由系统自动发送，请勿回复。")
+	assert.NotContains(t, body, `class="logo"`)
+}
+
+func TestBranding_WithBranding(t *testing.T) {
+	tm := NewTemplateManager(WithBranding(Branding{
+		LogoURL:      "https://cdn.example.com/logo.png",
+		FooterText:   "示例科技 · 让协作更简单",
+		PrimaryColor: "#1f6feb",
+	}))
+	_, body, err := tm.Render(TenantActivationData{UserName: "张三", TenantName: "示例科技", ActivationLink: "https://example.com/a"})
+	assert.NoError(t, err)
+	assert.Contains(t, body, `<img src="https://cdn.example.com/logo.png"`)
+	assert.Contains(t, body, ".button-primary { background-color: #1f6feb; }")
+	assert.Contains(t, body, "示例科技 · 让协作更简单")
+	// 未设置的字段使用默认值
+	assert.Contains(t, body, "background-color: #f4f4f7")
+
+	// 模板数据中的品牌配置优先
+	_, body, err = tm.RenderTemplate(EmailTypeTenantActivation, map[string]interface{}{
+		"TenantName": "示例科技",
+		"Branding":   Branding{PrimaryColor: "#ff0000"},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, body, ".button-primary { background-color: #ff0000; }")
+	assert.Contains(t, body, "https://cdn.example.com/logo.png")
+}
+
+func TestBranding_UnsafeColorFiltered(t *testing.T) {
+	tm := NewTemplateManager(WithBranding(Branding{PrimaryColor: "red;} body { background: url(javascript:alert(1))"}))
+	_, body, err := tm.Render(QuotaWarningData{TenantName: "示例科技", UsagePercent: 50})
+	assert.NoError(t, err)
+	assert.NotContains(t, body, "javascript")
+	assert.Contains(t, body, "ZgotmplZ")
+}
+
+func TestBranding_CustomTemplateUsesPartials(t *testing.T) {
+	tm := NewTemplateManager(WithBranding(Branding{FooterText: "自定义页脚"}))
+	err := tm.RegisterTemplate("order_confirmation", `{{define "subject"}}订单 {{.OrderNo}} 已确认{{end}}
+{{define "heading"}}订单已确认{{end}}
+{{define "body"}}{{template "email_header" .}}<p>订单 {{.OrderNo}}</p>{{template "email_footer" .}}{{end}}`)
+	assert.NoError(t, err)
+
+	subject, body, err := tm.RenderTemplate("order_confirmation", map[string]interface{}{"OrderNo": "A001", "CurrentYear": 2025})
+	assert.NoError(t, err)
+	assert.Equal(t, "订单 A001 已确认", subject)
+	assert.Contains(t, body, "<h1>订单已确认</h1>")
+	assert.Contains(t, body, ".button-base")
+	assert.Contains(t, body, "自定义页脚")
+	assert.Contains(t, body, "&copy; 2025. 保留所有权利。")
+}
+
+type brandingResolverFunc func(ctx context.Context, tenantID uint32) (*Branding, error)
+
+func (f brandingResolverFunc) ResolveBranding(ctx context.Context, tenantID uint32) (*Branding, error) {
+	return f(ctx, tenantID)
+}
+
+func TestSender_BrandingResolver(t *testing.T) {
+	provider := &fakeProvider{}
+	sender := NewSenderWithProvider(&Config{Branding: Branding{FooterText: "默认页脚"}}, provider)
+	sender.SetBrandingResolver(brandingResolverFunc(func(_ context.Context, tenantID uint32) (*Branding, error) {
+		switch tenantID {
+		case 1:
+			return &Branding{LogoURL: "https://cdn.example.com/t1.png"}, nil
+		case 2:
+			return nil, errors.New("db down")
+		}
+		return nil, nil
+	}))
+
+	for _, tenantID := range []uint32{0, 1, 2, 3} {
+		ctx := WithTenantID(context.Background(), tenantID)
+		_, err := sender.SendVerificationCodeEmail(ctx, "a@example.com", "123456", 0)
+		assert.NoError(t, err)
+	}
+
+	assert.Len(t, provider.sent, 4)
+	for i, data := range provider.sent {
+		assert.Contains(t, data.Body, "默认页脚")
+		if i == 1 {
+			assert.Contains(t, data.Body, "https://cdn.example.com/t1.png")
+		} else {
+			assert.NotContains(t, data.Body, `class="logo"`)
+		}
+	}
+}
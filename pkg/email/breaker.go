@@ -0,0 +1,109 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen 熔断器处于打开状态，邮件没有发送
+//
+// IsTransient 将其视为暂时性错误，Queue 会一直等待到熔断器恢复而不消耗重试次数
+var ErrCircuitOpen = errors.New("email circuit breaker is open")
+
+// CircuitBreakerConfig 熔断器配置
+//
+// 连续 FailureThreshold 次暂时性错误（网络错误、超时、4xx 应答等）后熔断器打开，
+// 之后的发送立即返回 ErrCircuitOpen，不再等待中继超时。
+// 打开 OpenTimeout 后放行一次探测发送，成功则恢复，失败则继续保持打开
+type CircuitBreakerConfig struct {
+	FailureThreshold int           `yaml:"failure_threshold"` // 触发熔断的连续失败次数，0 表示不启用
+	OpenTimeout      time.Duration `yaml:"open_timeout"`      // 打开后多久放行探测发送，默认 30 秒
+}
+
+// breakerState 熔断器状态
+type breakerState int
+
+const (
+	breakerClosed   breakerState = iota // 正常发送
+	breakerOpen                         // 拒绝发送
+	breakerHalfOpen                     // 探测发送进行中
+)
+
+// circuitBreaker 发送熔断器
+type circuitBreaker struct {
+	threshold   int
+	openTimeout time.Duration
+	now         func() time.Time
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+}
+
+// newCircuitBreaker 根据配置创建熔断器，未启用时返回 nil
+func newCircuitBreaker(config *CircuitBreakerConfig) *circuitBreaker {
+	if config == nil || config.FailureThreshold <= 0 {
+		return nil
+	}
+	openTimeout := config.OpenTimeout
+	if openTimeout <= 0 {
+		openTimeout = 30 * time.Second
+	}
+	return &circuitBreaker{
+		threshold:   config.FailureThreshold,
+		openTimeout: openTimeout,
+		now:         time.Now,
+	}
+}
+
+// allow 判断是否可以发送，打开状态超时后只放行一次探测发送
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerClosed:
+		return nil
+	case breakerOpen:
+		if b.now().Sub(b.openedAt) < b.openTimeout {
+			return ErrCircuitOpen
+		}
+		b.state = breakerHalfOpen
+		return nil
+	default:
+		return ErrCircuitOpen
+	}
+}
+
+// record 记录发送结果
+//
+// 只有暂时性错误计为失败；永久性错误说明中继可以正常应答，与成功一样使熔断器恢复。
+// 调用方取消的发送不影响熔断器状态
+func (b *circuitBreaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if errors.Is(err, context.Canceled) {
+		// 探测发送被取消，重新放行下一次探测
+		if b.state == breakerHalfOpen {
+			b.state = breakerOpen
+		}
+		return
+	}
+
+	if !IsTransient(err) {
+		b.state = breakerClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= b.threshold {
+		b.state = breakerOpen
+		b.openedAt = b.now()
+		b.failures = 0
+	}
+}
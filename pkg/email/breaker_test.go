@@ -0,0 +1,72 @@
+package email
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	b := newCircuitBreaker(&CircuitBreakerConfig{FailureThreshold: 2, OpenTimeout: time.Minute})
+	b.now = func() time.Time { return now }
+	transient := &ProviderError{Provider: ProviderSendGrid, StatusCode: 503}
+
+	// 永久性错误不计入失败
+	b.record(transient)
+	b.record(&ProviderError{Provider: ProviderSendGrid, StatusCode: 400})
+	b.record(transient)
+	assert.NoError(t, b.allow())
+
+	b.record(transient)
+	assert.ErrorIs(t, b.allow(), ErrCircuitOpen)
+
+	// 超时后只放行一次探测
+	now = now.Add(time.Minute)
+	assert.NoError(t, b.allow())
+	assert.ErrorIs(t, b.allow(), ErrCircuitOpen)
+
+	// 探测失败重新打开
+	b.record(transient)
+	assert.ErrorIs(t, b.allow(), ErrCircuitOpen)
+
+	// 探测被取消时允许下一次探测
+	now = now.Add(time.Minute)
+	assert.NoError(t, b.allow())
+	b.record(context.Canceled)
+	assert.NoError(t, b.allow())
+
+	// 探测成功后恢复
+	b.record(nil)
+	assert.NoError(t, b.allow())
+	assert.NoError(t, b.allow())
+
+	assert.Nil(t, newCircuitBreaker(&CircuitBreakerConfig{}))
+}
+
+func TestSender_CircuitBreaker(t *testing.T) {
+	provider := &fakeProvider{errs: []error{
+		&ProviderError{Provider: ProviderSendGrid, StatusCode: 503},
+		&ProviderError{Provider: ProviderSendGrid, StatusCode: 503},
+	}}
+	sender := NewSenderWithProvider(&Config{CircuitBreaker: CircuitBreakerConfig{FailureThreshold: 2, OpenTimeout: 50 * time.Millisecond}}, provider)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err := sender.SendEmail(ctx, NewEmailData("a@example.com", "hi", ""))
+		assert.Error(t, err)
+	}
+	_, err := sender.SendEmail(ctx, NewEmailData("a@example.com", "hi", ""))
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.True(t, IsTransient(err))
+	assert.Equal(t, 2, provider.called)
+
+	// 队列在熔断期间等待，恢复后发送成功且不消耗重试次数
+	q := NewQueue(sender, &QueueConfig{Workers: 1, MaxRetries: -1, RetryBackoff: 10 * time.Millisecond})
+	assert.NoError(t, q.Enqueue(NewEmailData("a@example.com", "hi", "")))
+	assert.NoError(t, q.Shutdown(context.Background()))
+	assert.Equal(t, 3, provider.called)
+	assert.Len(t, provider.sent, 1)
+}
@@ -0,0 +1,478 @@
+package email
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// cssCommentPattern CSS 注释
+var cssCommentPattern = regexp.MustCompile(`(?s)/\*.*?\*/`)
+
+// cssDeclaration 一条 CSS 声明
+type cssDeclaration struct {
+	property  string
+	value     string
+	important bool
+}
+
+// cssSpecificity 选择器优先级：ID 数量、类数量、标签数量
+type cssSpecificity [3]int
+
+func (s cssSpecificity) less(o cssSpecificity) bool {
+	for i := range s {
+		if s[i] != o[i] {
+			return s[i] < o[i]
+		}
+	}
+	return false
+}
+
+// cssCompound 复合选择器，如 a.button-base.button-primary
+type cssCompound struct {
+	tag     string
+	id      string
+	classes []string
+	child   bool // 与左侧选择器是父子关系（>），否则为后代关系
+}
+
+// cssRule 可以内联的规则，每条规则只有一个选择器
+type cssRule struct {
+	selector    []cssCompound
+	specificity cssSpecificity
+	decls       []cssDeclaration
+	order       int
+}
+
+// InlineCSS 将 <style> 中的规则内联到元素的 style 属性
+//
+// Outlook 和部分国内网页邮箱会删除 <style> 块，按钮等样式随之丢失。
+// 支持标签、类、ID 选择器及其后代（空格）和子元素（>）组合；
+// 伪类、属性选择器和 @media 等无法内联的规则保留在 <style> 中，全部内联后删除 <style>。
+// 元素原有的 style 属性优先于样式表，样式表中的 !important 声明除外。
+//
+// 参数:
+//   - body: HTML 正文
+//
+// 返回:
+//   - string: 内联后的 HTML，没有 <style> 时原样返回
+//   - error: HTML 解析失败时返回错误
+//
+// 使用示例:
+//
+//	tm := email.NewTemplateManager(email.WithInlineCSS())
+//	// 或者对自行渲染的正文单独处理
+//	body, err := email.InlineCSS(body)
+func InlineCSS(body string) (string, error) {
+	if !strings.Contains(strings.ToLower(body), "<style") {
+		return body, nil
+	}
+
+	doc, err := html.Parse(strings.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse html: %w", err)
+	}
+
+	var rules []*cssRule
+	var styles []*html.Node
+	walkHTML(doc, func(n *html.Node) {
+		if n.Type == html.ElementNode && n.DataAtom == atom.Style {
+			styles = append(styles, n)
+		}
+	})
+	for _, style := range styles {
+		var text strings.Builder
+		for c := style.FirstChild; c != nil; c = c.NextSibling {
+			text.WriteString(c.Data)
+		}
+		inlinable, rest := parseStylesheet(text.String(), len(rules))
+		rules = append(rules, inlinable...)
+
+		if strings.TrimSpace(rest) == "" {
+			style.Parent.RemoveChild(style)
+			continue
+		}
+		for c := style.FirstChild; c != nil; c = style.FirstChild {
+			style.RemoveChild(c)
+		}
+		style.AppendChild(&html.Node{Type: html.TextNode, Data: rest})
+	}
+
+	if len(rules) > 0 {
+		walkHTML(doc, func(n *html.Node) {
+			if n.Type == html.ElementNode && inBody(n) {
+				applyRules(n, rules)
+			}
+		})
+	}
+
+	var out strings.Builder
+	if err := html.Render(&out, doc); err != nil {
+		return "", fmt.Errorf("failed to render html: %w", err)
+	}
+	return out.String(), nil
+}
+
+// WithInlineCSS 渲染后将 <style> 中的规则内联到元素的 style 属性，见 InlineCSS
+func WithInlineCSS() TemplateManagerOption {
+	return func(tm *TemplateManager) {
+		tm.inlineCSS = true
+	}
+}
+
+// postRender 渲染后的处理步骤
+func (tm *TemplateManager) postRender(subject, body string, err error) (string, string, error) {
+	if err != nil || !tm.inlineCSS {
+		return subject, body, err
+	}
+	body, err = InlineCSS(body)
+	if err != nil {
+		return "", "", err
+	}
+	return subject, body, nil
+}
+
+// walkHTML 先序遍历节点
+func walkHTML(n *html.Node, fn func(*html.Node)) {
+	fn(n)
+	for c := n.FirstChild; c != nil; {
+		// fn 可能移除当前节点，提前取得下一个节点
+		next := c.NextSibling
+		walkHTML(c, fn)
+		c = next
+	}
+}
+
+// inBody 判断元素是否会被显示，<head> 中的元素不需要内联
+func inBody(n *html.Node) bool {
+	for p := n; p != nil; p = p.Parent {
+		if p.DataAtom == atom.Head {
+			return false
+		}
+	}
+	return true
+}
+
+// parseStylesheet 解析样式表，返回可以内联的规则和需要保留在 <style> 中的文本
+func parseStylesheet(css string, order int) ([]*cssRule, string) {
+	css = cssCommentPattern.ReplaceAllString(css, "")
+
+	var rules []*cssRule
+	var rest strings.Builder
+	for i := 0; i < len(css); {
+		// 跳过空白
+		for i < len(css) && isCSSSpace(css[i]) {
+			i++
+		}
+		if i >= len(css) {
+			break
+		}
+
+		// @media 等规则原样保留
+		if css[i] == '@' {
+			end := atRuleEnd(css, i)
+			rest.WriteString(css[i:end])
+			rest.WriteString("\n")
+			i = end
+			continue
+		}
+
+		open := strings.IndexByte(css[i:], '{')
+		if open < 0 {
+			break
+		}
+		closing := strings.IndexByte(css[i+open:], '}')
+		if closing < 0 {
+			break
+		}
+		selectors := css[i : i+open]
+		block := css[i+open+1 : i+open+closing]
+		i += open + closing + 1
+
+		decls := parseDeclarations(block)
+		for _, sel := range strings.Split(selectors, ",") {
+			sel = strings.TrimSpace(sel)
+			if sel == "" {
+				continue
+			}
+			compounds, spec, ok := parseSelector(sel)
+			if !ok {
+				rest.WriteString(sel + " {" + block + "}\n")
+				continue
+			}
+			rules = append(rules, &cssRule{selector: compounds, specificity: spec, decls: decls, order: order})
+			order++
+		}
+	}
+	return rules, rest.String()
+}
+
+// atRuleEnd 返回 @ 规则结束的位置，支持嵌套的大括号
+func atRuleEnd(css string, start int) int {
+	depth := 0
+	for i := start; i < len(css); i++ {
+		switch css[i] {
+		case ';':
+			if depth == 0 {
+				return i + 1
+			}
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth <= 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(css)
+}
+
+// parseDeclarations 解析声明块
+func parseDeclarations(block string) []cssDeclaration {
+	var decls []cssDeclaration
+	for _, part := range strings.Split(block, ";") {
+		colon := strings.IndexByte(part, ':')
+		if colon < 0 {
+			continue
+		}
+		property := strings.ToLower(strings.TrimSpace(part[:colon]))
+		value := strings.TrimSpace(part[colon+1:])
+		if property == "" || value == "" {
+			continue
+		}
+		var important bool
+		if idx := strings.Index(strings.ToLower(value), "!important"); idx >= 0 {
+			important = true
+			value = strings.TrimSpace(value[:idx])
+		}
+		decls = append(decls, cssDeclaration{property: property, value: value, important: important})
+	}
+	return decls
+}
+
+// parseSelector 解析选择器，包含伪类、属性选择器、兄弟组合器时返回 false
+func parseSelector(sel string) ([]cssCompound, cssSpecificity, bool) {
+	var compounds []cssCompound
+	var spec cssSpecificity
+
+	// 在 > 两侧补空格，统一按空白拆分
+	fields := strings.Fields(strings.ReplaceAll(sel, ">", " > "))
+	child := false
+	for _, field := range fields {
+		if field == ">" {
+			if len(compounds) == 0 || child {
+				return nil, spec, false
+			}
+			child = true
+			continue
+		}
+		if strings.ContainsAny(field, ":[+~()") {
+			return nil, spec, false
+		}
+
+		parts := splitCompound(field)
+		if parts == nil {
+			return nil, spec, false
+		}
+		c := cssCompound{child: child}
+		child = false
+		for _, part := range parts {
+			switch {
+			case part[0] == '.':
+				c.classes = append(c.classes, part[1:])
+				spec[1]++
+			case part[0] == '#':
+				if c.id != "" {
+					return nil, spec, false
+				}
+				c.id = part[1:]
+				spec[0]++
+			case part == "*":
+			default:
+				c.tag = strings.ToLower(part)
+				spec[2]++
+			}
+		}
+		compounds = append(compounds, c)
+	}
+	if len(compounds) == 0 || child {
+		return nil, spec, false
+	}
+	return compounds, spec, true
+}
+
+// splitCompound 将 a.btn#main 拆分为 a、.btn、#main
+func splitCompound(s string) []string {
+	var parts []string
+	start := 0
+	for i := 1; i < len(s); i++ {
+		if s[i] == '.' || s[i] == '#' {
+			parts = append(parts, s[start:i])
+			start = i
+		}
+	}
+	parts = append(parts, s[start:])
+	for _, p := range parts {
+		if p == "." || p == "#" {
+			return nil
+		}
+	}
+	return parts
+}
+
+func isCSSSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r' || b == '\f'
+}
+
+// matches 判断元素是否匹配选择器，从右向左匹配
+func (r *cssRule) matches(n *html.Node) bool {
+	return matchFrom(n, r.selector, len(r.selector)-1)
+}
+
+func matchFrom(n *html.Node, compounds []cssCompound, i int) bool {
+	c := compounds[i]
+	if !c.matchElement(n) {
+		return false
+	}
+	if i == 0 {
+		return true
+	}
+	for p := n.Parent; p != nil && p.Type == html.ElementNode; p = p.Parent {
+		if matchFrom(p, compounds, i-1) {
+			return true
+		}
+		if c.child {
+			return false
+		}
+	}
+	return false
+}
+
+// matchElement 判断单个元素是否匹配复合选择器
+func (c cssCompound) matchElement(n *html.Node) bool {
+	if n.Type != html.ElementNode {
+		return false
+	}
+	if c.tag != "" && c.tag != n.Data {
+		return false
+	}
+	if c.id != "" && attr(n, "id") != c.id {
+		return false
+	}
+	if len(c.classes) > 0 {
+		classes := strings.Fields(attr(n, "class"))
+		for _, want := range c.classes {
+			found := false
+			for _, have := range classes {
+				if have == want {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// attr 返回元素的属性值
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// applyRules 将匹配的规则写入元素的 style 属性
+func applyRules(n *html.Node, rules []*cssRule) {
+	type winner struct {
+		decl cssDeclaration
+		spec cssSpecificity
+		ord  int
+		pos  int // 在规则中的位置
+	}
+
+	var matched []*cssRule
+	for _, r := range rules {
+		if r.matches(n) {
+			matched = append(matched, r)
+		}
+	}
+	if len(matched) == 0 {
+		return
+	}
+
+	// 按层叠规则选出每个属性的最终值
+	winners := make(map[string]*winner)
+	for _, r := range matched {
+		for pos, d := range r.decls {
+			w, ok := winners[d.property]
+			if ok {
+				if w.decl.important && !d.important {
+					continue
+				}
+				if w.decl.important == d.important && r.specificity.less(w.spec) {
+					continue
+				}
+			}
+			winners[d.property] = &winner{decl: d, spec: r.specificity, ord: r.order, pos: pos}
+		}
+	}
+
+	ordered := make([]*winner, 0, len(winners))
+	for _, w := range winners {
+		ordered = append(ordered, w)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		if ordered[i].spec != ordered[j].spec {
+			return ordered[i].spec.less(ordered[j].spec)
+		}
+		if ordered[i].ord != ordered[j].ord {
+			return ordered[i].ord < ordered[j].ord
+		}
+		return ordered[i].pos < ordered[j].pos
+	})
+
+	decls := make([]cssDeclaration, 0, len(ordered))
+	index := make(map[string]int, len(ordered))
+	for _, w := range ordered {
+		index[w.decl.property] = len(decls)
+		decls = append(decls, w.decl)
+	}
+
+	// 原有的 style 属性优先，样式表中的 !important 声明除外
+	for _, d := range parseDeclarations(attr(n, "style")) {
+		if i, ok := index[d.property]; ok {
+			if decls[i].important && !d.important {
+				continue
+			}
+			decls[i] = d
+			continue
+		}
+		index[d.property] = len(decls)
+		decls = append(decls, d)
+	}
+
+	parts := make([]string, len(decls))
+	for i, d := range decls {
+		parts[i] = d.property + ": " + d.value
+	}
+	style := strings.Join(parts, "; ") + ";"
+
+	for i := range n.Attr {
+		if n.Attr[i].Key == "style" {
+			n.Attr[i].Val = style
+			return
+		}
+	}
+	n.Attr = append(n.Attr, html.Attribute{Key: "style", Val: style})
+}
@@ -0,0 +1,61 @@
+package email
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInlineCSS(t *testing.T) {
+	body := `<!DOCTYPE html>
+<html><head><style>
+/* 注释 */
+p { color: #333; margin: 0 }
+.content p { color: #111 }
+.button-base { padding: 14px; color: #fff !important; }
+a:hover { color: red }
+@media (max-width: 600px) { .content { padding: 8px } }
+</style></head>
+<body><div class="content"><p>正文</p><a class="button-base" style="color: blue; border: 0">激活</a></div><p id="x">页脚</p></body></html>`
+
+	out, err := InlineCSS(body)
+	assert.NoError(t, err)
+
+	// 优先级高的规则覆盖同名属性
+	assert.Contains(t, out, `<p style="margin: 0; color: #111;">正文</p>`)
+	assert.Contains(t, out, `<p id="x" style="color: #333; margin: 0;">页脚</p>`)
+	// 原有 style 优先，!important 除外
+	assert.Contains(t, out, `style="padding: 14px; color: #fff; border: 0;"`)
+	// 无法内联的规则保留
+	assert.Contains(t, out, "a:hover")
+	assert.Contains(t, out, "@media (max-width: 600px)")
+	assert.NotContains(t, out, ".content p")
+}
+
+func TestInlineCSS_RemovesStyle(t *testing.T) {
+	out, err := InlineCSS(`<html><head><style>div > .a { color: red }</style></head><body><div><span class="a">x</span><p><span class="a">y</span></p></div></body></html>`)
+	assert.NoError(t, err)
+	assert.NotContains(t, out, "<style")
+	assert.Contains(t, out, `<span class="a" style="color: red;">x</span>`)
+	assert.Contains(t, out, `<span class="a">y</span>`)
+
+	// 没有 <style> 时原样返回
+	out, err = InlineCSS("<p>hi</p>")
+	assert.NoError(t, err)
+	assert.Equal(t, "<p>hi</p>", out)
+}
+
+func TestWithInlineCSS_BuiltinTemplates(t *testing.T) {
+	tm := NewTemplateManager(WithInlineCSS())
+	_, body, err := tm.Render(TenantActivationData{
+		UserName:       "张三",
+		TenantName:     "示例科技",
+		ActivationLink: "https://example.com/activate?token=abc&x=1",
+		ExpireTime:     "24小时",
+	})
+	assert.NoError(t, err)
+	assert.NotContains(t, body, "<style")
+	assert.True(t, strings.Contains(body, `class="button-base button-primary" style="`), body)
+	assert.Contains(t, body, "https://example.com/activate?token=abc&amp;x=1")
+}
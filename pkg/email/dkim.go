@@ -0,0 +1,246 @@
+package email
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// DKIMConfig DKIM 签名配置
+//
+// Domain、Selector 和私钥都配置后启用签名，公钥需要发布在 <selector>._domainkey.<domain> 的 TXT 记录中
+type DKIMConfig struct {
+	Domain         string   `yaml:"domain"`           // 签名域名（d=）
+	Selector       string   `yaml:"selector"`         // 选择器（s=）
+	PrivateKey     string   `yaml:"private_key"`      // PEM 格式私钥，支持 RSA 和 Ed25519
+	PrivateKeyFile string   `yaml:"private_key_file"` // 私钥文件路径，PrivateKey 为空时使用
+	Headers        []string `yaml:"headers"`          // 参与签名的邮件头，为空时使用 defaultDKIMHeaders
+}
+
+// Enabled 是否启用 DKIM 签名
+func (c *DKIMConfig) Enabled() bool {
+	return c.Domain != "" && c.Selector != "" && (c.PrivateKey != "" || c.PrivateKeyFile != "")
+}
+
+// defaultDKIMHeaders 默认参与签名的邮件头，邮件中不存在的头会被跳过
+var defaultDKIMHeaders = []string{"From", "To", "Cc", "Subject", "Date", "Message-ID", "Reply-To", "MIME-Version", "Content-Type"}
+
+// dkimSigner DKIM 签名器，使用 relaxed/relaxed 规范化（RFC 6376）
+type dkimSigner struct {
+	domain    string
+	selector  string
+	key       crypto.Signer
+	algorithm string
+	headers   []string
+	now       func() time.Time
+}
+
+// newDKIMSigner 根据配置创建签名器
+func newDKIMSigner(config *DKIMConfig) (*dkimSigner, error) {
+	keyPEM := []byte(config.PrivateKey)
+	if len(keyPEM) == 0 {
+		data, err := os.ReadFile(config.PrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read dkim private key: %w", err)
+		}
+		keyPEM = data
+	}
+
+	key, err := parseDKIMKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &dkimSigner{
+		domain:   config.Domain,
+		selector: config.Selector,
+		key:      key,
+		headers:  config.Headers,
+		now:      time.Now,
+	}
+	if len(s.headers) == 0 {
+		s.headers = defaultDKIMHeaders
+	}
+	switch key.(type) {
+	case *rsa.PrivateKey:
+		s.algorithm = "rsa-sha256"
+	case ed25519.PrivateKey:
+		s.algorithm = "ed25519-sha256"
+	}
+	return s, nil
+}
+
+// parseDKIMKey 解析 PKCS#1 或 PKCS#8 格式的私钥
+func parseDKIMKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("invalid dkim private key: no PEM block found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dkim private key: %w", err)
+	}
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return k, nil
+	case ed25519.PrivateKey:
+		return k, nil
+	default:
+		return nil, fmt.Errorf("unsupported dkim private key type %T", key)
+	}
+}
+
+// Sign 对邮件签名，返回在头部添加了 DKIM-Signature 的邮件
+func (s *dkimSigner) Sign(msg []byte) ([]byte, error) {
+	header, body := splitMessage(msg)
+	fields := parseHeaderFields(header)
+
+	bodyHash := sha256.Sum256(relaxedBody(body))
+
+	// 选择参与签名的邮件头，同名头取最后一个（RFC 6376 5.4.2）
+	var names []string
+	var signed bytes.Buffer
+	for _, name := range s.headers {
+		field, ok := lastHeaderField(fields, name)
+		if !ok {
+			continue
+		}
+		names = append(names, strings.ToLower(name))
+		signed.WriteString(relaxedHeader(field))
+	}
+
+	var sig strings.Builder
+	fmt.Fprintf(&sig, "DKIM-Signature: v=1; a=%s; c=relaxed/relaxed; d=%s; s=%s;\r\n", s.algorithm, s.domain, s.selector)
+	fmt.Fprintf(&sig, "\tt=%d; h=%s;\r\n", s.now().Unix(), strings.Join(names, ":"))
+	fmt.Fprintf(&sig, "\tbh=%s;\r\n", base64.StdEncoding.EncodeToString(bodyHash[:]))
+	sig.WriteString("\tb=")
+
+	// 签名输入中 DKIM-Signature 的 b= 为空，且不带结尾的 CRLF
+	signed.WriteString(strings.TrimSuffix(relaxedHeader(sig.String()), "\r\n"))
+
+	signature, err := s.signData(signed.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign message with dkim: %w", err)
+	}
+
+	// b= 的值在验证时会被整体去掉，可以任意折行
+	b := base64.StdEncoding.EncodeToString(signature)
+	for len(b) > 72 {
+		sig.WriteString(b[:72] + "\r\n\t ")
+		b = b[72:]
+	}
+	sig.WriteString(b + "\r\n")
+
+	out := make([]byte, 0, sig.Len()+len(msg))
+	out = append(out, sig.String()...)
+	return append(out, msg...), nil
+}
+
+// signData 使用私钥对签名输入签名
+func (s *dkimSigner) signData(data []byte) ([]byte, error) {
+	hash := sha256.Sum256(data)
+	if _, ok := s.key.(ed25519.PrivateKey); ok {
+		// RFC 8463: Ed25519 对 SHA-256 摘要签名
+		return s.key.Sign(rand.Reader, hash[:], crypto.Hash(0))
+	}
+	return s.key.Sign(rand.Reader, hash[:], crypto.SHA256)
+}
+
+// splitMessage 拆分邮件头和正文，邮件头包含结尾的 CRLF
+func splitMessage(msg []byte) (header, body []byte) {
+	if i := bytes.Index(msg, []byte("\r\n\r\n")); i >= 0 {
+		return msg[:i+2], msg[i+4:]
+	}
+	return msg, nil
+}
+
+// parseHeaderFields 将邮件头拆分为字段，每个字段保留折行和结尾的 CRLF
+func parseHeaderFields(header []byte) []string {
+	var fields []string
+	for _, line := range strings.SplitAfter(string(header), "\r\n") {
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(fields) > 0 {
+			fields[len(fields)-1] += line
+			continue
+		}
+		fields = append(fields, line)
+	}
+	return fields
+}
+
+// lastHeaderField 查找最后一个同名的邮件头
+func lastHeaderField(fields []string, name string) (string, bool) {
+	for i := len(fields) - 1; i >= 0; i-- {
+		k, _, ok := strings.Cut(fields[i], ":")
+		if ok && strings.EqualFold(strings.TrimSpace(k), name) {
+			return fields[i], true
+		}
+	}
+	return "", false
+}
+
+// relaxedHeader relaxed 规范化单个邮件头（RFC 6376 3.4.2）
+func relaxedHeader(field string) string {
+	k, v, _ := strings.Cut(field, ":")
+	k = strings.ToLower(strings.TrimSpace(k))
+	v = strings.ReplaceAll(v, "\r\n", "")
+	v = strings.Join(strings.FieldsFunc(v, isWSP), " ")
+	return k + ":" + v + "\r\n"
+}
+
+// relaxedBody relaxed 规范化正文（RFC 6376 3.4.4）
+func relaxedBody(body []byte) []byte {
+	lines := strings.Split(strings.ReplaceAll(string(body), "\r\n", "\n"), "\n")
+
+	var buf bytes.Buffer
+	blank := 0
+	for _, line := range lines {
+		line = strings.TrimRightFunc(line, isWSP)
+		// 行内连续空白压缩为一个空格，保留行首空白
+		var b strings.Builder
+		space := false
+		for _, r := range line {
+			if isWSP(r) {
+				space = true
+				continue
+			}
+			if space {
+				b.WriteByte(' ')
+				space = false
+			}
+			b.WriteRune(r)
+		}
+
+		// 结尾的空行会被去掉，中间的空行原样保留
+		if b.Len() == 0 {
+			blank++
+			continue
+		}
+		for ; blank > 0; blank-- {
+			buf.WriteString("\r\n")
+		}
+		buf.WriteString(b.String())
+		buf.WriteString("\r\n")
+	}
+	return buf.Bytes()
+}
+
+func isWSP(r rune) bool {
+	return r == ' ' || r == '\t'
+}
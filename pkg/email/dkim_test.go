@@ -0,0 +1,129 @@
+package email
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDKIMCanonicalization(t *testing.T) {
+	// RFC 6376 3.4.5 的示例
+	fields := parseHeaderFields([]byte("A: X\r\nB : Y\t\r\n\tZ  \r\n"))
+	assert.Equal(t, []string{"A: X\r\n", "B : Y\t\r\n\tZ  \r\n"}, fields)
+	assert.Equal(t, "a:X\r\n", relaxedHeader(fields[0]))
+	assert.Equal(t, "b:Y Z\r\n", relaxedHeader(fields[1]))
+
+	assert.Equal(t, " C\r\nD E\r\n", string(relaxedBody([]byte(" C \r\nD \t E\r\n\r\n\r\n"))))
+	assert.Equal(t, "a\r\n\r\nb\r\n", string(relaxedBody([]byte("a\r\n\r\nb"))))
+	assert.Empty(t, relaxedBody([]byte("\r\n\r\n")))
+}
+
+func TestDKIMSign(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	edDER, err := x509.MarshalPKCS8PrivateKey(edKey)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		pem       string
+		algorithm string
+		verify    func(hash, sig []byte) error
+	}{
+		{
+			name:      "rsa",
+			pem:       string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)})),
+			algorithm: "rsa-sha256",
+			verify: func(hash, sig []byte) error {
+				return rsa.VerifyPKCS1v15(&rsaKey.PublicKey, crypto.SHA256, hash, sig)
+			},
+		},
+		{
+			name:      "ed25519",
+			pem:       string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: edDER})),
+			algorithm: "ed25519-sha256",
+			verify: func(hash, sig []byte) error {
+				if !ed25519.Verify(edKey.Public().(ed25519.PublicKey), hash, sig) {
+					return assert.AnError
+				}
+				return nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer, err := newDKIMSigner(&DKIMConfig{Domain: "example.com", Selector: "mail", PrivateKey: tt.pem})
+			assert.NoError(t, err)
+			signer.now = func() time.Time { return time.Unix(1700000000, 0) }
+
+			msg, err := buildMessage("no-reply@example.com", &EmailData{MessageID: "1.abc@example.com", To: []string{"user@example.com"}, Subject: "你好", Body: "<p>hi</p>"})
+			assert.NoError(t, err)
+			signed, err := signer.Sign(msg)
+			assert.NoError(t, err)
+			assert.True(t, strings.HasSuffix(string(signed), string(msg)))
+
+			verifyDKIM(t, signed, tt.algorithm, tt.verify)
+		})
+	}
+}
+
+func TestDKIMInvalidKey(t *testing.T) {
+	_, err := NewProvider(&Config{SMTP: SMTPConfig{
+		Host: "smtp.example.com",
+		From: "no-reply@example.com",
+		DKIM: DKIMConfig{Domain: "example.com", Selector: "mail", PrivateKey: "not a key"},
+	}})
+	assert.ErrorContains(t, err, "invalid dkim private key")
+}
+
+// verifyDKIM 按验证方的流程检查签名（RFC 6376 6.1.3）
+func verifyDKIM(t *testing.T, signed []byte, algorithm string, verify func(hash, sig []byte) error) {
+	header, body := splitMessage(signed)
+	fields := parseHeaderFields(header)
+	sigField := fields[0]
+	assert.True(t, strings.HasPrefix(sigField, "DKIM-Signature:"))
+
+	tags := map[string]string{}
+	_, value, _ := strings.Cut(sigField, ":")
+	for _, tag := range strings.Split(value, ";") {
+		k, v, _ := strings.Cut(tag, "=")
+		tags[strings.TrimSpace(k)] = strings.Join(strings.Fields(v), "")
+	}
+	assert.Equal(t, algorithm, tags["a"])
+	assert.Equal(t, "relaxed/relaxed", tags["c"])
+	assert.Equal(t, "example.com", tags["d"])
+	assert.Equal(t, "mail", tags["s"])
+	assert.Equal(t, "1700000000", tags["t"])
+	assert.Equal(t, "from:to:subject:date:message-id:mime-version:content-type", tags["h"])
+
+	bodyHash := sha256.Sum256(relaxedBody(body))
+	assert.Equal(t, base64.StdEncoding.EncodeToString(bodyHash[:]), tags["bh"])
+
+	var data strings.Builder
+	for _, name := range strings.Split(tags["h"], ":") {
+		field, ok := lastHeaderField(fields[1:], name)
+		assert.True(t, ok)
+		data.WriteString(relaxedHeader(field))
+	}
+	emptyB := regexp.MustCompile(`b=[^;]*$`).ReplaceAllString(strings.TrimSuffix(sigField, "\r\n"), "b=")
+	data.WriteString(strings.TrimSuffix(relaxedHeader(emptyB), "\r\n"))
+
+	sig, err := base64.StdEncoding.DecodeString(tags["b"])
+	assert.NoError(t, err)
+	hash := sha256.Sum256([]byte(data.String()))
+	assert.NoError(t, verify(hash[:], sig))
+}
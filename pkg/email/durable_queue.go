@@ -0,0 +1,183 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DurableQueueConfig 持久化发送队列配置
+type DurableQueueConfig struct {
+	Workers      int           `yaml:"workers"`       // 并发发送的 worker 数量，默认 4
+	MaxRetries   int           `yaml:"max_retries"`   // 暂时性错误的最大重试次数，默认 3，负数表示不重试
+	RetryBackoff time.Duration `yaml:"retry_backoff"` // 首次重试间隔，之后每次翻倍，默认 1 秒
+	Visibility   time.Duration `yaml:"visibility"`    // 任务取出后的不可见时间，超时未完成的任务由其他 worker 接管，默认 5 分钟
+	PollInterval time.Duration `yaml:"poll_interval"` // 队列为空时的轮询间隔，默认 1 秒
+
+	// Retryable 判断错误是否可以重试，默认使用 IsTransient
+	Retryable func(err error) bool `yaml:"-"`
+	// OnError 最终发送失败时的回调（重试耗尽或不可重试的错误）
+	OnError func(job *Job, err error) `yaml:"-"`
+}
+
+// DurableQueue 基于 JobStore 的持久化异步发送队列
+//
+// 与 Queue 不同，任务保存在外部存储中（如 redisqueue.Store），进程重启后继续发送；
+// 多个实例可以共用同一个存储，worker 崩溃时任务在 Visibility 超时后由其他 worker 接管。
+// 入队时可以指定幂等键，调用方重试时不会重复发送同一封邮件。
+//
+// 接管机制意味着任务至少发送一次，发送成功后确认前崩溃的任务可能重复发送。
+//
+// 使用示例:
+//
+//	rdb := redis.NewClient(&redis.Options{Addr: "127.0.0.1:6379"})
+//	store := redisqueue.New(rdb, nil)
+//	queue := email.NewDurableQueue(sender, store, &email.DurableQueueConfig{Workers: 8})
+//	go queue.Run(ctx)
+//
+//	key := fmt.Sprintf("activation:%d", tenantID)
+//	if err := queue.Enqueue(ctx, key, data); err != nil && !errors.Is(err, email.ErrDuplicateJob) {
+//	    return err
+//	}
+type DurableQueue struct {
+	sender *Sender
+	store  JobStore
+	config DurableQueueConfig
+}
+
+// NewDurableQueue 创建持久化发送队列，调用 Run 后开始发送
+func NewDurableQueue(sender *Sender, store JobStore, config *DurableQueueConfig) *DurableQueue {
+	cfg := DurableQueueConfig{}
+	if config != nil {
+		cfg = *config
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = 4
+	}
+	if cfg.MaxRetries < 0 {
+		cfg.MaxRetries = 0
+	} else if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = 3
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = time.Second
+	}
+	if cfg.Visibility <= 0 {
+		cfg.Visibility = 5 * time.Minute
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = time.Second
+	}
+	if cfg.Retryable == nil {
+		cfg.Retryable = IsTransient
+	}
+	return &DurableQueue{sender: sender, store: store, config: cfg}
+}
+
+// Enqueue 将邮件保存到队列
+//
+// 参数:
+//   - ctx: 上下文，其中的租户ID（见 WithTenantID）随任务保存，发送时恢复
+//   - key: 幂等键，如 "activation:<用户ID>"，为空表示不去重
+//   - data: 邮件数据，附件需要使用 Content
+//
+// 返回:
+//   - error: 相同幂等键的任务已经入队时返回 ErrDuplicateJob
+func (q *DurableQueue) Enqueue(ctx context.Context, key string, data *EmailData) error {
+	for _, a := range data.Attachments {
+		if a.Content == nil && a.Reader != nil {
+			return fmt.Errorf("attachment %s uses Reader, which cannot be persisted", a.Filename)
+		}
+	}
+	return q.store.Push(ctx, &Job{Key: key, Data: data, TenantID: TenantIDFromContext(ctx), EnqueuedAt: time.Now()})
+}
+
+// Run 启动 worker 发送队列中的任务，直到 ctx 被取消
+//
+// ctx 取消后不再取出新任务，等待正在进行的发送完成后返回；
+// 未完成的任务保留在存储中，下次启动后继续发送
+func (q *DurableQueue) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	wg.Add(q.config.Workers)
+	for i := 0; i < q.config.Workers; i++ {
+		go func() {
+			defer wg.Done()
+			q.worker(ctx)
+		}()
+	}
+	wg.Wait()
+	return ctx.Err()
+}
+
+// worker 循环取出并处理任务
+func (q *DurableQueue) worker(ctx context.Context) {
+	for ctx.Err() == nil {
+		job, err := q.store.Claim(ctx, q.config.Visibility)
+		if err != nil || job == nil {
+			// 队列为空或存储暂时不可用，等待后重试
+			timer := time.NewTimer(q.config.PollInterval)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+			}
+			continue
+		}
+		q.process(job)
+	}
+}
+
+// process 发送一个任务，失败时按退避时间放回存储
+//
+// 收件人地址校验或抑制列表检查不通过时不重试，直接确认并回调 OnError。
+// 发送不受 Run 的 ctx 控制，避免关闭时中断已经开始的 SMTP 会话，
+// 单次发送的时长由渠道超时或 EmailData.Timeout 限制
+func (q *DurableQueue) process(job *Job) {
+	ctx := context.Background()
+	if job.TenantID != 0 {
+		ctx = WithTenantID(ctx, job.TenantID)
+	}
+
+	if err := q.sender.validateRecipients(ctx, job.Data.Recipients()...); err != nil {
+		q.fail(ctx, job, err)
+		return
+	}
+
+	_, err := q.sender.SendEmail(ctx, job.Data)
+	if err == nil {
+		q.storeError(job, q.store.Ack(ctx, job))
+		return
+	}
+
+	// 熔断器打开时不消耗重试次数
+	if errors.Is(err, ErrCircuitOpen) {
+		q.storeError(job, q.store.Retry(ctx, job, q.config.RetryBackoff))
+		return
+	}
+
+	if job.Attempts < q.config.MaxRetries && q.config.Retryable(err) {
+		delay := q.config.RetryBackoff << job.Attempts
+		job.Attempts++
+		q.storeError(job, q.store.Retry(ctx, job, delay))
+		return
+	}
+
+	q.fail(ctx, job, err)
+}
+
+// fail 确认最终失败的任务并回调 OnError
+func (q *DurableQueue) fail(ctx context.Context, job *Job, err error) {
+	q.storeError(job, q.store.Ack(ctx, job))
+	if q.config.OnError != nil {
+		q.config.OnError(job, err)
+	}
+}
+
+// storeError 记录更新任务状态失败，任务会在 Visibility 超时后重新可见
+func (q *DurableQueue) storeError(job *Job, err error) {
+	if err != nil {
+		q.sender.logger.Errorf("更新邮件任务状态失败: job_id=%s, error=%v", job.ID, err)
+	}
+}
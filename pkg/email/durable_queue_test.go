@@ -0,0 +1,121 @@
+package email
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryJobStore_Dedup(t *testing.T) {
+	store := NewMemoryJobStore(time.Hour)
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	assert.NoError(t, store.Push(ctx, &Job{Key: "activation:1"}))
+	assert.ErrorIs(t, store.Push(ctx, &Job{Key: "activation:1"}), ErrDuplicateJob)
+	assert.NoError(t, store.Push(ctx, &Job{}))
+	assert.NoError(t, store.Push(ctx, &Job{}))
+	assert.Equal(t, 3, store.Len())
+
+	// 确认后幂等键仍然有效，去重窗口结束后可以再次入队
+	job, _ := store.Claim(ctx, time.Minute)
+	assert.NoError(t, store.Ack(ctx, job))
+	assert.ErrorIs(t, store.Push(ctx, &Job{Key: "activation:1"}), ErrDuplicateJob)
+
+	now = now.Add(time.Hour)
+	assert.NoError(t, store.Push(ctx, &Job{Key: "activation:1"}))
+}
+
+func TestMemoryJobStore_Visibility(t *testing.T) {
+	store := NewMemoryJobStore(0)
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	assert.NoError(t, store.Push(ctx, &Job{Key: "a"}))
+	job, err := store.Claim(ctx, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", job.Key)
+
+	// 不可见期间不会被重复取出
+	job2, _ := store.Claim(ctx, time.Minute)
+	assert.Nil(t, job2)
+
+	// 超时未确认的任务被重新取出
+	now = now.Add(time.Minute)
+	reclaimed, _ := store.Claim(ctx, time.Minute)
+	assert.Equal(t, job.ID, reclaimed.ID)
+
+	reclaimed.Attempts = 1
+	assert.NoError(t, store.Retry(ctx, reclaimed, time.Second))
+	now = now.Add(time.Second)
+	retried, _ := store.Claim(ctx, time.Minute)
+	assert.Equal(t, 1, retried.Attempts)
+}
+
+func TestDurableQueue_RetryAndAck(t *testing.T) {
+	provider := &fakeProvider{errs: []error{&ProviderError{Provider: ProviderSendGrid, StatusCode: 503}}}
+	store := NewMemoryJobStore(0)
+	q := NewDurableQueue(NewSenderWithProvider(&Config{}, provider), store, &DurableQueueConfig{
+		Workers:      2,
+		RetryBackoff: time.Millisecond,
+		PollInterval: time.Millisecond,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- q.Run(ctx) }()
+
+	data := NewEmailData("a@example.com", "激活账号", "")
+	assert.NoError(t, q.Enqueue(ctx, "activation:1", data))
+	// 调用方重试时不会重复发送
+	assert.ErrorIs(t, q.Enqueue(ctx, "activation:1", data), ErrDuplicateJob)
+
+	assert.Eventually(t, func() bool { return store.Len() == 0 }, time.Second, time.Millisecond)
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+
+	assert.Equal(t, 2, provider.called)
+	assert.Len(t, provider.sent, 1)
+}
+
+func TestDurableQueue_PermanentError(t *testing.T) {
+	provider := &fakeProvider{errs: []error{&ProviderError{Provider: ProviderSendGrid, StatusCode: 400}}}
+	store := NewMemoryJobStore(0)
+
+	var mu sync.Mutex
+	var failed []*Job
+	q := NewDurableQueue(NewSenderWithProvider(&Config{}, provider), store, &DurableQueueConfig{
+		PollInterval: time.Millisecond,
+		OnError: func(job *Job, _ error) {
+			mu.Lock()
+			failed = append(failed, job)
+			mu.Unlock()
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go q.Run(ctx)
+
+	assert.NoError(t, q.Enqueue(ctx, "", NewEmailData("a@example.com", "hi", "")))
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(failed) == 1
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, 0, store.Len())
+	assert.Equal(t, 1, provider.called)
+}
+
+func TestDurableQueue_RejectsReaderAttachment(t *testing.T) {
+	q := NewDurableQueue(NewSenderWithProvider(&Config{}, &fakeProvider{}), NewMemoryJobStore(0), nil)
+	data := NewEmailData("a@example.com", "hi", "")
+	data.Attachments = []Attachment{{Filename: "a.pdf", Reader: strings.NewReader("pdf")}}
+	assert.Error(t, q.Enqueue(context.Background(), "", data))
+}
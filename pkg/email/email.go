@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"time"
+
+	"github.com/heyinLab/common/pkg/email/bounce"
 )
 
 // Service 邮件服务
@@ -18,14 +20,111 @@ func NewService(config *Config) Service {
 	}
 }
 
+// NewServiceWithProvider 使用指定的发送渠道创建邮件服务，用于自定义渠道
+func NewServiceWithProvider(config *Config, provider EmailProvider) Service {
+	return Service{
+		sender: NewSenderWithProvider(config, provider),
+	}
+}
+
+// SetTemplateManager 替换模板管理器，用于启用租户自定义模板
+//
+// 使用示例:
+//
+//	svc := email.NewService(cfg)
+//	svc.SetTemplateManager(email.NewTemplateManager(email.WithTemplateStore(store, 0)))
+func (s *Service) SetTemplateManager(tm *TemplateManager) {
+	s.sender.SetTemplateManager(tm)
+}
+
+// Provider 返回当前使用的发送渠道，测试中可以断言为 *CaptureProvider 读取捕获的邮件
+func (s *Service) Provider() EmailProvider {
+	return s.sender.Provider()
+}
+
+// Close 释放发送渠道持有的资源，服务退出时调用
+func (s *Service) Close() error {
+	return s.sender.Close()
+}
+
+// SetConfigResolver 设置租户配置解析，请求中带有 TenantID 时通过租户自己的 SMTP 发送，见 Sender.SetConfigResolver
+func (s *Service) SetConfigResolver(resolver ConfigResolver) {
+	s.sender.SetConfigResolver(resolver)
+}
+
+// SetSuppressionStore 设置抑制列表，发送前拒绝硬退信、投诉和退订的地址
+//
+// 抑制列表通常与 bounce.Processor 共用，由退信邮箱和服务商回调写入。
+// 收件人被抑制时返回包装了 bounce.ErrSuppressed 的错误，查询失败时同样拒绝发送。
+//
+// 使用示例:
+//
+//	store := bounce.NewMemoryStore()
+//	processor := bounce.NewProcessor(store)
+//	mux.Handle("/webhooks/email/ses", bounce.NewWebhookHandler(processor, events.BounceParser(events.ParseSES)))
+//
+//	svc := email.NewService(config)
+//	svc.SetSuppressionStore(store)
+//
+//	// 用户退订
+//	_ = store.Add(ctx, addr, bounce.ReasonUnsubscribe)
+func (s *Service) SetSuppressionStore(store bounce.SuppressionStore) {
+	s.sender.SetSuppressionStore(store)
+}
+
+// OnBeforeSend 注册发送前钩子，见 Sender.OnBeforeSend
+func (s *Service) OnBeforeSend(hook BeforeSendHook) {
+	s.sender.OnBeforeSend(hook)
+}
+
+// OnAfterSend 注册发送后钩子，见 Sender.OnAfterSend
+func (s *Service) OnAfterSend(hook AfterSendHook) {
+	s.sender.OnAfterSend(hook)
+}
+
+// validateRecipients 按配置校验收件人地址并检查抑制列表，见 Sender.validateRecipients
+func (s *Service) validateRecipients(ctx context.Context, addrs ...string) error {
+	return s.sender.validateRecipients(ctx, addrs...)
+}
+
+// RegisterTemplate 注册自定义邮件类型的模板，见 TemplateManager.RegisterTemplate
+func (s *Service) RegisterTemplate(emailType EmailType, tmpl string) error {
+	return s.sender.templates.RegisterTemplate(emailType, tmpl)
+}
+
+// SendTemplateEmail 发送自定义类型的邮件
+func (s *Service) SendTemplateEmail(ctx context.Context, req *TemplateEmailRequest) (*SendResult, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	if len(req.To) == 0 || req.Type == "" {
+		return nil, fmt.Errorf("required fields cannot be empty")
+	}
+
+	if err := s.validateRecipients(ctx, req.To...); err != nil {
+		return nil, err
+	}
+
+	if req.TenantID != 0 {
+		ctx = WithTenantID(ctx, req.TenantID)
+	}
+
+	return s.sender.SendTemplateEmail(ctx, req.Type, req.To, req.Data)
+}
+
 // SendTenantActivationEmail 发送租户激活邮件
-func (s *Service) SendTenantActivationEmail(ctx context.Context, req *TenantActivationEmailRequest) error {
+func (s *Service) SendTenantActivationEmail(ctx context.Context, req *TenantActivationEmailRequest) (*SendResult, error) {
 	if req == nil {
-		return fmt.Errorf("request cannot be nil")
+		return nil, fmt.Errorf("request cannot be nil")
 	}
 
 	if req.To == "" || req.UserName == "" || req.TenantName == "" || req.ActivationLink == "" {
-		return fmt.Errorf("required fields cannot be empty")
+		return nil, fmt.Errorf("required fields cannot be empty")
+	}
+
+	if err := s.validateRecipients(ctx, req.To); err != nil {
+		return nil, err
 	}
 
 	// 设置默认过期时间（24小时）
@@ -34,6 +133,10 @@ func (s *Service) SendTenantActivationEmail(ctx context.Context, req *TenantActi
 		expireTime = req.ExpireTime
 	}
 
+	if req.TenantID != 0 {
+		ctx = WithTenantID(ctx, req.TenantID)
+	}
+
 	return s.sender.SendTenantActivationEmail(
 		ctx,
 		req.To,
@@ -45,13 +148,17 @@ func (s *Service) SendTenantActivationEmail(ctx context.Context, req *TenantActi
 }
 
 // SendInvitationEmail 发送邀请邮件
-func (s *Service) SendInvitationEmail(ctx context.Context, req *InvitationEmailRequest) error {
+func (s *Service) SendInvitationEmail(ctx context.Context, req *InvitationEmailRequest) (*SendResult, error) {
 	if req == nil {
-		return fmt.Errorf("request cannot be nil")
+		return nil, fmt.Errorf("request cannot be nil")
 	}
 
 	if req.To == "" || req.UserName == "" || req.TenantName == "" || req.DepartmentName == "" || req.AcceptLink == "" {
-		return fmt.Errorf("required fields cannot be empty")
+		return nil, fmt.Errorf("required fields cannot be empty")
+	}
+
+	if err := s.validateRecipients(ctx, req.To); err != nil {
+		return nil, err
 	}
 
 	// 设置默认过期时间（7天）
@@ -66,6 +173,10 @@ func (s *Service) SendInvitationEmail(ctx context.Context, req *InvitationEmailR
 		inviteTime = req.InviteTime
 	}
 
+	if req.TenantID != 0 {
+		ctx = WithTenantID(ctx, req.TenantID)
+	}
+
 	return s.sender.SendInvitationEmail(
 		ctx,
 		req.To,
@@ -82,13 +193,17 @@ func (s *Service) SendInvitationEmail(ctx context.Context, req *InvitationEmailR
 }
 
 // SendPasswordResetEmail 发送密码重置邮件
-func (s *Service) SendPasswordResetEmail(ctx context.Context, req *PasswordResetEmailRequest) error {
+func (s *Service) SendPasswordResetEmail(ctx context.Context, req *PasswordResetEmailRequest) (*SendResult, error) {
 	if req == nil {
-		return fmt.Errorf("request cannot be nil")
+		return nil, fmt.Errorf("request cannot be nil")
 	}
 
 	if req.To == "" || req.UserName == "" || req.ResetLink == "" {
-		return fmt.Errorf("required fields cannot be empty")
+		return nil, fmt.Errorf("required fields cannot be empty")
+	}
+
+	if err := s.validateRecipients(ctx, req.To); err != nil {
+		return nil, err
 	}
 
 	// 设置默认过期时间（1小时）
@@ -97,6 +212,10 @@ func (s *Service) SendPasswordResetEmail(ctx context.Context, req *PasswordReset
 		expireTime = req.ExpireTime
 	}
 
+	if req.TenantID != 0 {
+		ctx = WithTenantID(ctx, req.TenantID)
+	}
+
 	return s.sender.SendPasswordResetEmail(
 		ctx,
 		req.To,
@@ -106,8 +225,141 @@ func (s *Service) SendPasswordResetEmail(ctx context.Context, req *PasswordReset
 	)
 }
 
+// SendVerificationCodeEmail 发送验证码邮件
+func (s *Service) SendVerificationCodeEmail(ctx context.Context, req *VerificationCodeEmailRequest) (*SendResult, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	if req.To == "" || req.Code == "" {
+		return nil, fmt.Errorf("required fields cannot be empty")
+	}
+
+	if err := s.validateRecipients(ctx, req.To); err != nil {
+		return nil, err
+	}
+
+	// 设置默认有效期（10分钟）
+	ttl := 10 * time.Minute
+	if req.TTL > 0 {
+		ttl = req.TTL
+	}
+
+	if req.TenantID != 0 {
+		ctx = WithTenantID(ctx, req.TenantID)
+	}
+
+	return s.sender.SendVerificationCodeEmail(ctx, req.To, req.Code, ttl)
+}
+
+// SendMFACodeEmail 发送登录二次验证邮件
+func (s *Service) SendMFACodeEmail(ctx context.Context, req *MFACodeEmailRequest) (*SendResult, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	if req.To == "" || req.UserName == "" || req.TenantName == "" || req.Code == "" {
+		return nil, fmt.Errorf("required fields cannot be empty")
+	}
+
+	if err := s.validateRecipients(ctx, req.To); err != nil {
+		return nil, err
+	}
+
+	// 设置默认有效期（5分钟）
+	ttl := 5 * time.Minute
+	if req.TTL > 0 {
+		ttl = req.TTL
+	}
+
+	if req.TenantID != 0 {
+		ctx = WithTenantID(ctx, req.TenantID)
+	}
+
+	return s.sender.SendMFACodeEmail(ctx, req.To, req.UserName, req.TenantName, req.Code, ttl)
+}
+
+// SendSecurityAlertEmail 发送异常登录提醒邮件
+func (s *Service) SendSecurityAlertEmail(ctx context.Context, req *SecurityAlertEmailRequest) (*SendResult, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	if req.To == "" || req.UserName == "" || req.TenantName == "" || req.IPAddress == "" || req.SecureLink == "" {
+		return nil, fmt.Errorf("required fields cannot be empty")
+	}
+
+	if err := s.validateRecipients(ctx, req.To); err != nil {
+		return nil, err
+	}
+
+	// 设置默认登录时间
+	loginTime := time.Now()
+	if !req.LoginTime.IsZero() {
+		loginTime = req.LoginTime
+	}
+
+	if req.TenantID != 0 {
+		ctx = WithTenantID(ctx, req.TenantID)
+	}
+
+	return s.sender.SendSecurityAlertEmail(
+		ctx,
+		req.To,
+		req.UserName,
+		req.TenantName,
+		loginTime.Format("2006-01-02 15:04:05"),
+		req.IPAddress,
+		req.Location,
+		req.Device,
+		req.SecureLink,
+	)
+}
+
+// SendQuotaWarningEmail 发送存储空间预警邮件
+func (s *Service) SendQuotaWarningEmail(ctx context.Context, req *QuotaWarningEmailRequest) (*SendResult, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	if req.To == "" || req.UserName == "" || req.TenantName == "" || req.ManageLink == "" {
+		return nil, fmt.Errorf("required fields cannot be empty")
+	}
+
+	if req.UsedBytes < 0 || req.TotalBytes <= 0 {
+		return nil, fmt.Errorf("invalid quota usage")
+	}
+
+	if err := s.validateRecipients(ctx, req.To); err != nil {
+		return nil, err
+	}
+
+	if req.TenantID != 0 {
+		ctx = WithTenantID(ctx, req.TenantID)
+	}
+
+	return s.sender.SendQuotaWarningEmail(
+		ctx,
+		req.To,
+		req.UserName,
+		req.TenantName,
+		req.UsedBytes,
+		req.TotalBytes,
+		req.ManageLink,
+	)
+}
+
+// TemplateEmailRequest 自定义类型邮件请求
+type TemplateEmailRequest struct {
+	TenantID uint32                 `json:"tenant_id"` // 租户ID（可选，用于选择租户自定义模板）
+	Type     EmailType              `json:"type"`      // 邮件类型，需要先通过 RegisterTemplate 注册
+	To       []string               `json:"to"`        // 收件人邮箱
+	Data     map[string]interface{} `json:"data"`      // 模板数据，CurrentYear 未设置时自动填充
+}
+
 // TenantActivationEmailRequest 租户激活邮件请求
 type TenantActivationEmailRequest struct {
+	TenantID       uint32 `json:"tenant_id"`       // 租户ID（可选，用于选择租户自定义模板）
 	To             string `json:"to"`              // 收件人邮箱
 	UserName       string `json:"user_name"`       // 用户名
 	TenantName     string `json:"tenant_name"`     // 租户名称
@@ -117,6 +369,7 @@ type TenantActivationEmailRequest struct {
 
 // InvitationEmailRequest 邀请邮件请求
 type InvitationEmailRequest struct {
+	TenantID       uint32 `json:"tenant_id"`       // 租户ID（可选，用于选择租户自定义模板）
 	To             string `json:"to"`              // 收件人邮箱
 	UserName       string `json:"user_name"`       // 用户名
 	TenantName     string `json:"tenant_name"`     // 租户名称
@@ -131,8 +384,51 @@ type InvitationEmailRequest struct {
 
 // PasswordResetEmailRequest 密码重置邮件请求
 type PasswordResetEmailRequest struct {
+	TenantID   uint32 `json:"tenant_id"`   // 租户ID（可选，用于选择租户自定义模板）
 	To         string `json:"to"`          // 收件人邮箱
 	UserName   string `json:"user_name"`   // 用户名
 	ResetLink  string `json:"reset_link"`  // 重置链接
 	ExpireTime string `json:"expire_time"` // 过期时间（可选）
 }
+
+// VerificationCodeEmailRequest 验证码邮件请求
+type VerificationCodeEmailRequest struct {
+	TenantID uint32        `json:"tenant_id"` // 租户ID（可选，用于选择租户自定义模板）
+	To       string        `json:"to"`        // 收件人邮箱
+	Code     string        `json:"code"`      // 验证码，可以使用 GenerateCode 生成
+	TTL      time.Duration `json:"ttl"`       // 有效期（可选，默认10分钟）
+}
+
+// MFACodeEmailRequest 登录二次验证邮件请求
+type MFACodeEmailRequest struct {
+	TenantID   uint32        `json:"tenant_id"`   // 租户ID（可选，用于选择租户自定义模板）
+	To         string        `json:"to"`          // 收件人邮箱
+	UserName   string        `json:"user_name"`   // 用户名
+	TenantName string        `json:"tenant_name"` // 租户名称
+	Code       string        `json:"code"`        // 验证码，可以使用 GenerateCode 生成
+	TTL        time.Duration `json:"ttl"`         // 有效期（可选，默认5分钟）
+}
+
+// SecurityAlertEmailRequest 异常登录提醒邮件请求
+type SecurityAlertEmailRequest struct {
+	TenantID   uint32    `json:"tenant_id"`   // 租户ID（可选，用于选择租户自定义模板）
+	To         string    `json:"to"`          // 收件人邮箱
+	UserName   string    `json:"user_name"`   // 用户名
+	TenantName string    `json:"tenant_name"` // 租户名称
+	LoginTime  time.Time `json:"login_time"`  // 登录时间（可选，默认当前时间）
+	IPAddress  string    `json:"ip_address"`  // 登录 IP
+	Location   string    `json:"location"`    // 登录地点（可选）
+	Device     string    `json:"device"`      // 登录设备（可选）
+	SecureLink string    `json:"secure_link"` // 账户安全设置链接
+}
+
+// QuotaWarningEmailRequest 存储空间预警邮件请求
+type QuotaWarningEmailRequest struct {
+	TenantID   uint32 `json:"tenant_id"`   // 租户ID（可选，用于选择租户自定义模板）
+	To         string `json:"to"`          // 收件人邮箱
+	UserName   string `json:"user_name"`   // 用户名
+	TenantName string `json:"tenant_name"` // 租户名称
+	UsedBytes  int64  `json:"used_bytes"`  // 已使用的字节数
+	TotalBytes int64  `json:"total_bytes"` // 总配额字节数
+	ManageLink string `json:"manage_link"` // 管理存储空间的链接
+}
@@ -0,0 +1,72 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// directMailEvent 阿里云邮件推送的投递状态消息
+type directMailEvent struct {
+	EnvID      string `json:"env_id"`
+	Rcpt       string `json:"rcpt"`
+	Status     string `json:"status"` // 0 表示投递成功，其他值表示失败
+	ErrCode    string `json:"err_code"`
+	ErrMsg     string `json:"err_msg"`
+	ActionTime string `json:"action_time"`
+}
+
+// ParseDirectMail 解析阿里云邮件推送通过消息服务（MNS）推送的投递状态消息
+//
+// 请求体可以是单条消息或消息数组。
+// 失败消息根据 err_code 中的 SMTP 应答码区分硬退信和软退信，无法判断时按软退信处理。
+// ProviderMessageID 为 env_id，与 SingleSendMail 返回的 EnvId 一致。
+func ParseDirectMail(body []byte) ([]*DeliveryEvent, error) {
+	var raw []directMailEvent
+	if len(body) > 0 && body[0] == '[' {
+		if err := json.Unmarshal(body, &raw); err != nil {
+			return nil, fmt.Errorf("decode directmail events: %w", err)
+		}
+	} else {
+		var e directMailEvent
+		if err := json.Unmarshal(body, &e); err != nil {
+			return nil, fmt.Errorf("decode directmail event: %w", err)
+		}
+		raw = append(raw, e)
+	}
+
+	events := make([]*DeliveryEvent, 0, len(raw))
+	for _, r := range raw {
+		e := &DeliveryEvent{
+			Recipient:         normalizeAddress(r.Rcpt),
+			Provider:          "directmail",
+			ProviderMessageID: r.EnvID,
+			Status:            r.ErrCode,
+			Diagnostic:        r.ErrMsg,
+			OccurredAt:        parseDirectMailTime(r.ActionTime),
+		}
+		if r.Status == "0" {
+			e.Type = EventTypeDelivered
+		} else {
+			e.Type = EventTypeBounced
+			e.BounceType = bounceTypeFromStatus(r.ErrCode, r.ErrMsg)
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// parseDirectMailTime 解析时间，支持 RFC 3339、北京时间的 "2006-01-02 15:04:05" 和 Unix 时间戳
+func parseDirectMailTime(v string) time.Time {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t
+	}
+	if t, err := time.ParseInLocation(time.DateTime, v, time.FixedZone("CST", 8*3600)); err == nil {
+		return t
+	}
+	if sec, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Unix(sec, 0)
+	}
+	return time.Now()
+}
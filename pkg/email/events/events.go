@@ -0,0 +1,178 @@
+// Package events 解析邮件服务商的投递状态回调
+//
+// SES、SendGrid 和阿里云邮件推送的回调格式各不相同，这里统一解析为 DeliveryEvent，
+// 业务方可以据此更新投递记录，或在硬退信后将地址标记为无效。
+//
+// 使用示例:
+//
+//	// 退信和投诉交给 bounce 处理，写入抑制列表
+//	mux.Handle("/webhooks/email/ses", bounce.NewWebhookHandler(processor, events.BounceParser(events.ParseSES)))
+//
+//	// 自行处理所有事件
+//	mux.Handle("/webhooks/email/sendgrid", events.NewHandler(events.ParseSendGrid,
+//	    func(ctx context.Context, evts []*events.DeliveryEvent) error {
+//	        return deliveryRepo.Save(ctx, evts)
+//	    }))
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/heyinLab/common/pkg/email/bounce"
+)
+
+// EventType 投递事件类型
+type EventType string
+
+const (
+	EventTypeDelivered EventType = "delivered" // 已投递到收件服务器
+	EventTypeDeferred  EventType = "deferred"  // 暂时投递失败，服务商会继续重试
+	EventTypeBounced   EventType = "bounced"   // 退信
+	EventTypeComplaint EventType = "complaint" // 收件人投诉（标记为垃圾邮件）
+	EventTypeDropped   EventType = "dropped"   // 服务商未发送，如地址在服务商的抑制列表中
+)
+
+// BounceType 退信类型
+type BounceType string
+
+const (
+	BounceTypeHard BounceType = "hard" // 永久性失败，不应再向该地址发送
+	BounceTypeSoft BounceType = "soft" // 暂时性失败
+)
+
+// DeliveryEvent 统一的投递事件
+type DeliveryEvent struct {
+	Type              EventType  `json:"type"`                  // 事件类型
+	BounceType        BounceType `json:"bounce_type,omitempty"` // 退信类型，仅退信事件有值
+	Recipient         string     `json:"recipient"`             // 收件人地址
+	Provider          string     `json:"provider"`              // 服务商: ses/sendgrid/directmail
+	ProviderMessageID string     `json:"provider_message_id"`   // 服务商消息ID，对应 SendResult.ProviderMessageID
+	Status            string     `json:"status,omitempty"`      // DSN 状态码或 SMTP 应答码，如 5.1.1
+	Diagnostic        string     `json:"diagnostic,omitempty"`  // 服务器返回的诊断信息
+	OccurredAt        time.Time  `json:"occurred_at"`           // 事件发生时间
+}
+
+// IsHardBounce 是否为硬退信
+func (e *DeliveryEvent) IsHardBounce() bool {
+	return e.Type == EventTypeBounced && e.BounceType == BounceTypeHard
+}
+
+// BounceEvent 转换为退信事件，投递成功转换为 bounce.EventTypeDelivered 用于清除软退信计数，
+// 其余与退信无关的事件返回 nil
+func (e *DeliveryEvent) BounceEvent() *bounce.Event {
+	var t bounce.EventType
+	switch {
+	case e.Type == EventTypeDelivered:
+		t = bounce.EventTypeDelivered
+	case e.Type == EventTypeComplaint:
+		t = bounce.EventTypeComplaint
+	case e.IsHardBounce():
+		t = bounce.EventTypeHardBounce
+	case e.Type == EventTypeBounced:
+		t = bounce.EventTypeSoftBounce
+	default:
+		return nil
+	}
+	return &bounce.Event{
+		Type:       t,
+		Recipient:  e.Recipient,
+		Status:     e.Status,
+		Diagnostic: e.Diagnostic,
+		Source:     e.Provider,
+		MessageID:  e.ProviderMessageID,
+		OccurredAt: e.OccurredAt,
+	}
+}
+
+// Parser 将回调请求体解析为投递事件
+type Parser func(body []byte) ([]*DeliveryEvent, error)
+
+// ErrIgnored 回调不包含投递事件，如 SNS 订阅确认，处理器直接返回 200
+var ErrIgnored = errors.New("callback contains no delivery events")
+
+// maxBodySize 回调请求体大小上限
+const maxBodySize = 1 << 20
+
+// NewHandler 创建接收投递回调的 HTTP 处理器
+//
+// 解析失败返回 400，处理失败返回 500，以便服务商按其策略重试。
+// 签名校验等鉴权需要由外层中间件完成。
+func NewHandler(parser Parser, handle func(ctx context.Context, events []*DeliveryEvent) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+		if err != nil {
+			http.Error(w, "failed to read body", http.StatusBadRequest)
+			return
+		}
+
+		events, err := parser(body)
+		if errors.Is(err, ErrIgnored) {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if err := handle(r.Context(), events); err != nil {
+			http.Error(w, "failed to process events", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// BounceParser 将 Parser 适配为 bounce.WebhookParser，只保留退信、投诉和投递成功事件
+func BounceParser(parser Parser) bounce.WebhookParser {
+	return func(r *http.Request) ([]*bounce.Event, error) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("read body: %w", err)
+		}
+
+		events, err := parser(body)
+		if errors.Is(err, ErrIgnored) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var result []*bounce.Event
+		for _, e := range events {
+			if b := e.BounceEvent(); b != nil {
+				result = append(result, b)
+			}
+		}
+		return result, nil
+	}
+}
+
+// bounceTypeFromStatus 根据状态码判断退信类型，规则见 bounce.Classify
+func bounceTypeFromStatus(status, diagnostic string) BounceType {
+	if bounce.Classify(status, diagnostic) == bounce.EventTypeHardBounce {
+		return BounceTypeHard
+	}
+	return BounceTypeSoft
+}
+
+// normalizeAddress 去掉地址两侧的空白和尖括号，并转为小写
+func normalizeAddress(addr string) string {
+	addr = strings.TrimSpace(addr)
+	addr = strings.TrimPrefix(addr, "<")
+	addr = strings.TrimSuffix(addr, ">")
+	return strings.ToLower(addr)
+}
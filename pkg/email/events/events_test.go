@@ -0,0 +1,121 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/heyinLab/common/pkg/email/bounce"
+	"github.com/stretchr/testify/assert"
+)
+
+const sesBounce = `{
+  "notificationType": "Bounce",
+  "mail": {"messageId": "0100018a-ses", "timestamp": "2024-05-01T08:00:00.000Z"},
+  "bounce": {
+    "bounceType": "Permanent",
+    "timestamp": "2024-05-01T08:00:05.000Z",
+    "bouncedRecipients": [{"emailAddress": "Gone@Example.com", "status": "5.1.1", "diagnosticCode": "smtp; 550 5.1.1 user unknown"}]
+  }
+}`
+
+func TestParseSES(t *testing.T) {
+	// SNS 包装的消息
+	envelope, _ := json.Marshal(map[string]string{"Type": "Notification", "Message": sesBounce})
+	events, err := ParseSES(envelope)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	e := events[0]
+	assert.True(t, e.IsHardBounce())
+	assert.Equal(t, "gone@example.com", e.Recipient)
+	assert.Equal(t, "0100018a-ses", e.ProviderMessageID)
+	assert.Equal(t, "5.1.1", e.Status)
+	assert.Equal(t, time.Date(2024, 5, 1, 8, 0, 5, 0, time.UTC), e.OccurredAt)
+
+	// 原始消息
+	events, err = ParseSES([]byte(`{"eventType":"Complaint","mail":{"messageId":"m1"},"complaint":{"complaintFeedbackType":"abuse","complainedRecipients":[{"emailAddress":"a@example.com"}]}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, EventTypeComplaint, events[0].Type)
+
+	// 订阅确认
+	_, err = ParseSES([]byte(`{"Type":"SubscriptionConfirmation","SubscribeURL":"https://sns.example.com"}`))
+	assert.ErrorIs(t, err, ErrIgnored)
+}
+
+func TestParseSendGrid(t *testing.T) {
+	events, err := ParseSendGrid([]byte(`[
+	  {"email":"a@example.com","event":"delivered","timestamp":1714550400,"sg_message_id":"abc123.filter0001.1.0","response":"250 OK"},
+	  {"email":"b@example.com","event":"bounce","type":"bounce","status":"5.1.1","reason":"550 user unknown","timestamp":1714550400,"sg_message_id":"abc123.filter0001.2.0"},
+	  {"email":"c@example.com","event":"bounce","type":"blocked","status":"5.7.1","reason":"blocked","timestamp":1714550400},
+	  {"email":"d@example.com","event":"spamreport","timestamp":1714550400},
+	  {"email":"e@example.com","event":"open","timestamp":1714550400}
+	]`))
+	assert.NoError(t, err)
+	assert.Len(t, events, 4)
+
+	assert.Equal(t, EventTypeDelivered, events[0].Type)
+	assert.Equal(t, "abc123", events[0].ProviderMessageID)
+	assert.True(t, events[1].IsHardBounce())
+	assert.Equal(t, BounceTypeSoft, events[2].BounceType)
+	assert.Equal(t, EventTypeComplaint, events[3].Type)
+}
+
+func TestParseDirectMail(t *testing.T) {
+	events, err := ParseDirectMail([]byte(`[
+	  {"env_id":"6000001","rcpt":"a@example.com","status":"0","action_time":"2024-05-01 16:00:00"},
+	  {"env_id":"6000002","rcpt":"b@example.com","status":"1","err_code":"550","err_msg":"mailbox not found"},
+	  {"env_id":"6000003","rcpt":"c@example.com","status":"1","err_code":"452","err_msg":"mailbox full"}
+	]`))
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+
+	assert.Equal(t, EventTypeDelivered, events[0].Type)
+	assert.Equal(t, time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC), events[0].OccurredAt.UTC())
+	assert.True(t, events[1].IsHardBounce())
+	assert.Equal(t, "6000002", events[1].ProviderMessageID)
+	assert.Equal(t, BounceTypeSoft, events[2].BounceType)
+}
+
+func TestNewHandler(t *testing.T) {
+	var got []*DeliveryEvent
+	handler := NewHandler(ParseSES, func(_ context.Context, events []*DeliveryEvent) error {
+		got = events
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(sesBounce)))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Len(t, got, 1)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"Type":"SubscriptionConfirmation"}`)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`not json`)))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestBounceParser(t *testing.T) {
+	store := bounce.NewMemoryStore()
+	handler := bounce.NewWebhookHandler(bounce.NewProcessor(store), BounceParser(ParseSendGrid))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`[
+	  {"email":"a@example.com","event":"delivered","timestamp":1714550400},
+	  {"email":"b@example.com","event":"bounce","type":"bounce","status":"5.1.1","timestamp":1714550400}
+	]`)))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	suppressed, err := store.IsSuppressed(context.Background(), "b@example.com")
+	assert.NoError(t, err)
+	assert.True(t, suppressed)
+	suppressed, err = store.IsSuppressed(context.Background(), "a@example.com")
+	assert.NoError(t, err)
+	assert.False(t, suppressed)
+}
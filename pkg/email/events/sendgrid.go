@@ -0,0 +1,78 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// sendGridEvent SendGrid Event Webhook 中的单个事件
+type sendGridEvent struct {
+	Email       string `json:"email"`
+	Event       string `json:"event"`
+	Type        string `json:"type"` // bounce 事件: bounce 或 blocked
+	Status      string `json:"status"`
+	Reason      string `json:"reason"`
+	Response    string `json:"response"`
+	Timestamp   int64  `json:"timestamp"`
+	SGMessageID string `json:"sg_message_id"`
+}
+
+// ParseSendGrid 解析 SendGrid Event Webhook 推送的事件数组
+//
+// 打开、点击、退订等非投递事件会被忽略。
+// ProviderMessageID 取 sg_message_id 第一个点之前的部分，与发送时响应头 X-Message-Id 一致。
+// 注意: 这里不校验 Signed Event Webhook 签名，需要在外层校验。
+func ParseSendGrid(body []byte) ([]*DeliveryEvent, error) {
+	var raw []sendGridEvent
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("decode sendgrid events: %w", err)
+	}
+
+	var events []*DeliveryEvent
+	for _, r := range raw {
+		e := &DeliveryEvent{
+			Recipient:         normalizeAddress(r.Email),
+			Provider:          "sendgrid",
+			ProviderMessageID: sendGridMessageID(r.SGMessageID),
+			Status:            r.Status,
+			OccurredAt:        time.Unix(r.Timestamp, 0),
+		}
+
+		switch r.Event {
+		case "delivered":
+			e.Type = EventTypeDelivered
+			e.Diagnostic = r.Response
+		case "deferred":
+			e.Type = EventTypeDeferred
+			e.Diagnostic = r.Response
+		case "bounce":
+			e.Type = EventTypeBounced
+			e.Diagnostic = r.Reason
+			// blocked 通常是对方服务器临时拒收（如内容或 IP 信誉），按软退信处理
+			if r.Type == "blocked" {
+				e.BounceType = BounceTypeSoft
+			} else {
+				e.BounceType = bounceTypeFromStatus(r.Status, r.Reason)
+			}
+		case "dropped":
+			e.Type = EventTypeDropped
+			e.Diagnostic = r.Reason
+		case "spamreport":
+			e.Type = EventTypeComplaint
+		default:
+			continue
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// sendGridMessageID 从 sg_message_id 中取出 X-Message-Id 部分
+func sendGridMessageID(id string) string {
+	if i := strings.Index(id, "."); i >= 0 {
+		return id[:i]
+	}
+	return id
+}
@@ -0,0 +1,126 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// snsMessage SNS 推送的消息外层
+type snsMessage struct {
+	Type    string `json:"Type"`
+	Message string `json:"Message"`
+}
+
+// sesNotification SES 通知（Notification 或 Event Publishing 格式）
+type sesNotification struct {
+	NotificationType string `json:"notificationType"`
+	EventType        string `json:"eventType"`
+	Mail             struct {
+		MessageID string `json:"messageId"`
+		Timestamp string `json:"timestamp"`
+	} `json:"mail"`
+	Bounce struct {
+		BounceType        string `json:"bounceType"`
+		Timestamp         string `json:"timestamp"`
+		BouncedRecipients []struct {
+			EmailAddress   string `json:"emailAddress"`
+			Status         string `json:"status"`
+			DiagnosticCode string `json:"diagnosticCode"`
+		} `json:"bouncedRecipients"`
+	} `json:"bounce"`
+	Complaint struct {
+		Timestamp             string `json:"timestamp"`
+		ComplaintFeedbackType string `json:"complaintFeedbackType"`
+		ComplainedRecipients  []struct {
+			EmailAddress string `json:"emailAddress"`
+		} `json:"complainedRecipients"`
+	} `json:"complaint"`
+	Delivery struct {
+		Timestamp    string   `json:"timestamp"`
+		Recipients   []string `json:"recipients"`
+		SMTPResponse string   `json:"smtpResponse"`
+	} `json:"delivery"`
+}
+
+// ParseSES 解析 AWS SES 通过 SNS 推送的通知
+//
+// 支持 SNS 包装的消息和原始消息（SNS raw message delivery），
+// SNS 订阅确认等非通知消息返回 ErrIgnored。
+// 注意: 这里不校验 SNS 签名，需要在外层校验或限制来源。
+func ParseSES(body []byte) ([]*DeliveryEvent, error) {
+	var envelope snsMessage
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("decode ses notification: %w", err)
+	}
+	switch envelope.Type {
+	case "Notification":
+		body = []byte(envelope.Message)
+	case "":
+		// 原始消息
+	default:
+		return nil, ErrIgnored
+	}
+
+	var n sesNotification
+	if err := json.Unmarshal(body, &n); err != nil {
+		return nil, fmt.Errorf("decode ses notification: %w", err)
+	}
+
+	kind := n.NotificationType
+	if kind == "" {
+		kind = n.EventType
+	}
+
+	newEvent := func(t EventType, recipient, timestamp string) *DeliveryEvent {
+		return &DeliveryEvent{
+			Type:              t,
+			Recipient:         normalizeAddress(recipient),
+			Provider:          "ses",
+			ProviderMessageID: n.Mail.MessageID,
+			OccurredAt:        parseSESTime(timestamp, n.Mail.Timestamp),
+		}
+	}
+
+	var events []*DeliveryEvent
+	switch kind {
+	case "Bounce":
+		bounceType := BounceTypeSoft
+		if n.Bounce.BounceType == "Permanent" {
+			bounceType = BounceTypeHard
+		}
+		for _, r := range n.Bounce.BouncedRecipients {
+			e := newEvent(EventTypeBounced, r.EmailAddress, n.Bounce.Timestamp)
+			e.BounceType = bounceType
+			e.Status = r.Status
+			e.Diagnostic = r.DiagnosticCode
+			events = append(events, e)
+		}
+	case "Complaint":
+		for _, r := range n.Complaint.ComplainedRecipients {
+			e := newEvent(EventTypeComplaint, r.EmailAddress, n.Complaint.Timestamp)
+			e.Diagnostic = n.Complaint.ComplaintFeedbackType
+			events = append(events, e)
+		}
+	case "Delivery":
+		for _, r := range n.Delivery.Recipients {
+			e := newEvent(EventTypeDelivered, r, n.Delivery.Timestamp)
+			e.Diagnostic = n.Delivery.SMTPResponse
+			events = append(events, e)
+		}
+	default:
+		// 打开、点击等事件不属于投递状态
+		return nil, ErrIgnored
+	}
+	return events, nil
+}
+
+// parseSESTime 解析 SES 的 ISO 8601 时间，失败时使用备选时间
+func parseSESTime(values ...string) time.Time {
+	for _, v := range values {
+		if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			return t
+		}
+	}
+	return time.Now()
+}
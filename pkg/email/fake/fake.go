@@ -0,0 +1,221 @@
+// Package fake 提供 email.SenderInterface 的内存实现，用于业务代码的单元测试
+//
+// fake.Sender 不渲染模板也不发送邮件，只记录每次调用的邮件类型、收件人和模板变量，
+// 测试中可以直接断言业务流程发送了哪些邮件。
+//
+// 使用示例:
+//
+//	mailer := fake.NewSender()
+//	svc := user.NewService(repo, mailer)
+//
+//	err := svc.ForgotPassword(ctx, "user@example.com")
+//	assert.NoError(t, err)
+//
+//	last := mailer.Last()
+//	assert.Equal(t, email.EmailTypePasswordReset, last.Type)
+//	assert.Equal(t, []string{"user@example.com"}, last.To)
+//	assert.Contains(t, last.Data["ResetLink"], "token=")
+package fake
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/heyinLab/common/pkg/email"
+)
+
+// ProviderFake fake.Sender 返回的 SendResult.Provider
+const ProviderFake email.ProviderType = "fake"
+
+// Message 记录的一次发送
+type Message struct {
+	Type  email.EmailType        // 邮件类型，SendEmail 发送时为空
+	To    []string               // 收件人
+	Data  map[string]interface{} // 模板变量，与内置模板中的变量同名
+	Email *email.EmailData       // SendEmail 发送的邮件，模板邮件为 nil
+}
+
+// Sender 记录发送请求的 email.SenderInterface 实现，可以并发使用
+type Sender struct {
+	mu       sync.Mutex
+	messages []*Message
+	err      error
+}
+
+var _ email.SenderInterface = (*Sender)(nil)
+
+// NewSender 创建内存发送器
+func NewSender() *Sender {
+	return &Sender{}
+}
+
+// SetError 设置之后所有发送返回的错误，用于测试发送失败的分支；nil 表示恢复正常
+//
+// 返回错误的发送不会被记录
+func (s *Sender) SetError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+// Messages 返回已记录的所有邮件
+func (s *Sender) Messages() []*Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Message(nil), s.messages...)
+}
+
+// Last 返回最后一封记录的邮件，没有时返回 nil
+func (s *Sender) Last() *Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.messages) == 0 {
+		return nil
+	}
+	return s.messages[len(s.messages)-1]
+}
+
+// ByType 返回指定类型的邮件
+func (s *Sender) ByType(emailType email.EmailType) []*Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var messages []*Message
+	for _, m := range s.messages {
+		if m.Type == emailType {
+			messages = append(messages, m)
+		}
+	}
+	return messages
+}
+
+// Reset 清空已记录的邮件和设置的错误
+func (s *Sender) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = nil
+	s.err = nil
+}
+
+// record 记录一次发送
+func (s *Sender) record(m *Message) (*email.SendResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.messages = append(s.messages, m)
+	return &email.SendResult{
+		MessageID: "fake-" + strconv.Itoa(len(s.messages)),
+		Provider:  ProviderFake,
+		Accepted:  append([]string(nil), m.To...),
+	}, nil
+}
+
+// recordData 记录一封模板邮件
+func (s *Sender) recordData(to []string, data email.TemplateData) (*email.SendResult, error) {
+	return s.record(&Message{Type: data.EmailType(), To: to, Data: data.Values()})
+}
+
+// SendEmail 记录邮件
+func (s *Sender) SendEmail(_ context.Context, data *email.EmailData) (*email.SendResult, error) {
+	copied := *data
+	return s.record(&Message{To: copied.Recipients(), Email: &copied})
+}
+
+// SendTemplateEmail 记录自定义类型邮件
+func (s *Sender) SendTemplateEmail(_ context.Context, emailType email.EmailType, to []string, data map[string]interface{}) (*email.SendResult, error) {
+	values := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		values[k] = v
+	}
+	return s.record(&Message{Type: emailType, To: append([]string(nil), to...), Data: values})
+}
+
+// SendTemplateData 记录内置模板邮件
+func (s *Sender) SendTemplateData(_ context.Context, to []string, data email.TemplateData) (*email.SendResult, error) {
+	return s.recordData(append([]string(nil), to...), data)
+}
+
+// SendTenantActivationEmail 记录租户激活邮件
+func (s *Sender) SendTenantActivationEmail(_ context.Context, to, userName, tenantName, activationLink, expireTime string) (*email.SendResult, error) {
+	return s.recordData([]string{to}, email.TenantActivationData{
+		UserName:       userName,
+		TenantName:     tenantName,
+		ActivationLink: activationLink,
+		ExpireTime:     expireTime,
+	})
+}
+
+// SendInvitationEmail 记录邀请邮件
+func (s *Sender) SendInvitationEmail(_ context.Context, to, userName, tenantName, departmentName, roleName, inviterName, inviteTime, acceptLink, declineLink, expireTime string) (*email.SendResult, error) {
+	return s.recordData([]string{to}, email.InvitationData{
+		UserName:       userName,
+		TenantName:     tenantName,
+		DepartmentName: departmentName,
+		RoleName:       roleName,
+		InviterName:    inviterName,
+		InviteTime:     inviteTime,
+		AcceptLink:     acceptLink,
+		DeclineLink:    declineLink,
+		ExpireTime:     expireTime,
+	})
+}
+
+// SendPasswordResetEmail 记录密码重置邮件
+func (s *Sender) SendPasswordResetEmail(_ context.Context, to, userName, resetLink, expireTime string) (*email.SendResult, error) {
+	return s.recordData([]string{to}, email.PasswordResetData{
+		UserName:   userName,
+		ResetLink:  resetLink,
+		ExpireTime: expireTime,
+	})
+}
+
+// SendVerificationCodeEmail 记录验证码邮件，ExpireTime 为 ttl 的字符串形式，如 "10m0s"
+func (s *Sender) SendVerificationCodeEmail(_ context.Context, to, code string, ttl time.Duration) (*email.SendResult, error) {
+	return s.recordData([]string{to}, email.VerificationCodeData{
+		Code:       code,
+		ExpireTime: ttl.String(),
+	})
+}
+
+// SendMFACodeEmail 记录登录二次验证邮件，ExpireTime 为 ttl 的字符串形式
+func (s *Sender) SendMFACodeEmail(_ context.Context, to, userName, tenantName, code string, ttl time.Duration) (*email.SendResult, error) {
+	return s.recordData([]string{to}, email.MFACodeData{
+		UserName:   userName,
+		TenantName: tenantName,
+		Code:       code,
+		ExpireTime: ttl.String(),
+	})
+}
+
+// SendSecurityAlertEmail 记录异常登录提醒邮件
+func (s *Sender) SendSecurityAlertEmail(_ context.Context, to, userName, tenantName, loginTime, ipAddress, location, device, secureLink string) (*email.SendResult, error) {
+	return s.recordData([]string{to}, email.SecurityAlertData{
+		UserName:   userName,
+		TenantName: tenantName,
+		LoginTime:  loginTime,
+		IPAddress:  ipAddress,
+		Location:   location,
+		Device:     device,
+		SecureLink: secureLink,
+	})
+}
+
+// SendQuotaWarningEmail 记录存储空间预警邮件
+//
+// 不做容量格式化，Data 中记录原始参数 UsedBytes、TotalBytes 和 ManageLink
+func (s *Sender) SendQuotaWarningEmail(_ context.Context, to, userName, tenantName string, usedBytes, totalBytes int64, manageLink string) (*email.SendResult, error) {
+	return s.record(&Message{
+		Type: email.EmailTypeQuotaWarning,
+		To:   []string{to},
+		Data: map[string]interface{}{
+			"UserName":   userName,
+			"TenantName": tenantName,
+			"UsedBytes":  usedBytes,
+			"TotalBytes": totalBytes,
+			"ManageLink": manageLink,
+		},
+	})
+}
@@ -0,0 +1,50 @@
+package fake
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/heyinLab/common/pkg/email"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSender_Record(t *testing.T) {
+	ctx := context.Background()
+	var mailer email.SenderInterface = NewSender()
+	s := mailer.(*Sender)
+
+	result, err := mailer.SendPasswordResetEmail(ctx, "user@example.com", "张三", "https://example.com/reset?token=abc", "1小时")
+	assert.NoError(t, err)
+	assert.Equal(t, ProviderFake, result.Provider)
+	assert.Equal(t, []string{"user@example.com"}, result.Accepted)
+
+	_, err = mailer.SendVerificationCodeEmail(ctx, "user@example.com", "042917", 10*time.Minute)
+	assert.NoError(t, err)
+	_, err = mailer.SendEmail(ctx, email.NewEmailData("ops@example.com", "报警", "<p>磁盘已满</p>"))
+	assert.NoError(t, err)
+
+	assert.Len(t, s.Messages(), 3)
+	reset := s.ByType(email.EmailTypePasswordReset)
+	assert.Len(t, reset, 1)
+	assert.Equal(t, "https://example.com/reset?token=abc", reset[0].Data["ResetLink"])
+
+	last := s.Last()
+	assert.Empty(t, last.Type)
+	assert.Equal(t, "报警", last.Email.Subject)
+}
+
+func TestSender_SetError(t *testing.T) {
+	s := NewSender()
+	s.SetError(errors.New("smtp down"))
+
+	_, err := s.SendInvitationEmail(context.Background(), "a@example.com", "李四", "示例科技", "研发部", "", "", "", "https://example.com/accept", "", "7天")
+	assert.EqualError(t, err, "smtp down")
+	assert.Nil(t, s.Last())
+
+	s.Reset()
+	_, err = s.SendTemplateData(context.Background(), []string{"a@example.com"}, email.MFACodeData{Code: "123456"})
+	assert.NoError(t, err)
+	assert.Equal(t, email.EmailTypeMFACode, s.Last().Type)
+}
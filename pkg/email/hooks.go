@@ -0,0 +1,64 @@
+package email
+
+import "errors"
+
+// ErrNoRecipients 发送前钩子或沙箱模式移除了所有收件人，邮件没有发送
+var ErrNoRecipients = errors.New("no recipients left after filtering")
+
+// BeforeSendHook 发送前钩子
+//
+// 收到的是 Sender 复制的数据，可以直接修改，如移除退订的收件人、添加邮件头。
+// To/Cc/Bcc 全部被清空时 SendEmail 返回 ErrNoRecipients
+type BeforeSendHook func(data *EmailData)
+
+// AfterSendHook 发送后钩子，发送成功时 err 为 nil，失败时 result 为 nil
+type AfterSendHook func(data *EmailData, result *SendResult, err error)
+
+// hooks 已注册的钩子
+type hooks struct {
+	before []BeforeSendHook
+	after  []AfterSendHook
+}
+
+// OnBeforeSend 注册发送前钩子，按注册顺序在每封邮件发送前调用
+//
+// 钩子在速率限制和熔断检查之前执行，MessageID 已生成。
+// 需要在开始发送前完成注册，发送过程中注册不是并发安全的。
+//
+// 使用示例:
+//
+//	sender.OnBeforeSend(func(data *email.EmailData) {
+//	    data.To = suppression.Filter(data.To)
+//	})
+func (s *Sender) OnBeforeSend(hook BeforeSendHook) {
+	s.hooks.before = append(s.hooks.before, hook)
+}
+
+// OnAfterSend 注册发送后钩子，按注册顺序在每封邮件发送结束后调用，用于写审计记录等
+//
+// 发送前钩子执行后的所有结果都会触发，包括 ErrNoRecipients、熔断和速率限制等错误。
+// 与 OnBeforeSend 相同，需要在开始发送前完成注册。
+//
+// 使用示例:
+//
+//	sender.OnAfterSend(func(data *email.EmailData, result *email.SendResult, err error) {
+//	    audit.Record(ctx, data.MessageID, data.To, err)
+//	})
+func (s *Sender) OnAfterSend(hook AfterSendHook) {
+	s.hooks.after = append(s.hooks.after, hook)
+}
+
+// cloneEmailData 复制邮件数据，收件人列表和邮件头也会复制，钩子修改时不影响调用方
+//
+// Headers 总是非 nil，钩子可以直接添加邮件头
+func cloneEmailData(data *EmailData) *EmailData {
+	d := *data
+	d.To = append([]string(nil), data.To...)
+	d.Cc = append([]string(nil), data.Cc...)
+	d.Bcc = append([]string(nil), data.Bcc...)
+	d.Headers = make(map[string]string, len(data.Headers))
+	for k, v := range data.Headers {
+		d.Headers[k] = v
+	}
+	return &d
+}
@@ -0,0 +1,61 @@
+package email
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSender_Hooks(t *testing.T) {
+	provider := &fakeProvider{}
+	sender := NewSenderWithProvider(&Config{}, provider)
+
+	sender.OnBeforeSend(func(data *EmailData) {
+		// 移除被屏蔽的收件人
+		to := data.To[:0]
+		for _, addr := range data.To {
+			if addr != "blocked@example.com" {
+				to = append(to, addr)
+			}
+		}
+		data.To = to
+		data.Headers["X-Audit"] = "1"
+	})
+
+	type record struct {
+		messageID string
+		to        []string
+		result    *SendResult
+		err       error
+	}
+	var records []record
+	sender.OnAfterSend(func(data *EmailData, result *SendResult, err error) {
+		records = append(records, record{data.MessageID, data.To, result, err})
+	})
+
+	data := &EmailData{To: []string{"a@example.com", "blocked@example.com"}, Subject: "hi", Headers: map[string]string{}}
+	result, err := sender.SendEmail(context.Background(), data)
+	assert.NoError(t, err)
+
+	// 调用方的数据不受影响
+	assert.Equal(t, []string{"a@example.com", "blocked@example.com"}, data.To)
+	assert.Empty(t, data.Headers)
+	assert.Empty(t, data.MessageID)
+
+	assert.Len(t, provider.sent, 1)
+	assert.Equal(t, []string{"a@example.com"}, provider.sent[0].To)
+	assert.Equal(t, "1", provider.sent[0].Headers["X-Audit"])
+
+	assert.Len(t, records, 1)
+	assert.Equal(t, result.MessageID, records[0].messageID)
+	assert.Same(t, result, records[0].result)
+
+	// 所有收件人都被移除
+	_, err = sender.SendEmail(context.Background(), NewEmailData("blocked@example.com", "hi", ""))
+	assert.ErrorIs(t, err, ErrNoRecipients)
+	assert.Len(t, provider.sent, 1)
+	assert.Len(t, records, 2)
+	assert.Nil(t, records[1].result)
+	assert.ErrorIs(t, records[1].err, ErrNoRecipients)
+}
@@ -0,0 +1,66 @@
+package email
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	// htmlInvisiblePattern 不可见的元素，整体删除
+	htmlInvisiblePattern = regexp.MustCompile(`(?is)<(?:head|style|script|title)\b.*?</(?:head|style|script|title)\s*>|<!--.*?-->`)
+	// htmlLinkPattern 链接，转换为 "文字 (地址)"
+	htmlLinkPattern = regexp.MustCompile(`(?is)<a\s[^>]*?href\s*=\s*["']([^"']*)["'][^>]*>(.*?)</a\s*>`)
+	// htmlLineBreakPattern 换行
+	htmlLineBreakPattern = regexp.MustCompile(`(?i)<br\s*/?>`)
+	// htmlBlockEndPattern 块级元素结束，转换为空行
+	htmlBlockEndPattern = regexp.MustCompile(`(?i)</(?:p|div|h[1-6]|table|ul|ol|blockquote|pre)\s*>|<hr\b[^>]*>`)
+	// htmlRowEndPattern 表格行结束，转换为换行
+	htmlRowEndPattern = regexp.MustCompile(`(?i)</tr\s*>`)
+	// htmlListItemPattern 列表项
+	htmlListItemPattern = regexp.MustCompile(`(?i)<li\b[^>]*>`)
+	// htmlTagPattern 其余标签
+	htmlTagPattern = regexp.MustCompile(`(?s)<[^>]*>`)
+
+	whitespacePattern = regexp.MustCompile(`\s+`)
+	blankLinesPattern = regexp.MustCompile(`\n{3,}`)
+)
+
+// htmlToText 将 HTML 正文转换为可读的纯文本，用于 multipart/alternative 的 text/plain 部分
+//
+// 只处理邮件模板中常见的结构：段落、换行、列表、表格和链接，
+// 链接保留地址，便于纯文本客户端的用户复制打开
+func htmlToText(body string) string {
+	s := htmlInvisiblePattern.ReplaceAllString(body, "")
+	// 源码中的换行和缩进在 HTML 中只是空白
+	s = whitespacePattern.ReplaceAllString(s, " ")
+
+	s = htmlLinkPattern.ReplaceAllStringFunc(s, func(m string) string {
+		sub := htmlLinkPattern.FindStringSubmatch(m)
+		href := html.UnescapeString(sub[1])
+		text := strings.TrimSpace(htmlTagPattern.ReplaceAllString(sub[2], ""))
+		switch {
+		case href == "" || strings.HasPrefix(href, "#"):
+			return text
+		case text == "" || html.UnescapeString(text) == href:
+			return href
+		default:
+			return text + " (" + href + ")"
+		}
+	})
+	s = htmlLineBreakPattern.ReplaceAllString(s, "\n")
+	s = htmlBlockEndPattern.ReplaceAllString(s, "\n\n")
+	s = htmlRowEndPattern.ReplaceAllString(s, "\n")
+	s = htmlListItemPattern.ReplaceAllString(s, "\n- ")
+	s = htmlTagPattern.ReplaceAllString(s, " ")
+	s = html.UnescapeString(s)
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		// strings.Fields 同时处理 &nbsp; 解码后的不换行空格
+		lines[i] = strings.Join(strings.Fields(line), " ")
+	}
+	s = strings.Join(lines, "\n")
+	s = blankLinesPattern.ReplaceAllString(s, "\n\n")
+	return strings.TrimSpace(s)
+}
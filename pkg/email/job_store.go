@@ -0,0 +1,157 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrDuplicateJob 相同幂等键的任务已经入队
+var ErrDuplicateJob = errors.New("email job with the same key already enqueued")
+
+// Job 持久化队列中的发送任务
+type Job struct {
+	ID         string     `json:"id"`          // 任务ID，由存储生成
+	Key        string     `json:"key"`         // 幂等键，为空表示不去重
+	Data       *EmailData `json:"data"`        // 邮件数据，附件需要使用 Content，Reader 不会被保存
+	TenantID   uint32     `json:"tenant_id"`   // 入队时上下文中的租户ID，发送时恢复
+	Attempts   int        `json:"attempts"`    // 已重试次数
+	EnqueuedAt time.Time  `json:"enqueued_at"` // 入队时间
+}
+
+// JobStore 持久化队列的存储
+//
+// 任务被 Claim 后在 visibility 时间内对其他消费者不可见，
+// 消费者崩溃时任务在超时后重新可见，由其他消费者接管。实现需要保证并发安全。
+type JobStore interface {
+	// Push 保存任务，Key 非空且在去重窗口内已存在时返回 ErrDuplicateJob
+	Push(ctx context.Context, job *Job) error
+	// Claim 取出一个可见的任务，没有任务时返回 nil
+	Claim(ctx context.Context, visibility time.Duration) (*Job, error)
+	// Retry 保存任务的最新状态，delay 后重新可见
+	Retry(ctx context.Context, job *Job, delay time.Duration) error
+	// Ack 删除已完成（发送成功或最终失败）的任务，幂等键保留到去重窗口结束
+	Ack(ctx context.Context, job *Job) error
+}
+
+// MemoryJobStore 基于内存的任务存储，进程重启后任务丢失，适用于测试和单实例部署
+type MemoryJobStore struct {
+	dedupTTL time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	seq     uint64
+	jobs    map[string]*memoryJob
+	keys    map[string]time.Time // 幂等键及其过期时间
+	ordered []string             // 按入队顺序排列的任务ID
+}
+
+// memoryJob 任务及其可见时间
+type memoryJob struct {
+	job       Job
+	visibleAt time.Time
+}
+
+// NewMemoryJobStore 创建内存任务存储，dedupTTL 为幂等键的去重窗口，默认 24 小时
+func NewMemoryJobStore(dedupTTL time.Duration) *MemoryJobStore {
+	if dedupTTL <= 0 {
+		dedupTTL = 24 * time.Hour
+	}
+	return &MemoryJobStore{
+		dedupTTL: dedupTTL,
+		now:      time.Now,
+		jobs:     make(map[string]*memoryJob),
+		keys:     make(map[string]time.Time),
+	}
+}
+
+// Push 保存任务
+func (s *MemoryJobStore) Push(_ context.Context, job *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if job.Key != "" {
+		if expireAt, ok := s.keys[job.Key]; ok && now.Before(expireAt) {
+			return ErrDuplicateJob
+		}
+		s.keys[job.Key] = now.Add(s.dedupTTL)
+	}
+
+	s.seq++
+	job.ID = strconv.FormatUint(s.seq, 10)
+	s.jobs[job.ID] = &memoryJob{job: *job, visibleAt: now}
+	s.ordered = append(s.ordered, job.ID)
+	return nil
+}
+
+// Claim 按可见时间和入队顺序取出任务
+func (s *MemoryJobStore) Claim(_ context.Context, visibility time.Duration) (*Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	var next *memoryJob
+	for _, id := range s.ordered {
+		j := s.jobs[id]
+		if j.visibleAt.After(now) {
+			continue
+		}
+		if next == nil || j.visibleAt.Before(next.visibleAt) {
+			next = j
+		}
+	}
+	if next == nil {
+		return nil, nil
+	}
+
+	next.visibleAt = now.Add(visibility)
+	job := next.job
+	return &job, nil
+}
+
+// Retry 更新任务状态并延迟可见
+func (s *MemoryJobStore) Retry(_ context.Context, job *Job, delay time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j, ok := s.jobs[job.ID]
+	if !ok {
+		return nil
+	}
+	j.job = *job
+	j.visibleAt = s.now().Add(delay)
+	return nil
+}
+
+// Ack 删除任务
+func (s *MemoryJobStore) Ack(_ context.Context, job *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.jobs, job.ID)
+	for i, id := range s.ordered {
+		if id == job.ID {
+			s.ordered = append(s.ordered[:i], s.ordered[i+1:]...)
+			break
+		}
+	}
+
+	// 顺便清理过期的幂等键
+	now := s.now()
+	for key, expireAt := range s.keys {
+		if !now.Before(expireAt) {
+			delete(s.keys, key)
+		}
+	}
+	return nil
+}
+
+// Len 返回未完成的任务数量，包括正在发送和等待重试的任务
+func (s *MemoryJobStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.jobs)
+}
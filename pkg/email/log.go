@@ -0,0 +1,65 @@
+package email
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// emailTypeKey 上下文中模板邮件类型的键，用于日志
+type emailTypeKey struct{}
+
+// newLogger 创建邮件模块的日志，logger 为空时使用 kratos 全局日志
+func newLogger(logger log.Logger) *log.Helper {
+	if logger == nil {
+		logger = log.GetLogger()
+	}
+	return log.NewHelper(log.With(logger, "module", "email"))
+}
+
+// logSend 记录一次发送的结果
+//
+// 只记录收件人的域名，避免在日志中留下完整的邮箱地址
+func (s *Sender) logSend(ctx context.Context, data *EmailData, result *SendResult, err error, duration time.Duration) {
+	emailType, _ := ctx.Value(emailTypeKey{}).(EmailType)
+	keyvals := []interface{}{
+		"email_type", string(emailType),
+		"message_id", data.MessageID,
+		"recipient_domain", recipientDomains(data.Recipients()),
+		"recipients", len(data.Recipients()),
+		"duration", duration,
+	}
+	if tenantID := TenantIDFromContext(ctx); tenantID != 0 {
+		keyvals = append(keyvals, "tenant_id", tenantID)
+	}
+
+	logger := s.logger.WithContext(ctx)
+	if err != nil {
+		logger.Errorw(append([]interface{}{log.DefaultMessageKey, "邮件发送失败", "result", "failed", "error", err.Error()}, keyvals...)...)
+		return
+	}
+	keyvals = append(keyvals, "provider", string(result.Provider))
+	if len(result.Rejected) > 0 {
+		keyvals = append(keyvals, "rejected", len(result.Rejected))
+	}
+	logger.Infow(append([]interface{}{log.DefaultMessageKey, "邮件发送成功", "result", "sent"}, keyvals...)...)
+}
+
+// recipientDomains 返回去重排序后的收件人域名，以逗号分隔
+func recipientDomains(addrs []string) string {
+	seen := make(map[string]struct{}, len(addrs))
+	domains := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		domain := addressDomain(addr)
+		if _, ok := seen[domain]; ok || domain == "" {
+			continue
+		}
+		seen[domain] = struct{}{}
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+	return strings.Join(domains, ",")
+}
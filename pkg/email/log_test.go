@@ -0,0 +1,62 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordLogger 记录日志的键值对
+type recordLogger struct {
+	mu      sync.Mutex
+	entries []map[string]interface{}
+	levels  []log.Level
+}
+
+func (l *recordLogger) Log(level log.Level, keyvals ...interface{}) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	entry := make(map[string]interface{})
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		entry[fmt.Sprint(keyvals[i])] = keyvals[i+1]
+	}
+	l.entries = append(l.entries, entry)
+	l.levels = append(l.levels, level)
+	return nil
+}
+
+func TestSender_Logging(t *testing.T) {
+	logger := &recordLogger{}
+	provider := &fakeProvider{errs: []error{nil, errors.New("relay down")}}
+	s := NewSenderWithProvider(&Config{Logger: logger}, provider)
+
+	ctx := WithTenantID(context.Background(), 7)
+	_, err := s.SendPasswordResetEmail(ctx, "User@Example.com", "张三", "https://example.com/reset", "1小时")
+	assert.NoError(t, err)
+
+	data := NewEmailData("a@b.example.com", "hi", "body")
+	data.Cc = []string{"c@example.com"}
+	_, err = s.SendEmail(context.Background(), data)
+	assert.Error(t, err)
+
+	assert.Len(t, logger.entries, 2)
+	sent := logger.entries[0]
+	assert.Equal(t, log.LevelInfo, logger.levels[0])
+	assert.Equal(t, "email", sent["module"])
+	assert.Equal(t, "password_reset", sent["email_type"])
+	assert.Equal(t, "example.com", sent["recipient_domain"])
+	assert.Equal(t, "sent", sent["result"])
+	assert.Equal(t, uint32(7), sent["tenant_id"])
+	assert.NotContains(t, fmt.Sprint(sent), "User@Example.com")
+
+	failed := logger.entries[1]
+	assert.Equal(t, log.LevelError, logger.levels[1])
+	assert.Equal(t, "", failed["email_type"])
+	assert.Equal(t, "b.example.com,example.com", failed["recipient_domain"])
+	assert.Equal(t, "relay down", failed["error"])
+}
@@ -0,0 +1,354 @@
+package email
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// base64LineLength base64 编码后每行的最大长度（RFC 2045）
+const base64LineLength = 76
+
+// buildMessage 构建邮件消息
+//
+// 正文为 multipart/alternative，包含 text/plain 和 text/html 两部分；
+// 有内嵌资源时与资源一起放在 multipart/related 中；有普通附件时外层再包一层 multipart/mixed。
+// 密送地址不会写入邮件头，只出现在 SMTP 信封中。
+// 主题和收件人中不允许出现换行符，非 ASCII 的主题使用 RFC 2047 编码。
+// data.From 不为空时覆盖渠道配置的发件人 from
+func buildMessage(from string, data *EmailData) ([]byte, error) {
+	if data.From != "" {
+		from = data.From
+	}
+	fromHeader, err := formatFrom(from)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.ContainsAny(data.Subject, "\r\n") {
+		return nil, fmt.Errorf("invalid subject: contains line break")
+	}
+	for _, addr := range append(append([]string{}, data.To...), data.Cc...) {
+		if strings.ContainsAny(addr, "\r\n") {
+			return nil, fmt.Errorf("invalid recipient address %q: contains line break", addr)
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", fromHeader)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(data.To, ", "))
+	if len(data.Cc) > 0 {
+		fmt.Fprintf(&buf, "Cc: %s\r\n", strings.Join(data.Cc, ", "))
+	}
+	if data.ReplyTo != "" {
+		if strings.ContainsAny(data.ReplyTo, "\r\n") {
+			return nil, fmt.Errorf("invalid reply-to address: contains line break")
+		}
+		fmt.Fprintf(&buf, "Reply-To: %s\r\n", data.ReplyTo)
+	}
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", data.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	if data.MessageID != "" {
+		fmt.Fprintf(&buf, "Message-ID: <%s>\r\n", data.MessageID)
+	}
+	if err := writeCustomHeaders(&buf, data.Headers); err != nil {
+		return nil, err
+	}
+	buf.WriteString("MIME-Version: 1.0\r\n")
+
+	header, body, err := buildBody(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var inline, attachments []*Attachment
+	for i := range data.Attachments {
+		if a := &data.Attachments[i]; a.Inline() {
+			inline = append(inline, a)
+		} else {
+			attachments = append(attachments, a)
+		}
+	}
+
+	if len(inline) > 0 {
+		header, body, err = buildRelated(header, body, inline)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(attachments) == 0 {
+		writeHeader(&buf, header)
+		buf.WriteString("\r\n")
+		buf.Write(body)
+		return buf.Bytes(), nil
+	}
+
+	mw := multipart.NewWriter(&buf)
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\n", mw.Boundary())
+	buf.WriteString("\r\n")
+
+	// 正文
+	part, err := mw.CreatePart(header)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(body); err != nil {
+		return nil, err
+	}
+
+	// 附件
+	for _, a := range attachments {
+		if err := writeAttachment(mw, a); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// reservedHeaders 由 buildMessage 或发送渠道生成的邮件头，不允许通过 EmailData.Headers 设置
+var reservedHeaders = map[string]struct{}{
+	"From":                      {},
+	"To":                        {},
+	"Cc":                        {},
+	"Bcc":                       {},
+	"Reply-To":                  {},
+	"Subject":                   {},
+	"Date":                      {},
+	"Message-Id":                {},
+	"Mime-Version":              {},
+	"Content-Type":              {},
+	"Content-Transfer-Encoding": {},
+	"Dkim-Signature":            {},
+}
+
+// validateHeaders 校验自定义邮件头，防止通过换行符注入额外的邮件头
+func validateHeaders(headers map[string]string) error {
+	for name, value := range headers {
+		if name == "" || strings.IndexFunc(name, func(r rune) bool { return r <= ' ' || r > '~' || r == ':' }) >= 0 {
+			return fmt.Errorf("invalid header name %q", name)
+		}
+		if _, ok := reservedHeaders[textproto.CanonicalMIMEHeaderKey(name)]; ok {
+			return fmt.Errorf("header %q cannot be overridden", name)
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return fmt.Errorf("invalid value for header %q: contains line break", name)
+		}
+	}
+	return nil
+}
+
+// writeCustomHeaders 按名称排序写入自定义邮件头，非 ASCII 的值使用 RFC 2047 编码
+func writeCustomHeaders(buf *bytes.Buffer, headers map[string]string) error {
+	if err := validateHeaders(headers); err != nil {
+		return err
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(buf, "%s: %s\r\n", name, mime.QEncoding.Encode("UTF-8", headers[name]))
+	}
+	return nil
+}
+
+// buildBody 构建正文部分，返回正文的 MIME 头和内容
+//
+// 纯文本优先使用 TextBody，未设置时从 HTML 正文生成；没有 HTML 正文时只发送纯文本
+func buildBody(data *EmailData) (textproto.MIMEHeader, []byte, error) {
+	text := data.TextBody
+	if text == "" {
+		text = htmlToText(data.Body)
+	}
+
+	if data.Body == "" {
+		return textPart("text/plain; charset=UTF-8", text)
+	}
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	// 按 RFC 2046，越靠后的部分越优先，HTML 放在最后
+	for _, p := range []struct{ contentType, content string }{
+		{"text/plain; charset=UTF-8", text},
+		{"text/html; charset=UTF-8", data.Body},
+	} {
+		header, body, err := textPart(p.contentType, p.content)
+		if err != nil {
+			return nil, nil, err
+		}
+		part, err := mw.CreatePart(header)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, err := part.Write(body); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	header := textproto.MIMEHeader{
+		"Content-Type": {mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": mw.Boundary()})},
+	}
+	return header, buf.Bytes(), nil
+}
+
+// buildRelated 将正文和内嵌资源组合为 multipart/related（RFC 2387）
+func buildRelated(header textproto.MIMEHeader, body []byte, inline []*Attachment) (textproto.MIMEHeader, []byte, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+
+	part, err := mw.CreatePart(header)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := part.Write(body); err != nil {
+		return nil, nil, err
+	}
+
+	for _, a := range inline {
+		if err := writeAttachment(mw, a); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	rootType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	related := textproto.MIMEHeader{
+		"Content-Type": {mime.FormatMediaType("multipart/related", map[string]string{
+			"type":     rootType,
+			"boundary": mw.Boundary(),
+		})},
+	}
+	return related, buf.Bytes(), nil
+}
+
+// textPart 以 quoted-printable 编码文本，避免超长行被 SMTP 服务器截断
+func textPart(contentType, content string) (textproto.MIMEHeader, []byte, error) {
+	var buf bytes.Buffer
+	qp := quotedprintable.NewWriter(&buf)
+	if _, err := io.WriteString(qp, content); err != nil {
+		return nil, nil, err
+	}
+	if err := qp.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	header := textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	}
+	return header, buf.Bytes(), nil
+}
+
+// writeHeader 按固定顺序写入正文的 MIME 头
+func writeHeader(buf *bytes.Buffer, header textproto.MIMEHeader) {
+	for _, key := range []string{"Content-Type", "Content-Transfer-Encoding"} {
+		if v := header.Get(key); v != "" {
+			fmt.Fprintf(buf, "%s: %s\r\n", key, v)
+		}
+	}
+}
+
+// writeAttachment 以 base64 编码写入一个附件，内嵌资源带 Content-ID 头
+func writeAttachment(mw *multipart.Writer, a *Attachment) error {
+	if err := a.validate(); err != nil {
+		return err
+	}
+	content, err := a.bytes()
+	if err != nil {
+		return fmt.Errorf("failed to read attachment %s: %w", a.Filename, err)
+	}
+
+	header := textproto.MIMEHeader{
+		"Content-Type":              {a.contentType()},
+		"Content-Transfer-Encoding": {"base64"},
+		"Content-Disposition":       {mime.FormatMediaType(a.disposition(), map[string]string{"filename": a.Filename})},
+	}
+	if a.Inline() {
+		header.Set("Content-ID", "<"+a.ContentID+">")
+	}
+	part, err := mw.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(content)
+	for len(encoded) > base64LineLength {
+		if _, err := io.WriteString(part, encoded[:base64LineLength]+"\r\n"); err != nil {
+			return err
+		}
+		encoded = encoded[base64LineLength:]
+	}
+	_, err = io.WriteString(part, encoded+"\r\n")
+	return err
+}
+
+// validate 校验写入附件 MIME 头的字段，防止通过换行符注入额外的邮件头
+func (a *Attachment) validate() error {
+	for _, f := range []struct{ name, value string }{
+		{"filename", a.Filename},
+		{"content type", a.ContentType},
+		{"content id", a.ContentID},
+	} {
+		if strings.ContainsAny(f.value, "\r\n") {
+			return fmt.Errorf("invalid attachment %s %q: contains line break", f.name, f.value)
+		}
+	}
+	return nil
+}
+
+// bytes 返回附件内容
+//
+// Reader 在第一次读取时缓存到 Content，重试和重新构建邮件时不会读到空内容
+func (a *Attachment) bytes() ([]byte, error) {
+	if a.Content != nil || a.Reader == nil {
+		return a.Content, nil
+	}
+	content, err := io.ReadAll(a.Reader)
+	if err != nil {
+		return nil, err
+	}
+	if content == nil {
+		content = []byte{}
+	}
+	a.Content = content
+	return content, nil
+}
+
+// disposition 返回附件的 Content-Disposition 类型
+func (a *Attachment) disposition() string {
+	if a.Inline() {
+		return "inline"
+	}
+	return "attachment"
+}
+
+// contentType 返回附件的 MIME 类型
+func (a *Attachment) contentType() string {
+	if a.ContentType != "" {
+		return a.ContentType
+	}
+	if t := mime.TypeByExtension(filepath.Ext(a.Filename)); t != "" {
+		return t
+	}
+	return "application/octet-stream"
+}
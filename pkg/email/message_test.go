@@ -0,0 +1,303 @@
+package email
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// readParts 读取 multipart 消息的所有部分，quoted-printable 内容会被自动解码
+func readParts(t *testing.T, r io.Reader, boundary string) ([]*multipart.Part, []string) {
+	var parts []*multipart.Part
+	var contents []string
+	mr := multipart.NewReader(r, boundary)
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			return parts, contents
+		}
+		assert.NoError(t, err)
+		content, err := io.ReadAll(part)
+		assert.NoError(t, err)
+		parts = append(parts, part)
+		contents = append(contents, string(content))
+	}
+}
+
+func TestBuildMessage_Alternative(t *testing.T) {
+	msg, err := buildMessage("no-reply@example.com", &EmailData{MessageID: "1.abc@example.com", To: []string{"user@example.com"}, Subject: "hi", Body: "<p>hi</p>"})
+	assert.NoError(t, err)
+
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	assert.NoError(t, err)
+	assert.Equal(t, "no-reply@example.com", m.Header.Get("From"))
+	assert.Equal(t, "user@example.com", m.Header.Get("To"))
+	assert.Equal(t, "hi", m.Header.Get("Subject"))
+	assert.Equal(t, "<1.abc@example.com>", m.Header.Get("Message-ID"))
+	_, err = m.Header.Date()
+	assert.NoError(t, err)
+	mediaType, params, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	parts, contents := readParts(t, m.Body, params["boundary"])
+	assert.Len(t, parts, 2)
+	assert.Equal(t, "text/plain; charset=UTF-8", parts[0].Header.Get("Content-Type"))
+	assert.Equal(t, "hi", contents[0])
+	assert.Equal(t, "text/html; charset=UTF-8", parts[1].Header.Get("Content-Type"))
+	assert.Equal(t, "<p>hi</p>", contents[1])
+}
+
+func TestBuildMessage_CustomHeaders(t *testing.T) {
+	data := &EmailData{To: []string{"user@example.com"}, Subject: "hi", Body: "<p>hi</p>", Headers: map[string]string{
+		"X-Entity-Ref-ID": "order-42",
+		"X-Campaign":      "春季促销",
+	}}
+	msg, err := buildMessage("no-reply@example.com", data)
+	assert.NoError(t, err)
+
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	assert.NoError(t, err)
+	assert.Equal(t, "order-42", m.Header.Get("X-Entity-Ref-ID"))
+	campaign, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("X-Campaign"))
+	assert.NoError(t, err)
+	assert.Equal(t, "春季促销", campaign)
+
+	for _, headers := range []map[string]string{
+		{"X-Tag": "a\r\nBcc: victim@example.com"},
+		{"X-Tag\r\nBcc": "victim@example.com"},
+		{"X Tag": "a"},
+		{"subject": "override"},
+		{"Message-Id": "<evil@example.com>"},
+	} {
+		data.Headers = headers
+		_, err := buildMessage("no-reply@example.com", data)
+		assert.Error(t, err, headers)
+	}
+}
+
+func TestBuildMessage_ReplyTo(t *testing.T) {
+	data := NewEmailData("user@example.com", "hi", "<p>hi</p>")
+	data.ReplyTo = "support@example.com"
+	msg, err := buildMessage("no-reply@example.com", data)
+	assert.NoError(t, err)
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	assert.NoError(t, err)
+	assert.Equal(t, "support@example.com", m.Header.Get("Reply-To"))
+
+	data.ReplyTo = "support@example.com\r\nBcc: victim@example.com"
+	_, err = buildMessage("no-reply@example.com", data)
+	assert.Error(t, err)
+
+	data.ReplyTo = ""
+	data.Headers = map[string]string{"Reply-To": "other@example.com"}
+	_, err = buildMessage("no-reply@example.com", data)
+	assert.Error(t, err)
+}
+
+func TestBuildMessage_From(t *testing.T) {
+	msg, err := buildMessage("希音平台 <noreply@heyin.com>", NewEmailData("user@example.com", "hi", "<p>hi</p>"))
+	assert.NoError(t, err)
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	assert.NoError(t, err)
+	assert.Equal(t, "=?utf-8?q?=E5=B8=8C=E9=9F=B3=E5=B9=B3=E5=8F=B0?= <noreply@heyin.com>", m.Header.Get("From"))
+	from, err := m.Header.AddressList("From")
+	assert.NoError(t, err)
+	assert.Equal(t, &mail.Address{Name: "希音平台", Address: "noreply@heyin.com"}, from[0])
+
+	// 单封邮件覆盖
+	data := NewEmailData("user@example.com", "hi", "<p>hi</p>")
+	data.From = "Billing <billing@heyin.com>"
+	msg, err = buildMessage("希音平台 <noreply@heyin.com>", data)
+	assert.NoError(t, err)
+	m, err = mail.ReadMessage(bytes.NewReader(msg))
+	assert.NoError(t, err)
+	assert.Equal(t, "\"Billing\" <billing@heyin.com>", m.Header.Get("From"))
+
+	data.From = "billing@heyin.com\r\nBcc: victim@example.com"
+	_, err = buildMessage("noreply@heyin.com", data)
+	assert.Error(t, err)
+}
+
+func TestBuildMessage_TextBody(t *testing.T) {
+	long := strings.Repeat("很长的一行", 100)
+	msg, err := buildMessage("no-reply@example.com", &EmailData{To: []string{"user@example.com"}, Subject: "hi", TextBody: long})
+	assert.NoError(t, err)
+
+	// 没有 HTML 正文时只发送纯文本，超长行被 quoted-printable 折行
+	for _, line := range strings.Split(string(msg), "\r\n") {
+		assert.LessOrEqual(t, len(line), 76)
+	}
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	assert.NoError(t, err)
+	assert.Equal(t, "text/plain; charset=UTF-8", m.Header.Get("Content-Type"))
+	decoded, err := io.ReadAll(quotedprintable.NewReader(m.Body))
+	assert.NoError(t, err)
+	assert.Equal(t, long, string(decoded))
+}
+
+func TestHTMLToText(t *testing.T) {
+	body := `<html><head><style>p { color: red; }</style></head>
+<body>
+  <h1>欢迎加入&nbsp;示例</h1>
+  <p>请点击
+     <a href="https://example.com/accept?a=1&amp;b=2">接受邀请</a>。</p>
+  <ul><li>第一项</li><li>第二项</li></ul>
+  <p>链接: <a href="https://example.com">https://example.com</a><br>谢谢</p>
+</body></html>`
+
+	assert.Equal(t, "欢迎加入 示例\n\n"+
+		"请点击 接受邀请 (https://example.com/accept?a=1&b=2)。\n\n"+
+		"- 第一项\n"+
+		"- 第二项\n\n"+
+		"链接: https://example.com\n"+
+		"谢谢", htmlToText(body))
+}
+
+func TestBuildMessage_Recipients(t *testing.T) {
+	data := &EmailData{
+		To:      []string{"a@example.com", "b@example.com"},
+		Cc:      []string{"c@example.com"},
+		Bcc:     []string{"d@example.com"},
+		Subject: "hi",
+	}
+	msg, err := buildMessage("no-reply@example.com", data)
+	assert.NoError(t, err)
+	assert.Contains(t, string(msg), "To: a@example.com, b@example.com\r\n")
+	assert.Contains(t, string(msg), "Cc: c@example.com\r\n")
+	assert.NotContains(t, string(msg), "d@example.com")
+	assert.Equal(t, []string{"a@example.com", "b@example.com", "c@example.com", "d@example.com"}, data.Recipients())
+}
+
+func TestBuildMessage_Attachments(t *testing.T) {
+	invoice := bytes.Repeat([]byte("%PDF-1.4 invoice "), 20)
+	msg, err := buildMessage("no-reply@example.com", &EmailData{
+		To:      []string{"user@example.com"},
+		Subject: "invoice",
+		Body:    "<p>see attached</p>",
+		Attachments: []Attachment{
+			{Filename: "invoice.pdf", Content: invoice},
+			{Filename: "导出.csv", ContentType: "text/csv", Reader: strings.NewReader("a,b\n1,2\n")},
+		},
+	})
+	assert.NoError(t, err)
+
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	assert.NoError(t, err)
+	mediaType, params, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+
+	mr := multipart.NewReader(m.Body, params["boundary"])
+
+	body, err := mr.NextPart()
+	assert.NoError(t, err)
+	mediaType, params, err = mime.ParseMediaType(body.Header.Get("Content-Type"))
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+	_, contents := readParts(t, body, params["boundary"])
+	assert.Equal(t, []string{"see attached", "<p>see attached</p>"}, contents)
+
+	pdf, err := mr.NextPart()
+	assert.NoError(t, err)
+	assert.Equal(t, "application/pdf", pdf.Header.Get("Content-Type"))
+	assert.Equal(t, "invoice.pdf", pdf.FileName())
+	raw, _ := io.ReadAll(pdf)
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\r\n")
+	for _, line := range lines {
+		assert.LessOrEqual(t, len(line), base64LineLength)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.Join(lines, ""))
+	assert.NoError(t, err)
+	assert.Equal(t, invoice, decoded)
+
+	csv, err := mr.NextPart()
+	assert.NoError(t, err)
+	assert.Equal(t, "text/csv", csv.Header.Get("Content-Type"))
+	assert.Equal(t, "导出.csv", csv.FileName())
+
+	_, err = mr.NextPart()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestBuildMessage_InlineImages(t *testing.T) {
+	png := []byte("\x89PNG fake image")
+	data := NewEmailData("user@example.com", "hi", "")
+	logo := data.EmbedImage("logo.png", png)
+	assert.Equal(t, "cid:logo.png", logo)
+	data.Body = `<img src="` + logo + `">`
+	data.Attachments = append(data.Attachments, Attachment{Filename: "a.txt", Content: []byte("a")})
+
+	msg, err := buildMessage("no-reply@example.com", data)
+	assert.NoError(t, err)
+
+	// mixed -> [related -> [alternative, logo.png], a.txt]
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	assert.NoError(t, err)
+	mediaType, params, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+
+	mr := multipart.NewReader(m.Body, params["boundary"])
+	related, err := mr.NextPart()
+	assert.NoError(t, err)
+	mediaType, params, err = mime.ParseMediaType(related.Header.Get("Content-Type"))
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/related", mediaType)
+	assert.Equal(t, "multipart/alternative", params["type"])
+
+	parts, contents := readParts(t, related, params["boundary"])
+	assert.Len(t, parts, 2)
+	assert.Equal(t, "<logo.png>", parts[1].Header.Get("Content-Id"))
+	assert.True(t, strings.HasPrefix(parts[1].Header.Get("Content-Disposition"), "inline"))
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(contents[1]))
+	assert.NoError(t, err)
+	assert.Equal(t, png, decoded)
+
+	attachment, err := mr.NextPart()
+	assert.NoError(t, err)
+	assert.Equal(t, "a.txt", attachment.FileName())
+}
+
+func TestBuildMessage_HeaderInjection(t *testing.T) {
+	for _, data := range []*EmailData{
+		{To: []string{"user@example.com"}, Subject: "hi\r\nBcc: victim@example.com"},
+		{To: []string{"user@example.com\r\nBcc: victim@example.com"}, Subject: "hi"},
+		{To: []string{"user@example.com"}, Cc: []string{"cc@example.com\nBcc: victim@example.com"}, Subject: "hi"},
+	} {
+		_, err := buildMessage("no-reply@example.com", data)
+		assert.Error(t, err)
+	}
+}
+
+func TestBuildMessage_AttachmentHeaderInjection(t *testing.T) {
+	for _, a := range []Attachment{
+		{Filename: "a.pdf\r\nBcc: victim@example.com", Content: []byte("pdf")},
+		{Filename: "a.pdf", ContentType: "application/pdf\r\nBcc: victim@example.com", Content: []byte("pdf")},
+		{Filename: "logo.png", ContentID: "logo\nBcc: victim@example.com", Content: []byte("png")},
+	} {
+		data := NewEmailData("user@example.com", "hi", "<p>hi</p>")
+		data.Attachments = []Attachment{a}
+		_, err := buildMessage("no-reply@example.com", data)
+		assert.ErrorContains(t, err, "contains line break")
+	}
+}
+
+func TestBuildMessage_EncodedSubject(t *testing.T) {
+	msg, err := buildMessage("no-reply@example.com", NewEmailData("user@example.com", "账户激活", "<p>hi</p>"))
+	assert.NoError(t, err)
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(m.Header.Get("Subject"), "=?UTF-8?q?"))
+	subject, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+	assert.NoError(t, err)
+	assert.Equal(t, "账户激活", subject)
+}
@@ -0,0 +1,177 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/textproto"
+	"time"
+)
+
+// EmailProvider 邮件发送渠道
+//
+// 不同渠道（SMTP、阿里云邮件推送、SendGrid、AWS SES）实现相同的接口，
+// 通过 Config.Provider 切换，业务代码无需修改
+type EmailProvider interface {
+	// Send 发送一封已渲染好的邮件
+	//
+	// 返回的 SendResult 由渠道填写服务器应答相关的字段，MessageID 和 Duration 由 Sender 填写
+	Send(ctx context.Context, data *EmailData) (*SendResult, error)
+}
+
+// ProviderType 发送渠道类型
+type ProviderType string
+
+const (
+	ProviderSMTP       ProviderType = "smtp"       // SMTP（默认）
+	ProviderDirectMail ProviderType = "directmail" // 阿里云邮件推送
+	ProviderSendGrid   ProviderType = "sendgrid"   // SendGrid
+	ProviderSES        ProviderType = "ses"        // AWS SES
+)
+
+// defaultProviderTimeout HTTP 类渠道的默认超时时间
+const defaultProviderTimeout = 30 * time.Second
+
+// NewProvider 根据配置创建发送渠道
+//
+// 参数:
+//   - config: 邮件配置，Provider 为空时使用 SMTP，开启 DryRun 时使用演练渠道
+//
+// 返回:
+//   - EmailProvider: 发送渠道
+//   - error: 渠道类型未知或必填配置缺失时返回错误
+func NewProvider(config *Config) (EmailProvider, error) {
+	switch config.DryRun.Mode {
+	case "":
+	case DryRunCapture:
+		return NewCaptureProvider(config.fromAddress()), nil
+	case DryRunFile:
+		return NewFileProvider(config.fromAddress(), config.DryRun.Dir), nil
+	default:
+		return nil, fmt.Errorf("unknown dry run mode: %s", config.DryRun.Mode)
+	}
+
+	switch ProviderType(config.Provider) {
+	case "", ProviderSMTP:
+		p, err := newCheckedSMTPProvider(&config.SMTP)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	case ProviderDirectMail:
+		c := config.DirectMail
+		if c.AccessKeyID == "" || c.AccessKeySecret == "" || c.AccountName == "" {
+			return nil, fmt.Errorf("directmail access key and account name are required")
+		}
+		return NewDirectMailProvider(&c), nil
+	case ProviderSendGrid:
+		c := config.SendGrid
+		if c.APIKey == "" || c.From == "" {
+			return nil, fmt.Errorf("sendgrid api key and from are required")
+		}
+		return NewSendGridProvider(&c), nil
+	case ProviderSES:
+		c := config.SES
+		if c.Region == "" || c.AccessKeyID == "" || c.SecretAccessKey == "" || c.From == "" {
+			return nil, fmt.Errorf("ses region, credentials and from are required")
+		}
+		return NewSESProvider(&c), nil
+	default:
+		return nil, fmt.Errorf("unknown email provider: %s", config.Provider)
+	}
+}
+
+// newCheckedSMTPProvider 校验配置后创建 SMTP 发送渠道
+func newCheckedSMTPProvider(config *SMTPConfig) (*SMTPProvider, error) {
+	if config.Host == "" || config.From == "" {
+		return nil, fmt.Errorf("smtp host and from are required")
+	}
+	if _, err := formatFrom(config.From); err != nil {
+		return nil, err
+	}
+	switch config.TLSMode {
+	case "", TLSModeImplicit, TLSModeSTARTTLS, TLSModeNone:
+	default:
+		return nil, fmt.Errorf("unknown smtp tls mode: %s", config.TLSMode)
+	}
+	if !validAuthMethod(config.AuthMethod) {
+		return nil, fmt.Errorf("unknown smtp auth method: %s", config.AuthMethod)
+	}
+	p := NewSMTPProvider(config)
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p, nil
+}
+
+// ProviderError HTTP 类渠道返回的错误响应
+type ProviderError struct {
+	Provider   ProviderType
+	StatusCode int
+	Message    string
+}
+
+func (e *ProviderError) Error() string {
+	return fmt.Sprintf("%s returned %d: %s", e.Provider, e.StatusCode, e.Message)
+}
+
+// IsTransient 判断发送错误是否为暂时性错误，暂时性错误可以重试
+//
+// 以下情况视为暂时性错误:
+//   - 网络错误和超时
+//   - SMTP 4xx 应答
+//   - HTTP 429 和 5xx 响应
+//   - 熔断器打开（ErrCircuitOpen）
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ErrCircuitOpen) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	var smtpErr *textproto.Error
+	if errors.As(err, &smtpErr) {
+		return smtpErr.Code >= 400 && smtpErr.Code < 500
+	}
+
+	var providerErr *ProviderError
+	if errors.As(err, &providerErr) {
+		return providerErr.StatusCode == http.StatusTooManyRequests || providerErr.StatusCode >= 500
+	}
+
+	return false
+}
+
+// errProvider 配置错误时的占位渠道，发送时返回配置错误
+type errProvider struct {
+	err error
+}
+
+func (p errProvider) Send(context.Context, *EmailData) (*SendResult, error) {
+	return nil, p.err
+}
+
+// sendTimeout 返回本次发送的超时时间，EmailData.Timeout 优先于渠道配置
+func sendTimeout(data *EmailData, configured time.Duration) time.Duration {
+	if data.Timeout > 0 {
+		return data.Timeout
+	}
+	return timeoutOrDefault(configured)
+}
+
+// timeoutOrDefault 返回配置的超时时间，未配置时使用默认值
+func timeoutOrDefault(timeout time.Duration) time.Duration {
+	if timeout <= 0 {
+		return defaultProviderTimeout
+	}
+	return timeout
+}
@@ -0,0 +1,186 @@
+package email
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultDirectMailEndpoint 阿里云邮件推送默认接入地址（杭州）
+	defaultDirectMailEndpoint = "https://dm.aliyuncs.com/"
+	// directMailAPIVersion 邮件推送 API 版本
+	directMailAPIVersion = "2015-11-23"
+	// directMailMaxRecipients SingleSendMail 单次最多收件人数量
+	directMailMaxRecipients = 100
+)
+
+// DirectMailProvider 通过阿里云邮件推送（DirectMail）SingleSendMail 接口发送邮件
+//
+// SingleSendMail 接口不支持附件和抄送/密送，需要这些功能时请使用 SMTP 渠道（阿里云同样提供 SMTP 接入）
+type DirectMailProvider struct {
+	config *DirectMailConfig
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewDirectMailProvider 创建阿里云邮件推送渠道
+func NewDirectMailProvider(config *DirectMailConfig) *DirectMailProvider {
+	return &DirectMailProvider{
+		config: config,
+		client: &http.Client{},
+		now:    time.Now,
+	}
+}
+
+// Send 发送邮件
+//
+// SingleSendMail 接口不支持自定义邮件头，EmailData.Headers 会被忽略；
+// 回复地址只能在控制台为发信地址配置，EmailData.ReplyTo 同样会被忽略。
+// EmailData.From 覆盖发件人时，地址需要是控制台中已创建的发信地址，显示名称作为发信人昵称
+func (p *DirectMailProvider) Send(ctx context.Context, data *EmailData) (*SendResult, error) {
+	if len(data.Attachments) > 0 {
+		return nil, fmt.Errorf("directmail api does not support attachments, use the smtp provider instead")
+	}
+	if len(data.Cc) > 0 || len(data.Bcc) > 0 {
+		return nil, fmt.Errorf("directmail api does not support cc or bcc, use the smtp provider instead")
+	}
+	if len(data.To) > directMailMaxRecipients {
+		return nil, fmt.Errorf("directmail api accepts at most %d recipients", directMailMaxRecipients)
+	}
+
+	params := url.Values{}
+	params.Set("Action", "SingleSendMail")
+	accountName, fromAlias := p.config.AccountName, p.config.FromAlias
+	if data.From != "" {
+		fromAlias, accountName = splitFrom(data.From)
+	}
+	params.Set("AccountName", accountName)
+	params.Set("AddressType", "1")
+	params.Set("ReplyToAddress", "false")
+	params.Set("ToAddress", strings.Join(data.To, ","))
+	params.Set("Subject", data.Subject)
+	params.Set("HtmlBody", data.Body)
+	if data.TextBody != "" {
+		params.Set("TextBody", data.TextBody)
+	} else if text := htmlToText(data.Body); text != "" {
+		params.Set("TextBody", text)
+	}
+	if fromAlias != "" {
+		params.Set("FromAlias", fromAlias)
+	}
+	if p.config.TagName != "" {
+		params.Set("TagName", p.config.TagName)
+	}
+
+	nonce, err := directMailNonce()
+	if err != nil {
+		return nil, err
+	}
+	regionID := p.config.RegionID
+	if regionID == "" {
+		regionID = "cn-hangzhou"
+	}
+	params.Set("Format", "JSON")
+	params.Set("Version", directMailAPIVersion)
+	params.Set("AccessKeyId", p.config.AccessKeyID)
+	params.Set("SignatureMethod", "HMAC-SHA1")
+	params.Set("SignatureVersion", "1.0")
+	params.Set("SignatureNonce", nonce)
+	params.Set("Timestamp", p.now().UTC().Format("2006-01-02T15:04:05Z"))
+	params.Set("RegionId", regionID)
+	params.Set("Signature", p.signature(http.MethodPost, params))
+
+	endpoint := p.config.Endpoint
+	if endpoint == "" {
+		endpoint = defaultDirectMailEndpoint
+	}
+
+	// 超时通过 ctx 控制，EmailData.Timeout 可以覆盖配置
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout(data, p.config.Timeout))
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create directmail request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send email: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode >= 300 {
+		var apiErr struct {
+			Code    string `json:"Code"`
+			Message string `json:"Message"`
+		}
+		if json.Unmarshal(body, &apiErr) == nil && apiErr.Code != "" {
+			return nil, &ProviderError{Provider: ProviderDirectMail, StatusCode: resp.StatusCode, Message: apiErr.Code + ": " + apiErr.Message}
+		}
+		return nil, &ProviderError{Provider: ProviderDirectMail, StatusCode: resp.StatusCode, Message: string(body)}
+	}
+
+	var result struct {
+		EnvID string `json:"EnvId"`
+	}
+	_ = json.Unmarshal(body, &result)
+	return &SendResult{
+		ProviderMessageID: result.EnvID,
+		Accepted:          data.Recipients(),
+		StatusCode:        resp.StatusCode,
+	}, nil
+}
+
+// signature 计算阿里云 RPC 风格签名
+//
+// StringToSign = Method + "&" + percentEncode("/") + "&" + percentEncode(规范化参数)
+func (p *DirectMailProvider) signature(method string, params url.Values) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, percentEncode(k)+"="+percentEncode(params.Get(k)))
+	}
+	stringToSign := method + "&" + percentEncode("/") + "&" + percentEncode(strings.Join(pairs, "&"))
+
+	mac := hmac.New(sha1.New, []byte(p.config.AccessKeySecret+"&"))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// percentEncode 阿里云要求的 RFC 3986 编码
+func percentEncode(s string) string {
+	s = url.QueryEscape(s)
+	s = strings.ReplaceAll(s, "+", "%20")
+	s = strings.ReplaceAll(s, "*", "%2A")
+	s = strings.ReplaceAll(s, "%7E", "~")
+	return s
+}
+
+// directMailNonce 生成签名随机数
+func directMailNonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,142 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DryRunMode 演练模式，开启后邮件不会发送给真实用户
+type DryRunMode string
+
+const (
+	DryRunCapture DryRunMode = "capture" // 保存在内存中，测试中通过 CaptureProvider 读取
+	DryRunFile    DryRunMode = "file"    // 写入目录中的 .eml 文件，可以用邮件客户端打开查看
+)
+
+// DryRunConfig 演练模式配置
+//
+// 开启后忽略 Provider 配置，所有邮件都交给演练渠道，便于集成测试和预发环境在保留真实配置的同时避免误发
+type DryRunConfig struct {
+	Mode DryRunMode `yaml:"mode"` // 为空表示关闭
+	Dir  string     `yaml:"dir"`  // file 模式下 .eml 文件的输出目录，默认 ./mail-out
+}
+
+// CapturedEmail 演练模式下捕获的邮件
+type CapturedEmail struct {
+	Data       *EmailData // 邮件数据
+	Raw        []byte     // 完整的 MIME 邮件内容
+	CapturedAt time.Time  // 捕获时间
+}
+
+// CaptureProvider 将邮件保存在内存中而不发送，用于测试
+//
+// 使用示例:
+//
+//	svc := email.NewService(&email.Config{DryRun: email.DryRunConfig{Mode: email.DryRunCapture}})
+//	// ... 执行业务流程
+//	capture := svc.Provider().(*email.CaptureProvider)
+//	last := capture.Last()
+//	assert.Contains(t, last.Data.Body, "激活链接")
+type CaptureProvider struct {
+	from string
+
+	mu       sync.Mutex
+	messages []*CapturedEmail
+}
+
+// NewCaptureProvider 创建内存捕获渠道
+func NewCaptureProvider(from string) *CaptureProvider {
+	return &CaptureProvider{from: from}
+}
+
+// Send 捕获邮件
+func (p *CaptureProvider) Send(_ context.Context, data *EmailData) (*SendResult, error) {
+	raw, err := buildMessage(p.from, data)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.messages = append(p.messages, &CapturedEmail{Data: data, Raw: raw, CapturedAt: time.Now()})
+	return &SendResult{Provider: ProviderType(DryRunCapture), Accepted: data.Recipients()}, nil
+}
+
+// Messages 返回已捕获的所有邮件
+func (p *CaptureProvider) Messages() []*CapturedEmail {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]*CapturedEmail(nil), p.messages...)
+}
+
+// Last 返回最后一封捕获的邮件，没有时返回 nil
+func (p *CaptureProvider) Last() *CapturedEmail {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.messages) == 0 {
+		return nil
+	}
+	return p.messages[len(p.messages)-1]
+}
+
+// Reset 清空已捕获的邮件
+func (p *CaptureProvider) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.messages = nil
+}
+
+// FileProvider 将邮件写入 .eml 文件而不发送
+type FileProvider struct {
+	from string
+	dir  string
+}
+
+// NewFileProvider 创建文件输出渠道，dir 不存在时在首次发送时创建
+func NewFileProvider(from, dir string) *FileProvider {
+	if dir == "" {
+		dir = "mail-out"
+	}
+	return &FileProvider{from: from, dir: dir}
+}
+
+// Send 将邮件写入文件，文件名为 <时间>-<Message-ID>.eml
+func (p *FileProvider) Send(_ context.Context, data *EmailData) (*SendResult, error) {
+	raw, err := buildMessage(p.from, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(p.dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	id := data.MessageID
+	if id == "" {
+		id = newMessageID(p.from)
+	}
+	name := time.Now().Format("20060102-150405.000000") + "-" + sanitizeFilename(id) + ".eml"
+	path := filepath.Join(p.dir, name)
+	if err := os.WriteFile(path, raw, 0o644); err != nil {
+		return nil, fmt.Errorf("failed to write email file: %w", err)
+	}
+
+	return &SendResult{Provider: ProviderType(DryRunFile), Accepted: data.Recipients(), Response: path}, nil
+}
+
+// sanitizeFilename 替换文件名中不安全的字符
+func sanitizeFilename(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_', r == '@':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
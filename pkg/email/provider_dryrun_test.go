@@ -0,0 +1,64 @@
+package email
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDryRunCapture(t *testing.T) {
+	// 保留真实 SMTP 配置，演练模式下不会连接
+	svc := NewService(&Config{
+		SMTP:   SMTPConfig{Host: "smtp.example.com", Port: 465, From: "no-reply@example.com"},
+		DryRun: DryRunConfig{Mode: DryRunCapture},
+	})
+
+	result, err := svc.SendPasswordResetEmail(context.Background(), &PasswordResetEmailRequest{
+		To:        "user@example.com",
+		UserName:  "张三",
+		ResetLink: "https://example.com/reset?token=abc",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, ProviderType(DryRunCapture), result.Provider)
+
+	capture, ok := svc.Provider().(*CaptureProvider)
+	assert.True(t, ok)
+	assert.Len(t, capture.Messages(), 1)
+	last := capture.Last()
+	assert.Equal(t, []string{"user@example.com"}, last.Data.To)
+	assert.Contains(t, last.Data.Body, "https://example.com/reset?token=abc")
+	assert.Contains(t, string(last.Raw), "Message-ID: <"+result.MessageID+">")
+
+	capture.Reset()
+	assert.Nil(t, capture.Last())
+}
+
+func TestDryRunFile(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "out")
+	sender := NewSender(&Config{
+		SMTP:   SMTPConfig{From: "no-reply@example.com"},
+		DryRun: DryRunConfig{Mode: DryRunFile, Dir: dir},
+	})
+
+	result, err := sender.SendEmail(context.Background(), NewEmailData("user@example.com", "hi", "<p>hi</p>"))
+	assert.NoError(t, err)
+
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.True(t, strings.HasSuffix(entries[0].Name(), ".eml"))
+	assert.Equal(t, filepath.Join(dir, entries[0].Name()), result.Response)
+
+	raw, err := os.ReadFile(result.Response)
+	assert.NoError(t, err)
+	assert.Contains(t, string(raw), "To: user@example.com\r\n")
+}
+
+func TestDryRunUnknownMode(t *testing.T) {
+	_, err := NewProvider(&Config{DryRun: DryRunConfig{Mode: "stdout"}})
+	assert.ErrorContains(t, err, "unknown dry run mode")
+}
@@ -0,0 +1,180 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// defaultSendGridEndpoint SendGrid v3 发送接口
+const defaultSendGridEndpoint = "https://api.sendgrid.com/v3/mail/send"
+
+// SendGridProvider 通过 SendGrid v3 API 发送邮件
+type SendGridProvider struct {
+	config *SendGridConfig
+	client *http.Client
+}
+
+// NewSendGridProvider 创建 SendGrid 发送渠道
+func NewSendGridProvider(config *SendGridConfig) *SendGridProvider {
+	return &SendGridProvider{
+		config: config,
+		client: &http.Client{},
+	}
+}
+
+type sendGridAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+type sendGridPersonalization struct {
+	To  []sendGridAddress `json:"to"`
+	Cc  []sendGridAddress `json:"cc,omitempty"`
+	Bcc []sendGridAddress `json:"bcc,omitempty"`
+}
+
+type sendGridContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type sendGridAttachment struct {
+	Content     string `json:"content"`
+	Type        string `json:"type,omitempty"`
+	Filename    string `json:"filename"`
+	Disposition string `json:"disposition"`
+	ContentID   string `json:"content_id,omitempty"`
+}
+
+type sendGridRequest struct {
+	Personalizations []sendGridPersonalization `json:"personalizations"`
+	From             sendGridAddress           `json:"from"`
+	ReplyTo          *sendGridAddress          `json:"reply_to,omitempty"`
+	Subject          string                    `json:"subject"`
+	Content          []sendGridContent         `json:"content"`
+	Attachments      []sendGridAttachment      `json:"attachments,omitempty"`
+	Headers          map[string]string         `json:"headers,omitempty"`
+}
+
+// Send 发送邮件
+//
+// 支持 EmailData.From 覆盖发件人，地址需要通过 SendGrid 的发件人验证
+func (p *SendGridProvider) Send(ctx context.Context, data *EmailData) (*SendResult, error) {
+	request := &sendGridRequest{
+		Personalizations: []sendGridPersonalization{{
+			To:  sendGridAddresses(data.To),
+			Cc:  sendGridAddresses(data.Cc),
+			Bcc: sendGridAddresses(data.Bcc),
+		}},
+		From:    p.from(data),
+		Subject: data.Subject,
+	}
+	if data.ReplyTo != "" {
+		request.ReplyTo = &sendGridAddress{Email: data.ReplyTo}
+	}
+	if err := validateHeaders(data.Headers); err != nil {
+		return nil, err
+	}
+	if len(data.Headers) > 0 || data.MessageID != "" {
+		request.Headers = make(map[string]string, len(data.Headers)+1)
+		for name, value := range data.Headers {
+			request.Headers[name] = value
+		}
+		if data.MessageID != "" {
+			request.Headers["Message-ID"] = "<" + data.MessageID + ">"
+		}
+	}
+	// SendGrid 要求 text/plain 在 text/html 之前
+	text := data.TextBody
+	if text == "" {
+		text = htmlToText(data.Body)
+	}
+	if text != "" {
+		request.Content = append(request.Content, sendGridContent{Type: "text/plain", Value: text})
+	}
+	if data.Body != "" {
+		request.Content = append(request.Content, sendGridContent{Type: "text/html", Value: data.Body})
+	}
+	for i := range data.Attachments {
+		a := &data.Attachments[i]
+		content, err := a.bytes()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read attachment %s: %w", a.Filename, err)
+		}
+		request.Attachments = append(request.Attachments, sendGridAttachment{
+			Content:     base64.StdEncoding.EncodeToString(content),
+			Type:        a.contentType(),
+			Filename:    a.Filename,
+			Disposition: a.disposition(),
+			ContentID:   a.ContentID,
+		})
+	}
+
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode sendgrid request: %w", err)
+	}
+
+	endpoint := p.config.Endpoint
+	if endpoint == "" {
+		endpoint = defaultSendGridEndpoint
+	}
+
+	// 超时通过 ctx 控制，EmailData.Timeout 可以覆盖配置
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout(data, p.config.Timeout))
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sendgrid request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+p.config.APIKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send email: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, &ProviderError{Provider: ProviderSendGrid, StatusCode: resp.StatusCode, Message: string(body)}
+	}
+
+	return &SendResult{
+		ProviderMessageID: resp.Header.Get("X-Message-Id"),
+		Accepted:          data.Recipients(),
+		StatusCode:        resp.StatusCode,
+	}, nil
+}
+
+// from 返回发件人，EmailData.From 优先，配置的 FromName 优先于 From 中的显示名称
+func (p *SendGridProvider) from(data *EmailData) sendGridAddress {
+	if data.From != "" {
+		name, addr := splitFrom(data.From)
+		return sendGridAddress{Email: addr, Name: name}
+	}
+	name, addr := splitFrom(p.config.From)
+	if p.config.FromName != "" {
+		name = p.config.FromName
+	}
+	return sendGridAddress{Email: addr, Name: name}
+}
+
+// sendGridAddresses 转换地址列表
+func sendGridAddresses(addrs []string) []sendGridAddress {
+	if len(addrs) == 0 {
+		return nil
+	}
+	result := make([]sendGridAddress, 0, len(addrs))
+	for _, addr := range addrs {
+		result = append(result, sendGridAddress{Email: addr})
+	}
+	return result
+}
@@ -0,0 +1,199 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// SESProvider 通过 AWS SES v2 API 发送邮件
+//
+// 使用 Raw 内容发送，邮件格式与 SMTP 渠道保持一致
+type SESProvider struct {
+	config *SESConfig
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewSESProvider 创建 AWS SES 发送渠道
+func NewSESProvider(config *SESConfig) *SESProvider {
+	return &SESProvider{
+		config: config,
+		client: &http.Client{},
+		now:    time.Now,
+	}
+}
+
+type sesRequest struct {
+	FromEmailAddress string         `json:"FromEmailAddress"`
+	Destination      sesDestination `json:"Destination"`
+	Content          sesContent     `json:"Content"`
+}
+
+type sesDestination struct {
+	ToAddresses  []string `json:"ToAddresses"`
+	CcAddresses  []string `json:"CcAddresses,omitempty"`
+	BccAddresses []string `json:"BccAddresses,omitempty"`
+}
+
+type sesContent struct {
+	Raw sesRawMessage `json:"Raw"`
+}
+
+type sesRawMessage struct {
+	Data string `json:"Data"`
+}
+
+// Send 发送邮件
+//
+// 支持 EmailData.From 覆盖发件人，地址需要是 SES 中已验证的身份
+func (p *SESProvider) Send(ctx context.Context, data *EmailData) (*SendResult, error) {
+	message, err := buildMessage(p.config.From, data)
+	if err != nil {
+		return nil, err
+	}
+
+	from := p.config.From
+	if data.From != "" {
+		from = data.From
+	}
+	_, fromAddress := splitFrom(from)
+
+	payload, err := json.Marshal(&sesRequest{
+		FromEmailAddress: fromAddress,
+		Destination: sesDestination{
+			ToAddresses:  data.To,
+			CcAddresses:  data.Cc,
+			BccAddresses: data.Bcc,
+		},
+		Content: sesContent{
+			Raw: sesRawMessage{Data: base64.StdEncoding.EncodeToString(message)},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode ses request: %w", err)
+	}
+
+	endpoint := p.config.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://email.%s.amazonaws.com", p.config.Region)
+	}
+
+	// 超时通过 ctx 控制，EmailData.Timeout 可以覆盖配置
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout(data, p.config.Timeout))
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(endpoint, "/")+"/v2/email/outbound-emails", bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ses request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	p.sign(req, payload)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send email: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, &ProviderError{Provider: ProviderSES, StatusCode: resp.StatusCode, Message: string(body)}
+	}
+
+	var result struct {
+		MessageID string `json:"MessageId"`
+	}
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 4096)).Decode(&result)
+	return &SendResult{
+		ProviderMessageID: result.MessageID,
+		Accepted:          data.Recipients(),
+		StatusCode:        resp.StatusCode,
+	}, nil
+}
+
+// sign 使用 AWS Signature Version 4 签名请求
+func (p *SESProvider) sign(req *http.Request, payload []byte) {
+	const service = "ses"
+
+	now := p.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(payload)
+
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if p.config.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", p.config.SessionToken)
+	}
+
+	signedHeaders := []string{"content-type", "host", "x-amz-content-sha256", "x-amz-date"}
+	if p.config.SessionToken != "" {
+		signedHeaders = append(signedHeaders, "x-amz-security-token")
+	}
+
+	var canonicalHeaders strings.Builder
+	for _, h := range signedHeaders {
+		canonicalHeaders.WriteString(h + ":" + strings.TrimSpace(req.Header.Get(h)) + "\n")
+	}
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, p.config.Region, service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+p.config.SecretAccessKey), date)
+	key = hmacSHA256(key, p.config.Region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		p.config.AccessKeyID, scope, strings.Join(signedHeaders, ";"), signature,
+	))
+}
+
+// canonicalQuery 按 SigV4 规则排序并编码查询参数
+func canonicalQuery(values url.Values) string {
+	// url.Values.Encode 按键排序，空格需编码为 %20
+	return strings.ReplaceAll(values.Encode(), "+", "%20")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,275 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/smtp"
+	"net/textproto"
+	"time"
+)
+
+// SMTPProvider 通过 SMTP 发送邮件，加密方式由 SMTPConfig.TLSMode 决定
+//
+// 支持 EmailData.From 覆盖邮件头中的发件人，中继是否允许由服务器决定
+//
+// 配置 MaxIdleConns 后复用已认证的连接，批量发送时避免每封邮件都重新握手和认证
+type SMTPProvider struct {
+	config *SMTPConfig
+	dialer contextDialer
+	pool   *smtpPool
+	dkim   *dkimSigner
+	err    error // 初始化错误，发送时返回
+}
+
+// NewSMTPProvider 创建 SMTP 发送渠道
+//
+// DKIM 私钥或代理地址无效时不会在这里返回错误，而是在发送时返回，需要提前校验时使用 NewProvider
+func NewSMTPProvider(config *SMTPConfig) *SMTPProvider {
+	p := &SMTPProvider{config: config}
+	p.dialer, p.err = newProxyDialer(config.Proxy)
+	if p.err == nil && config.DKIM.Enabled() {
+		p.dkim, p.err = newDKIMSigner(&config.DKIM)
+	}
+	if config.MaxIdleConns > 0 {
+		p.pool = newSMTPPool(config.MaxIdleConns, config.MaxConnLifetime, p.dial)
+	}
+	return p
+}
+
+// Send 发送邮件
+func (p *SMTPProvider) Send(ctx context.Context, data *EmailData) (*SendResult, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	// 设置超时，EmailData.Timeout 可以覆盖配置
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout(data, p.config.Timeout))
+	defer cancel()
+
+	// 默认回复地址
+	if data.ReplyTo == "" && p.config.ReplyTo != "" {
+		d := *data
+		d.ReplyTo = p.config.ReplyTo
+		data = &d
+	}
+
+	// 构建邮件内容
+	message, err := buildMessage(p.config.From, data)
+	if err != nil {
+		return nil, err
+	}
+
+	// DKIM 签名
+	if p.dkim != nil {
+		if message, err = p.dkim.Sign(message); err != nil {
+			return nil, err
+		}
+	}
+
+	// 发送邮件
+	var result *SendResult
+	if p.pool != nil {
+		result, err = p.sendPooled(ctx, data.Recipients(), message)
+	} else {
+		result, err = p.sendOnce(ctx, data.Recipients(), message)
+	}
+	if err != nil {
+		// ctx 取消或超时导致的网络错误，返回 ctx 的错误以便调用方和熔断器区分
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, fmt.Errorf("failed to send email: %w (%v)", ctxErr, err)
+		}
+		return nil, fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return result, nil
+}
+
+// Close 关闭连接池中的空闲连接
+func (p *SMTPProvider) Close() error {
+	if p.pool != nil {
+		p.pool.close()
+	}
+	return nil
+}
+
+// sendOnce 每封邮件使用新连接发送
+func (p *SMTPProvider) sendOnce(ctx context.Context, to []string, msg []byte) (*SendResult, error) {
+	c, err := p.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.close()
+
+	stop := c.watch(ctx)
+	defer stop()
+
+	return p.transact(c.client, to, msg)
+}
+
+// sendPooled 从连接池获取连接发送邮件
+func (p *SMTPProvider) sendPooled(ctx context.Context, to []string, msg []byte) (*SendResult, error) {
+	c, err := p.pool.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stop := c.watch(ctx)
+	result, err := p.transact(c.client, to, msg)
+	interrupted := !stop()
+	if err != nil {
+		// 出错后会话状态不确定，不再复用
+		c.close()
+		return nil, err
+	}
+	if interrupted {
+		// 邮件已经发送，但 ctx 取消时连接的截止时间被修改，不再复用
+		c.close()
+		return result, nil
+	}
+
+	p.pool.put(c)
+	return result, nil
+}
+
+// dial 连接 SMTP 服务器并完成认证
+func (p *SMTPProvider) dial(ctx context.Context) (*smtpConn, error) {
+	// 构建SMTP地址
+	addr := fmt.Sprintf("%s:%d", p.config.Host, p.config.Port)
+
+	tlsConfig := &tls.Config{
+		ServerName: p.config.Host,
+	}
+
+	// 连接到SMTP服务器，配置了代理时通过代理建立连接
+	conn, err := p.dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+	if p.config.TLSMode == "" || p.config.TLSMode == TLSModeImplicit {
+		tlsConn := tls.Client(conn, tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to connect to SMTP server: %w", err)
+		}
+		conn = tlsConn
+	}
+
+	// 整个会话受 ctx 的超时限制，ctx 取消时中断握手和认证
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	defer interruptOnDone(ctx, conn)()
+
+	// 创建SMTP客户端
+	client, err := smtp.NewClient(conn, p.config.Host)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create SMTP client: %w", err)
+	}
+
+	// 升级为TLS
+	if p.config.TLSMode == TLSModeSTARTTLS {
+		if err = client.StartTLS(tlsConfig); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("SMTP STARTTLS failed: %w", err)
+		}
+	}
+
+	// 认证，本地开发中继通常不需要认证
+	if p.config.Username != "" {
+		method := p.config.AuthMethod
+		if method == AuthAuto {
+			_, params := client.Extension("AUTH")
+			method = negotiateAuth(params)
+		}
+		auth, err := newSMTPAuth(method, p.config)
+		if err != nil {
+			client.Close()
+			return nil, err
+		}
+		if err = client.Auth(auth); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("SMTP authentication failed: %w", err)
+		}
+	}
+
+	return &smtpConn{client: client, conn: conn, createdAt: time.Now()}, nil
+}
+
+// transact 在已认证的会话上发送一封邮件
+//
+// 部分收件人被拒绝时继续发送给其他收件人，被拒绝的收件人记录在 SendResult.Rejected 中；
+// 全部被拒绝时返回第一个收件人的错误
+func (p *SMTPProvider) transact(client *smtp.Client, to []string, msg []byte) (*SendResult, error) {
+	// 设置发件人，信封发件人总是使用配置的地址，退信会回到该地址
+	_, from := splitFrom(p.config.From)
+	if err := client.Mail(from); err != nil {
+		return nil, fmt.Errorf("failed to set sender: %w", err)
+	}
+
+	// 设置收件人
+	result := &SendResult{Provider: ProviderSMTP}
+	var firstErr error
+	for _, recipient := range to {
+		if err := client.Rcpt(recipient); err != nil {
+			var smtpErr *textproto.Error
+			if !errors.As(err, &smtpErr) {
+				return nil, fmt.Errorf("failed to set recipient %s: %w", recipient, err)
+			}
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed to set recipient %s: %w", recipient, err)
+			}
+			if result.Rejected == nil {
+				result.Rejected = make(map[string]string)
+			}
+			result.Rejected[recipient] = fmt.Sprintf("%d %s", smtpErr.Code, smtpErr.Msg)
+			continue
+		}
+		result.Accepted = append(result.Accepted, recipient)
+	}
+	if len(result.Accepted) == 0 {
+		return nil, firstErr
+	}
+
+	// 发送邮件内容
+	code, response, err := smtpData(client, msg)
+	if err != nil {
+		return nil, err
+	}
+	result.StatusCode = code
+	result.Response = response
+
+	return result, nil
+}
+
+// smtpData 发送 DATA 命令和邮件内容，返回服务器的最终应答
+//
+// net/smtp 的 Data 会丢弃应答内容，而应答中通常包含服务器分配的队列ID
+func smtpData(client *smtp.Client, msg []byte) (int, string, error) {
+	id, err := client.Text.Cmd("DATA")
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to get data writer: %w", err)
+	}
+	client.Text.StartResponse(id)
+	_, _, err = client.Text.ReadResponse(354)
+	client.Text.EndResponse(id)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to get data writer: %w", err)
+	}
+
+	writer := client.Text.DotWriter()
+	if _, err := writer.Write(msg); err != nil {
+		return 0, "", fmt.Errorf("failed to write message: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return 0, "", fmt.Errorf("failed to close data writer: %w", err)
+	}
+
+	code, response, err := client.Text.ReadResponse(250)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to close data writer: %w", err)
+	}
+	return code, response, nil
+}
@@ -0,0 +1,193 @@
+package email
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProvider(t *testing.T) {
+	p, err := NewProvider(&Config{SMTP: SMTPConfig{Host: "smtp.example.com", From: "no-reply@example.com"}})
+	assert.NoError(t, err)
+	assert.IsType(t, &SMTPProvider{}, p)
+
+	p, err = NewProvider(&Config{Provider: "sendgrid", SendGrid: SendGridConfig{APIKey: "key", From: "a@example.com"}})
+	assert.NoError(t, err)
+	assert.IsType(t, &SendGridProvider{}, p)
+
+	_, err = NewProvider(&Config{Provider: "ses"})
+	assert.Error(t, err)
+
+	_, err = NewProvider(&Config{Provider: "pigeon"})
+	assert.Error(t, err)
+
+	_, err = NewProvider(&Config{SMTP: SMTPConfig{Host: "smtp.example.com", From: "no-reply@example.com", TLSMode: "ssl"}})
+	assert.ErrorContains(t, err, "unknown smtp tls mode")
+
+	// 配置错误在发送时返回
+	_, err = NewSender(&Config{Provider: "pigeon"}).SendEmail(context.Background(), NewEmailData("a@example.com", "hi", ""))
+	assert.ErrorContains(t, err, "unknown email provider")
+}
+
+func TestSMTPProvider_TLSMode(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	host, port, _ := net.SplitHostPort(server.ln.Addr().String())
+	portNum, _ := strconv.Atoi(port)
+
+	// 明文中继，不认证
+	p := NewSMTPProvider(&SMTPConfig{Host: host, Port: portNum, From: "no-reply@example.com", TLSMode: TLSModeNone})
+	result, err := p.Send(context.Background(), NewEmailData("a@example.com", "hi", "<p>hi</p>"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a@example.com"}, result.Accepted)
+	assert.Equal(t, 250, result.StatusCode)
+	assert.Equal(t, int32(1), server.mails.Load())
+
+	// 部分收件人被拒绝时继续发送
+	data := NewEmailData("a@example.com", "hi", "<p>hi</p>")
+	data.Cc = []string{"reject@example.com"}
+	result, err = p.Send(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a@example.com"}, result.Accepted)
+	assert.Equal(t, map[string]string{"reject@example.com": "550 5.1.1 no such user"}, result.Rejected)
+	assert.Equal(t, "2.0.0 Ok: queued as ABC123", result.Response)
+
+	// 全部被拒绝时返回错误
+	_, err = p.Send(context.Background(), NewEmailData("reject@example.com", "hi", "<p>hi</p>"))
+	assert.ErrorContains(t, err, "no such user")
+	assert.Equal(t, int32(2), server.mails.Load())
+
+	// 服务器不支持 STARTTLS 时不会降级为明文发送
+	p = NewSMTPProvider(&SMTPConfig{Host: host, Port: portNum, From: "no-reply@example.com", TLSMode: TLSModeSTARTTLS})
+	_, err = p.Send(context.Background(), NewEmailData("a@example.com", "hi", "<p>hi</p>"))
+	assert.ErrorContains(t, err, "STARTTLS")
+	assert.Equal(t, int32(2), server.mails.Load())
+}
+
+func TestSMTPProvider_ReplyTo(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	host, port, _ := net.SplitHostPort(server.ln.Addr().String())
+	portNum, _ := strconv.Atoi(port)
+	p := NewSMTPProvider(&SMTPConfig{Host: host, Port: portNum, From: "no-reply@example.com", ReplyTo: "support@example.com", TLSMode: TLSModeNone})
+
+	replyTo := func() string {
+		m, err := mail.ReadMessage(strings.NewReader(server.last.Load().(string)))
+		assert.NoError(t, err)
+		return m.Header.Get("Reply-To")
+	}
+
+	// 使用配置的默认值，不修改调用方的数据
+	data := NewEmailData("a@example.com", "hi", "<p>hi</p>")
+	_, err := p.Send(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, "support@example.com", replyTo())
+	assert.Empty(t, data.ReplyTo)
+
+	// 单封邮件覆盖
+	data.ReplyTo = "sales@example.com"
+	_, err = p.Send(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, "sales@example.com", replyTo())
+}
+
+func TestSendGridProvider(t *testing.T) {
+	var got sendGridRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	p := NewSendGridProvider(&SendGridConfig{APIKey: "key", From: "希音平台 <no-reply@example.com>", Endpoint: srv.URL})
+	_, err := p.Send(context.Background(), &EmailData{
+		MessageID: "1.abc@example.com",
+		To:        []string{"user@example.com"},
+		Subject:   "hi",
+		Body:      "<p>hi</p>",
+		ReplyTo:   "support@example.com",
+		Headers:   map[string]string{"X-Entity-Ref-ID": "order-42"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &sendGridAddress{Email: "support@example.com"}, got.ReplyTo)
+	assert.Equal(t, sendGridAddress{Email: "no-reply@example.com", Name: "希音平台"}, got.From)
+	assert.Equal(t, map[string]string{"X-Entity-Ref-ID": "order-42", "Message-ID": "<1.abc@example.com>"}, got.Headers)
+	assert.Equal(t, "user@example.com", got.Personalizations[0].To[0].Email)
+	assert.Equal(t, "no-reply@example.com", got.From.Email)
+	assert.Equal(t, []sendGridContent{
+		{Type: "text/plain", Value: "hi"},
+		{Type: "text/html", Value: "<p>hi</p>"},
+	}, got.Content)
+}
+
+func TestSESProvider(t *testing.T) {
+	var got sesRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/email/outbound-emails", r.URL.Path)
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/"))
+		assert.Contains(t, r.Header.Get("Authorization"), "/us-east-1/ses/aws4_request")
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"MessageId":"ses-1"}`))
+	}))
+	defer srv.Close()
+
+	p := NewSESProvider(&SESConfig{Region: "us-east-1", AccessKeyID: "AKID", SecretAccessKey: "secret", From: "no-reply@example.com", Endpoint: srv.URL})
+	result, err := p.Send(context.Background(), &EmailData{To: []string{"user@example.com"}, Subject: "hi", Body: "<p>hi</p>"})
+	assert.NoError(t, err)
+	assert.Equal(t, "ses-1", result.ProviderMessageID)
+	assert.Equal(t, []string{"user@example.com"}, got.Destination.ToAddresses)
+
+	raw, err := base64.StdEncoding.DecodeString(got.Content.Raw.Data)
+	assert.NoError(t, err)
+	assert.Contains(t, string(raw), "To: user@example.com\r\n")
+	assert.Contains(t, string(raw), "<p>hi</p>")
+}
+
+func TestDirectMailProvider(t *testing.T) {
+	p := NewDirectMailProvider(&DirectMailConfig{AccessKeyID: "id", AccessKeySecret: "secret", AccountName: "no-reply@mail.example.com"})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		params, err := url.ParseQuery(string(body))
+		assert.NoError(t, err)
+		assert.Equal(t, "SingleSendMail", params.Get("Action"))
+		assert.Equal(t, "user@example.com", params.Get("ToAddress"))
+
+		signature := params.Get("Signature")
+		params.Del("Signature")
+		assert.Equal(t, p.signature(http.MethodPost, params), signature)
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"Code":"InvalidToAddress","Message":"bad address"}`))
+	}))
+	defer srv.Close()
+	p.config.Endpoint = srv.URL
+
+	_, err := p.Send(context.Background(), &EmailData{To: []string{"user@example.com"}, Subject: "hi", Body: "<p>hi</p>"})
+	assert.ErrorContains(t, err, "InvalidToAddress")
+}
+
+func TestPercentEncode(t *testing.T) {
+	assert.Equal(t, "a%20b%2Ac~", percentEncode("a b*c~"))
+}
+
+func TestSendTimeout(t *testing.T) {
+	data := &EmailData{}
+	assert.Equal(t, defaultProviderTimeout, sendTimeout(data, 0))
+	assert.Equal(t, 5*time.Second, sendTimeout(data, 5*time.Second))
+
+	data.Timeout = 2 * time.Minute
+	assert.Equal(t, 2*time.Minute, sendTimeout(data, 5*time.Second))
+}
@@ -0,0 +1,235 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrQueueFull 队列已满
+	ErrQueueFull = errors.New("email queue is full")
+	// ErrQueueClosed 队列已关闭
+	ErrQueueClosed = errors.New("email queue is closed")
+)
+
+// QueueConfig 异步发送队列配置
+type QueueConfig struct {
+	Workers      int           `yaml:"workers"`       // 并发发送的 worker 数量，默认 4
+	Size         int           `yaml:"size"`          // 队列容量，默认 1000
+	MaxRetries   int           `yaml:"max_retries"`   // 暂时性错误的最大重试次数，默认 3，负数表示不重试
+	RetryBackoff time.Duration `yaml:"retry_backoff"` // 首次重试间隔，之后每次翻倍，默认 1 秒
+
+	// Retryable 判断错误是否可以重试，默认使用 IsTransient
+	Retryable func(err error) bool `yaml:"-"`
+	// OnError 最终发送失败时的回调（重试耗尽或不可重试的错误）
+	OnError func(data *EmailData, err error) `yaml:"-"`
+}
+
+// Queue 异步邮件发送队列
+//
+// 调用方将邮件放入队列后立即返回，由后台 worker 发送并重试暂时性错误，
+// 避免 SMTP 响应慢时阻塞 HTTP 请求。
+//
+// 使用 Reader 的附件在第一次发送时缓存到 Content，重试时重新发送完整内容。
+//
+// 租户邮件使用 EnqueueContext 入队，worker 发送时恢复上下文中的租户ID，
+// 通过租户自己的 SMTP 和模板发送。
+//
+// 使用示例:
+//
+//	queue := email.NewQueue(sender, &email.QueueConfig{
+//	    Workers: 8,
+//	    OnError: func(data *email.EmailData, err error) {
+//	        log.Errorf("邮件发送失败: to=%v, error=%v", data.To, err)
+//	    },
+//	})
+//	defer queue.Shutdown(context.Background())
+//
+//	if err := queue.EnqueueContext(ctx, email.NewEmailData(to, subject, body)); err != nil {
+//	    return err
+//	}
+type Queue struct {
+	sender *Sender
+	config QueueConfig
+	jobs   chan queueJob
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// queueJob 队列中的邮件及入队时的租户ID
+type queueJob struct {
+	data     *EmailData
+	tenantID uint32
+}
+
+// NewQueue 创建异步发送队列并启动 worker
+func NewQueue(sender *Sender, config *QueueConfig) *Queue {
+	cfg := QueueConfig{}
+	if config != nil {
+		cfg = *config
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = 4
+	}
+	if cfg.Size <= 0 {
+		cfg.Size = 1000
+	}
+	if cfg.MaxRetries < 0 {
+		cfg.MaxRetries = 0
+	} else if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = 3
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = time.Second
+	}
+	if cfg.Retryable == nil {
+		cfg.Retryable = IsTransient
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	q := &Queue{
+		sender: sender,
+		config: cfg,
+		jobs:   make(chan queueJob, cfg.Size),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	q.wg.Add(cfg.Workers)
+	for i := 0; i < cfg.Workers; i++ {
+		go q.worker()
+	}
+	return q
+}
+
+// Enqueue 将邮件放入队列，不会阻塞，使用默认发送渠道，租户邮件请使用 EnqueueContext
+//
+// 返回:
+//   - error: 队列已满返回 ErrQueueFull，已关闭返回 ErrQueueClosed
+func (q *Queue) Enqueue(data *EmailData) error {
+	return q.EnqueueContext(context.Background(), data)
+}
+
+// EnqueueContext 将邮件放入队列，不会阻塞
+//
+// 保存 ctx 中的租户ID（见 WithTenantID），worker 发送时恢复，ctx 的取消不影响后台发送
+//
+// 返回:
+//   - error: 队列已满返回 ErrQueueFull，已关闭返回 ErrQueueClosed
+func (q *Queue) EnqueueContext(ctx context.Context, data *EmailData) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.closed {
+		return ErrQueueClosed
+	}
+
+	select {
+	case q.jobs <- queueJob{data: data, tenantID: TenantIDFromContext(ctx)}:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Len 返回队列中等待发送的邮件数量
+func (q *Queue) Len() int {
+	return len(q.jobs)
+}
+
+// Shutdown 停止接收新邮件，并等待队列中的邮件发送完成
+//
+// ctx 到期时取消正在进行的发送和重试并返回 ctx 的错误，未发送的邮件会被丢弃
+func (q *Queue) Shutdown(ctx context.Context) error {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.jobs)
+	}
+	q.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		q.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		q.cancel()
+		return nil
+	case <-ctx.Done():
+		q.cancel()
+		<-done
+		return ctx.Err()
+	}
+}
+
+// worker 从队列中取出邮件并发送
+func (q *Queue) worker() {
+	defer q.wg.Done()
+	for job := range q.jobs {
+		if q.ctx.Err() != nil {
+			// 已超时关闭，丢弃剩余邮件
+			q.fail(job.data, q.ctx.Err())
+			continue
+		}
+		if err := q.send(job); err != nil {
+			q.fail(job.data, err)
+		}
+	}
+}
+
+// send 发送邮件，暂时性错误按指数退避重试
+//
+// 发送前校验收件人地址并检查抑制列表，不通过时不重试。
+// 熔断器打开时按首次重试间隔等待，不消耗重试次数，直到熔断器恢复或队列关闭
+func (q *Queue) send(job queueJob) error {
+	ctx, data := q.ctx, job.data
+	if job.tenantID != 0 {
+		ctx = WithTenantID(ctx, job.tenantID)
+	}
+	if err := q.sender.validateRecipients(ctx, data.Recipients()...); err != nil {
+		return err
+	}
+
+	backoff := q.config.RetryBackoff
+	attempt := 0
+	for {
+		_, err := q.sender.SendEmail(ctx, data)
+		if err == nil {
+			return nil
+		}
+
+		delay := q.config.RetryBackoff
+		if !errors.Is(err, ErrCircuitOpen) {
+			if attempt >= q.config.MaxRetries || !q.config.Retryable(err) {
+				return err
+			}
+			attempt++
+			delay = backoff
+			backoff *= 2
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-q.ctx.Done():
+			timer.Stop()
+			return err
+		}
+	}
+}
+
+func (q *Queue) fail(data *EmailData, err error) {
+	if q.config.OnError != nil {
+		q.config.OnError(data, err)
+	}
+}
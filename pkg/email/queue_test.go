@@ -0,0 +1,143 @@
+package email
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeProvider 测试用的发送渠道，按顺序返回预设的错误
+type fakeProvider struct {
+	mu     sync.Mutex
+	errs   []error
+	sent   []*EmailData
+	called int
+}
+
+func (p *fakeProvider) Send(_ context.Context, data *EmailData) (*SendResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.called++
+	if len(p.errs) > 0 {
+		err := p.errs[0]
+		p.errs = p.errs[1:]
+		if err != nil {
+			return nil, err
+		}
+	}
+	p.sent = append(p.sent, data)
+	return &SendResult{Accepted: data.Recipients()}, nil
+}
+
+func TestQueue_RetryTransient(t *testing.T) {
+	provider := &fakeProvider{errs: []error{
+		&ProviderError{Provider: ProviderSendGrid, StatusCode: 503},
+		&ProviderError{Provider: ProviderSendGrid, StatusCode: 429},
+	}}
+	q := NewQueue(NewSenderWithProvider(&Config{}, provider), &QueueConfig{Workers: 1, RetryBackoff: time.Millisecond})
+
+	assert.NoError(t, q.Enqueue(NewEmailData("a@example.com", "hi", "")))
+	assert.NoError(t, q.Shutdown(context.Background()))
+
+	assert.Equal(t, 3, provider.called)
+	assert.Len(t, provider.sent, 1)
+}
+
+func TestQueue_PermanentError(t *testing.T) {
+	provider := &fakeProvider{errs: []error{&ProviderError{Provider: ProviderSendGrid, StatusCode: 400}}}
+
+	var failed []error
+	q := NewQueue(NewSenderWithProvider(&Config{}, provider), &QueueConfig{
+		Workers:      1,
+		RetryBackoff: time.Millisecond,
+		OnError: func(_ *EmailData, err error) {
+			failed = append(failed, err)
+		},
+	})
+
+	assert.NoError(t, q.Enqueue(NewEmailData("a@example.com", "hi", "")))
+	assert.NoError(t, q.Shutdown(context.Background()))
+
+	assert.Equal(t, 1, provider.called)
+	assert.Len(t, failed, 1)
+}
+
+func TestQueue_FullAndClosed(t *testing.T) {
+	block := make(chan struct{})
+	provider := &blockingProvider{block: block}
+	q := NewQueue(NewSenderWithProvider(&Config{}, provider), &QueueConfig{Workers: 1, Size: 1})
+
+	// 第一封被 worker 取走并阻塞，第二封留在队列中，第三封放不下
+	assert.NoError(t, q.Enqueue(NewEmailData("a@example.com", "1", "")))
+	assert.Eventually(t, func() bool { return q.Len() == 0 }, time.Second, time.Millisecond)
+	assert.NoError(t, q.Enqueue(NewEmailData("a@example.com", "2", "")))
+	assert.ErrorIs(t, q.Enqueue(NewEmailData("a@example.com", "3", "")), ErrQueueFull)
+
+	close(block)
+	assert.NoError(t, q.Shutdown(context.Background()))
+	assert.ErrorIs(t, q.Enqueue(NewEmailData("a@example.com", "4", "")), ErrQueueClosed)
+}
+
+func TestIsTransient(t *testing.T) {
+	assert.True(t, IsTransient(context.DeadlineExceeded))
+	assert.True(t, IsTransient(&ProviderError{StatusCode: 502}))
+	assert.False(t, IsTransient(&ProviderError{StatusCode: 401}))
+	assert.False(t, IsTransient(errors.New("render failed")))
+	assert.False(t, IsTransient(nil))
+}
+
+type blockingProvider struct {
+	block chan struct{}
+}
+
+func (p *blockingProvider) Send(ctx context.Context, _ *EmailData) (*SendResult, error) {
+	select {
+	case <-p.block:
+		return &SendResult{}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// buildingProvider 每次发送都构建完整邮件，第一次返回暂时性错误
+type buildingProvider struct {
+	mu       sync.Mutex
+	messages [][]byte
+}
+
+func (p *buildingProvider) Send(_ context.Context, data *EmailData) (*SendResult, error) {
+	msg, err := buildMessage("no-reply@example.com", data)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.messages = append(p.messages, msg)
+	if len(p.messages) == 1 {
+		return nil, &ProviderError{Provider: ProviderSendGrid, StatusCode: 503}
+	}
+	return &SendResult{Accepted: data.Recipients()}, nil
+}
+
+func TestQueue_RetryReaderAttachment(t *testing.T) {
+	provider := &buildingProvider{}
+	q := NewQueue(NewSenderWithProvider(&Config{}, provider), &QueueConfig{Workers: 1, RetryBackoff: time.Millisecond})
+
+	data := NewEmailData("a@example.com", "发票", "<p>hi</p>")
+	data.Attachments = []Attachment{{Filename: "invoice.pdf", Reader: strings.NewReader("%PDF-1.4 invoice")}}
+	assert.NoError(t, q.Enqueue(data))
+	assert.NoError(t, q.Shutdown(context.Background()))
+
+	// 重试时重新构建的邮件包含完整的附件内容
+	encoded := base64.StdEncoding.EncodeToString([]byte("%PDF-1.4 invoice"))
+	assert.Len(t, provider.messages, 2)
+	for _, msg := range provider.messages {
+		assert.Contains(t, string(msg), encoded)
+	}
+}
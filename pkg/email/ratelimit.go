@@ -0,0 +1,153 @@
+package email
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimitConfig 发送速率限制配置
+//
+// 使用令牌桶算法，允许瞬时发送 Limit 封，之后按 Limit/Period 的速率恢复。
+// 超过限制时发送会等待，直到有可用额度或 ctx 结束
+type RateLimitConfig struct {
+	Limit       int           `yaml:"limit"`        // 每个周期最多发送的邮件数，0 表示不限制
+	Period      time.Duration `yaml:"period"`       // 统计周期，默认 1 分钟
+	DomainLimit int           `yaml:"domain_limit"` // 每个周期发往同一收件人域名的邮件数，0 表示不限制
+}
+
+// tokenBucket 令牌桶
+type tokenBucket struct {
+	capacity float64
+	interval time.Duration // 恢复一个令牌所需的时间
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(limit int, period time.Duration) *tokenBucket {
+	return &tokenBucket{
+		capacity: float64(limit),
+		interval: period / time.Duration(limit),
+		tokens:   float64(limit),
+		last:     time.Now(),
+	}
+}
+
+// take 尝试取出一个令牌，失败时返回需要等待的时间
+func (b *tokenBucket) take() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens += float64(now.Sub(b.last)) / float64(b.interval)
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) * float64(b.interval))
+}
+
+// wait 等待并取出一个令牌
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		delay := b.take()
+		if delay == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// rateLimiter 发送速率限制器，包含全局限制和按收件人域名的限制
+type rateLimiter struct {
+	global *tokenBucket
+
+	domainLimit int
+	period      time.Duration
+	mu          sync.Mutex
+	domains     map[string]*tokenBucket
+}
+
+// newRateLimiter 根据配置创建限制器，未配置限制时返回 nil
+func newRateLimiter(config *RateLimitConfig) *rateLimiter {
+	if config == nil || (config.Limit <= 0 && config.DomainLimit <= 0) {
+		return nil
+	}
+
+	period := config.Period
+	if period <= 0 {
+		period = time.Minute
+	}
+
+	l := &rateLimiter{
+		domainLimit: config.DomainLimit,
+		period:      period,
+		domains:     make(map[string]*tokenBucket),
+	}
+	if config.Limit > 0 {
+		l.global = newTokenBucket(config.Limit, period)
+	}
+	return l
+}
+
+// wait 等待直到邮件可以发送，同一封邮件发往多个域名时每个域名各计一次
+func (l *rateLimiter) wait(ctx context.Context, recipients []string) error {
+	if l.global != nil {
+		if err := l.global.wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	if l.domainLimit <= 0 {
+		return nil
+	}
+	seen := make(map[string]bool)
+	for _, addr := range recipients {
+		domain := addressDomain(addr)
+		if domain == "" || seen[domain] {
+			continue
+		}
+		seen[domain] = true
+		if err := l.domain(domain).wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// domain 返回域名对应的令牌桶，不存在时创建
+func (l *rateLimiter) domain(domain string) *tokenBucket {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b, ok := l.domains[domain]
+	if !ok {
+		b = newTokenBucket(l.domainLimit, l.period)
+		l.domains[domain] = b
+	}
+	return b
+}
+
+// addressDomain 返回邮件地址的域名（小写）
+func addressDomain(addr string) string {
+	addr = strings.TrimSuffix(strings.TrimSpace(addr), ">")
+	i := strings.LastIndex(addr, "@")
+	if i < 0 {
+		return ""
+	}
+	return strings.ToLower(addr[i+1:])
+}
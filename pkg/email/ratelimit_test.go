@@ -0,0 +1,61 @@
+package email
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSender_RateLimit(t *testing.T) {
+	provider := &fakeProvider{}
+	sender := NewSenderWithProvider(&Config{RateLimit: RateLimitConfig{Limit: 2, Period: 100 * time.Millisecond}}, provider)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		_, err := sender.SendEmail(context.Background(), NewEmailData("a@example.com", "hi", ""))
+		assert.NoError(t, err)
+	}
+
+	// 前两封立即发送，后两封每 50ms 恢复一封
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+	assert.Len(t, provider.sent, 4)
+}
+
+func TestSender_RateLimitContext(t *testing.T) {
+	provider := &fakeProvider{}
+	sender := NewSenderWithProvider(&Config{RateLimit: RateLimitConfig{Limit: 1, Period: time.Hour}}, provider)
+
+	_, err := sender.SendEmail(context.Background(), NewEmailData("a@example.com", "hi", ""))
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = sender.SendEmail(ctx, NewEmailData("a@example.com", "hi", ""))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Len(t, provider.sent, 1)
+}
+
+func TestSender_DomainRateLimit(t *testing.T) {
+	provider := &fakeProvider{}
+	sender := NewSenderWithProvider(&Config{RateLimit: RateLimitConfig{DomainLimit: 1, Period: time.Hour}}, provider)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := sender.SendEmail(ctx, NewEmailData("a@gmail.com", "hi", ""))
+	assert.NoError(t, err)
+	// 其他域名不受影响
+	_, err = sender.SendEmail(ctx, NewEmailData("a@qq.com", "hi", ""))
+	assert.NoError(t, err)
+	// 同一域名（不区分大小写）需要等待
+	_, err = sender.SendEmail(ctx, NewEmailData("b@GMAIL.com", "hi", ""))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestAddressDomain(t *testing.T) {
+	assert.Equal(t, "example.com", addressDomain("User@Example.com"))
+	assert.Equal(t, "example.com", addressDomain("张三 <user@example.com>"))
+	assert.Equal(t, "", addressDomain("invalid"))
+}
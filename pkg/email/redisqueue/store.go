@@ -0,0 +1,193 @@
+// Package redisqueue 提供基于 Redis 的邮件任务存储，配合 email.DurableQueue 使用
+//
+// 键布局（P 为 Config.Prefix）:
+//
+//	P:jobs      hash，任务ID → 任务 JSON
+//	P:ready     list，等待发送的任务ID
+//	P:inflight  zset，已取出的任务ID，分数为重新可见的时间（毫秒）
+//	P:seq       任务ID计数器
+//	P:key:<键>  幂等键，过期时间为去重窗口
+//
+// 所有状态变更通过 Lua 脚本原子执行。默认前缀带有 hash tag，所有键位于同一个槽，
+// 因此同样可以使用 *redis.ClusterClient。
+package redisqueue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/heyinLab/common/pkg/email"
+	"github.com/redis/go-redis/v9"
+)
+
+// Config Redis 存储配置
+type Config struct {
+	// Prefix 键前缀，默认 {email:queue}，使用 Redis Cluster 时必须带有 hash tag
+	Prefix string `yaml:"prefix"`
+	// DedupTTL 幂等键的去重窗口，默认 24 小时
+	DedupTTL time.Duration `yaml:"dedup_ttl"`
+}
+
+// Store 基于 Redis 的 email.JobStore 实现
+//
+// 不可见时间按本机时钟计算，多实例部署时各实例的时钟偏差应远小于 Visibility。
+type Store struct {
+	client redis.UniversalClient
+	config Config
+	now    func() time.Time
+}
+
+var _ email.JobStore = (*Store)(nil)
+
+// New 创建 Redis 任务存储，client 由调用方管理，config 为 nil 时使用默认配置
+func New(client redis.UniversalClient, config *Config) *Store {
+	var cfg Config
+	if config != nil {
+		cfg = *config
+	}
+	if cfg.Prefix == "" {
+		cfg.Prefix = "{email:queue}"
+	}
+	if cfg.DedupTTL <= 0 {
+		cfg.DedupTTL = 24 * time.Hour
+	}
+	return &Store{client: client, config: cfg, now: time.Now}
+}
+
+// pushScript 检查幂等键后保存任务
+//
+// KEYS: jobs, ready, seq, dedup key；ARGV: payload, dedup ttl(ms), 是否去重
+var pushScript = redis.NewScript(`
+if ARGV[3] == '1' then
+  if not redis.call('SET', KEYS[4], '1', 'NX', 'PX', ARGV[2]) then
+    return false
+  end
+end
+local id = tostring(redis.call('INCR', KEYS[3]))
+redis.call('HSET', KEYS[1], id, ARGV[1])
+redis.call('LPUSH', KEYS[2], id)
+return id
+`)
+
+// claimScript 先把超时的任务放回 ready，再取出一个任务
+//
+// KEYS: ready, inflight, jobs；ARGV: now(ms), visibility(ms)
+var claimScript = redis.NewScript(`
+local expired = redis.call('ZRANGEBYSCORE', KEYS[2], '-inf', ARGV[1], 'LIMIT', 0, 100)
+for _, id in ipairs(expired) do
+  redis.call('ZREM', KEYS[2], id)
+  redis.call('RPUSH', KEYS[1], id)
+end
+local id = redis.call('RPOP', KEYS[1])
+if not id then
+  return false
+end
+local payload = redis.call('HGET', KEYS[3], id)
+if not payload then
+  return false
+end
+redis.call('ZADD', KEYS[2], tonumber(ARGV[1]) + tonumber(ARGV[2]), id)
+return {id, payload}
+`)
+
+// retryScript 更新任务并设置重新可见的时间，已确认的任务忽略
+//
+// KEYS: jobs, inflight；ARGV: id, payload, visible at(ms)
+var retryScript = redis.NewScript(`
+if redis.call('HEXISTS', KEYS[1], ARGV[1]) == 0 then
+  return 0
+end
+redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+redis.call('ZADD', KEYS[2], ARGV[3], ARGV[1])
+return 1
+`)
+
+// ackScript 删除任务
+//
+// KEYS: jobs, inflight, ready；ARGV: id
+var ackScript = redis.NewScript(`
+redis.call('HDEL', KEYS[1], ARGV[1])
+redis.call('ZREM', KEYS[2], ARGV[1])
+redis.call('LREM', KEYS[3], 0, ARGV[1])
+return 1
+`)
+
+// Push 保存任务，幂等键在去重窗口内已存在时返回 email.ErrDuplicateJob
+func (s *Store) Push(ctx context.Context, job *email.Job) error {
+	payload, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("marshal job: %w", err)
+	}
+	dedup := "0"
+	if job.Key != "" {
+		dedup = "1"
+	}
+
+	id, err := pushScript.Run(ctx, s.client,
+		[]string{s.key("jobs"), s.key("ready"), s.key("seq"), s.key("key:" + job.Key)},
+		string(payload), s.config.DedupTTL.Milliseconds(), dedup).Text()
+	if errors.Is(err, redis.Nil) {
+		return email.ErrDuplicateJob
+	}
+	if err != nil {
+		return fmt.Errorf("redis: push job: %w", err)
+	}
+	job.ID = id
+	return nil
+}
+
+// Claim 取出一个可见的任务，超过不可见时间未确认的任务会被重新取出
+func (s *Store) Claim(ctx context.Context, visibility time.Duration) (*email.Job, error) {
+	items, err := claimScript.Run(ctx, s.client,
+		[]string{s.key("ready"), s.key("inflight"), s.key("jobs")},
+		s.now().UnixMilli(), visibility.Milliseconds()).StringSlice()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis: claim job: %w", err)
+	}
+	if len(items) != 2 {
+		return nil, fmt.Errorf("redis: unexpected claim reply %v", items)
+	}
+
+	job := &email.Job{}
+	if err := json.Unmarshal([]byte(items[1]), job); err != nil {
+		return nil, fmt.Errorf("unmarshal job %s: %w", items[0], err)
+	}
+	job.ID = items[0]
+	return job, nil
+}
+
+// Retry 保存任务的重试次数，delay 后重新可见
+func (s *Store) Retry(ctx context.Context, job *email.Job, delay time.Duration) error {
+	payload, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("marshal job: %w", err)
+	}
+	err = retryScript.Run(ctx, s.client,
+		[]string{s.key("jobs"), s.key("inflight")},
+		job.ID, string(payload), s.now().Add(delay).UnixMilli()).Err()
+	if err != nil {
+		return fmt.Errorf("redis: retry job: %w", err)
+	}
+	return nil
+}
+
+// Ack 删除任务，幂等键保留到去重窗口结束
+func (s *Store) Ack(ctx context.Context, job *email.Job) error {
+	err := ackScript.Run(ctx, s.client,
+		[]string{s.key("jobs"), s.key("inflight"), s.key("ready")}, job.ID).Err()
+	if err != nil {
+		return fmt.Errorf("redis: ack job: %w", err)
+	}
+	return nil
+}
+
+// key 返回带前缀的键名
+func (s *Store) key(name string) string {
+	return s.config.Prefix + ":" + name
+}
@@ -0,0 +1,96 @@
+package redisqueue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/heyinLab/common/pkg/email"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStore(t *testing.T) (*Store, *miniredis.Miniredis) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+	return New(client, nil), mr
+}
+
+func TestStore_PushAndClaim(t *testing.T) {
+	s, mr := newTestStore(t)
+	ctx := context.Background()
+
+	job := &email.Job{Key: "activation:1", Data: &email.EmailData{To: []string{"a@example.com"}}}
+	require.NoError(t, s.Push(ctx, job))
+	assert.Equal(t, "1", job.ID)
+
+	// 去重窗口内的相同幂等键
+	err := s.Push(ctx, &email.Job{Key: "activation:1", Data: job.Data})
+	assert.ErrorIs(t, err, email.ErrDuplicateJob)
+	assert.True(t, mr.Exists("{email:queue}:key:activation:1"))
+
+	claimed, err := s.Claim(ctx, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "1", claimed.ID)
+	assert.Equal(t, "activation:1", claimed.Key)
+	assert.Equal(t, []string{"a@example.com"}, claimed.Data.To)
+
+	// 没有可见的任务
+	none, err := s.Claim(ctx, time.Minute)
+	require.NoError(t, err)
+	assert.Nil(t, none)
+
+	require.NoError(t, s.Ack(ctx, claimed))
+	assert.False(t, mr.Exists("{email:queue}:jobs"))
+}
+
+func TestStore_RetryAndVisibility(t *testing.T) {
+	s, _ := newTestStore(t)
+	now := time.Now()
+	s.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	require.NoError(t, s.Push(ctx, &email.Job{Data: &email.EmailData{Subject: "hi"}}))
+	claimed, err := s.Claim(ctx, time.Minute)
+	require.NoError(t, err)
+
+	claimed.Attempts = 2
+	require.NoError(t, s.Retry(ctx, claimed, 10*time.Second))
+
+	// 重新可见之前取不到
+	job, err := s.Claim(ctx, time.Minute)
+	require.NoError(t, err)
+	assert.Nil(t, job)
+
+	now = now.Add(11 * time.Second)
+	job, err = s.Claim(ctx, time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, job)
+	assert.Equal(t, 2, job.Attempts)
+
+	// 超过不可见时间未确认的任务被重新取出
+	now = now.Add(2 * time.Minute)
+	job, err = s.Claim(ctx, time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, job)
+	assert.Equal(t, claimed.ID, job.ID)
+
+	// 已确认的任务不会被 Retry 恢复
+	require.NoError(t, s.Ack(ctx, job))
+	require.NoError(t, s.Retry(ctx, job, 0))
+	job, err = s.Claim(ctx, time.Minute)
+	require.NoError(t, err)
+	assert.Nil(t, job)
+}
+
+func TestStore_RedisUnavailable(t *testing.T) {
+	s, mr := newTestStore(t)
+	mr.Close()
+
+	err := s.Push(context.Background(), &email.Job{Data: &email.EmailData{}})
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, email.ErrDuplicateJob)
+}
@@ -0,0 +1,194 @@
+package email
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	texttemplate "text/template"
+)
+
+// Renderer 模板引擎，负责把模板文本编译为可渲染的 Template
+//
+// 内置 HTMLRenderer（html/template，默认）和 TextRenderer（text/template）。
+// 需要 MJML 等预编译步骤时，可以实现该接口，在编译后交给内置引擎解析。
+//
+// 使用示例:
+//
+//	type mjmlRenderer struct{ html email.Renderer }
+//
+//	func (r mjmlRenderer) Parse(name, text string) (email.Template, error) {
+//	    compiled, err := mjml.Compile(text)
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    return r.html.Parse(name, compiled)
+//	}
+//
+//	func (r mjmlRenderer) ParseParts(name, subject, body string) (email.Template, error) {
+//	    compiled, err := mjml.Compile(body)
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    return r.html.ParseParts(name, subject, compiled)
+//	}
+type Renderer interface {
+	// Parse 解析包含 {{define "subject"}} 和 {{define "body"}} 两个块的模板文本，
+	// 用于 RegisterTemplate 和从文件加载的模板
+	Parse(name, text string) (Template, error)
+	// ParseParts 分别解析主题和正文模板，用于模板存储中的租户模板
+	ParseParts(name, subject, body string) (Template, error)
+}
+
+// Template 编译后的邮件模板，需要支持并发渲染
+type Template interface {
+	// Render 渲染主题和正文
+	Render(data map[string]interface{}) (subject string, body string, err error)
+}
+
+// executor html/template 和 text/template 模板的公共方法
+type executor interface {
+	Execute(w io.Writer, data any) error
+}
+
+// goTemplate 基于 Go 标准库模板的 Template 实现
+type goTemplate struct {
+	subject executor
+	body    executor
+}
+
+// Render 渲染主题和正文
+func (t *goTemplate) Render(data map[string]interface{}) (string, string, error) {
+	// 渲染主题
+	var subjectBuilder strings.Builder
+	if err := t.subject.Execute(&subjectBuilder, data); err != nil {
+		return "", "", fmt.Errorf("failed to render subject: %w", err)
+	}
+
+	// 渲染正文
+	var bodyBuilder strings.Builder
+	if err := t.body.Execute(&bodyBuilder, data); err != nil {
+		return "", "", fmt.Errorf("failed to render body: %w", err)
+	}
+
+	return subjectBuilder.String(), bodyBuilder.String(), nil
+}
+
+// HTMLRenderer 使用 html/template 的模板引擎，输出会按上下文自动转义，是默认的引擎
+type HTMLRenderer struct {
+	funcs htmltemplate.FuncMap
+}
+
+// NewHTMLRenderer 创建 html/template 模板引擎
+//
+// funcs 为额外的模板函数，与内置的 cid 函数合并，同名时覆盖内置函数
+func NewHTMLRenderer(funcs htmltemplate.FuncMap) *HTMLRenderer {
+	merged := htmltemplate.FuncMap{}
+	for name, fn := range templateFuncs {
+		merged[name] = fn
+	}
+	for name, fn := range funcs {
+		merged[name] = fn
+	}
+	return &HTMLRenderer{funcs: merged}
+}
+
+// Parse 解析包含 subject 和 body 块的模板，模板中可以使用公共页眉页脚（见 layoutPartials）
+func (r *HTMLRenderer) Parse(name, text string) (Template, error) {
+	t, err := r.newTemplate(name).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template for %s: %w", name, err)
+	}
+	subject, body := t.Lookup("subject"), t.Lookup("body")
+	if subject == nil {
+		return nil, fmt.Errorf("subject template not found for %s", name)
+	}
+	if body == nil {
+		return nil, fmt.Errorf("body template not found for %s", name)
+	}
+	return &goTemplate{subject: subject, body: body}, nil
+}
+
+// ParseParts 分别解析主题和正文模板，正文中可以使用公共页眉页脚
+func (r *HTMLRenderer) ParseParts(name, subject, body string) (Template, error) {
+	t := r.newTemplate(name)
+	st, err := t.New("subject").Parse(subject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse subject template: %w", err)
+	}
+	bt, err := t.New("body").Parse(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse body template: %w", err)
+	}
+	return &goTemplate{subject: st, body: bt}, nil
+}
+
+// newTemplate 创建已解析公共页眉页脚的模板集合
+func (r *HTMLRenderer) newTemplate(name string) *htmltemplate.Template {
+	return htmltemplate.Must(htmltemplate.New(name).Funcs(r.funcs).Parse(layoutPartials))
+}
+
+// TextRenderer 使用 text/template 的模板引擎，不做任何转义
+//
+// 适用于纯文本邮件，或由预编译步骤生成、已经转义过的 HTML，不提供公共页眉页脚。
+// 模板中的变量来自用户输入时要自行转义，否则会有 HTML 注入风险。
+type TextRenderer struct {
+	funcs texttemplate.FuncMap
+}
+
+// NewTextRenderer 创建 text/template 模板引擎
+//
+// funcs 为额外的模板函数，与内置的 cid 函数合并，同名时覆盖内置函数
+func NewTextRenderer(funcs texttemplate.FuncMap) *TextRenderer {
+	merged := texttemplate.FuncMap{
+		"cid": CIDURL,
+	}
+	for name, fn := range funcs {
+		merged[name] = fn
+	}
+	return &TextRenderer{funcs: merged}
+}
+
+// Parse 解析包含 subject 和 body 块的模板
+func (r *TextRenderer) Parse(name, text string) (Template, error) {
+	t, err := texttemplate.New(name).Funcs(r.funcs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template for %s: %w", name, err)
+	}
+	subject, body := t.Lookup("subject"), t.Lookup("body")
+	if subject == nil {
+		return nil, fmt.Errorf("subject template not found for %s", name)
+	}
+	if body == nil {
+		return nil, fmt.Errorf("body template not found for %s", name)
+	}
+	return &goTemplate{subject: subject, body: body}, nil
+}
+
+// ParseParts 分别解析主题和正文模板
+func (r *TextRenderer) ParseParts(name, subject, body string) (Template, error) {
+	t := texttemplate.New(name).Funcs(r.funcs)
+	st, err := t.New("subject").Parse(subject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse subject template: %w", err)
+	}
+	bt, err := t.New("body").Parse(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse body template: %w", err)
+	}
+	return &goTemplate{subject: st, body: bt}, nil
+}
+
+// defaultRenderer 默认的 html/template 模板引擎，内置模板总是使用它解析
+var defaultRenderer = NewHTMLRenderer(nil)
+
+// WithRenderer 使用指定的模板引擎解析 RegisterTemplate、LoadFS 和模板存储中的模板
+//
+// 内置模板依赖 html/template 的转义，始终使用默认引擎解析。
+func WithRenderer(renderer Renderer) TemplateManagerOption {
+	return func(tm *TemplateManager) {
+		if renderer != nil {
+			tm.renderer = renderer
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package email
+
+import (
+	"context"
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	texttemplate "text/template"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTMLRenderer(t *testing.T) {
+	r := NewHTMLRenderer(htmltemplate.FuncMap{"upper": strings.ToUpper})
+
+	tmpl, err := r.Parse("greeting", `{{define "subject"}}Hi {{upper .Name}}{{end}}{{define "body"}}<p>{{.Name}}</p><img src="{{cid "logo"}}">{{end}}`)
+	assert.NoError(t, err)
+	subject, body, err := tmpl.Render(map[string]interface{}{"Name": "<b>bob</b>"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Hi &lt;B&gt;BOB&lt;/B&gt;", subject)
+	assert.Equal(t, `<p>&lt;b&gt;bob&lt;/b&gt;</p><img src="cid:logo">`, body)
+
+	_, err = r.Parse("broken", `{{define "subject"}}hi{{end}}`)
+	assert.ErrorContains(t, err, "body template not found")
+
+	tmpl, err = r.ParseParts("stored", "Hi {{.Name}}", "<p>{{.Name}}</p>")
+	assert.NoError(t, err)
+	subject, body, err = tmpl.Render(map[string]interface{}{"Name": "bob"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Hi bob", subject)
+	assert.Equal(t, "<p>bob</p>", body)
+}
+
+func TestTextRenderer(t *testing.T) {
+	r := NewTextRenderer(texttemplate.FuncMap{"upper": strings.ToUpper})
+
+	tmpl, err := r.Parse("greeting", `{{define "subject"}}Hi {{upper .Name}}{{end}}{{define "body"}}{{.Name}} {{cid "logo"}}{{end}}`)
+	assert.NoError(t, err)
+	subject, body, err := tmpl.Render(map[string]interface{}{"Name": "<b>bob</b>"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Hi <B>BOB</B>", subject)
+	assert.Equal(t, "<b>bob</b> cid:logo", body)
+
+	_, err = r.ParseParts("stored", "{{.Name", "")
+	assert.ErrorContains(t, err, "failed to parse subject template")
+}
+
+func TestWithRenderer(t *testing.T) {
+	tm := NewTemplateManager(WithRenderer(NewTextRenderer(nil)))
+
+	// 内置模板仍使用 html/template
+	_, body, err := tm.RenderTemplate(EmailTypePasswordReset, map[string]interface{}{"UserName": "<b>bob</b>"})
+	assert.NoError(t, err)
+	assert.Contains(t, body, "&lt;b&gt;bob&lt;/b&gt;")
+
+	assert.NoError(t, tm.RegisterTemplate("plain", `{{define "subject"}}hi{{end}}{{define "body"}}{{.Name}}{{end}}`))
+	_, body, err = tm.RenderTenantTemplate(context.Background(), 0, "plain", map[string]interface{}{"Name": "<b>bob</b>"})
+	assert.NoError(t, err)
+	assert.Equal(t, "<b>bob</b>", body)
+}
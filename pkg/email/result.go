@@ -0,0 +1,33 @@
+package email
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+// SendResult 邮件发送结果，用于保存投递记录并与退信关联
+type SendResult struct {
+	MessageID         string            `json:"message_id"`          // 邮件头中的 Message-ID（不含尖括号）
+	ProviderMessageID string            `json:"provider_message_id"` // 渠道返回的消息ID，如 SES MessageId、SendGrid X-Message-Id、邮件推送 EnvId
+	Provider          ProviderType      `json:"provider"`            // 发送渠道
+	Accepted          []string          `json:"accepted"`            // 服务器接受的收件人
+	Rejected          map[string]string `json:"rejected"`            // 服务器拒绝的收件人及拒绝原因
+	StatusCode        int               `json:"status_code"`         // SMTP 应答码或 HTTP 状态码
+	Response          string            `json:"response"`            // 服务器应答，如 "2.0.0 Ok: queued as 4B1C2"
+	Duration          time.Duration     `json:"duration"`            // 发送耗时，不含限流等待
+	TemplateVersion   string            `json:"template_version"`    // 模板实验选中的版本，见 TemplateManager.RegisterVariants
+}
+
+// newMessageID 生成全局唯一的 Message-ID（不含尖括号），域名取自发件人地址
+func newMessageID(from string) string {
+	domain := addressDomain(from)
+	if domain == "" {
+		domain = "localhost"
+	}
+
+	var b [12]byte
+	_, _ = rand.Read(b[:])
+	return strconv.FormatInt(time.Now().UnixNano(), 36) + "." + hex.EncodeToString(b[:]) + "@" + domain
+}
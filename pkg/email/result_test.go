@@ -0,0 +1,32 @@
+package email
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSender_SendResult(t *testing.T) {
+	provider := &fakeProvider{}
+	sender := NewSenderWithProvider(&Config{SMTP: SMTPConfig{From: "no-reply@example.com"}}, provider)
+
+	data := NewEmailData("a@example.com", "hi", "")
+	result, err := sender.SendEmail(context.Background(), data)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasSuffix(result.MessageID, "@example.com"))
+	assert.Equal(t, ProviderSMTP, result.Provider)
+	assert.Equal(t, []string{"a@example.com"}, result.Accepted)
+	assert.Positive(t, result.Duration)
+
+	// 渠道收到带 Message-ID 的副本，调用方的数据不变
+	assert.Equal(t, result.MessageID, provider.sent[0].MessageID)
+	assert.Empty(t, data.MessageID)
+
+	// 调用方指定的 Message-ID 原样使用
+	data.MessageID = "order-1@example.com"
+	result, err = sender.SendEmail(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, "order-1@example.com", result.MessageID)
+}
@@ -0,0 +1,93 @@
+package email
+
+import (
+	"context"
+	"strings"
+)
+
+// SandboxHeader 沙箱模式下记录被改写的原收件人的邮件头
+const SandboxHeader = "X-Sandbox-Original-Recipients"
+
+// SandboxConfig 收件人沙箱配置，用于测试和预发环境
+//
+// 开启后只有白名单内的收件人会收到邮件，其他收件人改写为 CatchAll 地址；
+// 未配置 CatchAll 时直接丢弃并记录日志。所有收件人都被丢弃时返回 ErrNoRecipients。
+//
+// 使用示例:
+//
+//	sandbox:
+//	  enabled: true
+//	  allowed_recipients: ["heyin.com", "qa-partner@example.com"]
+//	  catch_all: "staging-mail@heyin.com"
+type SandboxConfig struct {
+	Enabled           bool     `yaml:"enabled"`            // 是否开启
+	AllowedRecipients []string `yaml:"allowed_recipients"` // 允许的地址或域名，域名同时匹配子域名，如 "heyin.com"
+	CatchAll          string   `yaml:"catch_all"`          // 非白名单收件人改写为该地址，为空时丢弃
+}
+
+// allowed 判断收件人是否在白名单内
+func (c *SandboxConfig) allowed(addr string) bool {
+	addr = strings.ToLower(addr)
+	domain := addressDomain(addr)
+	for _, entry := range c.AllowedRecipients {
+		entry = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(entry), "@"))
+		if entry == "" {
+			continue
+		}
+		if strings.Contains(entry, "@") {
+			if addr == entry {
+				return true
+			}
+			continue
+		}
+		if domain == entry || strings.HasSuffix(domain, "."+entry) {
+			return true
+		}
+	}
+	return false
+}
+
+// applySandbox 按沙箱配置过滤收件人，被改写或丢弃的收件人记录在 SandboxHeader 中
+func (s *Sender) applySandbox(ctx context.Context, data *EmailData) {
+	c := &s.config.Sandbox
+	if !c.Enabled {
+		return
+	}
+
+	var blocked []string
+	filter := func(addrs []string) []string {
+		kept := addrs[:0]
+		for _, addr := range addrs {
+			if c.allowed(addr) {
+				kept = append(kept, addr)
+			} else {
+				blocked = append(blocked, addr)
+			}
+		}
+		return kept
+	}
+	data.To = filter(data.To)
+	data.Cc = filter(data.Cc)
+	data.Bcc = filter(data.Bcc)
+	if len(blocked) == 0 {
+		return
+	}
+
+	if c.CatchAll == "" {
+		s.logger.WithContext(ctx).Warnf("沙箱模式丢弃收件人: message_id=%s, recipients=%v", data.MessageID, blocked)
+		return
+	}
+
+	catchAll := true
+	for _, addr := range data.Recipients() {
+		if strings.EqualFold(addr, c.CatchAll) {
+			catchAll = false
+			break
+		}
+	}
+	if catchAll {
+		data.To = append(data.To, c.CatchAll)
+	}
+	data.Headers[SandboxHeader] = strings.Join(blocked, ", ")
+	s.logger.WithContext(ctx).Infof("沙箱模式改写收件人: message_id=%s, recipients=%v, catch_all=%s", data.MessageID, blocked, c.CatchAll)
+}
@@ -0,0 +1,51 @@
+package email
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSandboxConfig_Allowed(t *testing.T) {
+	c := &SandboxConfig{AllowedRecipients: []string{"heyin.com", "@partner.cn", "qa@example.com"}}
+
+	assert.True(t, c.allowed("dev@heyin.com"))
+	assert.True(t, c.allowed("Dev@Mail.Heyin.com"))
+	assert.True(t, c.allowed("a@partner.cn"))
+	assert.True(t, c.allowed("QA@example.com"))
+	assert.False(t, c.allowed("other@example.com"))
+	assert.False(t, c.allowed("user@notheyin.com"))
+}
+
+func TestSender_Sandbox(t *testing.T) {
+	provider := &fakeProvider{}
+	s := NewSenderWithProvider(&Config{Sandbox: SandboxConfig{
+		Enabled:           true,
+		AllowedRecipients: []string{"heyin.com"},
+		CatchAll:          "catch@heyin.com",
+	}}, provider)
+
+	data := NewEmailData("dev@heyin.com", "hi", "body")
+	data.Cc = []string{"customer@example.com"}
+	data.Bcc = []string{"vip@example.com"}
+	_, err := s.SendEmail(context.Background(), data)
+	assert.NoError(t, err)
+
+	sent := provider.sent[0]
+	assert.Equal(t, []string{"dev@heyin.com", "catch@heyin.com"}, sent.To)
+	assert.Empty(t, sent.Cc)
+	assert.Empty(t, sent.Bcc)
+	assert.Equal(t, "customer@example.com, vip@example.com", sent.Headers[SandboxHeader])
+	// 不修改调用方的数据
+	assert.Equal(t, []string{"customer@example.com"}, data.Cc)
+}
+
+func TestSender_SandboxDrop(t *testing.T) {
+	provider := &fakeProvider{}
+	s := NewSenderWithProvider(&Config{Sandbox: SandboxConfig{Enabled: true, AllowedRecipients: []string{"heyin.com"}}}, provider)
+
+	_, err := s.SendEmail(context.Background(), NewEmailData("customer@example.com", "hi", "body"))
+	assert.ErrorIs(t, err, ErrNoRecipients)
+	assert.Equal(t, 0, provider.called)
+}
@@ -0,0 +1,53 @@
+package email
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_SecurityEmails(t *testing.T) {
+	ctx := context.Background()
+	svc := NewService(&Config{SMTP: SMTPConfig{From: "no-reply@example.com"}, DryRun: DryRunConfig{Mode: DryRunCapture}})
+	capture := svc.Provider().(*CaptureProvider)
+
+	_, err := svc.SendMFACodeEmail(ctx, &MFACodeEmailRequest{
+		To: "user@example.com", UserName: "张三", TenantName: "示例科技", Code: "583021",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "示例科技 登录验证码：583021", capture.Last().Data.Subject)
+	assert.Contains(t, capture.Last().Data.Body, "5分钟")
+
+	loginTime := time.Date(2025, 1, 1, 3, 12, 45, 0, time.Local)
+	_, err = svc.SendSecurityAlertEmail(ctx, &SecurityAlertEmailRequest{
+		To: "user@example.com", UserName: "张三", TenantName: "示例科技",
+		LoginTime: loginTime, IPAddress: "203.0.113.7", SecureLink: "https://example.com/security",
+	})
+	assert.NoError(t, err)
+	body := capture.Last().Data.Body
+	assert.Contains(t, body, "2025-01-01 03:12:45")
+	assert.Contains(t, body, "203.0.113.7")
+	assert.NotContains(t, body, "登录地点")
+
+	_, err = svc.SendQuotaWarningEmail(ctx, &QuotaWarningEmailRequest{
+		To: "user@example.com", UserName: "张三", TenantName: "示例科技",
+		UsedBytes: 9728 << 20, TotalBytes: 10 << 30, ManageLink: "https://example.com/storage",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "示例科技 存储空间已使用 95%", capture.Last().Data.Subject)
+	assert.Contains(t, capture.Last().Data.Body, "9.5GB / 10GB")
+
+	_, err = svc.SendQuotaWarningEmail(ctx, &QuotaWarningEmailRequest{
+		To: "user@example.com", UserName: "张三", TenantName: "示例科技", ManageLink: "https://example.com/storage",
+	})
+	assert.Error(t, err)
+}
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "512B", formatBytes(512))
+	assert.Equal(t, "1KB", formatBytes(1024))
+	assert.Equal(t, "1.5MB", formatBytes(3<<19))
+	assert.Equal(t, "10GB", formatBytes(10<<30))
+}
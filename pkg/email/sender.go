@@ -2,139 +2,304 @@ package email
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
-	"net/smtp"
+	"io"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/heyinLab/common/pkg/email/bounce"
 )
 
+// SenderInterface 邮件发送接口，*Sender 实现了该接口
+//
+// 业务代码依赖该接口而不是 *Sender，单元测试中可以替换为 fake.Sender
+//
+// 使用示例:
+//
+//	type UserService struct {
+//	    mailer email.SenderInterface
+//	}
+//
+//	// 测试中
+//	mailer := fake.NewSender()
+//	svc := &UserService{mailer: mailer}
+//	// ... 执行业务流程
+//	assert.Equal(t, email.EmailTypePasswordReset, mailer.Last().Type)
+type SenderInterface interface {
+	SendEmail(ctx context.Context, data *EmailData) (*SendResult, error)
+	SendTemplateEmail(ctx context.Context, emailType EmailType, to []string, data map[string]interface{}) (*SendResult, error)
+	SendTemplateData(ctx context.Context, to []string, data TemplateData) (*SendResult, error)
+	SendTenantActivationEmail(ctx context.Context, to, userName, tenantName, activationLink, expireTime string) (*SendResult, error)
+	SendInvitationEmail(ctx context.Context, to, userName, tenantName, departmentName, roleName, inviterName, inviteTime, acceptLink, declineLink, expireTime string) (*SendResult, error)
+	SendPasswordResetEmail(ctx context.Context, to, userName, resetLink, expireTime string) (*SendResult, error)
+	SendVerificationCodeEmail(ctx context.Context, to, code string, ttl time.Duration) (*SendResult, error)
+	SendMFACodeEmail(ctx context.Context, to, userName, tenantName, code string, ttl time.Duration) (*SendResult, error)
+	SendSecurityAlertEmail(ctx context.Context, to, userName, tenantName, loginTime, ipAddress, location, device, secureLink string) (*SendResult, error)
+	SendQuotaWarningEmail(ctx context.Context, to, userName, tenantName string, usedBytes, totalBytes int64, manageLink string) (*SendResult, error)
+}
+
+var _ SenderInterface = (*Sender)(nil)
+
 // Sender 邮件发送器
 type Sender struct {
-	config *Config
+	config    *Config
+	provider  EmailProvider
+	templates *TemplateManager
+	limiter   *rateLimiter
+	breaker   *circuitBreaker
+	hooks     hooks
+	logger    *log.Helper
+
+	// 租户自定义 SMTP
+	resolver ConfigResolver
+	tenants  tenantProviders
+
+	// 租户品牌配置
+	branding BrandingResolver
+
+	// 抑制列表，由 Service 和发送队列在发送前检查
+	suppression bounce.SuppressionStore
 }
 
-// NewSender 创建邮件发送器
+// NewSender 创建邮件发送器，发送渠道由 config.Provider 决定
+//
+// 配置错误不会在这里返回，而是在发送时返回，需要提前校验时使用 NewProvider
 func NewSender(config *Config) *Sender {
-	return &Sender{
-		config: config,
+	provider, err := NewProvider(config)
+	if err != nil {
+		provider = errProvider{err: err}
 	}
+	return NewSenderWithProvider(config, provider)
 }
 
-// SendEmail 发送邮件
-func (s *Sender) SendEmail(ctx context.Context, data *EmailData) error {
-	// 设置超时
-	ctx, cancel := context.WithTimeout(ctx, s.config.SMTP.Timeout)
-	defer cancel()
-
-	// 构建邮件内容
-	message := s.buildMessage(data)
+// NewSenderWithProvider 使用指定的发送渠道创建邮件发送器
+func NewSenderWithProvider(config *Config, provider EmailProvider) *Sender {
+	return &Sender{
+		config:    config,
+		provider:  provider,
+		templates: newConfiguredTemplateManager(config),
+		limiter:   newRateLimiter(&config.RateLimit),
+		breaker:   newCircuitBreaker(&config.CircuitBreaker),
+		logger:    newLogger(config.Logger),
+	}
+}
 
-	// 配置SMTP认证
-	auth := smtp.PlainAuth("", s.config.SMTP.Username, s.config.SMTP.Password, s.config.SMTP.Host)
+// newConfiguredTemplateManager 根据配置创建模板管理器
+func newConfiguredTemplateManager(config *Config) *TemplateManager {
+	var opts []TemplateManagerOption
+	if config.InlineCSS {
+		opts = append(opts, WithInlineCSS())
+	}
+	opts = append(opts, WithBranding(config.Branding))
+	return NewTemplateManager(opts...)
+}
 
-	// 构建SMTP地址
-	addr := fmt.Sprintf("%s:%d", s.config.SMTP.Host, s.config.SMTP.Port)
+// Provider 返回当前使用的发送渠道，如演练模式下的 *CaptureProvider
+func (s *Sender) Provider() EmailProvider {
+	return s.provider
+}
 
-	// 发送邮件
-	err := s.sendWithTLS(addr, auth, s.config.SMTP.From, []string{data.To}, []byte(message))
-	if err != nil {
-		return fmt.Errorf("failed to send email: %w", err)
+// Close 释放发送渠道持有的资源，如 SMTP 连接池中的连接
+func (s *Sender) Close() error {
+	s.tenants.close()
+	if closer, ok := s.provider.(io.Closer); ok {
+		return closer.Close()
 	}
-
 	return nil
 }
 
-// buildMessage 构建邮件消息
-func (s *Sender) buildMessage(data *EmailData) string {
-	message := fmt.Sprintf("From: %s\r\n", s.config.SMTP.From)
-	message += fmt.Sprintf("To: %s\r\n", data.To)
-	message += fmt.Sprintf("Subject: %s\r\n", data.Subject)
-	message += "MIME-Version: 1.0\r\n"
-	message += "Content-Type: text/html; charset=UTF-8\r\n"
-	message += "\r\n"
-	message += data.Body
-
-	return message
+// SetTemplateManager 替换模板管理器，用于启用租户自定义模板
+func (s *Sender) SetTemplateManager(tm *TemplateManager) {
+	s.templates = tm
 }
 
-// sendWithTLS 使用TLS发送邮件
-func (s *Sender) sendWithTLS(addr string, auth smtp.Auth, from string, to []string, msg []byte) error {
-	// 连接到SMTP服务器
-	conn, err := tls.Dial("tcp", addr, &tls.Config{
-		ServerName: s.config.SMTP.Host,
-	})
+// SendEmail 发送邮件
+//
+// 配置了 RateLimit 时，超过限制会等待到有可用额度，ctx 结束时返回 ctx 的错误。
+// 配置了 CircuitBreaker 且熔断器打开时立即返回 ErrCircuitOpen。
+// 注册的钩子见 OnBeforeSend 和 OnAfterSend
+//
+// 返回:
+//   - *SendResult: 发送结果，包含 Message-ID、服务器接受的收件人和耗时
+//   - error: 发送失败时返回错误
+func (s *Sender) SendEmail(ctx context.Context, data *EmailData) (*SendResult, error) {
+	if len(data.To) == 0 {
+		return nil, fmt.Errorf("at least one recipient is required")
+	}
+	// 租户自定义 SMTP，熔断器只作用于默认发送渠道
+	provider, breaker := s.provider, s.breaker
+	tenantProvider, from, err := s.resolveProvider(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to connect to SMTP server: %w", err)
+		return nil, err
+	}
+	if tenantProvider != nil {
+		provider, breaker = tenantProvider, nil
 	}
-	defer conn.Close()
 
-	// 创建SMTP客户端
-	client, err := smtp.NewClient(conn, s.config.SMTP.Host)
-	if err != nil {
-		return fmt.Errorf("failed to create SMTP client: %w", err)
+	// 复制一份避免修改调用方的数据，并生成 Message-ID
+	data = cloneEmailData(data)
+	if data.MessageID == "" {
+		data.MessageID = newMessageID(from)
 	}
-	defer client.Quit()
 
-	// 认证
-	if err = client.Auth(auth); err != nil {
-		return fmt.Errorf("SMTP authentication failed: %w", err)
+	for _, hook := range s.hooks.before {
+		hook(data)
+	}
+	s.applySandbox(ctx, data)
+	start := time.Now()
+	result, err := s.send(ctx, provider, breaker, data)
+	s.logSend(ctx, data, result, err, time.Since(start))
+	for _, hook := range s.hooks.after {
+		hook(data, result, err)
 	}
+	return result, err
+}
 
-	// 设置发件人
-	if err = client.Mail(from); err != nil {
-		return fmt.Errorf("failed to set sender: %w", err)
+// SetSuppressionStore 设置抑制列表，见 Service.SetSuppressionStore
+//
+// 直接使用 Sender 创建 Queue 或 DurableQueue 时通过这里设置，worker 发送前检查收件人
+func (s *Sender) SetSuppressionStore(store bounce.SuppressionStore) {
+	s.suppression = store
+}
+
+// validateRecipients 按配置校验收件人地址并检查抑制列表
+//
+// Service 在渲染模板前调用，Queue 和 DurableQueue 在 worker 发送前调用
+func (s *Sender) validateRecipients(ctx context.Context, addrs ...string) error {
+	opts := s.config.Validation.options()
+	for _, addr := range addrs {
+		if err := ValidateAddress(ctx, addr, opts...); err != nil {
+			return err
+		}
 	}
+	return s.checkSuppressed(ctx, addrs...)
+}
 
-	// 设置收件人
-	for _, recipient := range to {
-		if err = client.Rcpt(recipient); err != nil {
-			return fmt.Errorf("failed to set recipient %s: %w", recipient, err)
+// checkSuppressed 检查收件人是否在抑制列表中
+func (s *Sender) checkSuppressed(ctx context.Context, addrs ...string) error {
+	if s.suppression == nil {
+		return nil
+	}
+	for _, addr := range addrs {
+		suppressed, err := s.suppression.IsSuppressed(ctx, addr)
+		if err != nil {
+			return fmt.Errorf("failed to check suppression list: %w", err)
+		}
+		if suppressed {
+			return fmt.Errorf("%w: %s", bounce.ErrSuppressed, addr)
 		}
 	}
+	return nil
+}
 
-	// 发送邮件内容
-	writer, err := client.Data()
-	if err != nil {
-		return fmt.Errorf("failed to get data writer: %w", err)
+// send 检查熔断和速率限制后通过发送渠道发送
+func (s *Sender) send(ctx context.Context, provider EmailProvider, breaker *circuitBreaker, data *EmailData) (*SendResult, error) {
+	if len(data.Recipients()) == 0 {
+		return nil, ErrNoRecipients
+	}
+	if breaker != nil {
+		if err := breaker.allow(); err != nil {
+			return nil, err
+		}
+	}
+	if s.limiter != nil {
+		if err := s.limiter.wait(ctx, data.Recipients()); err != nil {
+			return nil, fmt.Errorf("rate limit wait: %w", err)
+		}
 	}
 
-	_, err = writer.Write(msg)
+	start := time.Now()
+	result, err := provider.Send(ctx, data)
+	if breaker != nil {
+		breaker.record(err)
+	}
 	if err != nil {
-		return fmt.Errorf("failed to write message: %w", err)
+		return nil, err
+	}
+	if result == nil {
+		result = &SendResult{Accepted: data.Recipients()}
+	}
+	result.MessageID = data.MessageID
+	if result.Provider == "" {
+		result.Provider = ProviderType(s.config.Provider)
 	}
+	if result.Provider == "" {
+		result.Provider = ProviderSMTP
+	}
+	result.Duration = time.Since(start)
+	return result, nil
+}
 
-	err = writer.Close()
-	if err != nil {
-		return fmt.Errorf("failed to close data writer: %w", err)
+// SendTemplateEmail 使用已注册的模板渲染并发送邮件，适用于自定义邮件类型
+//
+// 租户ID从上下文中获取（见 WithTenantID），用于选择租户自定义模板
+func (s *Sender) SendTemplateEmail(ctx context.Context, emailType EmailType, to []string, data map[string]interface{}) (*SendResult, error) {
+	// 复制一份，避免修改调用方的数据
+	values := map[string]interface{}{
+		"CurrentYear": time.Now().Year(),
+	}
+	for k, v := range data {
+		values[k] = v
 	}
 
-	return nil
+	return s.sendTemplate(ctx, emailType, to, values)
 }
 
-// SendTenantActivationEmail 发送租户激活邮件
-func (s *Sender) SendTenantActivationEmail(ctx context.Context, to, userName, tenantName, activationLink, expireTime string) error {
-	tm := NewTemplateManager()
-
-	data := map[string]interface{}{
-		"UserName":       userName,
-		"TenantName":     tenantName,
-		"ActivationLink": activationLink,
-		"ExpireTime":     expireTime,
-		"CurrentYear":    time.Now().Year(),
+// sendTemplate 渲染模板并发送，配置了模板实验时以第一个收件人为种子选择版本
+func (s *Sender) sendTemplate(ctx context.Context, emailType EmailType, to []string, data map[string]interface{}) (*SendResult, error) {
+	var seed string
+	if len(to) > 0 {
+		seed = strings.ToLower(to[0])
 	}
 
-	subject, body, err := tm.RenderTemplate(EmailTypeTenantActivation, data)
+	data = s.resolveBranding(ctx, data)
+	subject, body, version, err := s.templates.renderTenantVersion(ctx, TenantIDFromContext(ctx), emailType, seed, data)
 	if err != nil {
-		return fmt.Errorf("failed to render template: %w", err)
+		return nil, fmt.Errorf("failed to render template: %w", err)
 	}
 
-	emailData := &EmailData{
+	ctx = context.WithValue(ctx, emailTypeKey{}, emailType)
+	result, err := s.SendEmail(ctx, &EmailData{
 		To:      to,
 		Subject: subject,
 		Body:    body,
+	})
+	if result != nil {
+		result.TemplateVersion = version
 	}
+	return result, err
+}
 
-	return s.SendEmail(ctx, emailData)
+// SendTemplateData 使用强类型数据渲染内置模板并发送
+//
+// 租户ID从上下文中获取（见 WithTenantID），用于选择租户自定义模板
+//
+// 使用示例:
+//
+//	result, err := sender.SendTemplateData(ctx, []string{"user@example.com"}, email.PasswordResetData{
+//	    UserName:   "张三",
+//	    ResetLink:  "https://example.com/reset?token=xxx",
+//	    ExpireTime: "1小时",
+//	})
+func (s *Sender) SendTemplateData(ctx context.Context, to []string, data TemplateData) (*SendResult, error) {
+	return s.sendTemplate(ctx, data.EmailType(), to, data.Values())
+}
+
+// sendData 向单个收件人发送内置模板邮件
+func (s *Sender) sendData(ctx context.Context, to string, data TemplateData) (*SendResult, error) {
+	return s.SendTemplateData(ctx, []string{to}, data)
+}
+
+// SendTenantActivationEmail 发送租户激活邮件
+func (s *Sender) SendTenantActivationEmail(ctx context.Context, to, userName, tenantName, activationLink, expireTime string) (*SendResult, error) {
+	return s.sendData(ctx, to, TenantActivationData{
+		UserName:       userName,
+		TenantName:     tenantName,
+		ActivationLink: activationLink,
+		ExpireTime:     expireTime,
+	})
 }
 
 func min(a, b int) int {
@@ -145,57 +310,119 @@ func min(a, b int) int {
 }
 
 // SendInvitationEmail 发送邀请邮件
-func (s *Sender) SendInvitationEmail(ctx context.Context, to, userName, tenantName, departmentName, roleName, inviterName, inviteTime, acceptLink, declineLink, expireTime string) error {
-	tm := NewTemplateManager()
-
-	data := map[string]interface{}{
-		"UserName":       userName,
-		"TenantName":     tenantName,
-		"DepartmentName": departmentName,
-		"RoleName":       roleName,
-		"InviterName":    inviterName,
-		"InviteTime":     inviteTime,
-		"AcceptLink":     acceptLink,
-		"DeclineLink":    declineLink,
-		"ExpireTime":     expireTime,
-		"CurrentYear":    time.Now().Year(),
-	}
-
-	subject, body, err := tm.RenderTemplate(EmailTypeInvitation, data)
-	if err != nil {
-		return fmt.Errorf("failed to render template: %w", err)
-	}
+func (s *Sender) SendInvitationEmail(ctx context.Context, to, userName, tenantName, departmentName, roleName, inviterName, inviteTime, acceptLink, declineLink, expireTime string) (*SendResult, error) {
+	return s.sendData(ctx, to, InvitationData{
+		UserName:       userName,
+		TenantName:     tenantName,
+		DepartmentName: departmentName,
+		RoleName:       roleName,
+		InviterName:    inviterName,
+		InviteTime:     inviteTime,
+		AcceptLink:     acceptLink,
+		DeclineLink:    declineLink,
+		ExpireTime:     expireTime,
+	})
+}
 
-	emailData := &EmailData{
-		To:      to,
-		Subject: subject,
-		Body:    body,
-	}
+// SendPasswordResetEmail 发送密码重置邮件
+func (s *Sender) SendPasswordResetEmail(ctx context.Context, to, userName, resetLink, expireTime string) (*SendResult, error) {
+	return s.sendData(ctx, to, PasswordResetData{
+		UserName:   userName,
+		ResetLink:  resetLink,
+		ExpireTime: expireTime,
+	})
+}
 
-	return s.SendEmail(ctx, emailData)
+// SendVerificationCodeEmail 发送验证码邮件
+//
+// 验证码的生成、保存和校验由调用方负责，可以使用 GenerateCode 生成
+//
+// 参数:
+//   - to: 收件人邮箱
+//   - code: 验证码
+//   - ttl: 验证码有效期，显示在邮件中
+func (s *Sender) SendVerificationCodeEmail(ctx context.Context, to, code string, ttl time.Duration) (*SendResult, error) {
+	return s.sendData(ctx, to, VerificationCodeData{
+		Code:       code,
+		ExpireTime: formatTTL(ttl),
+	})
 }
 
-// SendPasswordResetEmail 发送密码重置邮件
-func (s *Sender) SendPasswordResetEmail(ctx context.Context, to, userName, resetLink, expireTime string) error {
-	tm := NewTemplateManager()
+// SendMFACodeEmail 发送登录二次验证邮件
+//
+// 参数:
+//   - to: 收件人邮箱
+//   - userName: 用户名
+//   - tenantName: 租户名称
+//   - code: 验证码
+//   - ttl: 验证码有效期，显示在邮件中
+func (s *Sender) SendMFACodeEmail(ctx context.Context, to, userName, tenantName, code string, ttl time.Duration) (*SendResult, error) {
+	return s.sendData(ctx, to, MFACodeData{
+		UserName:   userName,
+		TenantName: tenantName,
+		Code:       code,
+		ExpireTime: formatTTL(ttl),
+	})
+}
 
-	data := map[string]interface{}{
-		"UserName":    userName,
-		"ResetLink":   resetLink,
-		"ExpireTime":  expireTime,
-		"CurrentYear": time.Now().Year(),
-	}
+// SendSecurityAlertEmail 发送异常登录提醒邮件
+//
+// 参数:
+//   - to: 收件人邮箱
+//   - userName: 用户名
+//   - tenantName: 租户名称
+//   - loginTime: 登录时间
+//   - ipAddress: 登录 IP
+//   - location: 登录地点，为空时不显示
+//   - device: 登录设备，为空时不显示
+//   - secureLink: 修改密码或查看安全设置的链接
+func (s *Sender) SendSecurityAlertEmail(ctx context.Context, to, userName, tenantName, loginTime, ipAddress, location, device, secureLink string) (*SendResult, error) {
+	return s.sendData(ctx, to, SecurityAlertData{
+		UserName:   userName,
+		TenantName: tenantName,
+		LoginTime:  loginTime,
+		IPAddress:  ipAddress,
+		Location:   location,
+		Device:     device,
+		SecureLink: secureLink,
+	})
+}
 
-	subject, body, err := tm.RenderTemplate(EmailTypePasswordReset, data)
-	if err != nil {
-		return fmt.Errorf("failed to render template: %w", err)
+// SendQuotaWarningEmail 发送存储空间预警邮件
+//
+// 参数:
+//   - to: 收件人邮箱
+//   - userName: 用户名
+//   - tenantName: 租户名称
+//   - usedBytes: 已使用的字节数
+//   - totalBytes: 总配额字节数，必须大于 0
+//   - manageLink: 管理存储空间的链接
+func (s *Sender) SendQuotaWarningEmail(ctx context.Context, to, userName, tenantName string, usedBytes, totalBytes int64, manageLink string) (*SendResult, error) {
+	if totalBytes <= 0 {
+		return nil, fmt.Errorf("total quota must be positive")
 	}
 
-	emailData := &EmailData{
-		To:      to,
-		Subject: subject,
-		Body:    body,
-	}
+	return s.sendData(ctx, to, QuotaWarningData{
+		UserName:     userName,
+		TenantName:   tenantName,
+		UsedSize:     formatBytes(usedBytes),
+		TotalSize:    formatBytes(totalBytes),
+		UsagePercent: min(int(usedBytes*100/totalBytes), 100),
+		ManageLink:   manageLink,
+	})
+}
 
-	return s.SendEmail(ctx, emailData)
+// formatBytes 将字节数格式化为邮件中显示的容量，例如 "512MB"、"9.5GB"
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return strconv.FormatInt(n, 10) + "B"
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit && exp < 4; v /= unit {
+		div *= unit
+		exp++
+	}
+	s := strconv.FormatFloat(float64(n)/float64(div), 'f', 1, 64)
+	return strings.TrimSuffix(s, ".0") + string("KMGTP"[exp]) + "B"
 }
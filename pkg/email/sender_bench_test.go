@@ -0,0 +1,35 @@
+package email
+
+import (
+	"context"
+	"testing"
+)
+
+// discardProvider 丢弃所有邮件，用于基准测试
+type discardProvider struct{}
+
+func (discardProvider) Send(_ context.Context, data *EmailData) (*SendResult, error) {
+	return &SendResult{Accepted: data.Recipients()}, nil
+}
+
+// BenchmarkNewTemplateManager 解析全部内置模板的开销
+func BenchmarkNewTemplateManager(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		NewTemplateManager()
+	}
+}
+
+// BenchmarkSender_SendPasswordResetEmail 发送使用 Sender 中缓存的 TemplateManager，不再重新解析模板
+func BenchmarkSender_SendPasswordResetEmail(b *testing.B) {
+	sender := NewSenderWithProvider(&Config{}, discardProvider{})
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := sender.SendPasswordResetEmail(ctx, "user@example.com", "张三", "https://example.com/reset", "1小时"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package email
+
+import (
+	"errors"
+	"fmt"
+	"net/smtp"
+	"strings"
+)
+
+// AuthMethod SMTP 认证方式
+type AuthMethod string
+
+const (
+	AuthAuto    AuthMethod = ""         // 根据 EHLO 应答中的 AUTH 扩展自动选择（默认）
+	AuthPlain   AuthMethod = "plain"    // AUTH PLAIN
+	AuthLogin   AuthMethod = "login"    // AUTH LOGIN，Office365 和部分国内邮箱只支持该方式
+	AuthCRAMMD5 AuthMethod = "cram-md5" // AUTH CRAM-MD5，密码不以明文传输
+	AuthXOAUTH2 AuthMethod = "xoauth2"  // AUTH XOAUTH2，Password 填写 OAuth2 访问令牌
+)
+
+// autoAuthOrder 自动选择时的优先顺序
+//
+// XOAUTH2 需要的是访问令牌而不是密码，不参与自动选择
+var autoAuthOrder = []AuthMethod{AuthPlain, AuthLogin, AuthCRAMMD5}
+
+// validAuthMethod 判断认证方式是否受支持
+func validAuthMethod(method AuthMethod) bool {
+	switch method {
+	case AuthAuto, AuthPlain, AuthLogin, AuthCRAMMD5, AuthXOAUTH2:
+		return true
+	default:
+		return false
+	}
+}
+
+// negotiateAuth 根据服务器 AUTH 扩展的参数（如 "LOGIN PLAIN XOAUTH2"）选择认证方式
+//
+// 服务器没有声明 AUTH 扩展或没有共同支持的方式时返回 AuthPlain，由服务器返回具体的错误
+func negotiateAuth(params string) AuthMethod {
+	offered := make(map[AuthMethod]bool)
+	for _, mech := range strings.Fields(params) {
+		offered[AuthMethod(strings.ToLower(mech))] = true
+	}
+	for _, method := range autoAuthOrder {
+		if offered[method] {
+			return method
+		}
+	}
+	return AuthPlain
+}
+
+// newSMTPAuth 创建认证方式对应的 smtp.Auth
+func newSMTPAuth(method AuthMethod, config *SMTPConfig) (smtp.Auth, error) {
+	switch method {
+	case AuthPlain:
+		return smtp.PlainAuth("", config.Username, config.Password, config.Host), nil
+	case AuthLogin:
+		return &loginAuth{username: config.Username, password: config.Password, host: config.Host}, nil
+	case AuthCRAMMD5:
+		return smtp.CRAMMD5Auth(config.Username, config.Password), nil
+	case AuthXOAUTH2:
+		return &xoauth2Auth{username: config.Username, token: config.Password, host: config.Host}, nil
+	default:
+		return nil, fmt.Errorf("unknown smtp auth method: %s", method)
+	}
+}
+
+// checkAuthTransport 与 smtp.PlainAuth 一致，只允许在 TLS 连接或本机上传输凭据
+func checkAuthTransport(server *smtp.ServerInfo, host string) error {
+	if server.Name != host {
+		return errors.New("wrong host name")
+	}
+	if !server.TLS && !isLocalhost(server.Name) {
+		return errors.New("unencrypted connection")
+	}
+	return nil
+}
+
+// isLocalhost 判断是否为本机地址
+func isLocalhost(name string) bool {
+	return name == "localhost" || name == "127.0.0.1" || name == "::1"
+}
+
+// loginAuth AUTH LOGIN 认证，依次应答服务器的用户名和密码提示
+type loginAuth struct {
+	username string
+	password string
+	host     string
+}
+
+// Start 开始认证
+func (a *loginAuth) Start(server *smtp.ServerInfo) (string, []byte, error) {
+	if err := checkAuthTransport(server, a.host); err != nil {
+		return "", nil, err
+	}
+	return "LOGIN", nil, nil
+}
+
+// Next 应答服务器的提示，提示内容通常为 "Username:" 和 "Password:"
+func (a *loginAuth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if !more {
+		return nil, nil
+	}
+	prompt := strings.ToLower(strings.TrimSpace(string(fromServer)))
+	switch {
+	case strings.HasPrefix(prompt, "user"):
+		return []byte(a.username), nil
+	case strings.HasPrefix(prompt, "pass"):
+		return []byte(a.password), nil
+	default:
+		return nil, fmt.Errorf("unexpected LOGIN prompt: %q", fromServer)
+	}
+}
+
+// xoauth2Auth AUTH XOAUTH2 认证，用于 Gmail、Office365 等只允许 OAuth2 的服务
+type xoauth2Auth struct {
+	username string
+	token    string
+	host     string
+}
+
+// Start 开始认证，初始应答中携带访问令牌
+func (a *xoauth2Auth) Start(server *smtp.ServerInfo) (string, []byte, error) {
+	if err := checkAuthTransport(server, a.host); err != nil {
+		return "", nil, err
+	}
+	return "XOAUTH2", []byte("user=" + a.username + "\x01auth=Bearer " + a.token + "\x01\x01"), nil
+}
+
+// Next 认证失败时服务器会返回 JSON 格式的错误详情，按协议应答空行后由服务器返回最终错误
+func (a *xoauth2Auth) Next(_ []byte, more bool) ([]byte, error) {
+	if more {
+		return []byte{}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,57 @@
+package email
+
+import (
+	"net/smtp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiateAuth(t *testing.T) {
+	assert.Equal(t, AuthPlain, negotiateAuth("LOGIN PLAIN XOAUTH2"))
+	assert.Equal(t, AuthLogin, negotiateAuth("LOGIN XOAUTH2"))
+	assert.Equal(t, AuthCRAMMD5, negotiateAuth("CRAM-MD5"))
+	assert.Equal(t, AuthPlain, negotiateAuth("XOAUTH2"))
+	assert.Equal(t, AuthPlain, negotiateAuth(""))
+}
+
+func TestLoginAuth(t *testing.T) {
+	auth, err := newSMTPAuth(AuthLogin, &SMTPConfig{Host: "smtp.example.com", Username: "user", Password: "secret"})
+	assert.NoError(t, err)
+
+	_, _, err = auth.Start(&smtp.ServerInfo{Name: "smtp.example.com"})
+	assert.Error(t, err, "不允许在明文连接上传输密码")
+
+	proto, resp, err := auth.Start(&smtp.ServerInfo{Name: "smtp.example.com", TLS: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "LOGIN", proto)
+	assert.Nil(t, resp)
+
+	resp, err = auth.Next([]byte("Username:"), true)
+	assert.NoError(t, err)
+	assert.Equal(t, "user", string(resp))
+	resp, err = auth.Next([]byte("Password:"), true)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", string(resp))
+	_, err = auth.Next([]byte("Token:"), true)
+	assert.Error(t, err)
+}
+
+func TestXOAUTH2Auth(t *testing.T) {
+	auth, err := newSMTPAuth(AuthXOAUTH2, &SMTPConfig{Host: "localhost", Username: "user@example.com", Password: "ya29.token"})
+	assert.NoError(t, err)
+
+	proto, resp, err := auth.Start(&smtp.ServerInfo{Name: "localhost"})
+	assert.NoError(t, err)
+	assert.Equal(t, "XOAUTH2", proto)
+	assert.Equal(t, "user=user@example.com\x01auth=Bearer ya29.token\x01\x01", string(resp))
+
+	resp, err = auth.Next([]byte(`{"status":"401"}`), true)
+	assert.NoError(t, err)
+	assert.Empty(t, resp)
+}
+
+func TestNewProvider_UnknownAuthMethod(t *testing.T) {
+	_, err := NewProvider(&Config{SMTP: SMTPConfig{Host: "smtp.example.com", From: "a@example.com", AuthMethod: "ntlm"}})
+	assert.Error(t, err)
+}
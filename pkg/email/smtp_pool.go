@@ -0,0 +1,126 @@
+package email
+
+import (
+	"context"
+	"net"
+	"net/smtp"
+	"sync"
+	"time"
+)
+
+// smtpConn 已认证的 SMTP 连接
+type smtpConn struct {
+	client    *smtp.Client
+	conn      net.Conn
+	createdAt time.Time
+}
+
+// close 结束会话并关闭连接
+func (c *smtpConn) close() {
+	if err := c.client.Quit(); err != nil {
+		c.client.Close()
+	}
+}
+
+// watch 在 ctx 取消时立即中断连接上正在进行的读写
+//
+// 返回的 stop 在会话结束后调用，返回 false 表示 ctx 已经取消，连接状态不确定，不能再复用
+func (c *smtpConn) watch(ctx context.Context) (stop func() bool) {
+	return interruptOnDone(ctx, c.conn)
+}
+
+// interruptOnDone ctx 取消时将连接的读写截止时间设为过去，使阻塞的读写立即返回
+func interruptOnDone(ctx context.Context, conn net.Conn) func() bool {
+	return context.AfterFunc(ctx, func() {
+		_ = conn.SetDeadline(time.Unix(1, 0))
+	})
+}
+
+// smtpPool SMTP 连接池
+//
+// 取出连接时发送 RSET 作为健康检查，失败的连接直接丢弃；
+// 超过最大存活时间的连接不再复用，避免服务器端主动断开导致发送失败
+type smtpPool struct {
+	maxIdle     int
+	maxLifetime time.Duration
+	dial        func(ctx context.Context) (*smtpConn, error)
+
+	mu     sync.Mutex
+	idle   []*smtpConn
+	closed bool
+}
+
+func newSMTPPool(maxIdle int, maxLifetime time.Duration, dial func(ctx context.Context) (*smtpConn, error)) *smtpPool {
+	return &smtpPool{
+		maxIdle:     maxIdle,
+		maxLifetime: maxLifetime,
+		dial:        dial,
+	}
+}
+
+// get 获取一个可用连接，没有空闲连接时新建
+func (p *smtpPool) get(ctx context.Context) (*smtpConn, error) {
+	for {
+		c := p.pop()
+		if c == nil {
+			return p.dial(ctx)
+		}
+		if p.expired(c) {
+			c.close()
+			continue
+		}
+
+		if deadline, ok := ctx.Deadline(); ok {
+			_ = c.conn.SetDeadline(deadline)
+		}
+		// 健康检查，同时重置上一次会话的状态
+		if err := c.client.Reset(); err != nil {
+			c.client.Close()
+			continue
+		}
+		return c, nil
+	}
+}
+
+// put 归还连接，池已满、已关闭或连接过期时关闭连接
+func (p *smtpPool) put(c *smtpConn) {
+	_ = c.conn.SetDeadline(time.Time{})
+
+	p.mu.Lock()
+	if p.closed || len(p.idle) >= p.maxIdle || p.expired(c) {
+		p.mu.Unlock()
+		c.close()
+		return
+	}
+	p.idle = append(p.idle, c)
+	p.mu.Unlock()
+}
+
+// pop 取出最近归还的空闲连接
+func (p *smtpPool) pop() *smtpConn {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.idle) == 0 {
+		return nil
+	}
+	c := p.idle[len(p.idle)-1]
+	p.idle = p.idle[:len(p.idle)-1]
+	return c
+}
+
+func (p *smtpPool) expired(c *smtpConn) bool {
+	return p.maxLifetime > 0 && time.Since(c.createdAt) > p.maxLifetime
+}
+
+// close 关闭所有空闲连接，之后归还的连接会被直接关闭
+func (p *smtpPool) close() {
+	p.mu.Lock()
+	idle := p.idle
+	p.idle = nil
+	p.closed = true
+	p.mu.Unlock()
+
+	for _, c := range idle {
+		c.close()
+	}
+}
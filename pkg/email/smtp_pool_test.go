@@ -0,0 +1,190 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/smtp"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSMTPServer 测试用的明文 SMTP 服务器，只实现发送一封邮件所需的命令
+type fakeSMTPServer struct {
+	ln    net.Listener
+	conns atomic.Int32
+	mails atomic.Int32
+	last  atomic.Value // 最后收到的邮件内容
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	s := &fakeSMTPServer{ln: ln}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			s.conns.Add(1)
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeSMTPServer) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 fake ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.Fields(line + " x")[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			reply("250 fake")
+		case "RCPT":
+			// 地址中包含 reject 的收件人被拒绝，包含 hang 的收件人不应答
+			if strings.Contains(line, "hang") {
+				continue
+			}
+			if strings.Contains(line, "reject") {
+				reply("550 5.1.1 no such user")
+			} else {
+				reply("250 OK")
+			}
+		case "MAIL", "RSET":
+			reply("250 OK")
+		case "DATA":
+			reply("354 go ahead")
+			var msg strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				msg.WriteString(l)
+			}
+			s.last.Store(msg.String())
+			s.mails.Add(1)
+			reply("250 2.0.0 Ok: queued as ABC123")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+// dial 建立不带 TLS 和认证的连接
+func (s *fakeSMTPServer) dial(ctx context.Context) (*smtpConn, error) {
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", s.ln.Addr().String())
+	if err != nil {
+		return nil, err
+	}
+	client, err := smtp.NewClient(conn, "localhost")
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &smtpConn{client: client, conn: conn, createdAt: time.Now()}, nil
+}
+
+func newPooledTestProvider(server *fakeSMTPServer, maxIdle int, lifetime time.Duration) *SMTPProvider {
+	p := &SMTPProvider{config: &SMTPConfig{From: "noreply@example.com"}}
+	p.pool = newSMTPPool(maxIdle, lifetime, server.dial)
+	return p
+}
+
+func TestSMTPPool_Reuse(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	p := newPooledTestProvider(server, 2, 0)
+
+	for i := 0; i < 5; i++ {
+		_, err := p.sendPooled(context.Background(), []string{"a@example.com"}, []byte("hi\r\n"))
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, int32(5), server.mails.Load())
+	assert.Equal(t, int32(1), server.conns.Load())
+	assert.NoError(t, p.Close())
+}
+
+func TestSMTPPool_MaxLifetime(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	p := newPooledTestProvider(server, 2, time.Nanosecond)
+
+	for i := 0; i < 3; i++ {
+		_, err := p.sendPooled(context.Background(), []string{"a@example.com"}, []byte("hi\r\n"))
+		assert.NoError(t, err)
+	}
+
+	// 连接过期后不再复用
+	assert.Equal(t, int32(3), server.conns.Load())
+}
+
+func TestSMTPPool_DiscardBrokenConn(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	p := newPooledTestProvider(server, 2, 0)
+
+	_, err := p.sendPooled(context.Background(), []string{"a@example.com"}, []byte("hi\r\n"))
+	assert.NoError(t, err)
+
+	// 模拟服务器断开空闲连接，健康检查失败后重新建立连接
+	p.pool.idle[0].conn.Close()
+	_, err = p.sendPooled(context.Background(), []string{"a@example.com"}, []byte("hi\r\n"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, int32(2), server.conns.Load())
+	assert.Equal(t, int32(2), server.mails.Load())
+}
+
+func TestSMTPProvider_CancelAbortsSession(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	p := newPooledTestProvider(server, 2, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := p.Send(ctx, NewEmailData("hang@example.com", "hi", "body"))
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.False(t, IsTransient(err))
+
+	// 被中断的连接不再复用
+	_, err = p.Send(context.Background(), NewEmailData("a@example.com", "hi", "body"))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), server.conns.Load())
+}
+
+func TestSMTPProvider_PerSendTimeout(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	p := newPooledTestProvider(server, 2, 0)
+	p.config.Timeout = time.Minute
+
+	data := NewEmailData("hang@example.com", "hi", "body")
+	data.Timeout = 50 * time.Millisecond
+
+	start := time.Now()
+	_, err := p.Send(context.Background(), data)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.True(t, IsTransient(err))
+}
@@ -0,0 +1,131 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/proxy"
+)
+
+// contextDialer 建立到 SMTP 服务器的 TCP 连接
+type contextDialer interface {
+	DialContext(ctx context.Context, network, addr string) (net.Conn, error)
+}
+
+// newProxyDialer 根据 SMTPConfig.Proxy 创建拨号器，未配置代理时直连
+//
+// 支持的代理地址:
+//   - socks5://[user:pass@]host:port，由代理解析 SMTP 服务器域名
+//   - http://[user:pass@]host:port 和 https://...，通过 HTTP CONNECT 建立隧道
+func newProxyDialer(rawURL string) (contextDialer, error) {
+	if rawURL == "" {
+		return &net.Dialer{}, nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid smtp proxy: %w", err)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid smtp proxy %s: missing host", u.Redacted())
+	}
+
+	switch u.Scheme {
+	case "socks5", "socks5h":
+		var auth *proxy.Auth
+		if u.User != nil {
+			password, _ := u.User.Password()
+			auth = &proxy.Auth{User: u.User.Username(), Password: password}
+		}
+		d, err := proxy.SOCKS5("tcp", u.Host, auth, &net.Dialer{})
+		if err != nil {
+			return nil, fmt.Errorf("invalid smtp proxy %s: %w", u.Redacted(), err)
+		}
+		return d.(contextDialer), nil
+	case "http", "https":
+		return &httpConnectDialer{proxyURL: u}, nil
+	default:
+		return nil, fmt.Errorf("unsupported smtp proxy scheme: %s", u.Scheme)
+	}
+}
+
+// httpConnectDialer 通过 HTTP CONNECT 代理建立隧道
+type httpConnectDialer struct {
+	proxyURL *url.URL
+}
+
+// DialContext 连接代理并请求到 addr 的隧道
+func (d *httpConnectDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	proxyAddr := d.proxyURL.Host
+	if d.proxyURL.Port() == "" {
+		port := "80"
+		if d.proxyURL.Scheme == "https" {
+			port = "443"
+		}
+		proxyAddr = net.JoinHostPort(d.proxyURL.Hostname(), port)
+	}
+
+	var conn net.Conn
+	var err error
+	if d.proxyURL.Scheme == "https" {
+		dialer := &tls.Dialer{NetDialer: &net.Dialer{}, Config: &tls.Config{ServerName: d.proxyURL.Hostname()}}
+		conn, err = dialer.DialContext(ctx, network, proxyAddr)
+	} else {
+		conn, err = (&net.Dialer{}).DialContext(ctx, network, proxyAddr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to smtp proxy: %w", err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	defer interruptOnDone(ctx, conn)()
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+	if u := d.proxyURL.User; u != nil {
+		password, _ := u.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(u.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("smtp proxy CONNECT failed: %w", err)
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("smtp proxy CONNECT failed: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("smtp proxy CONNECT to %s refused: %s", addr, resp.Status)
+	}
+
+	// SMTP 服务器的问候语可能已经和代理响应一起读入缓冲区
+	return &bufferedConn{Conn: conn, r: br}, nil
+}
+
+// bufferedConn 先读取缓冲区中的数据再读取连接
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
@@ -0,0 +1,157 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// startTestProxy 启动测试代理，handshake 完成握手后返回目标地址
+func startTestProxy(t *testing.T, handshake func(conn net.Conn, r *bufio.Reader) (string, bool)) (string, *atomic.Value) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	target := &atomic.Value{}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				addr, ok := handshake(conn, r)
+				if !ok {
+					return
+				}
+				target.Store(addr)
+				upstream, err := net.Dial("tcp", addr)
+				if err != nil {
+					return
+				}
+				defer upstream.Close()
+				go io.Copy(upstream, r)
+				io.Copy(conn, upstream)
+			}()
+		}
+	}()
+	return ln.Addr().String(), target
+}
+
+// httpConnectHandshake 要求 Basic 认证 user:pass 的 HTTP CONNECT 代理
+func httpConnectHandshake(conn net.Conn, r *bufio.Reader) (string, bool) {
+	req, err := http.ReadRequest(r)
+	if err != nil || req.Method != http.MethodConnect {
+		return "", false
+	}
+	if user, pass, ok := parseProxyAuth(req.Header.Get("Proxy-Authorization")); !ok || user != "user" || pass != "pass" {
+		io.WriteString(conn, "HTTP/1.1 407 Proxy Authentication Required\r\n\r\n")
+		return "", false
+	}
+	io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+	return req.Host, true
+}
+
+func parseProxyAuth(header string) (string, string, bool) {
+	req := &http.Request{Header: http.Header{"Authorization": {header}}}
+	return req.BasicAuth()
+}
+
+// socks5Handshake 不需要认证的 SOCKS5 代理，只支持 CONNECT
+func socks5Handshake(conn net.Conn, r *bufio.Reader) (string, bool) {
+	head := make([]byte, 2)
+	if _, err := io.ReadFull(r, head); err != nil {
+		return "", false
+	}
+	if _, err := io.ReadFull(r, make([]byte, head[1])); err != nil {
+		return "", false
+	}
+	conn.Write([]byte{5, 0})
+
+	req := make([]byte, 4)
+	if _, err := io.ReadFull(r, req); err != nil {
+		return "", false
+	}
+	var host string
+	switch req[3] {
+	case 1:
+		ip := make([]byte, 4)
+		io.ReadFull(r, ip)
+		host = net.IP(ip).String()
+	case 3:
+		n, _ := r.ReadByte()
+		name := make([]byte, n)
+		io.ReadFull(r, name)
+		host = string(name)
+	default:
+		return "", false
+	}
+	port := make([]byte, 2)
+	io.ReadFull(r, port)
+
+	conn.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0})
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))), true
+}
+
+func newProxiedSMTPProvider(t *testing.T, server *fakeSMTPServer, proxyURL string) *SMTPProvider {
+	host, port, _ := net.SplitHostPort(server.ln.Addr().String())
+	portNum, _ := strconv.Atoi(port)
+	p, err := newCheckedSMTPProvider(&SMTPConfig{
+		Host:    host,
+		Port:    portNum,
+		From:    "noreply@example.com",
+		TLSMode: TLSModeNone,
+		Proxy:   proxyURL,
+	})
+	assert.NoError(t, err)
+	return p
+}
+
+func TestSMTPProvider_HTTPConnectProxy(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	proxyAddr, target := startTestProxy(t, httpConnectHandshake)
+
+	p := newProxiedSMTPProvider(t, server, "http://user:pass@"+proxyAddr)
+	_, err := p.Send(context.Background(), NewEmailData("a@example.com", "hi", "body"))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), server.mails.Load())
+	assert.Equal(t, server.ln.Addr().String(), target.Load())
+
+	// 认证失败
+	p = newProxiedSMTPProvider(t, server, "http://user:wrong@"+proxyAddr)
+	_, err = p.Send(context.Background(), NewEmailData("a@example.com", "hi", "body"))
+	assert.ErrorContains(t, err, "407")
+}
+
+func TestSMTPProvider_SOCKS5Proxy(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	proxyAddr, target := startTestProxy(t, socks5Handshake)
+
+	p := newProxiedSMTPProvider(t, server, "socks5://"+proxyAddr)
+	_, err := p.Send(context.Background(), NewEmailData("a@example.com", "hi", "body"))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), server.mails.Load())
+	assert.Equal(t, server.ln.Addr().String(), target.Load())
+}
+
+func TestNewProxyDialer_Invalid(t *testing.T) {
+	_, err := newProxyDialer("ftp://proxy:21")
+	assert.ErrorContains(t, err, "unsupported smtp proxy scheme")
+
+	_, err = newProxyDialer("http://user:secret@")
+	assert.ErrorContains(t, err, "missing host")
+	assert.NotContains(t, err.Error(), "secret")
+
+	_, err = newCheckedSMTPProvider(&SMTPConfig{Host: "smtp.example.com", From: "a@example.com", Proxy: "gopher://x"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,87 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/heyinLab/common/pkg/email/bounce"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingStore 查询总是失败的抑制列表
+type failingStore struct{ bounce.SuppressionStore }
+
+func (failingStore) IsSuppressed(context.Context, string) (bool, error) {
+	return false, errors.New("redis down")
+}
+
+func TestService_SuppressionStore(t *testing.T) {
+	ctx := context.Background()
+	svc := NewService(&Config{SMTP: SMTPConfig{From: "no-reply@example.com"}, DryRun: DryRunConfig{Mode: DryRunCapture}})
+
+	store := bounce.NewMemoryStore()
+	processor := bounce.NewProcessor(store)
+	svc.SetSuppressionStore(store)
+
+	req := &VerificationCodeEmailRequest{To: "gone@example.com", Code: "042917"}
+	_, err := svc.SendVerificationCodeEmail(ctx, req)
+	assert.NoError(t, err)
+
+	// 硬退信后不再发送
+	assert.NoError(t, processor.Handle(ctx, &bounce.Event{Type: bounce.EventTypeHardBounce, Recipient: "Gone@example.com"}))
+	_, err = svc.SendVerificationCodeEmail(ctx, req)
+	assert.ErrorIs(t, err, bounce.ErrSuppressed)
+	assert.Len(t, svc.Provider().(*CaptureProvider).Messages(), 1)
+
+	// 查询失败时拒绝发送
+	svc.SetSuppressionStore(failingStore{})
+	_, err = svc.SendVerificationCodeEmail(ctx, &VerificationCodeEmailRequest{To: "ok@example.com", Code: "042917"})
+	assert.ErrorContains(t, err, "redis down")
+}
+
+func TestQueue_SuppressionStore(t *testing.T) {
+	ctx := context.Background()
+	store := bounce.NewMemoryStore()
+	assert.NoError(t, store.Add(ctx, "gone@example.com", bounce.ReasonHardBounce))
+
+	provider := &fakeProvider{}
+	sender := NewSenderWithProvider(&Config{}, provider)
+	sender.SetSuppressionStore(store)
+
+	var mu sync.Mutex
+	var failed []error
+	onError := func(err error) {
+		mu.Lock()
+		failed = append(failed, err)
+		mu.Unlock()
+	}
+
+	q := NewQueue(sender, &QueueConfig{Workers: 1, OnError: func(_ *EmailData, err error) { onError(err) }})
+	assert.NoError(t, q.Enqueue(NewEmailData("gone@example.com", "hi", "")))
+	assert.NoError(t, q.Enqueue(NewEmailData("not-an-address", "hi", "")))
+	assert.NoError(t, q.Enqueue(NewEmailData("ok@example.com", "hi", "")))
+	assert.NoError(t, q.Shutdown(ctx))
+
+	dq := NewDurableQueue(sender, NewMemoryJobStore(0), &DurableQueueConfig{
+		PollInterval: time.Millisecond,
+		OnError:      func(_ *Job, err error) { onError(err) },
+	})
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go dq.Run(runCtx)
+	assert.NoError(t, dq.Enqueue(ctx, "", NewEmailData("gone@example.com", "hi", "")))
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(failed) == 3
+	}, time.Second, time.Millisecond)
+
+	// 被抑制和地址无效的邮件不会交给发送渠道
+	assert.ErrorIs(t, failed[0], bounce.ErrSuppressed)
+	assert.Error(t, failed[1])
+	assert.ErrorIs(t, failed[2], bounce.ErrSuppressed)
+	assert.Equal(t, 1, provider.called)
+}
@@ -0,0 +1,222 @@
+package email
+
+import "time"
+
+// TemplateData 内置邮件类型的强类型模板数据
+//
+// 字段名与模板中的变量一一对应，拼写错误在编译时就能发现。
+// 自定义邮件类型继续使用 map[string]interface{}，见 RenderTemplate 和 SendTemplateEmail。
+type TemplateData interface {
+	// EmailType 返回数据对应的邮件类型
+	EmailType() EmailType
+	// Values 返回渲染模板使用的变量，CurrentYear 为 0 时填充为当前年份
+	Values() map[string]interface{}
+}
+
+// currentYear 返回模板中显示的年份，未设置时使用当前年份
+func currentYear(year int) int {
+	if year == 0 {
+		return time.Now().Year()
+	}
+	return year
+}
+
+// TenantActivationData 租户激活邮件模板数据
+type TenantActivationData struct {
+	UserName       string // 用户名
+	TenantName     string // 租户名称
+	ActivationLink string // 激活链接
+	ExpireTime     string // 过期时间
+	CurrentYear    int    // 版权年份（可选）
+}
+
+// EmailType 返回租户激活邮件类型
+func (d TenantActivationData) EmailType() EmailType { return EmailTypeTenantActivation }
+
+// Values 返回模板变量
+func (d TenantActivationData) Values() map[string]interface{} {
+	return map[string]interface{}{
+		"UserName":       d.UserName,
+		"TenantName":     d.TenantName,
+		"ActivationLink": d.ActivationLink,
+		"ExpireTime":     d.ExpireTime,
+		"CurrentYear":    currentYear(d.CurrentYear),
+	}
+}
+
+// InvitationData 邀请邮件模板数据
+type InvitationData struct {
+	UserName       string // 用户名
+	TenantName     string // 租户名称
+	DepartmentName string // 部门名称
+	RoleName       string // 角色名称
+	InviterName    string // 邀请人姓名
+	InviteTime     string // 邀请时间
+	AcceptLink     string // 接受链接
+	DeclineLink    string // 拒绝链接
+	ExpireTime     string // 过期时间
+	CurrentYear    int    // 版权年份（可选）
+}
+
+// EmailType 返回邀请邮件类型
+func (d InvitationData) EmailType() EmailType { return EmailTypeInvitation }
+
+// Values 返回模板变量
+func (d InvitationData) Values() map[string]interface{} {
+	return map[string]interface{}{
+		"UserName":       d.UserName,
+		"TenantName":     d.TenantName,
+		"DepartmentName": d.DepartmentName,
+		"RoleName":       d.RoleName,
+		"InviterName":    d.InviterName,
+		"InviteTime":     d.InviteTime,
+		"AcceptLink":     d.AcceptLink,
+		"DeclineLink":    d.DeclineLink,
+		"ExpireTime":     d.ExpireTime,
+		"CurrentYear":    currentYear(d.CurrentYear),
+	}
+}
+
+// PasswordResetData 密码重置邮件模板数据
+type PasswordResetData struct {
+	UserName    string // 用户名
+	TenantName  string // 租户名称（可选）
+	ResetLink   string // 重置链接
+	ExpireTime  string // 过期时间
+	CurrentYear int    // 版权年份（可选）
+}
+
+// EmailType 返回密码重置邮件类型
+func (d PasswordResetData) EmailType() EmailType { return EmailTypePasswordReset }
+
+// Values 返回模板变量
+func (d PasswordResetData) Values() map[string]interface{} {
+	return map[string]interface{}{
+		"UserName":    d.UserName,
+		"TenantName":  d.TenantName,
+		"ResetLink":   d.ResetLink,
+		"ExpireTime":  d.ExpireTime,
+		"CurrentYear": currentYear(d.CurrentYear),
+	}
+}
+
+// VerificationCodeData 验证码邮件模板数据
+type VerificationCodeData struct {
+	Code        string // 验证码
+	ExpireTime  string // 有效期
+	TenantName  string // 租户名称（可选）
+	CurrentYear int    // 版权年份（可选）
+}
+
+// EmailType 返回验证码邮件类型
+func (d VerificationCodeData) EmailType() EmailType { return EmailTypeVerificationCode }
+
+// Values 返回模板变量
+func (d VerificationCodeData) Values() map[string]interface{} {
+	return map[string]interface{}{
+		"Code":        d.Code,
+		"ExpireTime":  d.ExpireTime,
+		"TenantName":  d.TenantName,
+		"CurrentYear": currentYear(d.CurrentYear),
+	}
+}
+
+// MFACodeData 登录二次验证邮件模板数据
+type MFACodeData struct {
+	UserName    string // 用户名
+	TenantName  string // 租户名称
+	Code        string // 验证码
+	ExpireTime  string // 有效期
+	CurrentYear int    // 版权年份（可选）
+}
+
+// EmailType 返回登录二次验证邮件类型
+func (d MFACodeData) EmailType() EmailType { return EmailTypeMFACode }
+
+// Values 返回模板变量
+func (d MFACodeData) Values() map[string]interface{} {
+	return map[string]interface{}{
+		"UserName":    d.UserName,
+		"TenantName":  d.TenantName,
+		"Code":        d.Code,
+		"ExpireTime":  d.ExpireTime,
+		"CurrentYear": currentYear(d.CurrentYear),
+	}
+}
+
+// SecurityAlertData 异常登录提醒邮件模板数据
+type SecurityAlertData struct {
+	UserName    string // 用户名
+	TenantName  string // 租户名称
+	LoginTime   string // 登录时间
+	IPAddress   string // 登录 IP
+	Location    string // 登录地点（可选）
+	Device      string // 登录设备（可选）
+	SecureLink  string // 账户安全设置链接
+	CurrentYear int    // 版权年份（可选）
+}
+
+// EmailType 返回异常登录提醒邮件类型
+func (d SecurityAlertData) EmailType() EmailType { return EmailTypeSecurityAlert }
+
+// Values 返回模板变量
+func (d SecurityAlertData) Values() map[string]interface{} {
+	return map[string]interface{}{
+		"UserName":    d.UserName,
+		"TenantName":  d.TenantName,
+		"LoginTime":   d.LoginTime,
+		"IPAddress":   d.IPAddress,
+		"Location":    d.Location,
+		"Device":      d.Device,
+		"SecureLink":  d.SecureLink,
+		"CurrentYear": currentYear(d.CurrentYear),
+	}
+}
+
+// QuotaWarningData 存储空间预警邮件模板数据
+type QuotaWarningData struct {
+	UserName     string // 用户名
+	TenantName   string // 租户名称
+	UsedSize     string // 已使用容量，例如 "9.5GB"
+	TotalSize    string // 总容量，例如 "10GB"
+	UsagePercent int    // 使用百分比
+	ManageLink   string // 管理存储空间的链接
+	CurrentYear  int    // 版权年份（可选）
+}
+
+// EmailType 返回存储空间预警邮件类型
+func (d QuotaWarningData) EmailType() EmailType { return EmailTypeQuotaWarning }
+
+// Values 返回模板变量
+func (d QuotaWarningData) Values() map[string]interface{} {
+	return map[string]interface{}{
+		"UserName":     d.UserName,
+		"TenantName":   d.TenantName,
+		"UsedSize":     d.UsedSize,
+		"TotalSize":    d.TotalSize,
+		"UsagePercent": d.UsagePercent,
+		"ManageLink":   d.ManageLink,
+		"CurrentYear":  currentYear(d.CurrentYear),
+	}
+}
+
+// Render 使用强类型数据渲染内置模板
+//
+// 参数:
+//   - data: 模板数据，邮件类型由数据类型决定
+//
+// 返回:
+//   - string: 邮件主题
+//   - string: 邮件正文
+//   - error: 渲染失败时返回错误
+//
+// 使用示例:
+//
+//	subject, body, err := tm.Render(email.PasswordResetData{
+//	    UserName:   "张三",
+//	    ResetLink:  "https://example.com/reset?token=xxx",
+//	    ExpireTime: "1小时",
+//	})
+func (tm *TemplateManager) Render(data TemplateData) (string, string, error) {
+	return tm.RenderTemplate(data.EmailType(), data.Values())
+}
@@ -0,0 +1,37 @@
+package email
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateManager_Render(t *testing.T) {
+	tm := NewTemplateManager()
+	data := PasswordResetData{
+		UserName:    "赵六",
+		ResetLink:   "https://example.com/reset?token=sample-token",
+		ExpireTime:  "1小时",
+		CurrentYear: 2025,
+	}
+
+	subject, body, err := tm.Render(data)
+	assert.NoError(t, err)
+
+	wantSubject, wantBody, err := tm.RenderTemplate(EmailTypePasswordReset, data.Values())
+	assert.NoError(t, err)
+	assert.Equal(t, wantSubject, subject)
+	assert.Equal(t, wantBody, body)
+	assert.Contains(t, body, "https://example.com/reset?token=sample-token")
+}
+
+func TestTemplateData_CurrentYear(t *testing.T) {
+	values := InvitationData{UserName: "李四"}.Values()
+	assert.Equal(t, time.Now().Year(), values["CurrentYear"])
+
+	_, body, err := NewTemplateManager().Render(TenantActivationData{UserName: "张三", CurrentYear: 2020})
+	assert.NoError(t, err)
+	assert.Contains(t, body, strconv.Itoa(2020))
+}
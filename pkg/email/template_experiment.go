@@ -0,0 +1,130 @@
+package email
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// TemplateVariant 模板实验中的一个版本
+type TemplateVariant struct {
+	Version  string // 版本名，如 "a"、"b"，发送后写入 SendResult.TemplateVersion
+	Weight   int    // 权重，收件人按权重比例分配到各版本
+	Template string // 模板文本，格式同 RegisterTemplate
+}
+
+// templateVariant 解析后的实验版本
+type templateVariant struct {
+	version string
+	weight  int
+	tmpl    Template
+}
+
+// templateExperiment 同一邮件类型的多个版本
+type templateExperiment struct {
+	variants    []templateVariant
+	totalWeight int
+}
+
+// pick 根据种子确定性地选择版本，同一种子总是得到同一版本
+func (e *templateExperiment) pick(emailType EmailType, seed string) *templateVariant {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(emailType))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(seed))
+	n := int(h.Sum64() % uint64(e.totalWeight))
+	for i := range e.variants {
+		if n < e.variants[i].weight {
+			return &e.variants[i]
+		}
+		n -= e.variants[i].weight
+	}
+	return &e.variants[len(e.variants)-1]
+}
+
+// RegisterVariants 为邮件类型注册多个模板版本，用于 A/B 实验
+//
+// 渲染时按种子（Sender 使用第一个收件人地址）确定性地选择版本，同一收件人总是收到同一版本。
+// 第一个版本同时作为该类型的默认模板。之后再通过 RegisterTemplate 或 LoadFS 注册同一类型时，实验结束。
+//
+// 参数:
+//   - emailType: 邮件类型
+//   - variants: 模板版本，版本名不能重复，权重必须大于 0
+//
+// 返回:
+//   - error: 参数无效或任一模板解析失败时返回错误，不会修改已有模板
+//
+// 使用示例:
+//
+//	err := tm.RegisterVariants(email.EmailTypeInvitation,
+//	    email.TemplateVariant{Version: "control", Weight: 90, Template: invitationV1},
+//	    email.TemplateVariant{Version: "short-copy", Weight: 10, Template: invitationV2},
+//	)
+//	// 发送后 result.TemplateVersion 为 "control" 或 "short-copy"
+func (tm *TemplateManager) RegisterVariants(emailType EmailType, variants ...TemplateVariant) error {
+	if emailType == "" {
+		return fmt.Errorf("email type cannot be empty")
+	}
+	if len(variants) == 0 {
+		return fmt.Errorf("at least one template variant is required")
+	}
+
+	e := &templateExperiment{}
+	seen := make(map[string]bool)
+	for _, v := range variants {
+		if v.Version == "" || seen[v.Version] {
+			return fmt.Errorf("template variant version must be unique and non-empty: %q", v.Version)
+		}
+		if v.Weight <= 0 {
+			return fmt.Errorf("template variant %s weight must be positive", v.Version)
+		}
+		seen[v.Version] = true
+
+		t, err := tm.renderer.Parse(string(emailType)+"@"+v.Version, v.Template)
+		if err != nil {
+			return err
+		}
+		e.variants = append(e.variants, templateVariant{version: v.Version, weight: v.Weight, tmpl: t})
+		e.totalWeight += v.Weight
+	}
+
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	tm.templates[emailType] = e.variants[0].tmpl
+	tm.experiments[emailType] = e
+	return nil
+}
+
+// RenderTemplateVersion 渲染模板，邮件类型配置了实验时根据种子选择版本
+//
+// 参数:
+//   - emailType: 邮件类型
+//   - seed: 选择版本的种子，通常为收件人地址
+//   - data: 模板数据
+//
+// 返回:
+//   - subject, body: 渲染结果
+//   - version: 选中的版本名，没有配置实验时为空
+//   - error: 模板不存在或渲染失败时返回错误
+func (tm *TemplateManager) RenderTemplateVersion(emailType EmailType, seed string, data map[string]interface{}) (string, string, string, error) {
+	tm.mu.RLock()
+	t, exists := tm.templates[emailType]
+	e := tm.experiments[emailType]
+	tm.mu.RUnlock()
+	if !exists {
+		return "", "", "", fmt.Errorf("template not found for type: %s", emailType)
+	}
+
+	var version string
+	if e != nil {
+		v := e.pick(emailType, seed)
+		t, version = v.tmpl, v.version
+	}
+	subject, body, err := tm.postRender(t.Render(tm.withBranding(data)))
+	return subject, body, version, err
+}
+
+// setTemplate 设置邮件类型的模板并结束该类型的实验，调用方需持有写锁
+func (tm *TemplateManager) setTemplate(emailType EmailType, t Template) {
+	tm.templates[emailType] = t
+	delete(tm.experiments, emailType)
+}
@@ -0,0 +1,59 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterVariants(t *testing.T) {
+	tm := NewTemplateManager()
+	err := tm.RegisterVariants("promo",
+		TemplateVariant{Version: "a", Weight: 3, Template: `{{define "subject"}}A{{end}}{{define "body"}}a{{end}}`},
+		TemplateVariant{Version: "b", Weight: 1, Template: `{{define "subject"}}B{{end}}{{define "body"}}b{{end}}`},
+	)
+	assert.NoError(t, err)
+	assert.Contains(t, tm.Types(), EmailType("promo"))
+
+	// 同一种子总是选中同一版本，分布接近权重
+	counts := map[string]int{}
+	for i := 0; i < 4000; i++ {
+		seed := fmt.Sprintf("user%d@example.com", i)
+		subject, _, version, err := tm.RenderTemplateVersion("promo", seed, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"a": "A", "b": "B"}[version], subject)
+		_, _, again, _ := tm.RenderTemplateVersion("promo", seed, nil)
+		assert.Equal(t, version, again)
+		counts[version]++
+	}
+	assert.InDelta(t, 3000, counts["a"], 200)
+	assert.InDelta(t, 1000, counts["b"], 200)
+
+	// 重新注册普通模板后实验结束
+	assert.NoError(t, tm.RegisterTemplate("promo", `{{define "subject"}}C{{end}}{{define "body"}}c{{end}}`))
+	subject, _, version, err := tm.RenderTemplateVersion("promo", "user1@example.com", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "C", subject)
+	assert.Empty(t, version)
+
+	// 参数校验
+	assert.Error(t, tm.RegisterVariants("promo"))
+	assert.Error(t, tm.RegisterVariants("promo", TemplateVariant{Version: "a", Weight: 0, Template: `{{define "subject"}}{{end}}{{define "body"}}{{end}}`}))
+	assert.Error(t, tm.RegisterVariants("promo",
+		TemplateVariant{Version: "a", Weight: 1, Template: `{{define "subject"}}{{end}}{{define "body"}}{{end}}`},
+		TemplateVariant{Version: "a", Weight: 1, Template: `{{define "subject"}}{{end}}{{define "body"}}{{end}}`},
+	))
+}
+
+func TestSender_TemplateVersion(t *testing.T) {
+	sender := NewSenderWithProvider(&Config{}, &fakeProvider{})
+	assert.NoError(t, sender.templates.RegisterVariants(EmailTypePasswordReset,
+		TemplateVariant{Version: "only", Weight: 1, Template: `{{define "subject"}}reset{{end}}{{define "body"}}{{.ResetLink}}{{end}}`},
+	))
+
+	result, err := sender.SendPasswordResetEmail(context.Background(), "a@example.com", "张三", "https://example.com/reset", "1小时")
+	assert.NoError(t, err)
+	assert.Equal(t, "only", result.TemplateVersion)
+}
@@ -0,0 +1,123 @@
+package email
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+)
+
+// templateExtensions 从文件系统加载时识别的模板文件扩展名
+var templateExtensions = []string{".html", ".tmpl", ".gohtml"}
+
+// NewTemplateManagerFromFS 创建模板管理器，并用文件系统中的模板覆盖内置模板
+//
+// 文件系统根目录下的每个 <邮件类型>.html（或 .tmpl、.gohtml）文件对应一种邮件类型，
+// 文件内容与内置模板格式相同，需要包含 {{define "subject"}} 和 {{define "body"}} 两个块。
+// 与内置类型同名的文件会覆盖内置模板，其他文件注册为新的邮件类型。
+//
+// 参数:
+//   - fsys: 模板所在的文件系统，如 embed.FS 或 os.DirFS
+//   - opts: 模板管理器选项
+//
+// 返回:
+//   - *TemplateManager: 模板管理器
+//   - error: 模板解析失败或缺少 subject/body 块时返回错误
+//
+// 使用示例:
+//
+//	//go:embed templates/*.html
+//	var templates embed.FS
+//
+//	sub, _ := fs.Sub(templates, "templates")
+//	tm, err := email.NewTemplateManagerFromFS(sub)
+func NewTemplateManagerFromFS(fsys fs.FS, opts ...TemplateManagerOption) (*TemplateManager, error) {
+	tm := NewTemplateManager(opts...)
+	if err := tm.LoadFS(fsys); err != nil {
+		return nil, err
+	}
+	return tm, nil
+}
+
+// NewTemplateManagerFromDir 创建模板管理器，并用目录中的模板覆盖内置模板
+//
+// 目录不存在时直接使用内置模板，便于各环境按需提供覆盖目录
+func NewTemplateManagerFromDir(dir string, opts ...TemplateManagerOption) (*TemplateManager, error) {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return NewTemplateManager(opts...), nil
+	}
+	return NewTemplateManagerFromFS(os.DirFS(dir), opts...)
+}
+
+// LoadFS 从文件系统加载模板，覆盖已存在的同名类型
+//
+// 可以多次调用以叠加多个来源，如先加载 embed 的默认模板，再加载环境目录中的覆盖模板。
+// 任一模板解析失败时不会修改已有模板。
+func (tm *TemplateManager) LoadFS(fsys fs.FS) error {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return fmt.Errorf("failed to read template directory: %w", err)
+	}
+
+	loaded := make(map[EmailType]Template)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		if !isTemplateExtension(path.Ext(name)) {
+			continue
+		}
+
+		emailType, t, err := tm.readTemplateFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		loaded[emailType] = t
+	}
+
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	for emailType, t := range loaded {
+		tm.setTemplate(emailType, t)
+	}
+	return nil
+}
+
+// loadFile 重新加载单个模板文件，解析失败时不修改已有模板
+func (tm *TemplateManager) loadFile(fsys fs.FS, name string) error {
+	emailType, t, err := tm.readTemplateFile(fsys, name)
+	if err != nil {
+		return err
+	}
+
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	tm.setTemplate(emailType, t)
+	return nil
+}
+
+// readTemplateFile 读取并解析模板文件，文件名去掉扩展名即为邮件类型
+func (tm *TemplateManager) readTemplateFile(fsys fs.FS, name string) (EmailType, Template, error) {
+	content, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read template %s: %w", name, err)
+	}
+
+	emailType := EmailType(strings.TrimSuffix(name, path.Ext(name)))
+	t, err := tm.renderer.Parse(string(emailType), string(content))
+	if err != nil {
+		return "", nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return emailType, t, nil
+}
+
+func isTemplateExtension(ext string) bool {
+	for _, e := range templateExtensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package email
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTemplateManagerFromFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"invitation.html":         {Data: []byte(`{{define "subject"}}加入 {{.TenantName}}{{end}}{{define "body"}}<p>{{.UserName}}</p>{{end}}`)},
+		"order_confirmation.tmpl": {Data: []byte(`{{define "subject"}}订单 {{.OrderNo}}{{end}}{{define "body"}}ok{{end}}`)},
+		"README.md":               {Data: []byte("ignored")},
+	}
+
+	tm, err := NewTemplateManagerFromFS(fsys)
+	assert.NoError(t, err)
+
+	// 覆盖内置模板
+	subject, body, err := tm.RenderTemplate(EmailTypeInvitation, map[string]interface{}{"TenantName": "示例", "UserName": "张三"})
+	assert.NoError(t, err)
+	assert.Equal(t, "加入 示例", subject)
+	assert.Equal(t, "<p>张三</p>", body)
+
+	// 新增类型
+	subject, _, err = tm.RenderTemplate("order_confirmation", map[string]interface{}{"OrderNo": "A001"})
+	assert.NoError(t, err)
+	assert.Equal(t, "订单 A001", subject)
+
+	// 未覆盖的内置模板保持不变
+	assert.Contains(t, tm.Types(), EmailTypePasswordReset)
+}
+
+func TestLoadFS_Invalid(t *testing.T) {
+	tm := NewTemplateManager()
+	err := tm.LoadFS(fstest.MapFS{
+		"invitation.html": {Data: []byte(`{{define "subject"}}only subject{{end}}`)},
+	})
+	assert.ErrorContains(t, err, "body template not found")
+
+	// 失败时不修改已有模板
+	subject, _, err := tm.RenderTemplate(EmailTypeInvitation, map[string]interface{}{"TenantName": "示例"})
+	assert.NoError(t, err)
+	assert.NotEqual(t, "only subject", subject)
+}
+
+func TestNewTemplateManagerFromDir(t *testing.T) {
+	tm, err := NewTemplateManagerFromDir(filepath.Join(t.TempDir(), "missing"))
+	assert.NoError(t, err)
+	assert.Equal(t, NewTemplateManager().Types(), tm.Types())
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "welcome.html"), []byte(`{{define "subject"}}hi{{end}}{{define "body"}}hello{{end}}`), 0o644))
+	tm, err = NewTemplateManagerFromDir(dir)
+	assert.NoError(t, err)
+	assert.Contains(t, tm.Types(), EmailType("welcome"))
+}
+
+func TestRegisterTemplate(t *testing.T) {
+	tm := NewTemplateManager()
+
+	err := tm.RegisterTemplate("order_confirmation", `{{define "subject"}}订单 {{.OrderNo}} 已确认{{end}}{{define "body"}}<p>{{.UserName}}</p>{{end}}`)
+	assert.NoError(t, err)
+
+	subject, body, err := tm.RenderTemplate("order_confirmation", map[string]interface{}{"OrderNo": "A001", "UserName": "张三"})
+	assert.NoError(t, err)
+	assert.Equal(t, "订单 A001 已确认", subject)
+	assert.Equal(t, "<p>张三</p>", body)
+
+	assert.Error(t, tm.RegisterTemplate("", `{{define "subject"}}{{end}}{{define "body"}}{{end}}`))
+	assert.ErrorContains(t, tm.RegisterTemplate("broken", `{{define "subject"}}x{{end}}`), "body template not found")
+	assert.ErrorContains(t, tm.RegisterTemplate("broken", `{{define "subject"}}{{.X{{end}}`), "failed to parse template")
+	assert.NotContains(t, tm.Types(), EmailType("broken"))
+}
+
+func TestTemplateFuncs_CID(t *testing.T) {
+	tm := NewTemplateManager()
+	assert.NoError(t, tm.RegisterTemplate("with_logo", `{{define "subject"}}hi{{end}}{{define "body"}}<img src="{{cid "logo.png"}}">{{end}}`))
+
+	_, body, err := tm.RenderTemplate("with_logo", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `<img src="cid:logo.png">`, body)
+}
+
+func TestWatchDir(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "welcome.html")
+	write := func(subject string) {
+		content := `{{define "subject"}}` + subject + `{{end}}{{define "body"}}hello{{end}}`
+		assert.NoError(t, os.WriteFile(file, []byte(content), 0o644))
+	}
+	write("v1")
+
+	tm, err := NewTemplateManagerFromDir(dir)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errs := make(chan error, 10)
+	go func() {
+		_ = tm.WatchDir(ctx, dir, func(err error) { errs <- err })
+	}()
+
+	subject := func() string {
+		s, _, err := tm.RenderTemplate("welcome", nil)
+		assert.NoError(t, err)
+		return s
+	}
+
+	// 监听启动前的写入不会被感知，因此每次检查前重新写入
+	assert.Eventually(t, func() bool {
+		write("v2")
+		return subject() == "v2"
+	}, 5*time.Second, 200*time.Millisecond)
+
+	// 解析失败时保留原模板
+	assert.NoError(t, os.WriteFile(file, []byte(`{{define "subject"}}broken`), 0o644))
+	select {
+	case err := <-errs:
+		assert.ErrorContains(t, err, "welcome.html")
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected reload error")
+	}
+	assert.Equal(t, "v2", subject())
+}
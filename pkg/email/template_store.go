@@ -0,0 +1,203 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// TemplateStatus 模板状态
+type TemplateStatus string
+
+const (
+	TemplateStatusDraft     TemplateStatus = "draft"     // 草稿，仅可预览
+	TemplateStatusPublished TemplateStatus = "published" // 已发布，发送时使用
+	TemplateStatusArchived  TemplateStatus = "archived"  // 已归档的历史版本
+)
+
+// StoredTemplate 租户自定义的邮件模板
+//
+// Subject 和 Body 为模板引擎（默认 html/template）语法的模板文本，可使用与内置模板相同的变量
+type StoredTemplate struct {
+	TenantID    uint32         `json:"tenant_id"`
+	Type        EmailType      `json:"type"`
+	Version     int            `json:"version"`
+	Subject     string         `json:"subject"`
+	Body        string         `json:"body"`
+	Status      TemplateStatus `json:"status"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	PublishedAt *time.Time     `json:"published_at,omitempty"`
+}
+
+// TemplateStore 租户模板存储
+//
+// 每个租户的每种邮件类型可以有多个版本，同一时间最多一个版本处于发布状态
+type TemplateStore interface {
+	// GetPublished 获取已发布的模板，没有自定义模板时返回 nil, nil
+	GetPublished(ctx context.Context, tenantID uint32, emailType EmailType) (*StoredTemplate, error)
+	// GetVersion 获取指定版本的模板（用于预览草稿）
+	GetVersion(ctx context.Context, tenantID uint32, emailType EmailType, version int) (*StoredTemplate, error)
+	// ListVersions 按版本号倒序列出所有版本
+	ListVersions(ctx context.Context, tenantID uint32, emailType EmailType) ([]*StoredTemplate, error)
+	// SaveDraft 保存为新的草稿版本
+	SaveDraft(ctx context.Context, tenantID uint32, emailType EmailType, subject, body string) (*StoredTemplate, error)
+	// Publish 发布指定版本，之前发布的版本会被归档
+	Publish(ctx context.Context, tenantID uint32, emailType EmailType, version int) error
+}
+
+// DefaultTemplateCacheTTL 租户模板缓存的默认有效期
+const DefaultTemplateCacheTTL = 5 * time.Minute
+
+// TemplateManagerOption 模板管理器选项
+type TemplateManagerOption func(*TemplateManager)
+
+// WithTemplateStore 使用租户模板存储
+//
+// ttl 为编译后模板的缓存时间，<=0 时使用 DefaultTemplateCacheTTL。
+// 多实例部署时，其他实例的发布操作最多延迟 ttl 生效。
+func WithTemplateStore(store TemplateStore, ttl time.Duration) TemplateManagerOption {
+	return func(tm *TemplateManager) {
+		if ttl <= 0 {
+			ttl = DefaultTemplateCacheTTL
+		}
+		tm.store = store
+		tm.cacheTTL = ttl
+	}
+}
+
+// tenantTemplateKey 租户模板缓存键
+type tenantTemplateKey struct {
+	tenantID  uint32
+	emailType EmailType
+}
+
+// cachedTemplate 缓存的租户模板，tmpl 为 nil 表示租户没有自定义模板
+type cachedTemplate struct {
+	tmpl      Template
+	version   int
+	expiresAt time.Time
+}
+
+// templateCache 租户模板缓存
+type templateCache struct {
+	mu      sync.RWMutex
+	entries map[tenantTemplateKey]*cachedTemplate
+}
+
+// RenderTenantTemplate 渲染租户模板
+//
+// 租户有已发布的自定义模板时使用自定义模板，否则回退到内置模板。
+// tenantID 为 0 或未配置模板存储时直接使用内置模板。
+func (tm *TemplateManager) RenderTenantTemplate(ctx context.Context, tenantID uint32, emailType EmailType, data map[string]interface{}) (string, string, error) {
+	subject, body, _, err := tm.renderTenantVersion(ctx, tenantID, emailType, "", data)
+	return subject, body, err
+}
+
+// renderTenantVersion 渲染租户模板，回退到内置模板时按 seed 选择实验版本
+//
+// 租户自定义模板不参与实验，返回的版本为空
+func (tm *TemplateManager) renderTenantVersion(ctx context.Context, tenantID uint32, emailType EmailType, seed string, data map[string]interface{}) (string, string, string, error) {
+	if tm.store == nil || tenantID == 0 {
+		return tm.RenderTemplateVersion(emailType, seed, data)
+	}
+
+	t, err := tm.tenantTemplate(ctx, tenantID, emailType)
+	if err != nil {
+		return "", "", "", err
+	}
+	if t == nil {
+		return tm.RenderTemplateVersion(emailType, seed, data)
+	}
+	subject, body, err := tm.postRender(t.Render(tm.withBranding(data)))
+	return subject, body, "", err
+}
+
+// PreviewTemplate 使用模板存储中的指定版本渲染，用于管理后台预览草稿
+func (tm *TemplateManager) PreviewTemplate(ctx context.Context, tenantID uint32, emailType EmailType, version int, data map[string]interface{}) (string, string, error) {
+	if tm.store == nil {
+		return "", "", fmt.Errorf("template store not configured")
+	}
+	st, err := tm.store.GetVersion(ctx, tenantID, emailType, version)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to load template: %w", err)
+	}
+	if st == nil {
+		return "", "", fmt.Errorf("template version %d not found for type: %s", version, emailType)
+	}
+	t, err := tm.parseStored(st)
+	if err != nil {
+		return "", "", err
+	}
+	return tm.postRender(t.Render(tm.withBranding(data)))
+}
+
+// Invalidate 清除租户模板缓存，发布新版本后调用
+func (tm *TemplateManager) Invalidate(tenantID uint32, emailType EmailType) {
+	tm.cache.mu.Lock()
+	defer tm.cache.mu.Unlock()
+	delete(tm.cache.entries, tenantTemplateKey{tenantID: tenantID, emailType: emailType})
+}
+
+// ParseStoredTemplate 使用默认模板引擎编译存储的模板，保存草稿前可用于校验语法
+func ParseStoredTemplate(st *StoredTemplate) (Template, error) {
+	return parseStoredTemplate(defaultRenderer, st)
+}
+
+// parseStored 使用模板管理器的模板引擎编译存储的模板
+func (tm *TemplateManager) parseStored(st *StoredTemplate) (Template, error) {
+	return parseStoredTemplate(tm.renderer, st)
+}
+
+func parseStoredTemplate(renderer Renderer, st *StoredTemplate) (Template, error) {
+	name := fmt.Sprintf("%s_%d_v%d", st.Type, st.TenantID, st.Version)
+	return renderer.ParseParts(name, st.Subject, st.Body)
+}
+
+// tenantTemplate 获取租户已发布的模板，优先使用缓存
+func (tm *TemplateManager) tenantTemplate(ctx context.Context, tenantID uint32, emailType EmailType) (Template, error) {
+	key := tenantTemplateKey{tenantID: tenantID, emailType: emailType}
+
+	tm.cache.mu.RLock()
+	entry, ok := tm.cache.entries[key]
+	tm.cache.mu.RUnlock()
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.tmpl, nil
+	}
+
+	st, err := tm.store.GetPublished(ctx, tenantID, emailType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tenant template: %w", err)
+	}
+
+	entry = &cachedTemplate{expiresAt: time.Now().Add(tm.cacheTTL)}
+	if st != nil {
+		t, err := tm.parseStored(st)
+		if err != nil {
+			return nil, err
+		}
+		entry.tmpl = t
+		entry.version = st.Version
+	}
+
+	tm.cache.mu.Lock()
+	tm.cache.entries[key] = entry
+	tm.cache.mu.Unlock()
+
+	return entry.tmpl, nil
+}
+
+// ========== 租户上下文 ==========
+
+type tenantIDKey struct{}
+
+// WithTenantID 在上下文中设置租户ID，Sender 会据此选择租户自定义模板
+func WithTenantID(ctx context.Context, tenantID uint32) context.Context {
+	return context.WithValue(ctx, tenantIDKey{}, tenantID)
+}
+
+// TenantIDFromContext 从上下文获取租户ID，未设置时返回 0
+func TenantIDFromContext(ctx context.Context) uint32 {
+	tenantID, _ := ctx.Value(tenantIDKey{}).(uint32)
+	return tenantID
+}
@@ -0,0 +1,100 @@
+package email
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryTemplateStore 测试用的模板存储
+type memoryTemplateStore struct {
+	templates []*StoredTemplate
+	loads     int
+}
+
+func (s *memoryTemplateStore) GetPublished(_ context.Context, tenantID uint32, emailType EmailType) (*StoredTemplate, error) {
+	s.loads++
+	for _, t := range s.templates {
+		if t.TenantID == tenantID && t.Type == emailType && t.Status == TemplateStatusPublished {
+			return t, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *memoryTemplateStore) GetVersion(_ context.Context, tenantID uint32, emailType EmailType, version int) (*StoredTemplate, error) {
+	for _, t := range s.templates {
+		if t.TenantID == tenantID && t.Type == emailType && t.Version == version {
+			return t, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *memoryTemplateStore) ListVersions(_ context.Context, tenantID uint32, emailType EmailType) ([]*StoredTemplate, error) {
+	return s.templates, nil
+}
+
+func (s *memoryTemplateStore) SaveDraft(_ context.Context, tenantID uint32, emailType EmailType, subject, body string) (*StoredTemplate, error) {
+	t := &StoredTemplate{TenantID: tenantID, Type: emailType, Version: len(s.templates) + 1, Subject: subject, Body: body, Status: TemplateStatusDraft}
+	s.templates = append(s.templates, t)
+	return t, nil
+}
+
+func (s *memoryTemplateStore) Publish(_ context.Context, tenantID uint32, emailType EmailType, version int) error {
+	for _, t := range s.templates {
+		if t.TenantID == tenantID && t.Type == emailType {
+			if t.Version == version {
+				t.Status = TemplateStatusPublished
+			} else if t.Status == TemplateStatusPublished {
+				t.Status = TemplateStatusArchived
+			}
+		}
+	}
+	return nil
+}
+
+func TestRenderTenantTemplate(t *testing.T) {
+	ctx := context.Background()
+	store := &memoryTemplateStore{}
+	tm := NewTemplateManager(WithTemplateStore(store, 0))
+	data := map[string]interface{}{"UserName": "张三", "TenantName": "示例科技"}
+
+	// 没有自定义模板时使用内置模板
+	subject, _, err := tm.RenderTenantTemplate(ctx, 1, EmailTypeInvitation, data)
+	assert.NoError(t, err)
+	assert.Contains(t, subject, "示例科技")
+
+	draft, err := store.SaveDraft(ctx, 1, EmailTypeInvitation, "{{.UserName}}，欢迎加入", "<p>{{.TenantName}}</p>")
+	assert.NoError(t, err)
+
+	// 草稿可以预览，但不影响发送
+	subject, body, err := tm.PreviewTemplate(ctx, 1, EmailTypeInvitation, draft.Version, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "张三，欢迎加入", subject)
+	assert.Equal(t, "<p>示例科技</p>", body)
+
+	assert.NoError(t, store.Publish(ctx, 1, EmailTypeInvitation, draft.Version))
+
+	// 缓存未失效前仍使用内置模板
+	subject, _, err = tm.RenderTenantTemplate(ctx, 1, EmailTypeInvitation, data)
+	assert.NoError(t, err)
+	assert.NotEqual(t, "张三，欢迎加入", subject)
+
+	tm.Invalidate(1, EmailTypeInvitation)
+	subject, _, err = tm.RenderTenantTemplate(ctx, 1, EmailTypeInvitation, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "张三，欢迎加入", subject)
+	assert.Equal(t, 2, store.loads)
+
+	// 其他租户不受影响
+	subject, _, err = tm.RenderTenantTemplate(ctx, 2, EmailTypeInvitation, data)
+	assert.NoError(t, err)
+	assert.NotEqual(t, "张三，欢迎加入", subject)
+}
+
+func TestTenantIDFromContext(t *testing.T) {
+	assert.Equal(t, uint32(0), TenantIDFromContext(context.Background()))
+	assert.Equal(t, uint32(7), TenantIDFromContext(WithTenantID(context.Background(), 7)))
+}
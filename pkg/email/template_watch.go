@@ -0,0 +1,97 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// templateReloadDelay 文件变化后等待的时间，合并编辑器保存时产生的多次写入
+const templateReloadDelay = 100 * time.Millisecond
+
+// WatchDir 监听模板目录，文件变化时重新解析对应的模板，直到 ctx 被取消
+//
+// 新增和修改的模板文件会被重新加载；解析失败时保留原模板并通过 onError 报告，
+// 删除文件不会删除已加载的模板。通常与 NewTemplateManagerFromDir 配合使用。
+//
+// 参数:
+//   - ctx: 控制监听的生命周期
+//   - dir: 模板目录
+//   - onError: 重新加载失败时的回调，可以为 nil
+//
+// 返回:
+//   - error: 无法监听目录时返回错误，ctx 取消后返回 ctx 的错误
+//
+// 使用示例:
+//
+//	tm, err := email.NewTemplateManagerFromDir("/etc/app/email-templates")
+//	if err != nil {
+//	    return err
+//	}
+//	go tm.WatchDir(ctx, "/etc/app/email-templates", func(err error) {
+//	    log.Errorf("重新加载邮件模板失败: %v", err)
+//	})
+func (tm *TemplateManager) WatchDir(ctx context.Context, dir string, onError func(error)) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create template watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(dir); err != nil {
+		return fmt.Errorf("failed to watch template directory: %w", err)
+	}
+
+	report := func(err error) {
+		if onError != nil {
+			onError(err)
+		}
+	}
+
+	fsys := os.DirFS(dir)
+	pending := make(map[string]struct{})
+	timer := time.NewTimer(templateReloadDelay)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Write) && !event.Has(fsnotify.Rename) {
+				continue
+			}
+			name := filepath.Base(event.Name)
+			if !isTemplateExtension(filepath.Ext(name)) {
+				continue
+			}
+			pending[name] = struct{}{}
+			timer.Reset(templateReloadDelay)
+
+		case <-timer.C:
+			for name := range pending {
+				delete(pending, name)
+				if err := tm.loadFile(fsys, name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+					report(err)
+				}
+			}
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			report(fmt.Errorf("template watcher: %w", err))
+		}
+	}
+}
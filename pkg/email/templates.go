@@ -3,123 +3,163 @@ package email
 import (
 	"fmt"
 	"html/template"
-	"strings"
+	"sort"
+	"sync"
+	"time"
 )
 
 // TemplateManager 模板管理器
 type TemplateManager struct {
-	templates map[EmailType]*template.Template
+	mu          sync.RWMutex
+	templates   map[EmailType]Template
+	experiments map[EmailType]*templateExperiment
+	renderer    Renderer
+	inlineCSS   bool
+	branding    Branding
+
+	// 租户自定义模板
+	store    TemplateStore
+	cacheTTL time.Duration
+	cache    templateCache
+}
+
+// templateFuncs 所有邮件模板可用的函数
+//
+//   - cid: 引用内嵌资源，如 <img src="{{cid "logo.png"}}">，见 EmailData.EmbedImage。
+//     html/template 会把 cid: 地址当作不安全的 URL 过滤掉，需要通过该函数输出
+var templateFuncs = template.FuncMap{
+	"cid": func(contentID string) template.URL {
+		return template.URL(CIDURL(contentID))
+	},
 }
 
 // NewTemplateManager 创建模板管理器
-func NewTemplateManager() *TemplateManager {
+func NewTemplateManager(opts ...TemplateManagerOption) *TemplateManager {
 	tm := &TemplateManager{
-		templates: make(map[EmailType]*template.Template),
+		templates:   make(map[EmailType]Template),
+		experiments: make(map[EmailType]*templateExperiment),
+		renderer:    defaultRenderer,
+		branding:    defaultBranding,
+		cache: templateCache{
+			entries: make(map[tenantTemplateKey]*cachedTemplate),
+		},
 	}
 	tm.initTemplates()
+	for _, opt := range opts {
+		opt(tm)
+	}
 	return tm
 }
 
 // initTemplates 初始化模板
 func (tm *TemplateManager) initTemplates() {
-	// 租户激活邮件模板
-	tm.templates[EmailTypeTenantActivation] = template.Must(template.New("tenant_activation").Parse(tenantActivationTemplate))
-
-	// 邀请加入邮件模板
-	tm.templates[EmailTypeInvitation] = template.Must(template.New("invitation").Parse(invitationTemplate))
-
-	// 密码重置邮件模板
-	tm.templates[EmailTypePasswordReset] = template.Must(template.New("password_reset").Parse(passwordResetTemplate))
-
-	// 验证模板是否正确解析
-	for emailType, t := range tm.templates {
-		if t.Lookup("subject") == nil {
-			panic(fmt.Sprintf("subject template not found for %s", emailType))
-		}
-		if t.Lookup("body") == nil {
-			panic(fmt.Sprintf("body template not found for %s", emailType))
+	for emailType, text := range map[EmailType]string{
+		EmailTypeTenantActivation: tenantActivationTemplate, // 租户激活邮件模板
+		EmailTypeInvitation:       invitationTemplate,       // 邀请加入邮件模板
+		EmailTypePasswordReset:    passwordResetTemplate,    // 密码重置邮件模板
+		EmailTypeVerificationCode: verificationCodeTemplate, // 验证码邮件模板
+		EmailTypeMFACode:          mfaCodeTemplate,          // 登录二次验证邮件模板
+		EmailTypeSecurityAlert:    securityAlertTemplate,    // 异常登录提醒邮件模板
+		EmailTypeQuotaWarning:     quotaWarningTemplate,     // 存储空间预警邮件模板
+	} {
+		t, err := defaultRenderer.Parse(string(emailType), text)
+		if err != nil {
+			panic(err)
 		}
+		tm.templates[emailType] = t
 	}
 }
 
-// RenderTemplate 渲染模板
-func (tm *TemplateManager) RenderTemplate(emailType EmailType, data map[string]interface{}) (string, string, error) {
-	t, exists := tm.templates[emailType]
-	if !exists {
-		return "", "", fmt.Errorf("template not found for type: %s", emailType)
-	}
-
-	// 渲染主题
-	var subjectBuilder strings.Builder
-	subjectTemplate := t.Lookup("subject")
-	if subjectTemplate == nil {
-		return "", "", fmt.Errorf("subject template not found")
+// RegisterTemplate 注册自定义邮件类型的模板，已存在的类型会被覆盖
+//
+// 模板需要包含 {{define "subject"}} 和 {{define "body"}} 两个块。
+// 正文可以使用公共页眉页脚和样式，只需编写中间的内容，见 layoutPartials。
+//
+// 参数:
+//   - emailType: 邮件类型，如 "order_confirmation"
+//   - tmpl: 模板文本
+//
+// 返回:
+//   - error: 类型为空、模板解析失败或缺少 subject/body 块时返回错误
+//
+// 使用示例:
+//
+//	const EmailTypeOrderConfirmation email.EmailType = "order_confirmation"
+//
+//	err := tm.RegisterTemplate(EmailTypeOrderConfirmation, `{{define "subject"}}订单 {{.OrderNo}} 已确认{{end}}
+//	{{define "heading"}}订单已确认{{end}}
+//	{{define "body"}}{{template "email_header" .}}<p>您好 {{.UserName}}，您的订单已确认。</p>{{template "email_footer" .}}{{end}}`)
+func (tm *TemplateManager) RegisterTemplate(emailType EmailType, tmpl string) error {
+	if emailType == "" {
+		return fmt.Errorf("email type cannot be empty")
 	}
-	err := subjectTemplate.Execute(&subjectBuilder, data)
+	t, err := tm.renderer.Parse(string(emailType), tmpl)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to render subject: %w", err)
+		return err
 	}
 
-	// 渲染正文
-	var bodyBuilder strings.Builder
-	bodyTemplate := t.Lookup("body")
-	if bodyTemplate == nil {
-		return "", "", fmt.Errorf("body template not found")
-	}
-	err = bodyTemplate.Execute(&bodyBuilder, data)
-	if err != nil {
-		return "", "", fmt.Errorf("failed to render body: %w", err)
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	tm.setTemplate(emailType, t)
+	return nil
+}
+
+// Types 返回已注册的邮件类型（按名称排序）
+func (tm *TemplateManager) Types() []EmailType {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	types := make([]EmailType, 0, len(tm.templates))
+	for emailType := range tm.templates {
+		types = append(types, emailType)
 	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
 
-	return subjectBuilder.String(), bodyBuilder.String(), nil
+// RenderTemplate 渲染模板，配置了实验时使用空种子选择版本，见 RenderTemplateVersion
+func (tm *TemplateManager) RenderTemplate(emailType EmailType, data map[string]interface{}) (string, string, error) {
+	subject, body, _, err := tm.RenderTemplateVersion(emailType, "", data)
+	return subject, body, err
 }
 
-// 这是一个 Go 代码文件，包含三个优化后的邮件模板常量。
+// 这是一个 Go 代码文件，包含内置邮件类型的模板常量。
 // 这些模板具有更好的邮件客户端兼容性。
 
-// 1. 租户激活邮件模板 (优化版)
-const tenantActivationTemplate = `{{define "subject"}}欢迎加入 {{.TenantName}} - 请激活您的账户{{end}}
-{{define "body"}}
-<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>账户激活</title>
-    <style>
+// layoutPartials 公共页眉、页脚和样式，HTMLRenderer 解析模板前会先解析这些块
+//
+// 模板正文以 {{template "email_header" .}} 开头、{{template "email_footer" .}} 结尾，
+// 并可以定义以下块:
+//   - title: 页面标题
+//   - heading: 页眉中的大标题
+//   - styles: 额外的 CSS，追加在公共样式之后，可以覆盖公共样式
+//
+// 公共样式提供 .button-base 与 .button-primary/.button-success/.button-secondary/.button-danger
+// 按钮，以及 .highlight、.link-box、.info-box、.warning、.code、.text-center 等辅助样式。
+// 颜色、Logo 和页脚文字来自 .Branding，见 Branding。
+const layoutPartials = `{{define "title"}}{{end}}{{define "heading"}}{{end}}{{define "styles"}}{{end}}
+{{define "email_styles"}}
         body { 
             font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', 'Roboto', 'Helvetica Neue', Arial, sans-serif; 
-            line-height: 1.6; 
-            color: #333333; 
-            font-size: 16px;
-            margin: 0;
-            padding: 0;
-            background-color: #f4f4f7; /* 浅灰色背景 */
+            line-height: 1.6; color: {{.Branding.TextColor}}; font-size: 16px;
+            margin: 0; padding: 0; background-color: {{.Branding.BackgroundColor}};
         }
         .container { 
-            max-width: 600px; 
-            margin: 20px auto; 
-            padding: 0; 
-            background-color: #ffffff; /* 白色卡片 */
-            border: 1px solid #e0e0e0;
-            border-radius: 8px;
-            overflow: hidden; 
+            max-width: 600px; margin: 20px auto; padding: 0; 
+            background-color: #ffffff; border: 1px solid #e0e0e0;
+            border-radius: 8px; overflow: hidden; 
         }
         .header { 
-            background-color: #ffffff; 
-            padding: 30px 20px; 
-            text-align: center; 
-            border-bottom: 1px solid #e0e0e0;
+            background-color: #ffffff; padding: 30px 20px; 
+            text-align: center; border-bottom: 1px solid #e0e0e0;
         }
         .header h1 { margin: 0; color: #222222; font-size: 24px; }
+        .logo { max-height: 48px; margin-bottom: 16px; border: 0; }
         .content { background: #ffffff; padding: 32px; }
         .content p, .content ul { margin-bottom: 20px; }
         .footer { 
-            background: #f9f9f9; 
-            padding: 20px; 
-            text-align: center; 
-            font-size: 13px; 
-            color: #777777; 
+            background: #f9f9f9; padding: 20px; text-align: center; 
+            font-size: 13px; color: #777777; 
         }
         
         /* --- 基础按钮样式 (重要) --- */
@@ -136,35 +176,84 @@ const tenantActivationTemplate = `{{define "subject"}}欢迎加入 {{.TenantName
             cursor: pointer;
             color: #ffffff !important; /* 强制白色文字 */
         }
-        .button-primary { 
-            background-color: #007bff; /* 纯蓝色 */
-        }
+        .button-primary { background-color: {{.Branding.PrimaryColor}}; }
+        .button-success { background-color: #28a745; }
+        .button-secondary { background-color: #6c757d; }
+        .button-danger { background-color: #dc3545; }
         
         /* --- 辅助样式 --- */
-        .highlight { color: #007bff; font-weight: bold; }
+        .highlight { color: {{.Branding.PrimaryColor}}; font-weight: bold; }
         .link-box {
-            word-break: break-all; 
-            background: #f8f9fa; 
-            padding: 12px; 
-            border-radius: 4px;
+            word-break: break-all; background: #f8f9fa; 
+            padding: 12px; border-radius: 4px;
             font-family: 'Courier New', Courier, monospace;
         }
+        .info-box { 
+            background: #f8f9fa; padding: 15px; 
+            border-radius: 4px; margin: 15px 0; 
+        }
+        .warning { 
+            background: #fff3cd; 
+            border: 1px solid #ffeeba; 
+            padding: 15px; 
+            border-radius: 4px; 
+            margin: 15px 0; 
+            color: #856404; /* 确保文字可读 */
+        }
+        .code {
+            display: inline-block;
+            padding: 16px 32px;
+            margin: 10px 0 20px;
+            background: #f8f9fa;
+            border: 1px dashed #cccccc;
+            border-radius: 8px;
+            font-family: 'Courier New', Courier, monospace;
+            font-size: 32px;
+            font-weight: bold;
+            letter-spacing: 8px;
+            color: #222222;
+        }
         .text-center { text-align: center; }
-    </style>
+{{end}}
+{{define "email_header"}}
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>{{template "title" .}}</title>
+    <style>{{template "email_styles" .}}{{template "styles" .}}</style>
 </head>
 <body>
     <div class="container">
         <div class="header">
-            <h1>欢迎加入 {{.TenantName}}</h1>
+            {{with .Branding.LogoURL}}<img src="{{.}}" alt="Logo" class="logo"><br>{{end}}
+            <h1>{{template "heading" .}}</h1>
         </div>
         <div class="content">
+{{end}}
+{{define "email_footer"}}
+        </div>
+        <div class="footer">
+            <p>{{.Branding.FooterText}}</p>
+            <p>&copy; {{.CurrentYear}}{{with .TenantName}} {{.}}{{end}}. 保留所有权利。</p>
+        </div>
+    </div>
+</body>
+</html>
+{{end}}`
+
+// 1. 租户激活邮件模板 (优化版)
+const tenantActivationTemplate = `{{define "subject"}}欢迎加入 {{.TenantName}} - 请激活您的账户{{end}}
+{{define "title"}}账户激活{{end}}
+{{define "heading"}}欢迎加入 {{.TenantName}}{{end}}
+{{define "body"}}{{template "email_header" .}}
             <h2>亲爱的 {{.UserName}}，</h2>
             <p>欢迎加入 <span class="highlight">{{.TenantName}}</span>！您的账户已成功创建。</p>
             
             <p>请点击下面的按钮激活您的账户：</p>
             <div class="text-center">
-             	<a href="{{.ActivationLink}}" class="button-base button-primary">激活账户</a>
-
+                <a href="{{.ActivationLink}}" class="button-base button-primary">激活账户</a>
             </div>
             
             <p>如果按钮无法点击，请复制以下链接到浏览器中打开：</p>
@@ -178,95 +267,19 @@ const tenantActivationTemplate = `{{define "subject"}}欢迎加入 {{.TenantName
             </ul>
             
             <p>如有任何问题，请联系我们的技术支持团队。</p>
-        </div>
-        <div class="footer">
-            <p>此邮件由系统自动发送，请勿回复。</p>
-            <p>&copy; {{.CurrentYear}} {{.TenantName}}. 保留所有权利。</p>
-        </div>
-    </div>
-</body>
-</html>
-{{end}}
+{{template "email_footer" .}}{{end}}
 `
 
 // 2. 邀请加入邮件模板 (优化版)
 const invitationTemplate = `
 {{define "subject"}}邀请您加入 {{.TenantName}} 的 {{.DepartmentName}} 部门{{end}}
-{{define "body"}}
-<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>部门邀请</title>
-    <style>
-        body { 
-            font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', 'Roboto', 'Helvetica Neue', Arial, sans-serif; 
-            line-height: 1.6; color: #333333; font-size: 16px;
-            margin: 0; padding: 0; background-color: #f4f4f7;
-        }
-        .container { 
-            max-width: 600px; margin: 20px auto; padding: 0; 
-            background-color: #ffffff; border: 1px solid #e0e0e0;
-            border-radius: 8px; overflow: hidden; 
-        }
-        .header { 
-            background-color: #ffffff; padding: 30px 20px; 
-            text-align: center; border-bottom: 1px solid #e0e0e0;
-        }
-        .header h1 { margin: 0; color: #222222; font-size: 24px; }
-        .content { background: #ffffff; padding: 32px; }
-        .content p, .content ul { margin-bottom: 20px; }
-        .footer { 
-            background: #f9f9f9; padding: 20px; text-align: center; 
-            font-size: 13px; color: #777777; 
-        }
-        
-        /* --- 基础按钮样式 (重要) --- */
-        .button-base {
-            display: inline-block; 
-            padding: 14px 28px; 
-            text-decoration: none !important; 
-            border-radius: 8px; 
-            margin: 10px 8px; /* 调整间距 */
-            font-size: 16px; 
-            font-weight: 600; 
-            text-align: center; 
-            border: none;
-            cursor: pointer;
-            color: #ffffff !important; 
-        }
-        .button-success { 
-            background-color: #28a745; /* 纯绿色 */
-        }
-        .button-secondary { 
-            background-color: #6c757d; /* 纯灰色 */
-        }
-        
-        /* --- 辅助样式 --- */
-        .highlight { color: #007bff; font-weight: bold; }
-        .link-box {
-            word-break: break-all; background: #f8f9fa; 
-            padding: 12px; border-radius: 4px;
-            font-family: 'Courier New', Courier, monospace;
-        }
-        .role-info { 
-            background: #f8f9fa; padding: 15px; 
-            border-radius: 4px; margin: 15px 0; 
-        }
-        .text-center { text-align: center; }
-    </style>
-</head>
-<body>
-    <div class="container">
-        <div class="header">
-            <h1>邀请</h1>
-        </div>
-        <div class="content">
+{{define "title"}}部门邀请{{end}}
+{{define "heading"}}邀请{{end}}
+{{define "body"}}{{template "email_header" .}}
             <h2>亲爱的 {{.UserName}}，</h2>
             <p><span class="highlight">{{.InviterName}}</span> 邀请您加入 <span class="highlight">{{.TenantName}}</span> 的 <span class="highlight">{{.DepartmentName}}</span> 部门。</p>
             
-            <div class="role-info">
+            <div class="info-box">
                 <h3>邀请详情：</h3>
                 <p><strong>组织：</strong>{{.TenantName}}</p>
                 <p><strong>部门：</strong>{{.DepartmentName}}</p>
@@ -289,92 +302,18 @@ const invitationTemplate = `
                 <li>接受邀请后，您将获得相应的部门权限</li>
                 <li>如有疑问，请联系邀请人或技术支持团队</li>
             </ul>
-        </div>
-        <div class="footer">
-            <p>此邮件由系统自动发送，请勿回复。</p>
-            <p>&copy; {{.CurrentYear}} {{.TenantName}}. 保留所有权利。</p>
-        </div>
-    </div>
-</body>
-</html>
-{{end}}
+{{template "email_footer" .}}{{end}}
 `
 
 // 3. 密码重置邮件模板 (优化版)
 const passwordResetTemplate = `
 {{define "subject"}}密码重置请求 - {{.TenantName}}{{end}}
-{{define "body"}}
-<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>密码重置</title>
-    <style>
-        body { 
-            font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', 'Roboto', 'Helvetica Neue', Arial, sans-serif; 
-            line-height: 1.6; color: #333333; font-size: 16px;
-            margin: 0; padding: 0; background-color: #f4f4f7;
-        }
-        .container { 
-            max-width: 600px; margin: 20px auto; padding: 0; 
-            background-color: #ffffff; border: 1px solid #e0e0e0;
-            border-radius: 8px; overflow: hidden; 
-        }
-        .header { 
-            background-color: #ffffff; padding: 30px 20px; 
-            text-align: center; border-bottom: 1px solid #e0e0e0;
-        }
-        .header h1 { margin: 0; color: #222222; font-size: 24px; }
-        .content { background: #ffffff; padding: 32px; }
-        .content p, .content ul { margin-bottom: 20px; }
-        .footer { 
-            background: #f9f9f9; padding: 20px; text-align: center; 
-            font-size: 13px; color: #777777; 
-        }
-        
-        /* --- 基础按钮样式 (重要) --- */
-        .button-base {
-            display: inline-block; 
-            padding: 14px 28px; 
-            text-decoration: none !important; 
-            border-radius: 8px; 
-            margin: 20px 0; 
-            font-size: 16px; 
-            font-weight: 600; 
-            text-align: center; 
-            border: none;
-            cursor: pointer;
-            color: #ffffff !important; 
-        }
-        .button-danger { 
-            background-color: #dc3545; /* 纯红色 */
-        }
-        
-        /* --- 辅助样式 --- */
-        .highlight { color: #dc3545; font-weight: bold; }
-        .link-box {
-            word-break: break-all; background: #f8f9fa; 
-            padding: 12px; border-radius: 4px;
-            font-family: 'Courier New', Courier, monospace;
-        }
-        .warning { 
-            background: #fff3cd; 
-            border: 1px solid #ffeeba; 
-            padding: 15px; 
-            border-radius: 4px; 
-            margin: 15px 0; 
-            color: #856404; /* 确保文字可读 */
-        }
-        .text-center { text-align: center; }
-    </style>
-</head>
-<body>
-    <div class="container">
-        <div class="header">
-            <h1>密码重置请求</h1>
-        </div>
-        <div class="content">
+{{define "title"}}密码重置{{end}}
+{{define "heading"}}密码重置请求{{end}}
+{{define "styles"}}
+        .highlight { color: #dc3545; }
+{{end}}
+{{define "body"}}{{template "email_header" .}}
             <h2>亲爱的 {{.UserName}}，</h2>
             <p>我们收到了您对该账户的密码重置请求。</p>
             
@@ -397,13 +336,97 @@ const passwordResetTemplate = `
                 <li>链接只能使用一次，使用后立即失效</li>
                 <li>为了账户安全，请设置一个强密码</li>
             </ul>
-        </div>
-        <div class="footer">
-            <p>此邮件由系统自动发送，请勿回复。</p>
-            <p>&copy; {{.CurrentYear}} {{.TenantName}}. 保留所有权利。</p>
-        </div>
-    </div>
-</body>
-</html>
+{{template "email_footer" .}}{{end}}
+`
+
+// 4. 验证码邮件模板
+const verificationCodeTemplate = `
+{{define "subject"}}您的验证码：{{.Code}}{{end}}
+{{define "title"}}验证码{{end}}
+{{define "heading"}}验证码{{end}}
+{{define "styles"}}
+        .highlight { color: #dc3545; }
+{{end}}
+{{define "body"}}{{template "email_header" .}}
+            <p>您好，</p>
+            <p>您正在进行身份验证，本次操作的验证码为：</p>
+            <div class="text-center">
+                <span class="code">{{.Code}}</span>
+            </div>
+            <p>验证码将在 <span class="highlight">{{.ExpireTime}}</span> 后失效，请尽快完成验证。</p>
+            <p>如果这不是您本人的操作，请忽略此邮件，并不要将验证码告诉任何人。</p>
+{{template "email_footer" .}}{{end}}
+`
+
+// 5. 登录二次验证（MFA）验证码邮件模板
+const mfaCodeTemplate = `
+{{define "subject"}}{{.TenantName}} 登录验证码：{{.Code}}{{end}}
+{{define "title"}}登录验证码{{end}}
+{{define "heading"}}登录验证码{{end}}
+{{define "styles"}}
+        .highlight { color: #dc3545; }
 {{end}}
+{{define "body"}}{{template "email_header" .}}
+            <h2>亲爱的 {{.UserName}}，</h2>
+            <p>您正在登录 {{.TenantName}}，请输入以下验证码完成二次验证：</p>
+            <div class="text-center">
+                <span class="code">{{.Code}}</span>
+            </div>
+            <p>验证码将在 <span class="highlight">{{.ExpireTime}}</span> 后失效。</p>
+            
+            <div class="warning">
+                <h3>⚠️ 安全提醒</h3>
+                <p>如果这不是您本人的登录操作，说明您的密码可能已经泄露，请立即修改密码。任何人向您索要验证码都是诈骗行为。</p>
+            </div>
+{{template "email_footer" .}}{{end}}
+`
+
+// 6. 异常登录安全提醒邮件模板
+const securityAlertTemplate = `
+{{define "subject"}}安全提醒：您的 {{.TenantName}} 账户有新的登录{{end}}
+{{define "title"}}安全提醒{{end}}
+{{define "heading"}}账户安全提醒{{end}}
+{{define "body"}}{{template "email_header" .}}
+            <h2>亲爱的 {{.UserName}}，</h2>
+            <p>我们检测到您的账户在一个不常用的设备或地点登录：</p>
+            
+            <div class="info-box">
+                <p><strong>登录时间：</strong>{{.LoginTime}}</p>
+                <p><strong>IP 地址：</strong>{{.IPAddress}}</p>
+                {{with .Location}}<p><strong>登录地点：</strong>{{.}}</p>{{end}}
+                {{with .Device}}<p><strong>设备：</strong>{{.}}</p>{{end}}
+            </div>
+            
+            <p>如果是您本人操作，请忽略此邮件。</p>
+            <p>如果不是您本人操作，请立即修改密码并检查账户的安全设置：</p>
+            <div class="text-center">
+                <a href="{{.SecureLink}}" class="button-base button-danger">保护我的账户</a>
+            </div>
+{{template "email_footer" .}}{{end}}
+`
+
+// 7. 存储空间配额预警邮件模板
+const quotaWarningTemplate = `
+{{define "subject"}}{{.TenantName}} 存储空间已使用 {{.UsagePercent}}%{{end}}
+{{define "title"}}存储空间预警{{end}}
+{{define "heading"}}存储空间预警{{end}}
+{{define "styles"}}
+        .highlight { color: #fd7e14; }
+        .usage-bar {
+            background: #e9ecef; border-radius: 4px;
+            height: 16px; overflow: hidden; margin: 10px 0 20px;
+        }
+        .usage-fill { background: #fd7e14; height: 16px; }
+{{end}}
+{{define "body"}}{{template "email_header" .}}
+            <h2>亲爱的 {{.UserName}}，</h2>
+            <p><span class="highlight">{{.TenantName}}</span> 的存储空间已使用 <span class="highlight">{{.UsagePercent}}%</span>（{{.UsedSize}} / {{.TotalSize}}）。</p>
+            <div class="usage-bar">
+                <div class="usage-fill" style="width: {{.UsagePercent}}%;"></div>
+            </div>
+            <p>存储空间用尽后将无法继续上传文件，请及时清理不需要的文件或升级存储套餐。</p>
+            <div class="text-center">
+                <a href="{{.ManageLink}}" class="button-base button-primary">管理存储空间</a>
+            </div>
+{{template "email_footer" .}}{{end}}
 `
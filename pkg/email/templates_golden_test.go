@@ -0,0 +1,18 @@
+package email_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/heyinLab/common/pkg/email"
+	"github.com/heyinLab/common/pkg/email/templatetest"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// TestTemplatesGolden 模板渲染结果回归测试
+//
+// 修改模板后执行 go test ./pkg/email -run TestTemplatesGolden -update 更新 golden 文件
+func TestTemplatesGolden(t *testing.T) {
+	templatetest.AssertGolden(t, email.NewTemplateManager(), "testdata/golden", *update)
+}
@@ -0,0 +1,202 @@
+// Package templatestore 提供 email.TemplateStore 的数据库实现
+//
+// 使用示例:
+//
+//	store := templatestore.NewGormStore(db)
+//	if err := store.AutoMigrate(); err != nil {
+//	    return err
+//	}
+//
+//	tm := email.NewTemplateManager(email.WithTemplateStore(store, 0))
+//	store.OnPublish(tm.Invalidate)
+//
+//	svc := email.NewService(cfg)
+//	svc.SetTemplateManager(tm)
+package templatestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/heyinLab/common/pkg/email"
+)
+
+// EmailTemplate 租户邮件模板表
+type EmailTemplate struct {
+	ID          uint64     `gorm:"primarykey;column:id"`
+	TenantID    uint32     `gorm:"column:tenant_id;not null;uniqueIndex:uk_tenant_type_version,priority:1"`    // 租户ID
+	Type        string     `gorm:"column:type;size:64;not null;uniqueIndex:uk_tenant_type_version,priority:2"` // 邮件类型
+	Version     int        `gorm:"column:version;not null;uniqueIndex:uk_tenant_type_version,priority:3"`      // 版本号，从 1 开始递增
+	Subject     string     `gorm:"column:subject;size:512;not null"`                                           // 主题模板
+	Body        string     `gorm:"column:body;type:text;not null"`                                             // 正文模板
+	Status      string     `gorm:"column:status;size:16;not null;index"`                                       // 状态
+	CreatedAt   time.Time  `gorm:"column:created_at"`                                                          // 创建时间
+	UpdatedAt   time.Time  `gorm:"column:updated_at"`                                                          // 更新时间
+	PublishedAt *time.Time `gorm:"column:published_at"`                                                        // 发布时间
+}
+
+// TableName 表名
+func (EmailTemplate) TableName() string {
+	return "email_templates"
+}
+
+// GormStore 基于 GORM 的租户模板存储
+type GormStore struct {
+	db        *gorm.DB
+	onPublish []func(tenantID uint32, emailType email.EmailType)
+}
+
+var _ email.TemplateStore = (*GormStore)(nil)
+
+// NewGormStore 创建基于 GORM 的模板存储
+func NewGormStore(db *gorm.DB) *GormStore {
+	return &GormStore{db: db}
+}
+
+// AutoMigrate 创建或更新模板表
+func (s *GormStore) AutoMigrate() error {
+	return s.db.AutoMigrate(&EmailTemplate{})
+}
+
+// OnPublish 注册发布回调，通常传入 TemplateManager.Invalidate 以清除缓存
+func (s *GormStore) OnPublish(fn func(tenantID uint32, emailType email.EmailType)) {
+	s.onPublish = append(s.onPublish, fn)
+}
+
+// GetPublished 获取已发布的模板，没有时返回 nil, nil
+func (s *GormStore) GetPublished(ctx context.Context, tenantID uint32, emailType email.EmailType) (*email.StoredTemplate, error) {
+	var m EmailTemplate
+	err := s.db.WithContext(ctx).
+		Where("tenant_id = ? AND type = ? AND status = ?", tenantID, string(emailType), string(email.TemplateStatusPublished)).
+		Order("version DESC").
+		First(&m).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toStored(&m), nil
+}
+
+// GetVersion 获取指定版本，不存在时返回 nil, nil
+func (s *GormStore) GetVersion(ctx context.Context, tenantID uint32, emailType email.EmailType, version int) (*email.StoredTemplate, error) {
+	var m EmailTemplate
+	err := s.db.WithContext(ctx).
+		Where("tenant_id = ? AND type = ? AND version = ?", tenantID, string(emailType), version).
+		First(&m).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toStored(&m), nil
+}
+
+// ListVersions 按版本号倒序列出所有版本
+func (s *GormStore) ListVersions(ctx context.Context, tenantID uint32, emailType email.EmailType) ([]*email.StoredTemplate, error) {
+	var models []EmailTemplate
+	err := s.db.WithContext(ctx).
+		Where("tenant_id = ? AND type = ?", tenantID, string(emailType)).
+		Order("version DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*email.StoredTemplate, 0, len(models))
+	for i := range models {
+		result = append(result, toStored(&models[i]))
+	}
+	return result, nil
+}
+
+// SaveDraft 校验模板语法后保存为新的草稿版本
+func (s *GormStore) SaveDraft(ctx context.Context, tenantID uint32, emailType email.EmailType, subject, body string) (*email.StoredTemplate, error) {
+	if _, err := email.ParseStoredTemplate(&email.StoredTemplate{
+		TenantID: tenantID,
+		Type:     emailType,
+		Subject:  subject,
+		Body:     body,
+	}); err != nil {
+		return nil, err
+	}
+
+	m := &EmailTemplate{
+		TenantID: tenantID,
+		Type:     string(emailType),
+		Subject:  subject,
+		Body:     body,
+		Status:   string(email.TemplateStatusDraft),
+	}
+
+	// 并发保存时由唯一索引保证版本号不重复
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var latest int
+		if err := tx.Model(&EmailTemplate{}).
+			Where("tenant_id = ? AND type = ?", tenantID, string(emailType)).
+			Select("COALESCE(MAX(version), 0)").
+			Scan(&latest).Error; err != nil {
+			return err
+		}
+		m.Version = latest + 1
+		return tx.Create(m).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toStored(m), nil
+}
+
+// Publish 发布指定版本，之前发布的版本会被归档
+func (s *GormStore) Publish(ctx context.Context, tenantID uint32, emailType email.EmailType, version int) error {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&EmailTemplate{}).
+			Where("tenant_id = ? AND type = ? AND status = ?", tenantID, string(emailType), string(email.TemplateStatusPublished)).
+			Update("status", string(email.TemplateStatusArchived)).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		result := tx.Model(&EmailTemplate{}).
+			Where("tenant_id = ? AND type = ? AND version = ?", tenantID, string(emailType), version).
+			Updates(map[string]interface{}{
+				"status":       string(email.TemplateStatusPublished),
+				"published_at": now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("template version %d not found for type: %s", version, emailType)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, fn := range s.onPublish {
+		fn(tenantID, emailType)
+	}
+	return nil
+}
+
+// toStored 转换为 email.StoredTemplate
+func toStored(m *EmailTemplate) *email.StoredTemplate {
+	return &email.StoredTemplate{
+		TenantID:    m.TenantID,
+		Type:        email.EmailType(m.Type),
+		Version:     m.Version,
+		Subject:     m.Subject,
+		Body:        m.Body,
+		Status:      email.TemplateStatus(m.Status),
+		UpdatedAt:   m.UpdatedAt,
+		PublishedAt: m.PublishedAt,
+	}
+}
@@ -0,0 +1,125 @@
+package templatestore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/heyinLab/common/pkg/email"
+)
+
+func newTestStore(t *testing.T) *GormStore {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	// 内存数据库只在同一个连接中可见
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	store := NewGormStore(db)
+	require.NoError(t, store.AutoMigrate())
+	return store
+}
+
+func TestGormStore_SaveDraft(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	v1, err := store.SaveDraft(ctx, 1, email.EmailTypeInvitation, "邀请 {{.Name}}", "<p>v1</p>")
+	require.NoError(t, err)
+	assert.Equal(t, 1, v1.Version)
+	assert.Equal(t, email.TemplateStatusDraft, v1.Status)
+
+	v2, err := store.SaveDraft(ctx, 1, email.EmailTypeInvitation, "邀请", "<p>v2</p>")
+	require.NoError(t, err)
+	assert.Equal(t, 2, v2.Version)
+
+	// 版本号按租户和邮件类型分别递增
+	other, err := store.SaveDraft(ctx, 2, email.EmailTypeInvitation, "邀请", "<p>other</p>")
+	require.NoError(t, err)
+	assert.Equal(t, 1, other.Version)
+
+	// 模板语法错误时不保存
+	_, err = store.SaveDraft(ctx, 1, email.EmailTypeInvitation, "邀请", "<p>{{.Name</p>")
+	assert.Error(t, err)
+
+	versions, err := store.ListVersions(ctx, 1, email.EmailTypeInvitation)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, 2, versions[0].Version)
+	assert.Equal(t, 1, versions[1].Version)
+
+	got, err := store.GetVersion(ctx, 1, email.EmailTypeInvitation, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "<p>v1</p>", got.Body)
+}
+
+func TestGormStore_Publish(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	var published []uint32
+	store.OnPublish(func(tenantID uint32, _ email.EmailType) {
+		published = append(published, tenantID)
+	})
+
+	got, err := store.GetPublished(ctx, 1, email.EmailTypeInvitation)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	for _, body := range []string{"<p>v1</p>", "<p>v2</p>"} {
+		_, err := store.SaveDraft(ctx, 1, email.EmailTypeInvitation, "邀请", body)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, store.Publish(ctx, 1, email.EmailTypeInvitation, 1))
+	got, err = store.GetPublished(ctx, 1, email.EmailTypeInvitation)
+	require.NoError(t, err)
+	assert.Equal(t, 1, got.Version)
+	assert.NotNil(t, got.PublishedAt)
+
+	// 发布新版本时归档之前发布的版本
+	require.NoError(t, store.Publish(ctx, 1, email.EmailTypeInvitation, 2))
+	got, err = store.GetPublished(ctx, 1, email.EmailTypeInvitation)
+	require.NoError(t, err)
+	assert.Equal(t, 2, got.Version)
+	v1, err := store.GetVersion(ctx, 1, email.EmailTypeInvitation, 1)
+	require.NoError(t, err)
+	assert.Equal(t, email.TemplateStatusArchived, v1.Status)
+
+	// 回滚即重新发布已归档的版本
+	require.NoError(t, store.Publish(ctx, 1, email.EmailTypeInvitation, 1))
+	got, err = store.GetPublished(ctx, 1, email.EmailTypeInvitation)
+	require.NoError(t, err)
+	assert.Equal(t, 1, got.Version)
+	v2, err := store.GetVersion(ctx, 1, email.EmailTypeInvitation, 2)
+	require.NoError(t, err)
+	assert.Equal(t, email.TemplateStatusArchived, v2.Status)
+	assert.Equal(t, []uint32{1, 1, 1}, published)
+}
+
+func TestGormStore_NotFound(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	got, err := store.GetVersion(ctx, 1, email.EmailTypeInvitation, 1)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	_, err = store.SaveDraft(ctx, 1, email.EmailTypeInvitation, "邀请", "<p>v1</p>")
+	require.NoError(t, err)
+	require.NoError(t, store.Publish(ctx, 1, email.EmailTypeInvitation, 1))
+
+	// 发布不存在的版本时报错，已发布的版本保持不变
+	err = store.Publish(ctx, 1, email.EmailTypeInvitation, 9)
+	assert.ErrorContains(t, err, "template version 9 not found")
+	published, err := store.GetPublished(ctx, 1, email.EmailTypeInvitation)
+	require.NoError(t, err)
+	assert.Equal(t, 1, published.Version)
+}
@@ -0,0 +1,30 @@
+// email-export 使用示例数据渲染所有邮件模板并输出为 HTML 文件，供设计人员预览
+//
+// 用法:
+//
+//	go run ./pkg/email/templatetest/cmd/email-export -out ./email-preview
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/heyinLab/common/pkg/email"
+	"github.com/heyinLab/common/pkg/email/templatetest"
+)
+
+func main() {
+	out := flag.String("out", "email-preview", "output directory")
+	flag.Parse()
+
+	tm := email.NewTemplateManager()
+	if err := templatetest.Export(tm, *out); err != nil {
+		fmt.Fprintf(os.Stderr, "export failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	for _, emailType := range tm.Types() {
+		fmt.Printf("%s/%s.html\n", *out, emailType)
+	}
+}
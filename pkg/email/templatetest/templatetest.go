@@ -0,0 +1,209 @@
+// Package templatetest 提供邮件模板的回归测试工具
+//
+// 使用固定的示例数据渲染所有已注册的邮件类型，并与 golden 文件比对，
+// 防止模板样式的改动在无人察觉的情况下进入生产环境。
+//
+// 使用示例:
+//
+//	var update = flag.Bool("update", false, "更新 golden 文件")
+//
+//	func TestTemplatesGolden(t *testing.T) {
+//	    templatetest.AssertGolden(t, email.NewTemplateManager(), "testdata", *update)
+//	}
+package templatetest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/heyinLab/common/pkg/email"
+)
+
+// SampleYear 示例数据中固定使用的年份，保证渲染结果稳定
+const SampleYear = 2025
+
+// Rendered 渲染结果
+type Rendered struct {
+	Subject string
+	Body    string
+}
+
+var (
+	samplesMu sync.RWMutex
+	samples   = map[email.EmailType]map[string]interface{}{
+		email.EmailTypeTenantActivation: {
+			"UserName":       "张三",
+			"TenantName":     "示例科技",
+			"ActivationLink": "https://example.com/activate?token=sample-token",
+			"ExpireTime":     "24小时",
+			"CurrentYear":    SampleYear,
+		},
+		email.EmailTypeInvitation: {
+			"UserName":       "李四",
+			"TenantName":     "示例科技",
+			"DepartmentName": "研发部",
+			"RoleName":       "开发工程师",
+			"InviterName":    "王五",
+			"InviteTime":     "2025-01-01 10:00:00",
+			"AcceptLink":     "https://example.com/invite/accept?token=sample-token",
+			"DeclineLink":    "https://example.com/invite/decline?token=sample-token",
+			"ExpireTime":     "7天",
+			"CurrentYear":    SampleYear,
+		},
+		email.EmailTypePasswordReset: {
+			"UserName":    "赵六",
+			"TenantName":  "示例科技",
+			"ResetLink":   "https://example.com/reset?token=sample-token",
+			"ExpireTime":  "1小时",
+			"CurrentYear": SampleYear,
+		},
+		email.EmailTypeVerificationCode: {
+			"Code":        "042917",
+			"ExpireTime":  "10分钟",
+			"TenantName":  "示例科技",
+			"CurrentYear": SampleYear,
+		},
+		email.EmailTypeMFACode: {
+			"UserName":    "张三",
+			"TenantName":  "示例科技",
+			"Code":        "583021",
+			"ExpireTime":  "5分钟",
+			"CurrentYear": SampleYear,
+		},
+		email.EmailTypeSecurityAlert: {
+			"UserName":    "张三",
+			"TenantName":  "示例科技",
+			"LoginTime":   "2025-01-01 03:12:45",
+			"IPAddress":   "203.0.113.7",
+			"Location":    "新加坡",
+			"Device":      "Chrome on Windows",
+			"SecureLink":  "https://example.com/account/security",
+			"CurrentYear": SampleYear,
+		},
+		email.EmailTypeQuotaWarning: {
+			"UserName":     "张三",
+			"TenantName":   "示例科技",
+			"UsedSize":     "9.5GB",
+			"TotalSize":    "10GB",
+			"UsagePercent": 95,
+			"ManageLink":   "https://example.com/storage",
+			"CurrentYear":  SampleYear,
+		},
+	}
+)
+
+// RegisterSample 注册邮件类型的示例数据
+//
+// 自定义邮件类型需要注册示例数据后才能得到有意义的 golden 文件
+func RegisterSample(emailType email.EmailType, data map[string]interface{}) {
+	samplesMu.Lock()
+	defer samplesMu.Unlock()
+	samples[emailType] = data
+}
+
+// Sample 返回邮件类型的示例数据，未注册时返回空数据
+func Sample(emailType email.EmailType) map[string]interface{} {
+	samplesMu.RLock()
+	defer samplesMu.RUnlock()
+	if data, ok := samples[emailType]; ok {
+		return data
+	}
+	return map[string]interface{}{}
+}
+
+// RenderAll 使用示例数据渲染所有已注册的邮件类型
+func RenderAll(tm *email.TemplateManager) (map[email.EmailType]Rendered, error) {
+	results := make(map[email.EmailType]Rendered)
+	for _, emailType := range tm.Types() {
+		subject, body, err := tm.RenderTemplate(emailType, Sample(emailType))
+		if err != nil {
+			return nil, fmt.Errorf("render %s: %w", emailType, err)
+		}
+		results[emailType] = Rendered{Subject: subject, Body: body}
+	}
+	return results, nil
+}
+
+// Export 将所有邮件类型的渲染结果写入目录，文件名为 <类型>.html
+//
+// 主题以 HTML 注释的形式写在文件开头，方便设计人员直接用浏览器打开预览
+func Export(tm *email.TemplateManager, dir string) error {
+	results, err := RenderAll(tm)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for emailType, r := range results {
+		if err := os.WriteFile(filePath(dir, emailType), []byte(format(r)), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertGolden 将渲染结果与 dir 下的 golden 文件比对
+//
+// update 为 true 时重写 golden 文件而不是比对
+func AssertGolden(t testing.TB, tm *email.TemplateManager, dir string, update bool) {
+	t.Helper()
+
+	if update {
+		if err := Export(tm, dir); err != nil {
+			t.Fatalf("更新 golden 文件失败: %v", err)
+		}
+		return
+	}
+
+	results, err := RenderAll(tm)
+	if err != nil {
+		t.Fatalf("渲染模板失败: %v", err)
+	}
+
+	for _, emailType := range tm.Types() {
+		path := filePath(dir, emailType)
+		want, err := os.ReadFile(path)
+		if err != nil {
+			t.Errorf("%s: 读取 golden 文件失败（使用 -update 生成）: %v", emailType, err)
+			continue
+		}
+		got := format(results[emailType])
+		if got != string(want) {
+			t.Errorf("%s: 渲染结果与 golden 文件不一致 (%s)\n%s", emailType, path, firstDiff(string(want), got))
+		}
+	}
+}
+
+// ========== 内部函数 ==========
+
+func filePath(dir string, emailType email.EmailType) string {
+	return filepath.Join(dir, string(emailType)+".html")
+}
+
+func format(r Rendered) string {
+	return fmt.Sprintf("<!-- subject: %s -->\n%s", r.Subject, r.Body)
+}
+
+// firstDiff 返回第一处不同的行，便于定位问题
+func firstDiff(want, got string) string {
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			return fmt.Sprintf("line %d:\n  want: %q\n  got:  %q", i+1, w, g)
+		}
+	}
+	return ""
+}
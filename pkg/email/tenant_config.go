@@ -0,0 +1,100 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ConfigResolver 租户发送配置解析
+//
+// 自带 SMTP 中继的租户通过自己的服务器和发件人地址发送邮件。
+// 每封邮件发送前都会调用，实现方需要自行缓存数据库等查询结果。
+type ConfigResolver interface {
+	// ResolveSMTP 返回租户的 SMTP 配置，租户没有自定义配置时返回 nil, nil
+	ResolveSMTP(ctx context.Context, tenantID uint32) (*SMTPConfig, error)
+}
+
+// tenantProvider 租户的 SMTP 发送渠道，配置不变时复用以保留连接池
+type tenantProvider struct {
+	config   SMTPConfig
+	provider *SMTPProvider
+}
+
+// tenantProviders 按租户缓存的发送渠道
+type tenantProviders struct {
+	mu      sync.Mutex
+	entries map[uint32]*tenantProvider
+}
+
+// get 返回与配置一致的发送渠道，配置变化时关闭旧渠道并重新创建
+func (tp *tenantProviders) get(tenantID uint32, config *SMTPConfig) (*SMTPProvider, error) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	if entry, ok := tp.entries[tenantID]; ok {
+		if reflect.DeepEqual(entry.config, *config) {
+			return entry.provider, nil
+		}
+		_ = entry.provider.Close()
+		delete(tp.entries, tenantID)
+	}
+
+	entry := &tenantProvider{config: *config}
+	provider, err := newCheckedSMTPProvider(&entry.config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid smtp config for tenant %d: %w", tenantID, err)
+	}
+	entry.provider = provider
+	if tp.entries == nil {
+		tp.entries = make(map[uint32]*tenantProvider)
+	}
+	tp.entries[tenantID] = entry
+	return provider, nil
+}
+
+// close 关闭所有租户的发送渠道
+func (tp *tenantProviders) close() {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	for tenantID, entry := range tp.entries {
+		_ = entry.provider.Close()
+		delete(tp.entries, tenantID)
+	}
+}
+
+// SetConfigResolver 设置租户配置解析，发送时按上下文中的租户ID（见 WithTenantID）选择 SMTP 配置
+//
+// 演练模式下不会使用租户配置。熔断器只作用于默认发送渠道，单个租户的中继故障不会影响其他租户。
+//
+// 使用示例:
+//
+//	sender.SetConfigResolver(resolver)
+//	ctx = email.WithTenantID(ctx, tenantID)
+//	_, err := sender.SendEmail(ctx, data) // 通过租户自己的 SMTP 发送
+func (s *Sender) SetConfigResolver(resolver ConfigResolver) {
+	s.resolver = resolver
+}
+
+// resolveProvider 返回租户的发送渠道和发件人地址，租户没有自定义配置时渠道为 nil，发件人为默认地址
+func (s *Sender) resolveProvider(ctx context.Context) (*SMTPProvider, string, error) {
+	tenantID := TenantIDFromContext(ctx)
+	if s.resolver == nil || tenantID == 0 || s.config.DryRun.Mode != "" {
+		return nil, s.config.fromAddress(), nil
+	}
+
+	config, err := s.resolver.ResolveSMTP(ctx, tenantID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to resolve smtp config for tenant %d: %w", tenantID, err)
+	}
+	if config == nil {
+		return nil, s.config.fromAddress(), nil
+	}
+
+	provider, err := s.tenants.get(tenantID, config)
+	if err != nil {
+		return nil, "", err
+	}
+	return provider, config.From, nil
+}
@@ -0,0 +1,107 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeConfigResolver map[uint32]*SMTPConfig
+
+func (r fakeConfigResolver) ResolveSMTP(_ context.Context, tenantID uint32) (*SMTPConfig, error) {
+	if tenantID == 99 {
+		return nil, errors.New("db down")
+	}
+	return r[tenantID], nil
+}
+
+func TestSender_ConfigResolver(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	host, port, _ := net.SplitHostPort(server.ln.Addr().String())
+	portNum, _ := strconv.Atoi(port)
+
+	resolver := fakeConfigResolver{
+		7: {Host: host, Port: portNum, From: "mail@tenant7.example", TLSMode: TLSModeNone},
+	}
+	provider := &fakeProvider{}
+	sender := NewSenderWithProvider(&Config{Provider: "sendgrid", SendGrid: SendGridConfig{From: "no-reply@example.com"}}, provider)
+	sender.SetConfigResolver(resolver)
+	defer sender.Close()
+
+	// 租户自己的 SMTP
+	ctx := WithTenantID(context.Background(), 7)
+	result, err := sender.SendEmail(ctx, NewEmailData("a@example.com", "hi", "<p>hi</p>"))
+	assert.NoError(t, err)
+	assert.Equal(t, ProviderSMTP, result.Provider)
+	assert.True(t, strings.HasSuffix(result.MessageID, "@tenant7.example"))
+	assert.Equal(t, int32(1), server.mails.Load())
+	assert.Empty(t, provider.sent)
+
+	// 配置不变时复用发送渠道
+	first := sender.tenants.entries[7].provider
+	_, err = sender.SendEmail(ctx, NewEmailData("a@example.com", "hi", "<p>hi</p>"))
+	assert.NoError(t, err)
+	assert.Same(t, first, sender.tenants.entries[7].provider)
+
+	// 配置变化时重新创建
+	resolver[7] = &SMTPConfig{Host: host, Port: portNum, From: "other@tenant7.example", TLSMode: TLSModeNone}
+	_, err = sender.SendEmail(ctx, NewEmailData("a@example.com", "hi", "<p>hi</p>"))
+	assert.NoError(t, err)
+	assert.NotSame(t, first, sender.tenants.entries[7].provider)
+	assert.Equal(t, int32(3), server.mails.Load())
+
+	// 没有自定义配置的租户使用默认渠道
+	result, err = sender.SendEmail(WithTenantID(context.Background(), 8), NewEmailData("a@example.com", "hi", ""))
+	assert.NoError(t, err)
+	assert.Equal(t, ProviderSendGrid, result.Provider)
+	assert.True(t, strings.HasSuffix(result.MessageID, "@example.com"))
+	assert.Len(t, provider.sent, 1)
+
+	// 解析失败
+	_, err = sender.SendEmail(WithTenantID(context.Background(), 99), NewEmailData("a@example.com", "hi", ""))
+	assert.ErrorContains(t, err, "db down")
+
+	// 配置无效
+	resolver[7] = &SMTPConfig{Host: host}
+	_, err = sender.SendEmail(ctx, NewEmailData("a@example.com", "hi", ""))
+	assert.ErrorContains(t, err, "smtp host and from are required")
+}
+
+func TestQueue_TenantConfig(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	host, port, _ := net.SplitHostPort(server.ln.Addr().String())
+	portNum, _ := strconv.Atoi(port)
+
+	provider := &fakeProvider{}
+	sender := NewSenderWithProvider(&Config{Provider: "sendgrid", SendGrid: SendGridConfig{From: "no-reply@example.com"}}, provider)
+	sender.SetConfigResolver(fakeConfigResolver{
+		7: {Host: host, Port: portNum, From: "mail@tenant7.example", TLSMode: TLSModeNone},
+	})
+	defer sender.Close()
+
+	ctx := WithTenantID(context.Background(), 7)
+
+	// 内存队列恢复入队时的租户ID，通过租户自己的 SMTP 发送
+	q := NewQueue(sender, &QueueConfig{Workers: 1})
+	assert.NoError(t, q.EnqueueContext(ctx, NewEmailData("a@example.com", "hi", "<p>hi</p>")))
+	assert.NoError(t, q.Enqueue(NewEmailData("b@example.com", "hi", "<p>hi</p>")))
+	assert.NoError(t, q.Shutdown(context.Background()))
+	assert.Equal(t, int32(1), server.mails.Load())
+	assert.Len(t, provider.sent, 1)
+
+	// 持久化队列随任务保存租户ID
+	store := NewMemoryJobStore(0)
+	dq := NewDurableQueue(sender, store, &DurableQueueConfig{PollInterval: time.Millisecond})
+	runCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go dq.Run(runCtx)
+	assert.NoError(t, dq.Enqueue(ctx, "", NewEmailData("a@example.com", "hi", "<p>hi</p>")))
+	assert.Eventually(t, func() bool { return server.mails.Load() == 2 }, time.Second, time.Millisecond)
+	assert.Len(t, provider.sent, 1)
+}
@@ -0,0 +1,59 @@
+package email
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// defaultCodeLength 默认验证码长度
+const defaultCodeLength = 6
+
+// GenerateCode 生成指定长度的数字验证码，使用 crypto/rand 保证不可预测
+//
+// 参数:
+//   - length: 验证码位数，小于等于 0 时使用 6 位
+//
+// 返回:
+//   - string: 验证码，可能以 0 开头
+//   - error: 随机数生成失败时返回错误
+//
+// 使用示例:
+//
+//	code, err := email.GenerateCode(6)
+//	if err != nil {
+//	    return err
+//	}
+//	// 保存 code 后发送
+//	_, err = sender.SendVerificationCodeEmail(ctx, "user@example.com", code, 10*time.Minute)
+func GenerateCode(length int) (string, error) {
+	if length <= 0 {
+		length = defaultCodeLength
+	}
+	ten := big.NewInt(10)
+	code := make([]byte, length)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, ten)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate code: %w", err)
+		}
+		code[i] = byte('0' + n.Int64())
+	}
+	return string(code), nil
+}
+
+// formatTTL 将有效期格式化为中文描述，如 10分钟、1小时30分钟
+func formatTTL(ttl time.Duration) string {
+	if ttl < time.Minute {
+		return fmt.Sprintf("%d秒", int(ttl.Seconds()))
+	}
+	if ttl < time.Hour {
+		return fmt.Sprintf("%d分钟", int(ttl.Minutes()))
+	}
+	hours := int(ttl.Hours())
+	if minutes := int(ttl.Minutes()) % 60; minutes > 0 {
+		return fmt.Sprintf("%d小时%d分钟", hours, minutes)
+	}
+	return fmt.Sprintf("%d小时", hours)
+}
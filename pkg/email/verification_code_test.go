@@ -0,0 +1,41 @@
+package email
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateCode(t *testing.T) {
+	code, err := GenerateCode(0)
+	assert.NoError(t, err)
+	assert.Regexp(t, `^[0-9]{6}$`, code)
+
+	code, err = GenerateCode(8)
+	assert.NoError(t, err)
+	assert.Regexp(t, `^[0-9]{8}$`, code)
+}
+
+func TestFormatTTL(t *testing.T) {
+	assert.Equal(t, "30秒", formatTTL(30*time.Second))
+	assert.Equal(t, "10分钟", formatTTL(10*time.Minute))
+	assert.Equal(t, "1小时", formatTTL(time.Hour))
+	assert.Equal(t, "1小时30分钟", formatTTL(90*time.Minute))
+}
+
+func TestService_SendVerificationCodeEmail(t *testing.T) {
+	svc := NewService(&Config{SMTP: SMTPConfig{From: "no-reply@example.com"}, DryRun: DryRunConfig{Mode: DryRunCapture}})
+
+	_, err := svc.SendVerificationCodeEmail(context.Background(), &VerificationCodeEmailRequest{To: "user@example.com", Code: "042917"})
+	assert.NoError(t, err)
+
+	last := svc.Provider().(*CaptureProvider).Last()
+	assert.Equal(t, "您的验证码：042917", last.Data.Subject)
+	assert.Contains(t, last.Data.Body, "042917")
+	assert.Contains(t, last.Data.Body, "10分钟")
+
+	_, err = svc.SendVerificationCodeEmail(context.Background(), &VerificationCodeEmailRequest{To: "user@example.com"})
+	assert.Error(t, err)
+}
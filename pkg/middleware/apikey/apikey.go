@@ -0,0 +1,182 @@
+// Package apikey 第三方集成（Webhook、开放接口）使用的 API Key 认证中间件
+//
+// 请求通过 X-API-Key 请求头携带密钥，中间件在 KeyStore 中查找对应的集成身份并写入 context，
+// 业务代码通过 FromContext 获取；RequireScopes 校验集成是否拥有接口要求的权限范围。
+// KeyStore 只保存密钥的 SHA256（HashKey），不保存明文。
+//
+// 使用示例:
+//
+//	store := apikey.NewRedisStore(rdb)
+//	http.Middleware(
+//	    selector.Server(apikey.Server(store), apikey.RequireScopes("orders:write")).
+//	        Prefix("/api.webhook.v1.").Build(),
+//	)
+package apikey
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	stdErrors "errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+)
+
+// DefaultHeader 默认携带 API Key 的请求头
+const DefaultHeader = "X-API-Key"
+
+// ErrKeyNotFound KeyStore 中不存在该密钥
+var ErrKeyNotFound = stdErrors.New("API Key 不存在")
+
+// Identity 集成身份
+type Identity struct {
+	// 集成ID
+	ID string `json:"id"`
+	// 集成名称
+	Name string `json:"name"`
+	// 所属租户ID，0 表示平台级集成
+	TenantID uint32 `json:"tenant_id"`
+	// 权限范围，"*" 表示全部
+	Scopes []string `json:"scopes"`
+	// 过期时间，零值表示不过期
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+}
+
+// HasScope 判断是否拥有权限范围
+func (i *Identity) HasScope(scope string) bool {
+	for _, s := range i.Scopes {
+		if s == scope || s == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// expired 判断是否已过期
+func (i *Identity) expired(now time.Time) bool {
+	return !i.ExpiresAt.IsZero() && !now.Before(i.ExpiresAt)
+}
+
+// KeyStore 按密钥的 SHA256 查找集成身份，不存在时返回 ErrKeyNotFound
+type KeyStore interface {
+	Lookup(ctx context.Context, keyHash string) (*Identity, error)
+}
+
+// KeyStoreFunc 把函数适配为 KeyStore，用于从数据库等自定义存储查找
+type KeyStoreFunc func(ctx context.Context, keyHash string) (*Identity, error)
+
+// Lookup 实现 KeyStore
+func (f KeyStoreFunc) Lookup(ctx context.Context, keyHash string) (*Identity, error) {
+	return f(ctx, keyHash)
+}
+
+// HashKey 计算密钥的 SHA256（十六进制），KeyStore 使用它作为查找键
+func HashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// identityKey 在 context 中传递 Identity 的 key
+type identityKey struct{}
+
+// NewContext 将集成身份存入 context
+func NewContext(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// FromContext 从 context 中获取集成身份
+func FromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+	return identity, ok
+}
+
+type options struct {
+	header string
+}
+
+// Option API Key 中间件选项
+type Option func(*options)
+
+// WithHeader 设置携带 API Key 的请求头，默认 X-API-Key
+func WithHeader(header string) Option {
+	return func(o *options) {
+		o.header = header
+	}
+}
+
+// Server API Key 认证中间件
+//
+// 缺少请求头时返回 401 AUTH_HEADER_MISSING，密钥不存在或已过期时返回 401 AUTH_HEADER_INVALID，
+// KeyStore 出错时返回 500 AUTH_SERVICE_ERROR
+func Server(store KeyStore, opts ...Option) middleware.Middleware {
+	o := &options{header: DefaultHeader}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, errors.New(int(businessErrors.ErrSystemError.HttpCode), businessErrors.ErrSystemError.Type, businessErrors.ErrSystemError.Message)
+			}
+
+			key := tr.RequestHeader().Get(o.header)
+			if key == "" {
+				return nil, errors.New(
+					int(businessErrors.ErrAuthHeaderMissing.HttpCode),
+					businessErrors.ErrAuthHeaderMissing.Type,
+					o.header+" header is missing",
+				)
+			}
+
+			identity, err := store.Lookup(ctx, HashKey(key))
+			if stdErrors.Is(err, ErrKeyNotFound) || (err == nil && (identity == nil || identity.expired(time.Now()))) {
+				return nil, errors.New(
+					int(businessErrors.ErrAuthHeaderInvalid.HttpCode),
+					businessErrors.ErrAuthHeaderInvalid.Type,
+					"Invalid API key",
+				)
+			}
+			if err != nil {
+				return nil, errors.New(
+					int(businessErrors.ErrAuthServiceError.HttpCode),
+					businessErrors.ErrAuthServiceError.Type,
+					businessErrors.ErrAuthServiceError.Message,
+				).WithCause(err)
+			}
+
+			return handler(NewContext(ctx, identity), req)
+		}
+	}
+}
+
+// RequireScopes 要求集成拥有全部权限范围，否则返回 403 PERMISSION_DENIED，需要放在 Server 之后
+func RequireScopes(scopes ...string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			identity, ok := FromContext(ctx)
+			if !ok || identity == nil {
+				return nil, errors.New(
+					int(businessErrors.ErrPermissionDenied.HttpCode),
+					businessErrors.ErrPermissionDenied.Type,
+					businessErrors.ErrPermissionDenied.Message,
+				)
+			}
+			for _, scope := range scopes {
+				if !identity.HasScope(scope) {
+					return nil, errors.New(
+						int(businessErrors.ErrPermissionDenied.HttpCode),
+						businessErrors.ErrPermissionDenied.Type,
+						"API key is missing scope "+scope,
+					)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
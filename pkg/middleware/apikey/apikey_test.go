@@ -0,0 +1,74 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/heyinLab/common/pkg/middleware/internal/mwtest"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func call(m middleware.Middleware, key string) (*Identity, error) {
+	tr := mwtest.NewTransport(transport.KindHTTP, mwtest.DefaultOperation)
+	if key != "" {
+		tr.Request.Set(DefaultHeader, key)
+	}
+	var identity *Identity
+	_, err := m(func(ctx context.Context, _ interface{}) (interface{}, error) {
+		identity, _ = FromContext(ctx)
+		return nil, nil
+	})(transport.NewServerContext(context.Background(), tr), nil)
+	return identity, err
+}
+
+func TestServer_StaticStore(t *testing.T) {
+	store := NewStaticStore(map[string]*Identity{
+		"key-1":   {ID: "erp", TenantID: 7, Scopes: []string{"orders:read"}},
+		"expired": {ID: "old", ExpiresAt: time.Now().Add(-time.Hour)},
+	})
+	m := middleware.Chain(Server(store), RequireScopes("orders:read"))
+
+	identity, err := call(m, "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, "erp", identity.ID)
+
+	_, err = call(m, "")
+	assert.Equal(t, "AUTH_HEADER_MISSING", errors.Reason(err))
+	_, err = call(m, "unknown")
+	assert.Equal(t, "AUTH_HEADER_INVALID", errors.Reason(err))
+	_, err = call(m, "expired")
+	assert.Equal(t, "AUTH_HEADER_INVALID", errors.Reason(err))
+
+	_, err = call(middleware.Chain(Server(store), RequireScopes("orders:write")), "key-1")
+	assert.Equal(t, 403, errors.Code(err))
+}
+
+func TestRedisStore(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+
+	store := NewRedisStore(rdb)
+	ctx := context.Background()
+	require.NoError(t, store.Save(ctx, "key-1", &Identity{ID: "erp", Scopes: []string{"*"}}, 0))
+
+	identity, err := call(Server(store), "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, "erp", identity.ID)
+	assert.True(t, identity.HasScope("anything"))
+
+	require.NoError(t, store.Delete(ctx, "key-1"))
+	_, err = call(Server(store), "key-1")
+	assert.Equal(t, "AUTH_HEADER_INVALID", errors.Reason(err))
+
+	mr.Close()
+	_, err = call(Server(store), "key-1")
+	assert.Equal(t, "AUTH_SERVICE_ERROR", errors.Reason(err))
+}
@@ -0,0 +1,85 @@
+package apikey
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// StaticStore 基于配置的 KeyStore，适合数量少且很少变更的集成
+type StaticStore struct {
+	identities map[string]*Identity
+}
+
+// NewStaticStore 创建静态 KeyStore，keys 为明文密钥到集成身份的映射
+func NewStaticStore(keys map[string]*Identity) *StaticStore {
+	identities := make(map[string]*Identity, len(keys))
+	for key, identity := range keys {
+		identities[HashKey(key)] = identity
+	}
+	return &StaticStore{identities: identities}
+}
+
+// Lookup 实现 KeyStore
+func (s *StaticStore) Lookup(_ context.Context, keyHash string) (*Identity, error) {
+	identity, ok := s.identities[keyHash]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return identity, nil
+}
+
+// defaultRedisPrefix Redis key 的默认前缀
+const defaultRedisPrefix = "apikey:"
+
+// RedisStore 基于 Redis 的 KeyStore，集成身份以 JSON 保存在 prefix + 密钥SHA256 下
+type RedisStore struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewRedisStore 创建 Redis KeyStore
+func NewRedisStore(client redis.UniversalClient) *RedisStore {
+	return &RedisStore{client: client, prefix: defaultRedisPrefix}
+}
+
+// WithPrefix 设置 Redis key 前缀，默认 "apikey:"
+func (s *RedisStore) WithPrefix(prefix string) *RedisStore {
+	s.prefix = prefix
+	return s
+}
+
+// Lookup 实现 KeyStore
+func (s *RedisStore) Lookup(ctx context.Context, keyHash string) (*Identity, error) {
+	data, err := s.client.Get(ctx, s.prefix+keyHash).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询 API Key 失败: %w", err)
+	}
+
+	var identity Identity
+	if err := json.Unmarshal(data, &identity); err != nil {
+		return nil, fmt.Errorf("解析 API Key 失败: %w", err)
+	}
+	return &identity, nil
+}
+
+// Save 保存密钥对应的集成身份，ttl 为 0 时不过期
+func (s *RedisStore) Save(ctx context.Context, key string, identity *Identity, ttl time.Duration) error {
+	data, err := json.Marshal(identity)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, s.prefix+HashKey(key), data, ttl).Err()
+}
+
+// Delete 吊销密钥
+func (s *RedisStore) Delete(ctx context.Context, key string) error {
+	return s.client.Del(ctx, s.prefix+HashKey(key)).Err()
+}
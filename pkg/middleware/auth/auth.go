@@ -12,7 +12,34 @@ import (
 	"github.com/go-kratos/kratos/v2/transport"
 )
 
+// ServerOptions Server 中间件选项
+type ServerOptions struct {
+	// 是否要求 X-Tenant-ID
+	NeedTenant bool
+	// 跳过认证的 operation 或 HTTP 路径，如 "/api.user.v1.Auth/Login"、"/healthz"，
+	// 以 * 结尾时按前缀匹配，如 "/api.user.v1.Public/*"
+	Skip []string
+	// 撤销检查，为 nil 时不检查
+	Revocation RevocationChecker
+}
+
+// Server 信任网关传来的请求头并提取 Claims 的服务端中间件
 func Server(needTenant bool) middleware.Middleware {
+	return NewServer(ServerOptions{NeedTenant: needTenant})
+}
+
+// NewServer 按选项创建 Server 中间件，Skip 中的公开接口（健康检查、登录、回调等）不做认证
+//
+// 使用示例:
+//
+//	auth.NewServer(auth.ServerOptions{
+//	    NeedTenant: true,
+//	    Skip:       []string{"/api.user.v1.Auth/Login", "/api.user.v1.Public/*"},
+//	    Revocation: auth.NewRedisRevocationStore(rdb),
+//	})
+func NewServer(opts ServerOptions) middleware.Middleware {
+	needTenant := opts.NeedTenant
+	skip := opts.Skip
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
 			// 从 context 中获取 transport 信息 (HTTP/gRPC)
@@ -21,9 +48,15 @@ func Server(needTenant bool) middleware.Middleware {
 				return nil, errors.New(int(businessErrors.ErrSystemError.HttpCode), businessErrors.ErrSystemError.Type, businessErrors.ErrSystemError.Message)
 			}
 
-			// 信任上游传递来的header X-User-ID X-User-Type  X-Tenant-ID X-Region-Name
+			// 公开接口不做认证
+			if matchOperation(tr, skip) {
+				return handler(ctx, req)
+			}
+
+			// 信任上游传递来的header X-User-ID X-User-Type  X-Tenant-ID X-Region-Name X-Token-ID
 			userId := tr.RequestHeader().Get(common.USERID)
 			regionName := tr.RequestHeader().Get(common.REGIONNAME)
+			tokenId := tr.RequestHeader().Get(common.TOKENID)
 			// 检查必需的 header
 			if userId == "" {
 				return nil, errors.New(
@@ -66,9 +99,15 @@ func Server(needTenant bool) middleware.Middleware {
 			}
 
 			claims := &Claims{
-				UserID:     uint32(userIdUint),
-				TenantID:   uint32(tenantIdUint),
-				RegionName: regionName,
+				UserID:      uint32(userIdUint),
+				TenantID:    uint32(tenantIdUint),
+				RegionName:  regionName,
+				TokenID:     tokenId,
+				Roles:       ParseList(tr.RequestHeader().Get(common.ROLES)),
+				Permissions: ParseList(tr.RequestHeader().Get(common.PERMISSIONS)),
+			}
+			if err := checkRevoked(ctx, opts.Revocation, claims); err != nil {
+				return nil, err
 			}
 			newCtx := NewContext(ctx, claims)
 
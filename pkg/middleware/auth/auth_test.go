@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/heyinLab/common/pkg/middleware/internal/mwtest"
+	"github.com/stretchr/testify/assert"
+)
+
+// operationContext 创建指定 operation 和请求头的服务端 context
+func operationContext(operation string, header map[string]string) context.Context {
+	tr := mwtest.NewTransport(transport.KindHTTP, operation)
+	for k, v := range header {
+		tr.Request.Set(k, v)
+	}
+	return transport.NewServerContext(context.Background(), tr)
+}
+
+func TestNewServer_Skip(t *testing.T) {
+	handler := NewServer(ServerOptions{
+		NeedTenant: true,
+		Skip:       []string{"/api.user.v1.Auth/Login", "/api.user.v1.Public/*"},
+	})(claimsHandler)
+
+	_, err := handler(operationContext("/api.user.v1.Auth/Login", nil), nil)
+	assert.NoError(t, err)
+	_, err = handler(operationContext("/api.user.v1.Public/Activate", nil), nil)
+	assert.NoError(t, err)
+
+	_, err = handler(operationContext("/api.user.v1.User/Get", nil), nil)
+	assert.Equal(t, "AUTH_HEADER_MISSING", errors.Reason(err))
+
+	reply, err := handler(operationContext("/api.user.v1.User/Get", map[string]string{
+		"X-User-ID": "42", "X-Tenant-ID": "7",
+	}), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &Claims{UserID: 42, TenantID: 7}, reply)
+}
+
+func TestNewWhiteListMatcher(t *testing.T) {
+	match := NewWhiteListMatcher("/healthz", "/api.user.v1.Public/*")
+
+	assert.False(t, match(context.Background(), "/healthz"))
+	assert.False(t, match(operationContext("/api.user.v1.Public/Activate", nil), "/api.user.v1.Public/Activate"))
+	assert.True(t, match(operationContext("/api.user.v1.User/Get", nil), "/api.user.v1.User/Get"))
+}
+
+func TestNewServer_RolesAndPermissions(t *testing.T) {
+	handler := NewServer(ServerOptions{})(claimsHandler)
+
+	reply, err := handler(operationContext("/api.user.v1.User/Get", map[string]string{
+		"X-User-ID":          "42",
+		"X-User-Roles":       "admin, editor",
+		"X-User-Permissions": "user:read,,user:write",
+	}), nil)
+	assert.NoError(t, err)
+	claims := reply.(*Claims)
+	assert.Equal(t, []string{"admin", "editor"}, claims.Roles)
+	assert.Equal(t, []string{"user:read", "user:write"}, claims.Permissions)
+	assert.True(t, claims.HasRole("editor"))
+	assert.False(t, claims.HasPermission("user:delete"))
+}
@@ -1,11 +1,41 @@
 package auth
 
-import "context"
+import (
+	"context"
+	"slices"
+	"strings"
+)
 
 type Claims struct {
 	UserID     uint32
 	TenantID   uint32
 	RegionName string
+	// TokenID 签发的 Token ID（JWT 的 jti），用于按 Token 撤销，网关未传递时为空
+	TokenID string
+	// Roles 用户角色，Permissions 用户权限，供 authz 等鉴权中间件使用
+	Roles       []string
+	Permissions []string
+}
+
+// HasRole 判断用户是否拥有角色
+func (c *Claims) HasRole(role string) bool {
+	return slices.Contains(c.Roles, role)
+}
+
+// HasPermission 判断用户是否拥有权限
+func (c *Claims) HasPermission(permission string) bool {
+	return slices.Contains(c.Permissions, permission)
+}
+
+// ParseList 解析逗号分隔的角色或权限请求头，忽略空白项，值为空时返回 nil
+func ParseList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
 }
 
 // 定义用于在 context 中传递 Claims 的 key
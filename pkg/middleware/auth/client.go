@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/heyinLab/common/pkg/middleware/common"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/grpc/metadata"
+)
+
+// Client 把 context 中的 Claims 转发给下游服务的客户端中间件
+//
+// 写入 X-User-ID / X-Tenant-ID / X-Region-Name / X-Token-ID / X-User-Roles / X-User-Permissions 请求头，HTTP 客户端作为请求头发送，
+// gRPC 客户端作为 metadata 发送，下游服务通过 Server 或 ExtractClaims 读取。
+// context 中没有 Claims 时原样调用。
+//
+// 使用示例:
+//
+//	conn, err := grpc.DialInsecure(ctx,
+//	    grpc.WithEndpoint("discovery:///user-server"),
+//	    grpc.WithMiddleware(auth.Client()),
+//	)
+func Client() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			claims, ok := FromContext(ctx)
+			if !ok || claims == nil || claims.UserID == 0 {
+				return handler(ctx, req)
+			}
+
+			kv := []string{
+				common.USERID, strconv.FormatUint(uint64(claims.UserID), 10),
+				common.TENANTID, strconv.FormatUint(uint64(claims.TenantID), 10),
+			}
+			if claims.RegionName != "" {
+				kv = append(kv, common.REGIONNAME, claims.RegionName)
+			}
+			if claims.TokenID != "" {
+				kv = append(kv, common.TOKENID, claims.TokenID)
+			}
+			if len(claims.Roles) > 0 {
+				kv = append(kv, common.ROLES, strings.Join(claims.Roles, ","))
+			}
+			if len(claims.Permissions) > 0 {
+				kv = append(kv, common.PERMISSIONS, strings.Join(claims.Permissions, ","))
+			}
+
+			// kratos 的 HTTP 和 gRPC 客户端都会把 transport 请求头发送出去
+			if tr, ok := transport.FromClientContext(ctx); ok {
+				for i := 0; i < len(kv); i += 2 {
+					tr.RequestHeader().Set(kv[i], kv[i+1])
+				}
+				return handler(ctx, req)
+			}
+
+			// 没有 kratos transport 时（如直接使用 grpc.ClientConn）写入 gRPC metadata
+			ctx = metadata.AppendToOutgoingContext(ctx, kv...)
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/heyinLab/common/pkg/middleware/internal/mwtest"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestClient(t *testing.T) {
+	claims := &Claims{UserID: 42, TenantID: 7, RegionName: "cn"}
+
+	tr := mwtest.NewTransport(transport.KindHTTP, mwtest.DefaultOperation)
+	ctx := transport.NewClientContext(NewContext(context.Background(), claims), tr)
+	_, err := Client()(func(context.Context, interface{}) (interface{}, error) { return nil, nil })(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "42", tr.Request.Get("X-User-ID"))
+	assert.Equal(t, "7", tr.Request.Get("X-Tenant-ID"))
+	assert.Equal(t, "cn", tr.Request.Get("X-Region-Name"))
+
+	var md metadata.MD
+	_, err = Client()(func(ctx context.Context, _ interface{}) (interface{}, error) {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil, nil
+	})(NewContext(context.Background(), claims), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"42"}, md.Get("x-user-id"))
+	assert.Equal(t, []string{"7"}, md.Get("x-tenant-id"))
+
+	// 没有 Claims 时不写入任何请求头
+	tr = mwtest.NewTransport(transport.KindHTTP, mwtest.DefaultOperation)
+	_, err = Client()(func(context.Context, interface{}) (interface{}, error) { return nil, nil })(transport.NewClientContext(context.Background(), tr), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, tr.Request)
+}
+
+func TestClient_RolesAndPermissions(t *testing.T) {
+	claims := &Claims{UserID: 42, TokenID: "t1", Roles: []string{"admin", "editor"}, Permissions: []string{"user:read"}}
+
+	tr := mwtest.NewTransport(transport.KindHTTP, mwtest.DefaultOperation)
+	ctx := transport.NewClientContext(NewContext(context.Background(), claims), tr)
+	_, err := Client()(func(context.Context, interface{}) (interface{}, error) { return nil, nil })(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "t1", tr.Request.Get("X-Token-ID"))
+	assert.Equal(t, "admin,editor", tr.Request.Get("X-User-Roles"))
+	assert.Equal(t, "user:read", tr.Request.Get("X-User-Permissions"))
+}
@@ -0,0 +1,287 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// defaultJWKSRefreshInterval 默认的公钥刷新间隔
+	defaultJWKSRefreshInterval = time.Hour
+
+	// defaultJWKSMinRefreshInterval 两次刷新之间的最小间隔，防止伪造的 kid 或不可用的 JWKS 地址打爆认证服务
+	defaultJWKSMinRefreshInterval = time.Minute
+
+	// defaultJWKSTimeout 默认的 JWKS 请求超时时间
+	defaultJWKSTimeout = 10 * time.Second
+)
+
+// JWKS 从 JWKS 地址加载并缓存验签公钥
+//
+// 公钥按 RefreshInterval 定期刷新；遇到未知的 kid 时认为发生了密钥轮换，立即刷新一次。
+// 刷新失败时继续使用已缓存的公钥，并在 MinRefreshInterval 之后才会再次尝试。
+type JWKS struct {
+	url                string
+	client             *http.Client
+	refreshInterval    time.Duration
+	minRefreshInterval time.Duration
+
+	group singleflight.Group
+
+	mu          sync.Mutex
+	keys        map[string]interface{}
+	fetchedAt   time.Time
+	lastAttempt time.Time
+}
+
+// JWKSOption JWKS 选项
+type JWKSOption func(*JWKS)
+
+// WithJWKSHTTPClient 设置请求 JWKS 使用的 HTTP 客户端，默认超时 10s
+func WithJWKSHTTPClient(client *http.Client) JWKSOption {
+	return func(k *JWKS) {
+		k.client = client
+	}
+}
+
+// WithJWKSRefreshInterval 设置公钥定期刷新的间隔，默认 1 小时
+func WithJWKSRefreshInterval(d time.Duration) JWKSOption {
+	return func(k *JWKS) {
+		k.refreshInterval = d
+	}
+}
+
+// WithJWKSMinRefreshInterval 设置两次刷新之间的最小间隔，默认 1 分钟
+func WithJWKSMinRefreshInterval(d time.Duration) JWKSOption {
+	return func(k *JWKS) {
+		k.minRefreshInterval = d
+	}
+}
+
+// NewJWKS 创建 JWKS 公钥缓存，首次验签时才会请求 url
+func NewJWKS(url string, opts ...JWKSOption) *JWKS {
+	k := &JWKS{
+		url:                url,
+		client:             &http.Client{Timeout: defaultJWKSTimeout},
+		refreshInterval:    defaultJWKSRefreshInterval,
+		minRefreshInterval: defaultJWKSMinRefreshInterval,
+	}
+	for _, opt := range opts {
+		opt(k)
+	}
+	return k
+}
+
+// Keyfunc 按 Token 头部的 kid 返回验签公钥，传给 JWT 使用
+//
+// Token 没有 kid 时只有在 JWKS 中恰好有一个公钥的情况下才能验签。
+// 已有缓存时定期刷新在后台进行，不阻塞请求；所有刷新（包括未知 kid 触发的刷新）
+// 两次之间至少间隔 MinRefreshInterval，JWKS 地址不可用时不会每个请求都去重试。
+func (k *JWKS) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	k.mu.Lock()
+	now := time.Now()
+	key, found := k.lookup(kid)
+	cached := k.keys != nil
+	// 首次加载、公钥过期或遇到未知 kid（可能发生了密钥轮换）时刷新，在锁内记录尝试时间，并发请求只有一个会刷新
+	refresh := now.Sub(k.lastAttempt) >= k.minRefreshInterval &&
+		(!found || now.Sub(k.fetchedAt) >= k.refreshInterval)
+	if refresh {
+		k.lastAttempt = now
+	}
+	k.mu.Unlock()
+
+	if found {
+		if refresh {
+			// 定期刷新在后台进行，本次请求继续使用已缓存的公钥
+			go func() { _ = k.refreshShared() }()
+		}
+		return key, nil
+	}
+	if !refresh {
+		if !cached {
+			return nil, fmt.Errorf("JWKS 暂不可用，稍后重试")
+		}
+		return nil, fmt.Errorf("未找到验签公钥: kid=%s", kid)
+	}
+
+	if err := k.refreshShared(); err != nil {
+		return nil, err
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if key, ok := k.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("未找到验签公钥: kid=%s", kid)
+}
+
+// Refresh 立即重新加载公钥，可以在服务启动时调用以提前发现配置错误
+func (k *JWKS) Refresh(ctx context.Context) error {
+	k.mu.Lock()
+	k.lastAttempt = time.Now()
+	k.mu.Unlock()
+	return k.refresh(ctx)
+}
+
+// lookup 查找 kid 对应的公钥，调用方需持有锁
+func (k *JWKS) lookup(kid string) (interface{}, bool) {
+	if kid == "" {
+		if len(k.keys) != 1 {
+			return nil, false
+		}
+		for _, key := range k.keys {
+			return key, true
+		}
+	}
+	key, ok := k.keys[kid]
+	return key, ok
+}
+
+// refreshShared 合并并发的刷新，同一时间只有一个请求访问 JWKS 地址
+func (k *JWKS) refreshShared() error {
+	_, err, _ := k.group.Do("refresh", func() (interface{}, error) {
+		return nil, k.refresh(context.Background())
+	})
+	return err
+}
+
+// refresh 重新加载公钥，请求 JWKS 时不持有锁
+func (k *JWKS) refresh(ctx context.Context) error {
+	keys, err := k.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	k.mu.Lock()
+	k.keys = keys
+	k.fetchedAt = time.Now()
+	k.mu.Unlock()
+	return nil
+}
+
+// fetch 请求并解析 JWKS
+func (k *JWKS) fetch(ctx context.Context) (map[string]interface{}, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, k.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("创建 JWKS 请求失败: %w", err)
+	}
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("请求 JWKS 失败: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("请求 JWKS 失败: status=%d", resp.StatusCode)
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("解析 JWKS 失败: %w", err)
+	}
+
+	keys := make(map[string]interface{}, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			// 跳过无法识别的公钥，不影响其他公钥
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("JWKS 中没有可用的验签公钥")
+	}
+	return keys, nil
+}
+
+// jsonWebKey RFC 7517 定义的公钥格式
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// publicKey 转换为 RSA、ECDSA 或 Ed25519 公钥
+func (j *jsonWebKey) publicKey() (interface{}, error) {
+	switch j.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(j.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(j.E)
+		if err != nil {
+			return nil, err
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 || exponent.Int64() < 3 {
+			return nil, fmt.Errorf("RSA 公钥指数无效")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch j.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("不支持的椭圆曲线: %s", j.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(j.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(j.Y)
+		if err != nil {
+			return nil, err
+		}
+		size := (curve.Params().BitSize + 7) / 8
+		if len(x) != size || len(y) != size {
+			return nil, fmt.Errorf("EC 公钥长度无效")
+		}
+		return ecdsa.ParseUncompressedPublicKey(curve, append(append([]byte{4}, x...), y...))
+
+	case "OKP":
+		if j.Crv != "Ed25519" {
+			return nil, fmt.Errorf("不支持的曲线: %s", j.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(j.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("Ed25519 公钥长度无效")
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, fmt.Errorf("不支持的公钥类型: %s", j.Kty)
+}
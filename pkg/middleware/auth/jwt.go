@@ -0,0 +1,268 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// 默认从 JWT 中读取的 claim 名称
+const (
+	DefaultUserIDClaim   = "user_id"
+	DefaultTenantIDClaim = "tenant_id"
+	DefaultRegionClaim   = "region_name"
+
+	// 角色和权限，值为字符串数组或以空格、逗号分隔的字符串
+	DefaultRolesClaim       = "roles"
+	DefaultPermissionsClaim = "permissions"
+)
+
+// authorizationHeader 携带 Bearer Token 的请求头
+const authorizationHeader = "Authorization"
+
+type jwtOptions struct {
+	needTenant  bool
+	userClaim   string
+	tenantClaim string
+	regionClaim string
+	parserOpts  []jwt.ParserOption
+	revocation  RevocationChecker
+}
+
+// JWTOption JWT 中间件选项
+type JWTOption func(*jwtOptions)
+
+// WithTenantRequired 要求 Token 中必须包含租户ID，与 Server(true) 的行为一致
+func WithTenantRequired() JWTOption {
+	return func(o *jwtOptions) {
+		o.needTenant = true
+	}
+}
+
+// WithClaimNames 设置用户ID、租户ID和区域对应的 claim 名称，传空字符串保留默认值
+func WithClaimNames(userID, tenantID, region string) JWTOption {
+	return func(o *jwtOptions) {
+		if userID != "" {
+			o.userClaim = userID
+		}
+		if tenantID != "" {
+			o.tenantClaim = tenantID
+		}
+		if region != "" {
+			o.regionClaim = region
+		}
+	}
+}
+
+// WithSigningMethods 限制允许的签名算法，如 "RS256"、"ES256"
+//
+// 使用对称密钥时必须设置，防止攻击者用公钥作为 HMAC 密钥伪造 Token
+func WithSigningMethods(methods ...string) JWTOption {
+	return func(o *jwtOptions) {
+		o.parserOpts = append(o.parserOpts, jwt.WithValidMethods(methods))
+	}
+}
+
+// WithIssuer 校验 Token 的签发方（iss）
+func WithIssuer(issuer string) JWTOption {
+	return func(o *jwtOptions) {
+		o.parserOpts = append(o.parserOpts, jwt.WithIssuer(issuer))
+	}
+}
+
+// WithAudience 校验 Token 的受众（aud）
+func WithAudience(audience string) JWTOption {
+	return func(o *jwtOptions) {
+		o.parserOpts = append(o.parserOpts, jwt.WithAudience(audience))
+	}
+}
+
+// WithLeeway 校验过期时间时允许的时钟偏差
+func WithLeeway(leeway time.Duration) JWTOption {
+	return func(o *jwtOptions) {
+		o.parserOpts = append(o.parserOpts, jwt.WithLeeway(leeway))
+	}
+}
+
+// WithRevocationChecker 校验签名后检查 Token 或用户是否已被撤销
+func WithRevocationChecker(checker RevocationChecker) JWTOption {
+	return func(o *jwtOptions) {
+		o.revocation = checker
+	}
+}
+
+// JWT 校验 Bearer Token 并提取 Claims 的服务端中间件
+//
+// Server 信任网关传来的 X-User-ID 等请求头，只能部署在网关之后；
+// 不经过网关直接对外暴露的服务使用 JWT 自行校验签名。
+// keyFunc 返回验签密钥，使用 JWKS 时传入 NewJWKS(url).Keyfunc。
+//
+// 用户ID默认读取 user_id claim，缺失时读取 sub；租户ID和区域分别读取 tenant_id 和 region_name，
+// 可以通过 WithClaimNames 修改，Token ID 读取 jti，角色和权限读取 roles 和 permissions。Token 必须包含过期时间（exp）。
+//
+// 使用示例:
+//
+//	jwks := auth.NewJWKS("https://auth.example.com/.well-known/jwks.json")
+//	http.Middleware(
+//	    auth.JWT(jwks.Keyfunc, auth.WithSigningMethods("RS256"), auth.WithTenantRequired()),
+//	)
+func JWT(keyFunc jwt.Keyfunc, opts ...JWTOption) middleware.Middleware {
+	o := &jwtOptions{
+		userClaim:   DefaultUserIDClaim,
+		tenantClaim: DefaultTenantIDClaim,
+		regionClaim: DefaultRegionClaim,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	parser := jwt.NewParser(append([]jwt.ParserOption{jwt.WithExpirationRequired()}, o.parserOpts...)...)
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, errors.New(int(businessErrors.ErrSystemError.HttpCode), businessErrors.ErrSystemError.Type, businessErrors.ErrSystemError.Message)
+			}
+
+			tokenString, err := bearerToken(tr.RequestHeader().Get(authorizationHeader))
+			if err != nil {
+				return nil, err
+			}
+
+			mapClaims := jwt.MapClaims{}
+			if _, err := parser.ParseWithClaims(tokenString, mapClaims, keyFunc); err != nil {
+				if errors.Is(err, jwt.ErrTokenExpired) {
+					return nil, errors.New(
+						int(businessErrors.ErrTokenExpired.HttpCode),
+						businessErrors.ErrTokenExpired.Type,
+						businessErrors.ErrTokenExpired.Message,
+					)
+				}
+				return nil, errors.New(
+					int(businessErrors.ErrTokenInvalid.HttpCode),
+					businessErrors.ErrTokenInvalid.Type,
+					businessErrors.ErrTokenInvalid.Message,
+				).WithCause(err)
+			}
+
+			claims, err := o.claimsFrom(mapClaims)
+			if err != nil {
+				return nil, err
+			}
+			if err := checkRevoked(ctx, o.revocation, claims); err != nil {
+				return nil, err
+			}
+
+			return handler(NewContext(ctx, claims), req)
+		}
+	}
+}
+
+// bearerToken 从 Authorization 请求头中取出 Bearer Token
+func bearerToken(header string) (string, error) {
+	if header == "" {
+		return "", errors.New(
+			int(businessErrors.ErrAuthHeaderMissing.HttpCode),
+			businessErrors.ErrAuthHeaderMissing.Type,
+			businessErrors.ErrAuthHeaderMissing.Message,
+		)
+	}
+	scheme, token, ok := strings.Cut(header, " ")
+	token = strings.TrimSpace(token)
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", errors.New(
+			int(businessErrors.ErrAuthHeaderInvalid.HttpCode),
+			businessErrors.ErrAuthHeaderInvalid.Type,
+			businessErrors.ErrAuthHeaderInvalid.Message,
+		)
+	}
+	return token, nil
+}
+
+// claimsFrom 把 JWT 中的 claim 转换为 Claims
+func (o *jwtOptions) claimsFrom(mapClaims jwt.MapClaims) (*Claims, error) {
+	userValue, ok := mapClaims[o.userClaim]
+	if !ok && o.userClaim == DefaultUserIDClaim {
+		userValue, ok = mapClaims["sub"]
+	}
+	userID, valid := claimUint32(userValue)
+	if !ok || !valid {
+		return nil, errors.New(
+			int(businessErrors.ErrTokenInvalid.HttpCode),
+			businessErrors.ErrTokenInvalid.Type,
+			"Token is missing a valid "+o.userClaim+" claim",
+		)
+	}
+
+	claims := &Claims{UserID: userID}
+	if tenantValue, ok := mapClaims[o.tenantClaim]; ok {
+		tenantID, valid := claimUint32(tenantValue)
+		if !valid {
+			return nil, errors.New(
+				int(businessErrors.ErrTenantInvalid.HttpCode),
+				businessErrors.ErrTenantInvalid.Type,
+				businessErrors.ErrTenantInvalid.Message,
+			)
+		}
+		claims.TenantID = tenantID
+	}
+	if o.needTenant && claims.TenantID == 0 {
+		return nil, errors.New(
+			int(businessErrors.ErrTenantMissing.HttpCode),
+			businessErrors.ErrTenantMissing.Type,
+			businessErrors.ErrTenantMissing.Message,
+		)
+	}
+	if region, ok := mapClaims[o.regionClaim].(string); ok {
+		claims.RegionName = region
+	}
+	if jti, ok := mapClaims["jti"].(string); ok {
+		claims.TokenID = jti
+	}
+	claims.Roles = claimList(mapClaims[DefaultRolesClaim])
+	claims.Permissions = claimList(mapClaims[DefaultPermissionsClaim])
+	return claims, nil
+}
+
+// claimUint32 解析数值或数字字符串形式的 claim
+func claimUint32(v interface{}) (uint32, bool) {
+	switch val := v.(type) {
+	case float64:
+		if val < 0 || val > float64(^uint32(0)) || val != float64(uint32(val)) {
+			return 0, false
+		}
+		return uint32(val), true
+	case json.Number:
+		n, err := strconv.ParseUint(val.String(), 10, 32)
+		return uint32(n), err == nil
+	case string:
+		n, err := strconv.ParseUint(val, 10, 32)
+		return uint32(n), err == nil
+	}
+	return 0, false
+}
+
+// claimList 解析字符串数组或以空格、逗号分隔的字符串形式的 claim
+func claimList(v interface{}) []string {
+	var list []string
+	switch val := v.(type) {
+	case []interface{}:
+		for _, item := range val {
+			if s, ok := item.(string); ok && s != "" {
+				list = append(list, s)
+			}
+		}
+	case string:
+		list = strings.FieldsFunc(val, func(r rune) bool { return r == ' ' || r == ',' })
+	}
+	return list
+}
@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/heyinLab/common/pkg/middleware/internal/mwtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serverContext 创建携带指定请求头的服务端 context
+func serverContext(header map[string]string) context.Context {
+	tr := mwtest.NewTransport(transport.KindHTTP, mwtest.DefaultOperation)
+	for k, v := range header {
+		tr.Request.Set(k, v)
+	}
+	return transport.NewServerContext(context.Background(), tr)
+}
+
+// claimsHandler 返回 context 中的 Claims
+func claimsHandler(ctx context.Context, _ interface{}) (interface{}, error) {
+	claims, _ := FromContext(ctx)
+	return claims, nil
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, key interface{}, kid string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	s, err := token.SignedString(key)
+	require.NoError(t, err)
+	return s
+}
+
+func TestJWT_HMAC(t *testing.T) {
+	secret := []byte("secret")
+	handler := JWT(func(*jwt.Token) (interface{}, error) { return secret, nil },
+		WithSigningMethods("HS256"), WithTenantRequired(),
+	)(claimsHandler)
+
+	exp := time.Now().Add(time.Hour).Unix()
+	token := signToken(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{
+		"sub": "42", "tenant_id": 7, "region_name": "cn", "exp": exp,
+	})
+	reply, err := handler(serverContext(map[string]string{"Authorization": "Bearer " + token}), nil)
+	require.NoError(t, err)
+	assert.Equal(t, &Claims{UserID: 42, TenantID: 7, RegionName: "cn"}, reply)
+
+	tests := []struct {
+		name   string
+		header string
+		reason string
+	}{
+		{"missing", "", "AUTH_HEADER_MISSING"},
+		{"not bearer", "Basic abc", "AUTH_HEADER_INVALID"},
+		{"bad signature", "Bearer " + signToken(t, jwt.SigningMethodHS256, []byte("other"), "", jwt.MapClaims{"sub": "1", "tenant_id": 7, "exp": exp}), "TOKEN_INVALID"},
+		{"expired", "Bearer " + signToken(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{"sub": "1", "tenant_id": 7, "exp": time.Now().Add(-time.Hour).Unix()}), "TOKEN_EXPIRED"},
+		{"no exp", "Bearer " + signToken(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{"sub": "1", "tenant_id": 7}), "TOKEN_INVALID"},
+		{"no tenant", "Bearer " + signToken(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{"sub": "1", "exp": exp}), "TENANT_MISSING"},
+		{"bad user", "Bearer " + signToken(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{"sub": "abc", "tenant_id": 7, "exp": exp}), "TOKEN_INVALID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := map[string]string{}
+			if tt.header != "" {
+				header["Authorization"] = tt.header
+			}
+			_, err := handler(serverContext(header), nil)
+			assert.Equal(t, tt.reason, errors.Reason(err))
+		})
+	}
+}
+
+func TestJWT_JWKSRotation(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	b64 := base64.RawURLEncoding.EncodeToString
+	rsaJWK := map[string]string{
+		"kty": "RSA", "kid": "rsa-1", "use": "sig",
+		"n": b64(rsaKey.N.Bytes()), "e": b64(big.NewInt(int64(rsaKey.E)).Bytes()),
+	}
+	ecJWK := map[string]string{
+		"kty": "EC", "kid": "ec-2", "crv": "P-256",
+		"x": b64(ecKey.X.FillBytes(make([]byte, 32))), "y": b64(ecKey.Y.FillBytes(make([]byte, 32))),
+	}
+
+	var rotated atomic.Bool
+	var fetches atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fetches.Add(1)
+		keys := []map[string]string{rsaJWK}
+		if rotated.Load() {
+			keys = append(keys, ecJWK)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": keys})
+	}))
+	defer srv.Close()
+
+	jwks := NewJWKS(srv.URL, WithJWKSMinRefreshInterval(0))
+	handler := JWT(jwks.Keyfunc, WithSigningMethods("RS256", "ES256"))(claimsHandler)
+	exp := time.Now().Add(time.Hour).Unix()
+
+	token := signToken(t, jwt.SigningMethodRS256, rsaKey, "rsa-1", jwt.MapClaims{"user_id": 1, "exp": exp})
+	reply, err := handler(serverContext(map[string]string{"Authorization": "Bearer " + token}), nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), reply.(*Claims).UserID)
+
+	// 缓存命中，不会再次请求
+	_, err = handler(serverContext(map[string]string{"Authorization": "Bearer " + token}), nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), fetches.Load())
+
+	// 新的 kid 触发刷新
+	rotated.Store(true)
+	token = signToken(t, jwt.SigningMethodES256, ecKey, "ec-2", jwt.MapClaims{"user_id": "2", "exp": exp})
+	reply, err = handler(serverContext(map[string]string{"Authorization": "Bearer " + token}), nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), reply.(*Claims).UserID)
+	assert.Equal(t, int32(2), fetches.Load())
+
+	token = signToken(t, jwt.SigningMethodES256, ecKey, "unknown", jwt.MapClaims{"user_id": 3, "exp": exp})
+	_, err = handler(serverContext(map[string]string{"Authorization": "Bearer " + token}), nil)
+	assert.Equal(t, "TOKEN_INVALID", errors.Reason(err))
+}
+
+func TestJWKS_EndpointDown(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	b64 := base64.RawURLEncoding.EncodeToString
+	body, err := json.Marshal(map[string]interface{}{"keys": []map[string]string{{
+		"kty": "RSA", "kid": "rsa-1",
+		"n": b64(rsaKey.N.Bytes()), "e": b64(big.NewInt(int64(rsaKey.E)).Bytes()),
+	}}})
+	require.NoError(t, err)
+
+	var down atomic.Bool
+	var fetches atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fetches.Add(1)
+		if down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write(body)
+	}))
+	defer srv.Close()
+
+	// 刷新间隔很短，但两次刷新至少间隔 1 小时
+	jwks := NewJWKS(srv.URL, WithJWKSRefreshInterval(time.Nanosecond), WithJWKSMinRefreshInterval(time.Hour))
+	require.NoError(t, jwks.Refresh(context.Background()))
+	handler := JWT(jwks.Keyfunc, WithSigningMethods("RS256"))(claimsHandler)
+	token := signToken(t, jwt.SigningMethodRS256, rsaKey, "rsa-1", jwt.MapClaims{"user_id": 1, "exp": time.Now().Add(time.Hour).Unix()})
+
+	// JWKS 不可用时继续使用缓存的公钥，不会每个请求都去请求 JWKS
+	down.Store(true)
+	for i := 0; i < 10; i++ {
+		_, err := handler(serverContext(map[string]string{"Authorization": "Bearer " + token}), nil)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), fetches.Load())
+
+	// 首次加载失败后，在最小间隔内不再重试
+	fetches.Store(0)
+	jwks = NewJWKS(srv.URL, WithJWKSMinRefreshInterval(time.Hour))
+	handler = JWT(jwks.Keyfunc, WithSigningMethods("RS256"))(claimsHandler)
+	for i := 0; i < 3; i++ {
+		_, err := handler(serverContext(map[string]string{"Authorization": "Bearer " + token}), nil)
+		assert.Equal(t, "TOKEN_INVALID", errors.Reason(err))
+	}
+	assert.Equal(t, int32(1), fetches.Load())
+}
+
+func TestJWT_RolesAndPermissions(t *testing.T) {
+	secret := []byte("secret")
+	handler := JWT(func(*jwt.Token) (interface{}, error) { return secret, nil },
+		WithSigningMethods("HS256"))(claimsHandler)
+
+	token := signToken(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{
+		"sub":         "42",
+		"roles":       []string{"admin", "editor"},
+		"permissions": "user:read user:write",
+		"exp":         time.Now().Add(time.Hour).Unix(),
+	})
+	reply, err := handler(serverContext(map[string]string{"Authorization": "Bearer " + token}), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"admin", "editor"}, reply.(*Claims).Roles)
+	assert.Equal(t, []string{"user:read", "user:write"}, reply.(*Claims).Permissions)
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisRevocationStore(t *testing.T) {
+	mr := miniredis.RunT(t)
+	store := NewRedisRevocationStore(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+	ctx := context.Background()
+
+	handler := NewServer(ServerOptions{Revocation: store})(claimsHandler)
+	request := func(tokenID string) error {
+		_, err := handler(serverContext(map[string]string{"X-User-ID": "42", "X-Token-ID": tokenID}), nil)
+		return err
+	}
+
+	assert.NoError(t, request("t1"))
+
+	// 撤销单个 Token
+	require.NoError(t, store.RevokeToken(ctx, "t1", time.Hour))
+	assert.Equal(t, "TOKEN_REVOKED", errors.Reason(request("t1")))
+	assert.NoError(t, request("t2"))
+	assert.NoError(t, request(""))
+
+	// 撤销用户的所有 Token
+	require.NoError(t, store.RevokeUser(ctx, 42, 0))
+	assert.Equal(t, "TOKEN_REVOKED", errors.Reason(request("t2")))
+	assert.Equal(t, "TOKEN_REVOKED", errors.Reason(request("")))
+	require.NoError(t, store.RestoreUser(ctx, 42))
+	assert.NoError(t, request("t2"))
+
+	// 撤销记录过期后自动失效
+	mr.FastForward(time.Hour)
+	assert.NoError(t, request("t1"))
+
+	// 撤销列表不可用时拒绝请求
+	mr.Close()
+	err := request("t1")
+	assert.Equal(t, "AUTH_SERVICE_ERROR", errors.Reason(err))
+	assert.Equal(t, 500, errors.Code(err))
+}
+
+func TestJWT_Revocation(t *testing.T) {
+	secret := []byte("secret")
+	checker := RevocationCheckerFunc(func(_ context.Context, userID uint32, tokenID string) (bool, error) {
+		return userID == 42 && tokenID == "revoked", nil
+	})
+	handler := JWT(func(*jwt.Token) (interface{}, error) { return secret, nil },
+		WithSigningMethods("HS256"), WithRevocationChecker(checker))(claimsHandler)
+	request := func(jti string) (interface{}, error) {
+		token := signToken(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{
+			"sub": "42",
+			"jti": jti,
+			"exp": time.Now().Add(time.Hour).Unix(),
+		})
+		return handler(serverContext(map[string]string{"Authorization": "Bearer " + token}), nil)
+	}
+
+	reply, err := request("active")
+	assert.NoError(t, err)
+	assert.Equal(t, &Claims{UserID: 42, TokenID: "active"}, reply)
+
+	_, err = request("revoked")
+	assert.Equal(t, "TOKEN_REVOKED", errors.Reason(err))
+	assert.Equal(t, 401, errors.Code(err))
+}
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// NewWhiteListMatcher 创建 kratos selector 的匹配函数，白名单中的接口返回 false，即不应用中间件
+//
+// 白名单的格式与 ServerOptions.Skip 相同，可以匹配 operation 或 HTTP 路径，以 * 结尾时按前缀匹配
+//
+// 使用示例:
+//
+//	selector.Server(auth.JWT(jwks.Keyfunc)).
+//	    Match(auth.NewWhiteListMatcher("/api.user.v1.Auth/Login", "/healthz")).
+//	    Build()
+func NewWhiteListMatcher(whiteList ...string) selector.MatchFunc {
+	return func(ctx context.Context, operation string) bool {
+		if tr, ok := transport.FromServerContext(ctx); ok {
+			return !matchOperation(tr, whiteList)
+		}
+		return !matchPattern(operation, whiteList)
+	}
+}
+
+// matchOperation 判断请求的 operation 或 HTTP 路径是否命中 patterns
+func matchOperation(tr transport.Transporter, patterns []string) bool {
+	if len(patterns) == 0 {
+		return false
+	}
+	if matchPattern(tr.Operation(), patterns) {
+		return true
+	}
+	if ht, ok := tr.(khttp.Transporter); ok && ht.Request() != nil {
+		return matchPattern(ht.Request().URL.Path, patterns)
+	}
+	return false
+}
+
+// matchPattern 精确匹配，或按以 * 结尾的前缀匹配
+func matchPattern(s string, patterns []string) bool {
+	for _, p := range patterns {
+		if prefix, ok := strings.CutSuffix(p, "*"); ok {
+			if strings.HasPrefix(s, prefix) {
+				return true
+			}
+		} else if s == p {
+			return true
+		}
+	}
+	return false
+}
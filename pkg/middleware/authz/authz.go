@@ -0,0 +1,119 @@
+// Package authz 按接口检查角色和权限的服务端鉴权中间件
+//
+// 每个 operation 需要的角色和权限由 Policy 描述，可以从 YAML 文件加载，
+// 也可以实现 PolicyProvider 从配置中心或数据库读取。
+// 用户的角色和权限来自 Claims.Roles 和 Claims.Permissions，需要放在认证中间件之后。
+//
+// 使用示例:
+//
+//	policy, err := authz.LoadPolicyFile("configs/authz.yaml")
+//	if err != nil {
+//	    panic(err)
+//	}
+//	grpc.Middleware(
+//	    auth.Server(true),
+//	    authz.Server(authz.StaticPolicy(policy)),
+//	)
+package authz
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+	authWare "github.com/heyinLab/common/pkg/middleware/auth"
+)
+
+type options struct {
+	bypass func(ctx context.Context, claims *authWare.Claims) bool
+}
+
+// Option 鉴权中间件选项
+type Option func(*options)
+
+// WithBypass 设置跳过鉴权的判断，如超级管理员，返回 true 时直接放行
+func WithBypass(fn func(ctx context.Context, claims *authWare.Claims) bool) Option {
+	return func(o *options) {
+		o.bypass = fn
+	}
+}
+
+// Server 鉴权中间件
+//
+// 没有匹配的规则且策略未设置 DefaultDeny 时放行；否则要求 context 中有 Claims，
+// 并且满足规则的角色和权限要求，不满足时返回 403 PERMISSION_DENIED。
+// 读取策略失败时返回 500 AUTH_SERVICE_ERROR。
+func Server(provider PolicyProvider, opts ...Option) middleware.Middleware {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			var operation string
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				operation = tr.Operation()
+			}
+
+			policy, err := provider.Policy(ctx)
+			if err != nil {
+				return nil, errors.New(
+					int(businessErrors.ErrAuthServiceError.HttpCode),
+					businessErrors.ErrAuthServiceError.Type,
+					businessErrors.ErrAuthServiceError.Message,
+				).WithCause(err)
+			}
+
+			rule := policy.Match(operation)
+			if rule == nil && !policy.DefaultDeny {
+				return handler(ctx, req)
+			}
+
+			claims, ok := authWare.FromContext(ctx)
+			if !ok || claims == nil {
+				return nil, permissionDenied(operation)
+			}
+			if o.bypass != nil && o.bypass(ctx, claims) {
+				return handler(ctx, req)
+			}
+			if rule == nil || !Allowed(rule, claims) {
+				return nil, permissionDenied(operation)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// Allowed 判断 Claims 是否满足规则：拥有任意一个要求的角色，并且拥有所有要求的权限
+func Allowed(rule *Rule, claims *authWare.Claims) bool {
+	if len(rule.Roles) > 0 {
+		hasRole := false
+		for _, role := range rule.Roles {
+			if claims.HasRole(role) {
+				hasRole = true
+				break
+			}
+		}
+		if !hasRole {
+			return false
+		}
+	}
+	for _, permission := range rule.Permissions {
+		if !claims.HasPermission(permission) {
+			return false
+		}
+	}
+	return true
+}
+
+// permissionDenied 返回 403 PERMISSION_DENIED，metadata 中带有被拒绝的 operation
+func permissionDenied(operation string) error {
+	return errors.New(
+		int(businessErrors.ErrPermissionDenied.HttpCode),
+		businessErrors.ErrPermissionDenied.Type,
+		businessErrors.ErrPermissionDenied.Message,
+	).WithMetadata(map[string]string{"operation": operation})
+}
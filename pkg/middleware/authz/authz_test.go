@@ -0,0 +1,107 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	authWare "github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/middleware/internal/mwtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPolicy = `
+default_deny: true
+rules:
+  - operation: /api.user.v1.User/Delete
+    roles: [admin, owner]
+  - operation: /api.user.v1.User/*
+    permissions: [user:read]
+  - operation: /api.user.v1.User/Export
+    roles: [admin]
+    permissions: [user:read, user:export]
+  - operation: /api.user.v1.Profile/*
+`
+
+func okHandler(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+
+func TestServer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "authz.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testPolicy), 0o600))
+	policy, err := LoadPolicyFile(path)
+	require.NoError(t, err)
+	handler := Server(StaticPolicy(policy))(okHandler)
+
+	call := func(operation string, claims *authWare.Claims) error {
+		ctx := transport.NewServerContext(context.Background(), mwtest.NewTransport(transport.KindGRPC, operation))
+		if claims != nil {
+			ctx = authWare.NewContext(ctx, claims)
+		}
+		_, err := handler(ctx, nil)
+		return err
+	}
+	admin := &authWare.Claims{UserID: 1, Roles: []string{"admin"}, Permissions: []string{"user:read"}}
+	reader := &authWare.Claims{UserID: 2, Permissions: []string{"user:read"}}
+	nobody := &authWare.Claims{UserID: 3}
+
+	// 角色满足任意一个即可
+	assert.NoError(t, call("/api.user.v1.User/Delete", admin))
+	err = call("/api.user.v1.User/Delete", reader)
+	assert.Equal(t, 403, kerrors.Code(err))
+	assert.Equal(t, "PERMISSION_DENIED", kerrors.Reason(err))
+	assert.Equal(t, "/api.user.v1.User/Delete", kerrors.FromError(err).Metadata["operation"])
+
+	// 前缀规则
+	assert.NoError(t, call("/api.user.v1.User/Get", reader))
+	assert.Error(t, call("/api.user.v1.User/Get", nobody))
+
+	// 权限需要全部满足
+	assert.Error(t, call("/api.user.v1.User/Export", admin))
+	assert.NoError(t, call("/api.user.v1.User/Export", &authWare.Claims{
+		UserID: 1, Roles: []string{"admin"}, Permissions: []string{"user:read", "user:export"},
+	}))
+
+	// 没有要求角色和权限的规则只要求已登录
+	assert.NoError(t, call("/api.user.v1.Profile/Get", nobody))
+	assert.Error(t, call("/api.user.v1.Profile/Get", nil))
+
+	// 没有匹配的规则时按 default_deny 拒绝
+	assert.Error(t, call("/api.order.v1.Order/Get", admin))
+}
+
+func TestServer_DefaultAllowAndBypass(t *testing.T) {
+	policy := &Policy{Rules: []Rule{{Operation: "/api.user.v1.User/Delete", Roles: []string{"admin"}}}}
+	handler := Server(StaticPolicy(policy), WithBypass(func(_ context.Context, claims *authWare.Claims) bool {
+		return claims.HasRole("root")
+	}))(okHandler)
+	call := func(operation string, claims *authWare.Claims) error {
+		ctx := authWare.NewContext(transport.NewServerContext(context.Background(), mwtest.NewTransport(transport.KindGRPC, operation)), claims)
+		_, err := handler(ctx, nil)
+		return err
+	}
+
+	assert.NoError(t, call("/api.user.v1.User/Get", &authWare.Claims{UserID: 2}))
+	assert.Error(t, call("/api.user.v1.User/Delete", &authWare.Claims{UserID: 2}))
+	assert.NoError(t, call("/api.user.v1.User/Delete", &authWare.Claims{UserID: 1, Roles: []string{"root"}}))
+}
+
+func TestServer_ProviderError(t *testing.T) {
+	handler := Server(PolicyProviderFunc(func(context.Context) (*Policy, error) {
+		return nil, errors.New("config center unavailable")
+	}))(okHandler)
+	_, err := handler(context.Background(), nil)
+	assert.Equal(t, 500, kerrors.Code(err))
+	assert.Equal(t, "AUTH_SERVICE_ERROR", kerrors.Reason(err))
+}
+
+func TestParsePolicy(t *testing.T) {
+	_, err := ParsePolicy([]byte("rules:\n  - roles: [admin]\n"))
+	assert.Error(t, err)
+	_, err = ParsePolicy([]byte("rules: ["))
+	assert.Error(t, err)
+}
@@ -0,0 +1,205 @@
+// Package casbin 基于 Casbin 的动态鉴权中间件
+//
+// 与 authz 的静态策略不同，策略保存在 Casbin adapter（数据库、文件等）中，
+// 可以在运行时修改，并通过定时重新加载或 Watcher 通知在所有实例上生效。
+//
+// 默认模型按租户隔离（RBAC with domains）:
+//   - 请求: sub（用户ID或角色）、dom（租户ID）、obj（kratos operation）
+//   - 策略: p, sub, dom, obj，dom 为 * 时对所有租户生效，obj 支持 keyMatch 通配，如 /api.user.v1.User/*
+//   - 角色: g, 用户, 角色, 租户
+//
+// 使用示例:
+//
+//	adapter, _ := gormadapter.NewAdapterByDB(db)
+//	enforcer, err := casbin.NewEnforcer(adapter, casbin.WithReloadInterval(time.Minute))
+//	if err != nil {
+//	    panic(err)
+//	}
+//	defer enforcer.Close()
+//	grpc.Middleware(
+//	    auth.Server(true),
+//	    casbin.Server(enforcer),
+//	)
+package casbin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	casbinv2 "github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+	authWare "github.com/heyinLab/common/pkg/middleware/auth"
+)
+
+// DefaultModel 默认的 Casbin 模型，按租户隔离的 RBAC
+const DefaultModel = `
+[request_definition]
+r = sub, dom, obj
+
+[policy_definition]
+p = sub, dom, obj
+
+[role_definition]
+g = _, _, _
+
+[policy_effect]
+e = some(where (p.eft == allow))
+
+[matchers]
+m = g(r.sub, p.sub, r.dom) && (p.dom == "*" || r.dom == p.dom) && keyMatch(r.obj, p.obj)
+`
+
+type enforcerOptions struct {
+	model          string
+	reloadInterval time.Duration
+	watcher        persist.Watcher
+}
+
+// EnforcerOption Enforcer 选项
+type EnforcerOption func(*enforcerOptions)
+
+// WithModel 设置 Casbin 模型文本，默认 DefaultModel
+//
+// 自定义模型的请求定义必须与 Server 传入的参数一致，即 r = sub, dom, obj
+func WithModel(text string) EnforcerOption {
+	return func(o *enforcerOptions) {
+		o.model = text
+	}
+}
+
+// WithReloadInterval 定时从 adapter 重新加载策略，0 表示不定时加载
+func WithReloadInterval(d time.Duration) EnforcerOption {
+	return func(o *enforcerOptions) {
+		o.reloadInterval = d
+	}
+}
+
+// WithWatcher 设置策略变更通知（如 Redis Watcher），收到通知时重新加载策略
+func WithWatcher(watcher persist.Watcher) EnforcerOption {
+	return func(o *enforcerOptions) {
+		o.watcher = watcher
+	}
+}
+
+// Enforcer 并发安全的 Casbin Enforcer，支持策略热加载
+type Enforcer struct {
+	*casbinv2.SyncedEnforcer
+	watcher persist.Watcher
+}
+
+// NewEnforcer 创建 Enforcer 并从 adapter 加载策略
+//
+// 修改策略使用内嵌的 SyncedEnforcer 方法，如 AddPolicy、AddGroupingPolicy，
+// 设置了 Watcher 时修改后会通知其他实例重新加载。
+func NewEnforcer(adapter persist.Adapter, opts ...EnforcerOption) (*Enforcer, error) {
+	o := &enforcerOptions{model: DefaultModel}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	m, err := model.NewModelFromString(o.model)
+	if err != nil {
+		return nil, fmt.Errorf("解析 Casbin 模型失败: %w", err)
+	}
+	synced, err := casbinv2.NewSyncedEnforcer(m, adapter)
+	if err != nil {
+		return nil, fmt.Errorf("创建 Casbin Enforcer 失败: %w", err)
+	}
+	if o.watcher != nil {
+		if err := synced.SetWatcher(o.watcher); err != nil {
+			return nil, fmt.Errorf("设置 Casbin Watcher 失败: %w", err)
+		}
+	}
+	if o.reloadInterval > 0 {
+		synced.StartAutoLoadPolicy(o.reloadInterval)
+	}
+	return &Enforcer{SyncedEnforcer: synced, watcher: o.watcher}, nil
+}
+
+// Close 停止定时加载并关闭 Watcher
+func (e *Enforcer) Close() {
+	e.StopAutoLoadPolicy()
+	if e.watcher != nil {
+		e.watcher.Close()
+	}
+}
+
+// SubjectFunc 返回请求的主体列表，任意一个主体有权限即放行
+type SubjectFunc func(ctx context.Context, claims *authWare.Claims) []string
+
+// DefaultSubjects 用户ID和 Claims 中的角色
+func DefaultSubjects(_ context.Context, claims *authWare.Claims) []string {
+	return append([]string{strconv.FormatUint(uint64(claims.UserID), 10)}, claims.Roles...)
+}
+
+type options struct {
+	subjects SubjectFunc
+}
+
+// Option 鉴权中间件选项
+type Option func(*options)
+
+// WithSubjects 设置请求的主体，默认 DefaultSubjects
+func WithSubjects(fn SubjectFunc) Option {
+	return func(o *options) {
+		o.subjects = fn
+	}
+}
+
+// Server Casbin 鉴权中间件
+//
+// 以 Claims 的用户ID和角色为主体、租户ID为域、operation 为对象调用 Enforce，
+// 没有 Claims 或没有权限时返回 403 PERMISSION_DENIED，Enforce 出错时返回 500 AUTH_SERVICE_ERROR。
+// 需要放在认证中间件之后。
+func Server(enforcer *Enforcer, opts ...Option) middleware.Middleware {
+	o := &options{subjects: DefaultSubjects}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			var operation string
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				operation = tr.Operation()
+			}
+
+			claims, ok := authWare.FromContext(ctx)
+			if !ok || claims == nil {
+				return nil, permissionDenied(operation)
+			}
+
+			domain := strconv.FormatUint(uint64(claims.TenantID), 10)
+			for _, subject := range o.subjects(ctx, claims) {
+				allowed, err := enforcer.Enforce(subject, domain, operation)
+				if err != nil {
+					return nil, errors.New(
+						int(businessErrors.ErrAuthServiceError.HttpCode),
+						businessErrors.ErrAuthServiceError.Type,
+						businessErrors.ErrAuthServiceError.Message,
+					).WithCause(err)
+				}
+				if allowed {
+					return handler(ctx, req)
+				}
+			}
+			return nil, permissionDenied(operation)
+		}
+	}
+}
+
+// permissionDenied 返回 403 PERMISSION_DENIED，metadata 中带有被拒绝的 operation
+func permissionDenied(operation string) error {
+	return errors.New(
+		int(businessErrors.ErrPermissionDenied.HttpCode),
+		businessErrors.ErrPermissionDenied.Type,
+		businessErrors.ErrPermissionDenied.Message,
+	).WithMetadata(map[string]string{"operation": operation})
+}
@@ -0,0 +1,105 @@
+package casbin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	fileadapter "github.com/casbin/casbin/v2/persist/file-adapter"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	authWare "github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/middleware/internal/mwtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeWatcher 手动触发策略变更通知
+type fakeWatcher struct {
+	callback func(string)
+	closed   bool
+}
+
+func (w *fakeWatcher) SetUpdateCallback(fn func(string)) error { w.callback = fn; return nil }
+func (w *fakeWatcher) Update() error                           { return nil }
+func (w *fakeWatcher) Close()                                  { w.closed = true }
+
+const testPolicy = `p, admin, *, /api.user.v1.User/*
+p, 42, 7, /api.order.v1.Order/Get
+g, 43, admin, 7
+`
+
+func okHandler(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+
+func writePolicy(t *testing.T, path, policy string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(policy), 0o600))
+}
+
+func TestServer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.csv")
+	writePolicy(t, path, testPolicy)
+	watcher := &fakeWatcher{}
+	enforcer, err := NewEnforcer(fileadapter.NewAdapter(path), WithWatcher(watcher))
+	require.NoError(t, err)
+
+	handler := Server(enforcer)(okHandler)
+	call := func(operation string, claims *authWare.Claims) error {
+		ctx := transport.NewServerContext(context.Background(), mwtest.NewTransport(transport.KindGRPC, operation))
+		if claims != nil {
+			ctx = authWare.NewContext(ctx, claims)
+		}
+		_, err := handler(ctx, nil)
+		return err
+	}
+
+	// 直接授予用户的权限，按租户隔离
+	assert.NoError(t, call("/api.order.v1.Order/Get", &authWare.Claims{UserID: 42, TenantID: 7}))
+	err = call("/api.order.v1.Order/Get", &authWare.Claims{UserID: 42, TenantID: 8})
+	assert.Equal(t, 403, errors.Code(err))
+	assert.Equal(t, "PERMISSION_DENIED", errors.Reason(err))
+
+	// Casbin 中分配的租户内角色
+	assert.NoError(t, call("/api.user.v1.User/Delete", &authWare.Claims{UserID: 43, TenantID: 7}))
+	assert.Error(t, call("/api.user.v1.User/Delete", &authWare.Claims{UserID: 43, TenantID: 8}))
+
+	// Claims 中的角色
+	assert.NoError(t, call("/api.user.v1.User/Get", &authWare.Claims{UserID: 1, TenantID: 9, Roles: []string{"admin"}}))
+
+	// 没有 Claims
+	assert.Equal(t, 403, errors.Code(call("/api.user.v1.User/Get", nil)))
+
+	// 收到变更通知后重新加载策略
+	writePolicy(t, path, "p, 44, *, /api.order.v1.Order/*\n")
+	watcher.callback("")
+	assert.NoError(t, call("/api.order.v1.Order/List", &authWare.Claims{UserID: 44}))
+	assert.Error(t, call("/api.order.v1.Order/Get", &authWare.Claims{UserID: 42, TenantID: 7}))
+
+	enforcer.Close()
+	assert.True(t, watcher.closed)
+}
+
+func TestNewEnforcer_ReloadInterval(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.csv")
+	writePolicy(t, path, testPolicy)
+	enforcer, err := NewEnforcer(fileadapter.NewAdapter(path), WithReloadInterval(10*time.Millisecond))
+	require.NoError(t, err)
+	t.Cleanup(enforcer.Close)
+
+	allowed, err := enforcer.Enforce("45", "7", "/api.user.v1.User/Get")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	writePolicy(t, path, testPolicy+"g, 45, admin, 7\n")
+	assert.Eventually(t, func() bool {
+		allowed, err := enforcer.Enforce("45", "7", "/api.user.v1.User/Get")
+		return err == nil && allowed
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestNewEnforcer_InvalidModel(t *testing.T) {
+	_, err := NewEnforcer(fileadapter.NewAdapter("unused.csv"), WithModel("[request_definition]"))
+	assert.Error(t, err)
+}
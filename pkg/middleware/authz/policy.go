@@ -0,0 +1,98 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Rule 一个接口需要的角色和权限
+type Rule struct {
+	// Operation kratos operation，如 "/api.user.v1.User/Delete"，以 * 结尾时按前缀匹配
+	Operation string `yaml:"operation"`
+	// Roles 拥有其中任意一个角色即可访问，为空时不检查角色
+	Roles []string `yaml:"roles"`
+	// Permissions 必须拥有其中所有权限才能访问，为空时不检查权限
+	Permissions []string `yaml:"permissions"`
+}
+
+// Policy 鉴权策略
+//
+// 请求的 operation 优先精确匹配规则，其次匹配前缀最长的规则。
+// 没有匹配的规则时，DefaultDeny 为 true 则拒绝，否则放行。
+//
+// YAML 格式:
+//
+//	default_deny: true
+//	rules:
+//	  - operation: /api.user.v1.User/Delete
+//	    roles: [admin]
+//	  - operation: /api.user.v1.User/*
+//	    permissions: [user:read]
+type Policy struct {
+	DefaultDeny bool   `yaml:"default_deny"`
+	Rules       []Rule `yaml:"rules"`
+}
+
+// ParsePolicy 解析 YAML 格式的鉴权策略
+func ParsePolicy(data []byte) (*Policy, error) {
+	var policy Policy
+	if err := yaml.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("解析鉴权策略失败: %w", err)
+	}
+	for i, rule := range policy.Rules {
+		if rule.Operation == "" {
+			return nil, fmt.Errorf("第 %d 条鉴权规则缺少 operation", i+1)
+		}
+	}
+	return &policy, nil
+}
+
+// LoadPolicyFile 读取 YAML 格式的鉴权策略文件
+func LoadPolicyFile(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("读取鉴权策略文件失败: %w", err)
+	}
+	return ParsePolicy(data)
+}
+
+// Match 返回 operation 对应的规则，没有匹配的规则时返回 nil
+func (p *Policy) Match(operation string) *Rule {
+	var matched *Rule
+	matchedLen := -1
+	for i := range p.Rules {
+		rule := &p.Rules[i]
+		prefix, wildcard := strings.CutSuffix(rule.Operation, "*")
+		switch {
+		case !wildcard && rule.Operation == operation:
+			return rule
+		case wildcard && strings.HasPrefix(operation, prefix) && len(prefix) > matchedLen:
+			matched, matchedLen = rule, len(prefix)
+		}
+	}
+	return matched
+}
+
+// PolicyProvider 提供当前生效的鉴权策略，每个请求调用一次，需要自行缓存
+type PolicyProvider interface {
+	Policy(ctx context.Context) (*Policy, error)
+}
+
+// PolicyProviderFunc 函数形式的 PolicyProvider
+type PolicyProviderFunc func(ctx context.Context) (*Policy, error)
+
+// Policy 实现 PolicyProvider
+func (f PolicyProviderFunc) Policy(ctx context.Context) (*Policy, error) {
+	return f(ctx)
+}
+
+// StaticPolicy 返回固定策略的 PolicyProvider
+func StaticPolicy(policy *Policy) PolicyProvider {
+	return PolicyProviderFunc(func(context.Context) (*Policy, error) {
+		return policy, nil
+	})
+}
@@ -0,0 +1,241 @@
+// Package circuitbreaker 客户端熔断中间件
+//
+// 基于 Google SRE 自适应熔断，每个 operation 一个熔断器，互不影响。
+// 被调用方返回服务端错误（500、503、504）计为失败，其余计为成功；
+// 熔断打开时请求在本地直接失败并返回 ErrNotAllowed，不会发往被调用方。
+//
+// 记录两类指标，标签为 operation:
+//   - client_circuit_breaker_rejected_total: 被熔断器拒绝的请求数
+//   - client_circuit_breaker_open: 熔断器是否处于拒绝状态（最近一次请求被拒绝为1，否则为0）
+//
+// 使用示例:
+//
+//	breaker := circuitbreaker.New(circuitbreaker.WithPolicy(common.DefaultCircuitBreakerPolicy()))
+//	defer breaker.Close()
+//	conn, err := grpc.DialInsecure(ctx,
+//	    grpc.WithEndpoint("discovery:///user-service"),
+//	    grpc.WithMiddleware(breaker.Middleware()),
+//	)
+package circuitbreaker
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-kratos/aegis/circuitbreaker"
+	"github.com/go-kratos/aegis/circuitbreaker/sre"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	kratosBreaker "github.com/go-kratos/kratos/v2/middleware/circuitbreaker"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/heyinLab/common/pkg/common"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// meterName 熔断指标使用的 Meter 名称
+const meterName = "github.com/heyinLab/common/pkg/middleware/circuitbreaker"
+
+// ErrNotAllowed 熔断器打开时本地拒绝的请求返回的错误，可以使用 errors.Is 判断
+//
+// 状态码为 503（gRPC Unavailable），与 kratos 熔断中间件的错误相同
+var ErrNotAllowed = kratosBreaker.ErrNotAllowed
+
+// NewBreaker 按策略创建单个 operation 的 SRE 熔断器，policy 中为零值的字段使用 sre 的默认值
+func NewBreaker(policy *common.CircuitBreakerPolicy) circuitbreaker.CircuitBreaker {
+	opts := []sre.Option{}
+	if policy != nil {
+		if policy.Success > 0 {
+			opts = append(opts, sre.WithSuccess(policy.Success))
+		}
+		if policy.Request > 0 {
+			opts = append(opts, sre.WithRequest(policy.Request))
+		}
+		if policy.Window > 0 {
+			opts = append(opts, sre.WithWindow(policy.Window))
+		}
+	}
+	return sre.NewBreaker(opts...)
+}
+
+type options struct {
+	newBreaker    func() circuitbreaker.CircuitBreaker
+	meterProvider metric.MeterProvider
+}
+
+// Option 熔断中间件选项
+type Option func(*options)
+
+// WithPolicy 设置熔断策略，默认使用 common.DefaultCircuitBreakerPolicy()
+func WithPolicy(policy *common.CircuitBreakerPolicy) Option {
+	return func(o *options) {
+		o.newBreaker = func() circuitbreaker.CircuitBreaker {
+			return NewBreaker(policy)
+		}
+	}
+}
+
+// WithBreaker 设置创建熔断器的函数，每个 operation 调用一次，用于替换 SRE 熔断器
+func WithBreaker(fn func() circuitbreaker.CircuitBreaker) Option {
+	return func(o *options) {
+		o.newBreaker = fn
+	}
+}
+
+// WithMeterProvider 设置熔断指标使用的 MeterProvider，默认使用全局的 otel.GetMeterProvider()
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(o *options) {
+		o.meterProvider = mp
+	}
+}
+
+// entry 单个 operation 的熔断器及其状态
+type entry struct {
+	breaker circuitbreaker.CircuitBreaker
+	open    atomic.Bool
+}
+
+// group 按 operation 懒创建熔断器
+type group struct {
+	newBreaker func() circuitbreaker.CircuitBreaker
+
+	mu      sync.RWMutex
+	entries map[string]*entry
+}
+
+// get 返回 operation 对应的熔断器，不存在时创建
+func (g *group) get(operation string) *entry {
+	g.mu.RLock()
+	e, ok := g.entries[operation]
+	g.mu.RUnlock()
+	if ok {
+		return e
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if e, ok = g.entries[operation]; !ok {
+		e = &entry{breaker: g.newBreaker()}
+		g.entries[operation] = e
+	}
+	return e
+}
+
+// observe 上报每个 operation 的熔断状态
+func (g *group) observe(open metric.Int64Observable, o metric.Observer) error {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	for operation, e := range g.entries {
+		var v int64
+		if e.open.Load() {
+			v = 1
+		}
+		o.ObserveInt64(open, v, metric.WithAttributes(attribute.String("operation", operation)))
+	}
+	return nil
+}
+
+// Breaker 按 operation 熔断的客户端中间件，持有熔断状态指标的注册
+//
+// 连接关闭时调用 Close 注销指标回调，否则 MeterProvider 会一直持有熔断器并上报已关闭连接的状态
+type Breaker struct {
+	group        *group
+	rejected     metric.Int64Counter
+	registration metric.Registration
+	closeOnce    sync.Once
+}
+
+// New 创建熔断中间件，与连接一起创建和关闭
+func New(opts ...Option) *Breaker {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.newBreaker == nil {
+		WithPolicy(common.DefaultCircuitBreakerPolicy())(o)
+	}
+	mp := o.meterProvider
+	if mp == nil {
+		mp = otel.GetMeterProvider()
+	}
+
+	b := &Breaker{group: &group{newBreaker: o.newBreaker, entries: make(map[string]*entry)}}
+	meter := mp.Meter(meterName)
+	var err error
+	b.rejected, err = meter.Int64Counter("client_circuit_breaker_rejected_total",
+		metric.WithDescription("The total number of requests rejected by the circuit breaker"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	open, err := meter.Int64ObservableGauge("client_circuit_breaker_open",
+		metric.WithDescription("Whether the circuit breaker is rejecting requests (1) or not (0)"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	b.registration, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		return b.group.observe(open, o)
+	}, open)
+	if err != nil {
+		otel.Handle(err)
+	}
+	return b
+}
+
+// Middleware 返回熔断中间件
+//
+// 熔断打开时返回 ErrNotAllowed，被拒绝的请求同样计为失败，使拒绝比例随失败持续上升；
+// 被调用方恢复后拒绝比例随成功率回升自动下降。
+// 与重试中间件一起使用时把熔断放在重试外层，熔断打开时直接失败，不会被重试。
+func (b *Breaker) Middleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			var operation string
+			if tr, ok := transport.FromClientContext(ctx); ok {
+				operation = tr.Operation()
+			}
+			e := b.group.get(operation)
+
+			if err := e.breaker.Allow(); err != nil {
+				e.open.Store(true)
+				e.breaker.MarkFailed()
+				b.rejected.Add(ctx, 1, metric.WithAttributes(attribute.String("operation", operation)))
+				return nil, ErrNotAllowed
+			}
+			e.open.Store(false)
+
+			reply, err := handler(ctx, req)
+			if isFailure(err) {
+				e.breaker.MarkFailed()
+			} else {
+				e.breaker.MarkSuccess()
+			}
+			return reply, err
+		}
+	}
+}
+
+// Close 注销熔断状态指标，可以重复调用
+func (b *Breaker) Close() error {
+	var err error
+	b.closeOnce.Do(func() {
+		if b.registration != nil {
+			err = b.registration.Unregister()
+		}
+	})
+	return err
+}
+
+// Client 客户端熔断中间件，行为见 Breaker.Middleware
+//
+// 熔断状态指标在进程退出前一直注册，适用于与进程同生命周期的连接；
+// 会关闭并重建连接时使用 New，在关闭连接时调用 Breaker.Close
+func Client(opts ...Option) middleware.Middleware {
+	return New(opts...).Middleware()
+}
+
+// isFailure 判断错误是否计入熔断失败，业务错误（4xx）说明被调用方正常工作，不计入
+func isFailure(err error) bool {
+	return err != nil && (errors.IsInternalServer(err) || errors.IsServiceUnavailable(err) || errors.IsGatewayTimeout(err))
+}
@@ -0,0 +1,139 @@
+package circuitbreaker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/aegis/circuitbreaker"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/heyinLab/common/pkg/middleware/internal/mwtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// countingBreaker 连续失败 threshold 次后打开
+type countingBreaker struct {
+	threshold int
+	failures  int
+}
+
+func (b *countingBreaker) Allow() error {
+	if b.failures >= b.threshold {
+		return circuitbreaker.ErrNotAllowed
+	}
+	return nil
+}
+
+func (b *countingBreaker) MarkSuccess() { b.failures = 0 }
+func (b *countingBreaker) MarkFailed()  { b.failures++ }
+
+func TestClient(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	m := Client(
+		WithBreaker(func() circuitbreaker.CircuitBreaker { return &countingBreaker{threshold: 2} }),
+		WithMeterProvider(provider),
+	)
+
+	var calls int
+	handler := m(func(_ context.Context, req interface{}) (interface{}, error) {
+		calls++
+		switch req {
+		case "unavailable":
+			return nil, errors.ServiceUnavailable("UNAVAILABLE", "unavailable")
+		case "not_found":
+			return nil, errors.NotFound("DATA_NOT_FOUND", "not found")
+		}
+		return "ok", nil
+	})
+	call := func(operation string, req interface{}) error {
+		ctx := transport.NewClientContext(context.Background(), mwtest.NewTransport(transport.KindGRPC, operation))
+		_, err := handler(ctx, req)
+		return err
+	}
+
+	// 业务错误不计入失败
+	for i := 0; i < 3; i++ {
+		assert.True(t, errors.IsNotFound(call("/user.v1.User/Get", "not_found")))
+	}
+
+	assert.True(t, errors.IsServiceUnavailable(call("/user.v1.User/Get", "unavailable")))
+	assert.True(t, errors.IsServiceUnavailable(call("/user.v1.User/Get", "unavailable")))
+	assert.Equal(t, 5, calls)
+
+	// 熔断打开后不再调用下游
+	err := call("/user.v1.User/Get", "ok")
+	assert.True(t, errors.Is(err, ErrNotAllowed))
+	assert.Equal(t, 5, calls)
+
+	// 熔断器按 operation 隔离
+	assert.NoError(t, call("/user.v1.User/List", "ok"))
+	assert.Equal(t, 6, calls)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	assert.Equal(t, int64(1), valueOf(t, rm, "client_circuit_breaker_rejected_total", "/user.v1.User/Get"))
+	assert.Equal(t, int64(1), valueOf(t, rm, "client_circuit_breaker_open", "/user.v1.User/Get"))
+	assert.Equal(t, int64(0), valueOf(t, rm, "client_circuit_breaker_open", "/user.v1.User/List"))
+}
+
+func TestBreaker_Close(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	b := New(WithMeterProvider(provider))
+
+	_, err := b.Middleware()(func(context.Context, interface{}) (interface{}, error) {
+		return "ok", nil
+	})(transport.NewClientContext(context.Background(), mwtest.NewTransport(transport.KindGRPC, "/user.v1.User/Get")), nil)
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	assert.Equal(t, int64(0), valueOf(t, rm, "client_circuit_breaker_open", "/user.v1.User/Get"))
+
+	// 关闭后不再上报熔断状态
+	require.NoError(t, b.Close())
+	require.NoError(t, b.Close())
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	for _, sm := range rm.ScopeMetrics {
+		for _, md := range sm.Metrics {
+			assert.NotEqual(t, "client_circuit_breaker_open", md.Name)
+		}
+	}
+}
+
+func TestNewBreaker(t *testing.T) {
+	assert.NotNil(t, NewBreaker(nil))
+	assert.NoError(t, NewBreaker(nil).Allow())
+}
+
+// valueOf 返回指标中 operation 标签等于 operation 的数据点的值
+func valueOf(t *testing.T, rm metricdata.ResourceMetrics, name, operation string) int64 {
+	t.Helper()
+	want := attribute.NewSet(attribute.String("operation", operation))
+	for _, sm := range rm.ScopeMetrics {
+		for _, md := range sm.Metrics {
+			if md.Name != name {
+				continue
+			}
+			var points []metricdata.DataPoint[int64]
+			switch data := md.Data.(type) {
+			case metricdata.Sum[int64]:
+				points = data.DataPoints
+			case metricdata.Gauge[int64]:
+				points = data.DataPoints
+			}
+			for _, dp := range points {
+				if dp.Attributes.Equals(&want) {
+					return dp.Value
+				}
+			}
+		}
+	}
+	t.Fatalf("指标 %s 中没有 operation=%s 的数据点", name, operation)
+	return 0
+}
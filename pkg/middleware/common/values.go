@@ -1,7 +1,11 @@
 package common
 
 const (
-	USERID     string = "X-User-ID"
-	TENANTID   string = "X-Tenant-ID"
-	REGIONNAME string = "X-Region-Name"
+	USERID      string = "X-User-ID"
+	TENANTID    string = "X-Tenant-ID"
+	REGIONNAME  string = "X-Region-Name"
+	REQUESTID   string = "X-Request-ID"
+	TOKENID     string = "X-Token-ID"
+	ROLES       string = "X-User-Roles"
+	PERMISSIONS string = "X-User-Permissions"
 )
@@ -0,0 +1,36 @@
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecurityHeaders(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	rec := httptest.NewRecorder()
+	SecurityHeaders()(next).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	h := rec.Header()
+	assert.Equal(t, "nosniff", h.Get("X-Content-Type-Options"))
+	assert.Equal(t, "DENY", h.Get("X-Frame-Options"))
+	assert.Equal(t, "max-age=31536000; includeSubDomains", h.Get("Strict-Transport-Security"))
+	assert.Equal(t, DefaultCSP, h.Get("Content-Security-Policy"))
+	assert.Equal(t, "no-referrer", h.Get("Referrer-Policy"))
+
+	rec = httptest.NewRecorder()
+	SecurityHeaders(
+		WithFrameOptions(""),
+		WithHSTS(time.Hour, false, true),
+		WithCSP("default-src 'self'"),
+	)(next).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	h = rec.Header()
+	assert.Empty(t, h.Get("X-Frame-Options"))
+	assert.Equal(t, "max-age=3600; preload", h.Get("Strict-Transport-Security"))
+	assert.Equal(t, "default-src 'self'", h.Get("Content-Security-Policy"))
+}
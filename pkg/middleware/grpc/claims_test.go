@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	authWare "github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+// forward 经过 ForwardClaims 和 ExtractClaims 后返回下游看到的 Claims
+func forward(t *testing.T, claims *authWare.Claims) *authWare.Claims {
+	t.Helper()
+	var md metadata.MD
+	_, err := ForwardClaims()(func(ctx context.Context, _ interface{}) (interface{}, error) {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil, nil
+	})(authWare.NewContext(context.Background(), claims), nil)
+	assert.NoError(t, err)
+
+	reply, err := ExtractClaims()(func(ctx context.Context, _ interface{}) (interface{}, error) {
+		got, _ := authWare.FromContext(ctx)
+		return got, nil
+	})(metadata.NewIncomingContext(context.Background(), md), nil)
+	assert.NoError(t, err)
+	return reply.(*authWare.Claims)
+}
+
+func TestForwardAndExtractClaims(t *testing.T) {
+	claims := &authWare.Claims{UserID: 42, TenantID: 7, RegionName: "cn", TokenID: "t1"}
+	assert.Equal(t, claims, forward(t, claims))
+}
+
+func TestForwardAndExtractClaims_RolesAndPermissions(t *testing.T) {
+	claims := &authWare.Claims{
+		UserID:      42,
+		Roles:       []string{"admin", "editor"},
+		Permissions: []string{"user:read"},
+	}
+	got := forward(t, claims)
+	assert.Equal(t, claims.Roles, got.Roles)
+	assert.Equal(t, claims.Permissions, got.Permissions)
+}
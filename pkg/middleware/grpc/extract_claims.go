@@ -35,12 +35,23 @@ func ExtractClaims() middleware.Middleware {
 					}
 				}
 
-				// 4. 解析 RegionName
+				// 4. 解析 RegionName 和 TokenID
 				if vals := md.Get(common.REGIONNAME); len(vals) > 0 {
 					claims.RegionName = vals[0]
 				}
+				if vals := md.Get(common.TOKENID); len(vals) > 0 {
+					claims.TokenID = vals[0]
+				}
+
+				// 5. 解析角色和权限，供 authz 等鉴权中间件使用
+				if vals := md.Get(common.ROLES); len(vals) > 0 {
+					claims.Roles = authWare.ParseList(vals[0])
+				}
+				if vals := md.Get(common.PERMISSIONS); len(vals) > 0 {
+					claims.Permissions = authWare.ParseList(vals[0])
+				}
 
-				// 5. 如果成功提取到了数据，将其注入到 Context 中
+				// 6. 如果成功提取到了数据，将其注入到 Context 中
 				// 这样后续的业务逻辑（Service层）就可以通过 authWare.FromContext(ctx) 拿到了
 				if hasData {
 					ctx = authWare.NewContext(ctx, claims)
@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/go-kratos/kratos/v2/middleware"
 	authWare "github.com/heyinLab/common/pkg/middleware/auth"
@@ -18,11 +19,21 @@ func ForwardClaims() middleware.Middleware {
 			if ok && claims != nil && claims.UserID != 0 {
 				// 2. 将关键字段转换为字符串并放入 gRPC Metadata
 				// 使用 AppendToOutgoingContext 可以保留已有的 metadata (如 trace_id)
-				ctx = metadata.AppendToOutgoingContext(ctx,
+				kv := []string{
 					common.USERID, strconv.FormatUint(uint64(claims.UserID), 10),
 					common.TENANTID, strconv.FormatUint(uint64(claims.TenantID), 10),
 					common.REGIONNAME, claims.RegionName,
-				)
+				}
+				if claims.TokenID != "" {
+					kv = append(kv, common.TOKENID, claims.TokenID)
+				}
+				if len(claims.Roles) > 0 {
+					kv = append(kv, common.ROLES, strings.Join(claims.Roles, ","))
+				}
+				if len(claims.Permissions) > 0 {
+					kv = append(kv, common.PERMISSIONS, strings.Join(claims.Permissions, ","))
+				}
+				ctx = metadata.AppendToOutgoingContext(ctx, kv...)
 			}
 			return handler(ctx, req)
 		}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	authWare "github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/middleware/common"
+)
+
+// ExtractClaims 从可信的 HTTP 请求头中提取 Claims，与 gRPC 的 ExtractClaims 对应
+//
+// 与 auth.Server 不同，请求头缺失或格式错误时不会拒绝请求，而是按匿名请求继续处理，
+// 适用于内部网格中同时服务登录用户和匿名用户的接口。
+// 只能用于请求头已经由网关或网格校验过的服务。
+func ExtractClaims() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			// 1. 获取 HTTP 请求头
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			header := tr.RequestHeader()
+
+			// 2. 解析 UserID，缺失或格式错误时按匿名请求处理
+			uid, err := strconv.ParseUint(header.Get(common.USERID), 10, 32)
+			if err != nil {
+				return handler(ctx, req)
+			}
+			claims := &authWare.Claims{UserID: uint32(uid)}
+
+			// 3. 解析 TenantID
+			if tid, err := strconv.ParseUint(header.Get(common.TENANTID), 10, 32); err == nil {
+				claims.TenantID = uint32(tid)
+			}
+
+			// 4. 解析 RegionName、TokenID、角色和权限
+			claims.RegionName = header.Get(common.REGIONNAME)
+			claims.TokenID = header.Get(common.TOKENID)
+			claims.Roles = authWare.ParseList(header.Get(common.ROLES))
+			claims.Permissions = authWare.ParseList(header.Get(common.PERMISSIONS))
+
+			// 5. 注入到 Context 中，后续通过 authWare.FromContext(ctx) 获取
+			return handler(authWare.NewContext(ctx, claims), req)
+		}
+	}
+}
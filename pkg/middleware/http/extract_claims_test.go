@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	authWare "github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/middleware/internal/mwtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractClaims(t *testing.T) {
+	extract := func(header map[string]string) (*authWare.Claims, bool) {
+		tr := mwtest.NewTransport(transport.KindHTTP, mwtest.DefaultOperation)
+		for k, v := range header {
+			tr.Request.Set(k, v)
+		}
+		var claims *authWare.Claims
+		var ok bool
+		_, err := ExtractClaims()(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			claims, ok = authWare.FromContext(ctx)
+			return nil, nil
+		})(transport.NewServerContext(context.Background(), tr), nil)
+		assert.NoError(t, err)
+		return claims, ok
+	}
+
+	claims, ok := extract(map[string]string{"X-User-ID": "42", "X-Tenant-ID": "7", "X-Region-Name": "cn"})
+	assert.True(t, ok)
+	assert.Equal(t, &authWare.Claims{UserID: 42, TenantID: 7, RegionName: "cn"}, claims)
+
+	claims, ok = extract(map[string]string{"X-User-ID": "42", "X-Tenant-ID": "bad"})
+	assert.True(t, ok)
+	assert.Equal(t, uint32(0), claims.TenantID)
+
+	// 匿名请求和格式错误的用户ID都不会被拒绝
+	_, ok = extract(nil)
+	assert.False(t, ok)
+	_, ok = extract(map[string]string{"X-User-ID": "abc"})
+	assert.False(t, ok)
+}
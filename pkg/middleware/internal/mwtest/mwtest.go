@@ -0,0 +1,48 @@
+// Package mwtest 中间件测试共用的 transport 实现
+package mwtest
+
+import (
+	"net/http"
+
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// DefaultOperation 测试默认使用的 operation
+const DefaultOperation = "/test.v1.Service/Get"
+
+// Header 用 http.Header 实现 transport.Header
+type Header http.Header
+
+func (h Header) Get(key string) string      { return http.Header(h).Get(key) }
+func (h Header) Set(key, value string)      { http.Header(h).Set(key, value) }
+func (h Header) Add(key, value string)      { http.Header(h).Add(key, value) }
+func (h Header) Values(key string) []string { return http.Header(h).Values(key) }
+func (h Header) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Transport 测试用的 transport.Transporter，Request 和 Reply 分别为请求头和响应头
+type Transport struct {
+	kind      transport.Kind
+	operation string
+
+	Request Header
+	Reply   Header
+}
+
+var _ transport.Transporter = (*Transport)(nil)
+
+// NewTransport 创建指定类型和 operation 的 Transport，请求头和响应头为空
+func NewTransport(kind transport.Kind, operation string) *Transport {
+	return &Transport{kind: kind, operation: operation, Request: Header{}, Reply: Header{}}
+}
+
+func (t *Transport) Kind() transport.Kind            { return t.kind }
+func (t *Transport) Endpoint() string                { return "" }
+func (t *Transport) Operation() string               { return t.operation }
+func (t *Transport) RequestHeader() transport.Header { return t.Request }
+func (t *Transport) ReplyHeader() transport.Header   { return t.Reply }
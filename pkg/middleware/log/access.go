@@ -0,0 +1,104 @@
+package log
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	kratoslog "github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	authWare "github.com/heyinLab/common/pkg/middleware/auth"
+)
+
+// DefaultSlowThreshold 默认的慢请求阈值
+const DefaultSlowThreshold = time.Second
+
+type options struct {
+	slowThreshold time.Duration
+}
+
+// Option 访问日志选项
+type Option func(*options)
+
+// WithSlowThreshold 设置慢请求阈值，耗时达到阈值的请求以 WARN 级别记录并标记 slow=true，0 表示不检查
+func WithSlowThreshold(d time.Duration) Option {
+	return func(o *options) {
+		o.slowThreshold = d
+	}
+}
+
+// Server 访问日志中间件
+//
+// 每个请求结束后记录一条结构化日志，字段:
+//   - kind / operation: 传输类型（http、grpc）和 kratos operation
+//   - method / path: HTTP 请求方法和路径，仅 HTTP 请求
+//   - user_id / tenant_id: context 中的 Claims，没有时为 0
+//   - code / reason: HTTP 状态码和错误原因
+//   - latency: 耗时（秒）
+//   - slow: 是否达到慢请求阈值
+//
+// 成功的请求以 INFO 级别记录，4xx 错误和慢请求以 WARN 级别记录，5xx 错误以 ERROR 级别记录。
+// Claims 由认证中间件写入，需要记录用户信息时把 Server 放在认证中间件之后。
+//
+// 使用示例:
+//
+//	http.Middleware(
+//	    recovery.Recovery(),
+//	    auth.Server(true),
+//	    log.Server(logger, log.WithSlowThreshold(500*time.Millisecond)),
+//	)
+func Server(logger kratoslog.Logger, opts ...Option) middleware.Middleware {
+	o := &options{slowThreshold: DefaultSlowThreshold}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			start := time.Now()
+			reply, err = handler(ctx, req)
+			latency := time.Since(start)
+
+			keyvals := []interface{}{"kind", "", "operation", ""}
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				keyvals = []interface{}{"kind", tr.Kind().String(), "operation", tr.Operation()}
+				if ht, ok := tr.(khttp.Transporter); ok && ht.Request() != nil {
+					keyvals = append(keyvals, "method", ht.Request().Method, "path", ht.Request().URL.Path)
+				}
+			}
+
+			var userID, tenantID uint32
+			if claims, ok := authWare.FromContext(ctx); ok && claims != nil {
+				userID, tenantID = claims.UserID, claims.TenantID
+			}
+
+			code, reason := int32(http.StatusOK), ""
+			if se := errors.FromError(err); se != nil {
+				code, reason = se.Code, se.Reason
+			}
+			slow := o.slowThreshold > 0 && latency >= o.slowThreshold
+
+			level := kratoslog.LevelInfo
+			switch {
+			case code >= http.StatusInternalServerError:
+				level = kratoslog.LevelError
+			case err != nil || slow:
+				level = kratoslog.LevelWarn
+			}
+
+			keyvals = append(keyvals,
+				"user_id", userID,
+				"tenant_id", tenantID,
+				"code", code,
+				"reason", reason,
+				"latency", latency.Seconds(),
+				"slow", slow,
+			)
+			_ = kratoslog.WithContext(ctx, logger).Log(level, keyvals...)
+			return reply, err
+		}
+	}
+}
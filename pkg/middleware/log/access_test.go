@@ -0,0 +1,64 @@
+package log
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	kratoslog "github.com/go-kratos/kratos/v2/log"
+	authWare "github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordLogger 记录最后一条日志
+type recordLogger struct {
+	level  kratoslog.Level
+	fields map[string]interface{}
+}
+
+func (l *recordLogger) Log(level kratoslog.Level, keyvals ...interface{}) error {
+	l.level = level
+	l.fields = map[string]interface{}{}
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		l.fields[keyvals[i].(string)] = keyvals[i+1]
+	}
+	return nil
+}
+
+func TestServer(t *testing.T) {
+	logger := &recordLogger{}
+	ctx := authWare.NewContext(context.Background(), &authWare.Claims{UserID: 42, TenantID: 7})
+
+	_, err := Server(logger)(func(context.Context, interface{}) (interface{}, error) {
+		return "ok", nil
+	})(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, kratoslog.LevelInfo, logger.level)
+	assert.Equal(t, uint32(42), logger.fields["user_id"])
+	assert.Equal(t, uint32(7), logger.fields["tenant_id"])
+	assert.Equal(t, int32(200), logger.fields["code"])
+	assert.Equal(t, false, logger.fields["slow"])
+
+	_, err = Server(logger)(func(context.Context, interface{}) (interface{}, error) {
+		return nil, errors.NotFound("USER_NOT_FOUND", "user not found")
+	})(ctx, nil)
+	assert.Error(t, err)
+	assert.Equal(t, kratoslog.LevelWarn, logger.level)
+	assert.Equal(t, int32(404), logger.fields["code"])
+	assert.Equal(t, "USER_NOT_FOUND", logger.fields["reason"])
+
+	_, _ = Server(logger)(func(context.Context, interface{}) (interface{}, error) {
+		return nil, errors.InternalServer("SYSTEM_ERROR", "boom")
+	})(ctx, nil)
+	assert.Equal(t, kratoslog.LevelError, logger.level)
+
+	_, err = Server(logger, WithSlowThreshold(time.Millisecond))(func(context.Context, interface{}) (interface{}, error) {
+		time.Sleep(2 * time.Millisecond)
+		return "ok", nil
+	})(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, kratoslog.LevelWarn, logger.level)
+	assert.Equal(t, true, logger.fields["slow"])
+	assert.Equal(t, uint32(0), logger.fields["user_id"])
+}
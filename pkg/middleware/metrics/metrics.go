@@ -0,0 +1,126 @@
+// Package metrics 请求指标中间件和 Prometheus 指标接口
+//
+// 服务端和客户端中间件记录三类指标，标签为 kind（http/grpc）、operation 和 code:
+//   - {server,client}_requests_code_total: 请求数，额外带 reason 标签
+//   - {server,client}_requests_seconds: 请求耗时（秒）直方图
+//   - {server,client}_requests_in_flight: 正在处理的请求数，只带 kind 和 operation 标签
+//
+// 使用示例:
+//
+//	provider, err := metrics.NewPrometheusMeterProvider()
+//	if err != nil {
+//	    panic(err)
+//	}
+//	httpSrv := http.NewServer(http.Middleware(metrics.Server()))
+//	metrics.Mount(httpSrv, "")
+//	conn, err := grpc.DialInsecure(ctx, grpc.WithMiddleware(metrics.Client()))
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// meterName 中间件使用的 Meter 名称
+const meterName = "github.com/heyinLab/common/pkg/middleware/metrics"
+
+type options struct {
+	meterProvider metric.MeterProvider
+}
+
+// Option 指标中间件选项
+type Option func(*options)
+
+// WithMeterProvider 设置 MeterProvider，默认使用全局的 otel.GetMeterProvider()
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(o *options) {
+		o.meterProvider = mp
+	}
+}
+
+// instruments 一组请求指标
+type instruments struct {
+	requests metric.Int64Counter
+	seconds  metric.Float64Histogram
+	inFlight metric.Int64UpDownCounter
+}
+
+// newInstruments 创建 side（server/client）对应的指标，创建失败时交给 otel 的错误处理器，返回的指标仍可使用
+func newInstruments(side string, opts []Option) *instruments {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	mp := o.meterProvider
+	if mp == nil {
+		mp = otel.GetMeterProvider()
+	}
+	meter := mp.Meter(meterName)
+
+	requests, err := meter.Int64Counter(side+"_requests_code_total",
+		metric.WithDescription("The total number of processed requests"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	seconds, err := meter.Float64Histogram(side+"_requests_seconds",
+		metric.WithDescription("Requests duration (sec)"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10))
+	if err != nil {
+		otel.Handle(err)
+	}
+	inFlight, err := meter.Int64UpDownCounter(side+"_requests_in_flight",
+		metric.WithDescription("The number of requests being processed"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	return &instruments{requests: requests, seconds: seconds, inFlight: inFlight}
+}
+
+// middleware 记录一次请求的指标，info 为请求的 transport 信息
+func (ins *instruments) middleware(info func(ctx context.Context) (transport.Transporter, bool)) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			var kind, operation string
+			if tr, ok := info(ctx); ok {
+				kind, operation = tr.Kind().String(), tr.Operation()
+			}
+			base := []attribute.KeyValue{
+				attribute.String("kind", kind),
+				attribute.String("operation", operation),
+			}
+
+			ins.inFlight.Add(ctx, 1, metric.WithAttributes(base...))
+			start := time.Now()
+			reply, err = handler(ctx, req)
+			latency := time.Since(start).Seconds()
+			ins.inFlight.Add(ctx, -1, metric.WithAttributes(base...))
+
+			code, reason := int32(200), ""
+			if se := errors.FromError(err); se != nil {
+				code, reason = se.Code, se.Reason
+			}
+			withCode := append(base, attribute.Int("code", int(code)))
+			ins.requests.Add(ctx, 1, metric.WithAttributes(append(withCode, attribute.String("reason", reason))...))
+			ins.seconds.Record(ctx, latency, metric.WithAttributes(withCode...))
+			return reply, err
+		}
+	}
+}
+
+// Server 服务端请求指标中间件
+func Server(opts ...Option) middleware.Middleware {
+	return newInstruments("server", opts).middleware(transport.FromServerContext)
+}
+
+// Client 客户端请求指标中间件
+func Client(opts ...Option) middleware.Middleware {
+	return newInstruments("client", opts).middleware(transport.FromClientContext)
+}
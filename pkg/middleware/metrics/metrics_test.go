@@ -0,0 +1,80 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestServer(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	m := Server(WithMeterProvider(provider))
+
+	var inFlight int64
+	handler := m(func(ctx context.Context, req interface{}) (interface{}, error) {
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(ctx, &rm))
+		inFlight = sumOf(t, rm, "server_requests_in_flight", nil)
+		if req == "fail" {
+			return nil, errors.NotFound("DATA_NOT_FOUND", "not found")
+		}
+		return "ok", nil
+	})
+	_, _ = handler(context.Background(), "ok")
+	assert.Equal(t, int64(1), inFlight)
+	_, _ = handler(context.Background(), "ok")
+	_, _ = handler(context.Background(), "fail")
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	assert.Equal(t, int64(2), sumOf(t, rm, "server_requests_code_total", []attribute.KeyValue{
+		attribute.String("kind", ""), attribute.String("operation", ""),
+		attribute.Int("code", 200), attribute.String("reason", ""),
+	}))
+	assert.Equal(t, int64(1), sumOf(t, rm, "server_requests_code_total", []attribute.KeyValue{
+		attribute.String("kind", ""), attribute.String("operation", ""),
+		attribute.Int("code", 404), attribute.String("reason", "DATA_NOT_FOUND"),
+	}))
+	assert.Equal(t, int64(0), sumOf(t, rm, "server_requests_in_flight", nil))
+
+	var histogramCount uint64
+	for _, sm := range rm.ScopeMetrics {
+		for _, md := range sm.Metrics {
+			if h, ok := md.Data.(metricdata.Histogram[float64]); ok && md.Name == "server_requests_seconds" {
+				for _, dp := range h.DataPoints {
+					histogramCount += dp.Count
+				}
+			}
+		}
+	}
+	assert.Equal(t, uint64(3), histogramCount)
+}
+
+// sumOf 返回指标中与 attrs 完全一致的数据点之和，attrs 为 nil 时累加所有数据点
+func sumOf(t *testing.T, rm metricdata.ResourceMetrics, name string, attrs []attribute.KeyValue) int64 {
+	t.Helper()
+	var total int64
+	for _, sm := range rm.ScopeMetrics {
+		for _, md := range sm.Metrics {
+			if md.Name != name {
+				continue
+			}
+			sum, ok := md.Data.(metricdata.Sum[int64])
+			require.True(t, ok)
+			for _, dp := range sum.DataPoints {
+				want := attribute.NewSet(attrs...)
+				if attrs == nil || dp.Attributes.Equals(&want) {
+					total += dp.Value
+				}
+			}
+		}
+	}
+	return total
+}
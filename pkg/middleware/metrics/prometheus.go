@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"fmt"
+
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	otelprom "go.opentelemetry.io/otel/exporters/prometheus"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// DefaultPath 默认的 Prometheus 指标接口路径
+const DefaultPath = "/metrics"
+
+// NewPrometheusMeterProvider 创建导出到 Prometheus 默认 Registry 的 MeterProvider 并设为全局 MeterProvider
+//
+// 每个进程只能调用一次，重复调用会因为重复注册而失败。关闭服务时调用 provider.Shutdown。
+func NewPrometheusMeterProvider() (*sdkmetric.MeterProvider, error) {
+	exporter, err := otelprom.New()
+	if err != nil {
+		return nil, fmt.Errorf("创建 Prometheus 导出器失败: %w", err)
+	}
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(exporter))
+	otel.SetMeterProvider(provider)
+	return provider, nil
+}
+
+// Mount 在 kratos HTTP 服务上挂载 Prometheus 指标接口，path 为空时使用 /metrics
+func Mount(srv *khttp.Server, path string) {
+	if path == "" {
+		path = DefaultPath
+	}
+	srv.Handle(path, promhttp.Handler())
+}
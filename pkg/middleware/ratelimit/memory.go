@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// defaultIdleTTL 令牌桶闲置多久后被清理
+const defaultIdleTTL = 10 * time.Minute
+
+// MemoryLimiter 进程内令牌桶限流器，每个 key 一个令牌桶
+//
+// 配额只在当前实例内生效，多实例部署时实际配额是单实例配额乘以实例数
+type MemoryLimiter struct {
+	limit   rate.Limit
+	burst   int
+	idleTTL time.Duration
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewMemoryLimiter 创建令牌桶限流器
+//
+// 参数:
+//   - perSecond: 每秒补充的令牌数
+//   - burst: 令牌桶容量，即允许的突发请求数
+func NewMemoryLimiter(perSecond float64, burst int) *MemoryLimiter {
+	return &MemoryLimiter{
+		limit:   rate.Limit(perSecond),
+		burst:   burst,
+		idleTTL: defaultIdleTTL,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// Allow 实现 Limiter
+func (l *MemoryLimiter) Allow(_ context.Context, key string) (bool, time.Duration, error) {
+	now := time.Now()
+
+	l.mu.Lock()
+	l.sweep(now)
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+	l.mu.Unlock()
+
+	r := b.limiter.ReserveN(now, 1)
+	if !r.OK() {
+		return false, time.Second, nil
+	}
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return false, delay, nil
+	}
+	return true, 0, nil
+}
+
+// sweep 清理闲置的令牌桶，调用方需持有锁
+func (l *MemoryLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < l.idleTTL {
+		return
+	}
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) >= l.idleTTL {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
@@ -0,0 +1,246 @@
+// Package ratelimit 按用户、租户或客户端IP限流的服务端中间件
+//
+// 提供两种限流器:
+//   - MemoryLimiter: 进程内令牌桶，适合单实例或只需要粗略限流的场景
+//   - RedisLimiter: 基于 Redis 的滑动窗口，多个实例共享同一个配额
+//
+// 使用示例:
+//
+//	limiter := ratelimit.NewRedisLimiter(rdb, 100, time.Minute)
+//	http.Middleware(
+//	    auth.Server(true),
+//	    ratelimit.Server(limiter,
+//	        ratelimit.WithKeyFunc(ratelimit.ByTenant),
+//	        ratelimit.WithTrustedProxies("10.0.0.0/8"),
+//	    ),
+//	)
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	authWare "github.com/heyinLab/common/pkg/middleware/auth"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// Reason 被限流时返回的错误原因
+	Reason = "TOO_MANY_REQUESTS"
+
+	// retryAfterHeader 告知客户端多久后重试的响应头
+	retryAfterHeader = "Retry-After"
+)
+
+// Limiter 限流器
+type Limiter interface {
+	// Allow 消耗 key 的一次配额，配额不足时返回 false 和建议的重试等待时间
+	Allow(ctx context.Context, key string) (allowed bool, retryAfter time.Duration, err error)
+}
+
+// KeyFunc 返回请求的限流 key，返回空字符串时不限流
+type KeyFunc func(ctx context.Context) string
+
+// ByUser 按用户限流，没有 Claims 时按客户端IP限流
+func ByUser(ctx context.Context) string {
+	if claims, ok := authWare.FromContext(ctx); ok && claims != nil && claims.UserID != 0 {
+		return "user:" + strconv.FormatUint(uint64(claims.UserID), 10)
+	}
+	return ByIP(ctx)
+}
+
+// ByTenant 按租户限流，没有租户时按客户端IP限流
+func ByTenant(ctx context.Context) string {
+	if claims, ok := authWare.FromContext(ctx); ok && claims != nil && claims.TenantID != 0 {
+		return "tenant:" + strconv.FormatUint(uint64(claims.TenantID), 10)
+	}
+	return ByIP(ctx)
+}
+
+// ByIP 按客户端IP限流
+//
+// 默认使用连接的对端地址（HTTP 的 RemoteAddr、gRPC 的 peer），不信任客户端可以伪造的
+// X-Forwarded-For 和 X-Real-IP；部署在反向代理之后时通过 WithTrustedProxies 配置代理地址
+func ByIP(ctx context.Context) string {
+	ip, ok := ctx.Value(clientIPKey{}).(string)
+	if !ok {
+		ip = clientIP(ctx, nil)
+	}
+	if ip != "" {
+		return "ip:" + ip
+	}
+	return ""
+}
+
+// clientIPKey Server 解析出的客户端IP在 context 中的 key
+type clientIPKey struct{}
+
+// clientIP 获取客户端IP
+//
+// 对端地址是可信代理时，从右向左读取 X-Forwarded-For，跳过可信代理追加的地址，
+// 第一个不可信的地址即客户端IP；没有 X-Forwarded-For 时读取 X-Real-IP
+func clientIP(ctx context.Context, trusted []netip.Prefix) string {
+	var remote string
+	tr, hasTransport := transport.FromServerContext(ctx)
+	if hasTransport {
+		if ht, ok := tr.(khttp.Transporter); ok && ht.Request() != nil {
+			remote = hostOnly(ht.Request().RemoteAddr)
+		}
+	}
+	if remote == "" {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			remote = hostOnly(p.Addr.String())
+		}
+	}
+	if !hasTransport || !isTrusted(remote, trusted) {
+		return remote
+	}
+
+	header := tr.RequestHeader()
+	if xff := header.Get("X-Forwarded-For"); xff != "" {
+		hops := strings.Split(xff, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if hop == "" {
+				continue
+			}
+			if !isTrusted(hop, trusted) {
+				return hop
+			}
+			remote = hop
+		}
+		return remote
+	}
+	if ip := strings.TrimSpace(header.Get("X-Real-IP")); ip != "" {
+		return ip
+	}
+	return remote
+}
+
+// isTrusted 判断地址是否属于可信代理
+func isTrusted(ip string, trusted []netip.Prefix) bool {
+	if len(trusted) == 0 {
+		return false
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTrustedProxies 解析IP或CIDR形式的代理地址，返回无法解析的项
+func parseTrustedProxies(proxies []string) ([]netip.Prefix, []string) {
+	var prefixes []netip.Prefix
+	var invalid []string
+	for _, proxy := range proxies {
+		if prefix, err := netip.ParsePrefix(proxy); err == nil {
+			prefixes = append(prefixes, prefix.Masked())
+		} else if addr, err := netip.ParseAddr(proxy); err == nil {
+			addr = addr.Unmap()
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+		} else {
+			invalid = append(invalid, proxy)
+		}
+	}
+	return prefixes, invalid
+}
+
+// hostOnly 去掉地址中的端口
+func hostOnly(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+type options struct {
+	keyFunc        KeyFunc
+	logger         log.Logger
+	trustedProxies []string
+}
+
+// Option 限流中间件选项
+type Option func(*options)
+
+// WithKeyFunc 设置限流 key，默认 ByUser
+func WithKeyFunc(fn KeyFunc) Option {
+	return func(o *options) {
+		o.keyFunc = fn
+	}
+}
+
+// WithTrustedProxies 设置可信的反向代理（网关、负载均衡）地址，支持IP和CIDR，如 "10.0.0.0/8"
+//
+// 只有请求来自可信代理时才读取 X-Forwarded-For 和 X-Real-IP，取最右侧第一个不是可信代理的地址，
+// 客户端自行添加的地址位于左侧，无法绕过按IP限流。无法解析的地址会被忽略并记录错误日志。
+func WithTrustedProxies(proxies ...string) Option {
+	return func(o *options) {
+		o.trustedProxies = append(o.trustedProxies, proxies...)
+	}
+}
+
+// WithLogger 设置记录限流器错误的日志，默认使用全局日志
+func WithLogger(logger log.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// Server 限流中间件
+//
+// 配额不足时返回 429 TOO_MANY_REQUESTS，并在 Retry-After 响应头和错误 metadata 的
+// retry_after 中给出建议的重试等待秒数。限流器出错（如 Redis 不可用）时放行请求并记录日志，
+// 避免限流组件故障导致服务不可用。
+// 按用户或租户限流时需要放在认证中间件之后。
+func Server(limiter Limiter, opts ...Option) middleware.Middleware {
+	o := &options{keyFunc: ByUser, logger: log.GetLogger()}
+	for _, opt := range opts {
+		opt(o)
+	}
+	helper := log.NewHelper(o.logger)
+	trusted, invalid := parseTrustedProxies(o.trustedProxies)
+	if len(invalid) > 0 {
+		helper.Errorf("忽略无法解析的可信代理地址: %v", invalid)
+	}
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			key := o.keyFunc(context.WithValue(ctx, clientIPKey{}, clientIP(ctx, trusted)))
+			if key == "" {
+				return handler(ctx, req)
+			}
+
+			allowed, retryAfter, err := limiter.Allow(ctx, key)
+			if err != nil {
+				helper.WithContext(ctx).Errorf("限流器出错，放行请求: key=%s, error=%v", key, err)
+				return handler(ctx, req)
+			}
+			if !allowed {
+				seconds := strconv.Itoa(int(math.Ceil(retryAfter.Seconds())))
+				if tr, ok := transport.FromServerContext(ctx); ok {
+					tr.ReplyHeader().Set(retryAfterHeader, seconds)
+				}
+				return nil, errors.New(429, Reason, "请求过于频繁，请稍后重试").
+					WithMetadata(map[string]string{"retry_after": seconds})
+			}
+			return handler(ctx, req)
+		}
+	}
+}
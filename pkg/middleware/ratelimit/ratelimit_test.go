@@ -0,0 +1,120 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	authWare "github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/middleware/internal/mwtest"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/peer"
+)
+
+func okHandler(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+
+func TestServer_MemoryLimiter(t *testing.T) {
+	handler := Server(NewMemoryLimiter(1, 2))(okHandler)
+
+	user := authWare.NewContext(context.Background(), &authWare.Claims{UserID: 42})
+	for i := 0; i < 2; i++ {
+		_, err := handler(user, nil)
+		assert.NoError(t, err)
+	}
+
+	tr := mwtest.NewTransport(transport.KindHTTP, mwtest.DefaultOperation)
+	_, err := handler(transport.NewServerContext(user, tr), nil)
+	assert.Equal(t, 429, errors.Code(err))
+	assert.Equal(t, Reason, errors.Reason(err))
+	assert.Equal(t, "1", tr.Reply.Get("Retry-After"))
+
+	// 其他用户不受影响
+	_, err = handler(authWare.NewContext(context.Background(), &authWare.Claims{UserID: 43}), nil)
+	assert.NoError(t, err)
+}
+
+func TestKeyFuncs(t *testing.T) {
+	tr := mwtest.NewTransport(transport.KindHTTP, mwtest.DefaultOperation)
+	tr.Request.Set("X-Forwarded-For", "203.0.113.7, 10.0.0.1")
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 5000}})
+	ctx = transport.NewServerContext(ctx, tr)
+
+	// 没有配置可信代理时不读取 X-Forwarded-For
+	assert.Equal(t, "ip:10.0.0.2", ByUser(ctx))
+	assert.Equal(t, "ip:10.0.0.2", ByTenant(ctx))
+
+	ctx = authWare.NewContext(ctx, &authWare.Claims{UserID: 42, TenantID: 7})
+	assert.Equal(t, "user:42", ByUser(ctx))
+	assert.Equal(t, "tenant:7", ByTenant(ctx))
+
+	assert.Equal(t, "", ByIP(context.Background()))
+}
+
+func TestServer_TrustedProxies(t *testing.T) {
+	var keys []string
+	limiter := limiterFunc(func(_ context.Context, key string) (bool, time.Duration, error) {
+		keys = append(keys, key)
+		return true, 0, nil
+	})
+	handler := Server(limiter, WithKeyFunc(ByIP), WithTrustedProxies("10.0.0.0/8", "192.168.1.1", "bad"))(okHandler)
+	call := func(remote string, header map[string]string) string {
+		tr := mwtest.NewTransport(transport.KindHTTP, mwtest.DefaultOperation)
+		for k, v := range header {
+			tr.Request.Set(k, v)
+		}
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(remote), Port: 5000}})
+		_, err := handler(transport.NewServerContext(ctx, tr), nil)
+		require.NoError(t, err)
+		return keys[len(keys)-1]
+	}
+
+	// 客户端伪造的最左侧地址被忽略，取最右侧第一个不可信的地址
+	assert.Equal(t, "ip:203.0.113.7", call("10.0.0.2", map[string]string{"X-Forwarded-For": "1.2.3.4, 203.0.113.7, 192.168.1.1"}))
+	assert.Equal(t, "ip:198.51.100.1", call("192.168.1.1", map[string]string{"X-Real-IP": "198.51.100.1"}))
+	// 全部是可信代理时取最左侧
+	assert.Equal(t, "ip:10.1.1.1", call("10.0.0.2", map[string]string{"X-Forwarded-For": "10.1.1.1, 10.2.2.2"}))
+	// 不是来自可信代理时使用对端地址
+	assert.Equal(t, "ip:203.0.113.9", call("203.0.113.9", map[string]string{"X-Forwarded-For": "1.2.3.4"}))
+}
+
+// limiterFunc 函数形式的 Limiter
+type limiterFunc func(ctx context.Context, key string) (bool, time.Duration, error)
+
+func (f limiterFunc) Allow(ctx context.Context, key string) (bool, time.Duration, error) {
+	return f(ctx, key)
+}
+
+func TestRedisLimiter(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+
+	limiter := NewRedisLimiter(rdb, 2, time.Second).WithPrefix("test:")
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		allowed, _, err := limiter.Allow(ctx, "user:42")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	}
+	allowed, retryAfter, err := limiter.Allow(ctx, "user:42")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Greater(t, retryAfter, time.Duration(0))
+	assert.LessOrEqual(t, retryAfter, time.Second)
+	assert.True(t, mr.Exists("test:user:42"))
+
+	allowed, _, err = limiter.Allow(ctx, "user:43")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	// Redis 不可用时放行
+	mr.Close()
+	_, err = Server(limiter)(okHandler)(authWare.NewContext(ctx, &authWare.Claims{UserID: 42}), nil)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,136 @@
+// Package recovery 捕获 panic 的服务端中间件
+//
+// 与 kratos recovery 相比，额外记录结构化的堆栈日志、累加 panic 指标，
+// 并调用可选的上报函数（如 Sentry），panic 统一转换为 SYSTEM_ERROR。
+//
+// 使用示例:
+//
+//	http.Middleware(
+//	    recovery.Recovery(
+//	        recovery.WithLogger(logger),
+//	        recovery.WithReporter(func(ctx context.Context, v interface{}, stack []byte) {
+//	            sentry.CurrentHub().Recover(v)
+//	        }),
+//	    ),
+//	)
+package recovery
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// meterName panic 指标使用的 Meter 名称
+	meterName = "github.com/heyinLab/common/pkg/middleware/recovery"
+
+	// maxStackSize 记录的堆栈最大字节数
+	maxStackSize = 64 << 10
+)
+
+// Reporter 上报 panic，stack 为发生 panic 的 goroutine 的堆栈
+type Reporter func(ctx context.Context, v interface{}, stack []byte)
+
+type options struct {
+	logger        log.Logger
+	reporter      Reporter
+	meterProvider metric.MeterProvider
+}
+
+// Option 恢复中间件选项
+type Option func(*options)
+
+// WithLogger 设置记录 panic 的日志，默认使用全局日志
+func WithLogger(logger log.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// WithReporter 设置 panic 上报函数，上报函数自身 panic 时会被忽略
+func WithReporter(reporter Reporter) Option {
+	return func(o *options) {
+		o.reporter = reporter
+	}
+}
+
+// WithMeterProvider 设置 panic 指标使用的 MeterProvider，默认使用全局的 otel.GetMeterProvider()
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(o *options) {
+		o.meterProvider = mp
+	}
+}
+
+// Recovery 捕获 panic 的服务端中间件
+//
+// panic 时以 ERROR 级别记录 operation、panic 值和堆栈，按 operation 累加 server_panics_total 指标，
+// 调用上报函数，并返回 500 SYSTEM_ERROR，不会把 panic 信息暴露给调用方
+func Recovery(opts ...Option) middleware.Middleware {
+	o := &options{logger: log.GetLogger()}
+	for _, opt := range opts {
+		opt(o)
+	}
+	mp := o.meterProvider
+	if mp == nil {
+		mp = otel.GetMeterProvider()
+	}
+	panics, err := mp.Meter(meterName).Int64Counter("server_panics_total",
+		metric.WithDescription("The total number of recovered panics"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			defer func() {
+				v := recover()
+				if v == nil {
+					return
+				}
+
+				stack := make([]byte, maxStackSize)
+				stack = stack[:runtime.Stack(stack, false)]
+
+				var operation string
+				if tr, ok := transport.FromServerContext(ctx); ok {
+					operation = tr.Operation()
+				}
+
+				_ = log.WithContext(ctx, o.logger).Log(log.LevelError,
+					"msg", "panic recovered",
+					"operation", operation,
+					"panic", fmt.Sprintf("%v", v),
+					"stack", string(stack),
+				)
+				panics.Add(ctx, 1, metric.WithAttributes(attribute.String("operation", operation)))
+				if o.reporter != nil {
+					report(ctx, o.reporter, v, stack)
+				}
+
+				reply = nil
+				err = errors.New(
+					int(businessErrors.ErrSystemError.HttpCode),
+					businessErrors.ErrSystemError.Type,
+					businessErrors.ErrSystemError.Message,
+				)
+			}()
+			return handler(ctx, req)
+		}
+	}
+}
+
+// report 调用上报函数，忽略上报函数自身的 panic
+func report(ctx context.Context, reporter Reporter, v interface{}, stack []byte) {
+	defer func() { _ = recover() }()
+	reporter(ctx, v, stack)
+}
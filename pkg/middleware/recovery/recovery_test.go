@@ -0,0 +1,67 @@
+package recovery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// recordLogger 记录最后一条日志
+type recordLogger struct {
+	fields map[string]interface{}
+}
+
+func (l *recordLogger) Log(_ log.Level, keyvals ...interface{}) error {
+	l.fields = map[string]interface{}{}
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		l.fields[keyvals[i].(string)] = keyvals[i+1]
+	}
+	return nil
+}
+
+func TestRecovery(t *testing.T) {
+	logger := &recordLogger{}
+	reader := sdkmetric.NewManualReader()
+	var reported interface{}
+	var reportedStack []byte
+
+	handler := Recovery(
+		WithLogger(logger),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+		WithReporter(func(_ context.Context, v interface{}, stack []byte) {
+			reported, reportedStack = v, stack
+			panic("reporter broken")
+		}),
+	)(func(context.Context, interface{}) (interface{}, error) {
+		panic("boom")
+	})
+
+	reply, err := handler(context.Background(), nil)
+	assert.Nil(t, reply)
+	assert.Equal(t, 500, errors.Code(err))
+	assert.Equal(t, "SYSTEM_ERROR", errors.Reason(err))
+
+	assert.Equal(t, "boom", reported)
+	assert.Contains(t, string(reportedStack), "TestRecovery")
+	assert.Equal(t, "boom", logger.fields["panic"])
+	assert.Contains(t, logger.fields["stack"], "TestRecovery")
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	sum := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+	assert.Equal(t, int64(1), sum.DataPoints[0].Value)
+
+	// 没有 panic 时原样返回
+	reply, err = Recovery(WithLogger(logger))(func(context.Context, interface{}) (interface{}, error) {
+		return "ok", nil
+	})(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", reply)
+}
@@ -0,0 +1,166 @@
+// Package region 多区域部署的区域校验和就近路由
+//
+// 请求的区域来自 Claims.RegionName 或 X-Region-Name 请求头:
+//   - Server 校验请求区域属于当前服务部署的区域，拒绝发往错误区域的请求
+//   - NodeFilter 调用下游服务时只选择同区域的实例，区域由注册中心元数据中的 region 标识
+//   - Client 把请求区域继续传递给下游服务
+//
+// 实例注册时在元数据中带上区域:
+//
+//	metadata:
+//	  region: cn-east
+//
+// 使用示例:
+//
+//	grpc.Middleware(
+//	    auth.Server(true),
+//	    region.Server([]string{"cn-east"}),
+//	)
+//	conn, err := grpc.DialInsecure(ctx,
+//	    grpc.WithEndpoint("discovery:///user-service"),
+//	    grpc.WithDiscovery(discovery),
+//	    grpc.WithNodeFilter(region.NodeFilter("cn-east")),
+//	    grpc.WithMiddleware(region.Client()),
+//	)
+package region
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+	authWare "github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/middleware/common"
+)
+
+// MetadataKey 注册中心实例元数据中表示区域的 key
+const MetadataKey = "region"
+
+type regionKey struct{}
+
+// NewContext 将请求区域存入 context
+func NewContext(ctx context.Context, region string) context.Context {
+	return context.WithValue(ctx, regionKey{}, region)
+}
+
+// FromContext 获取请求区域，依次读取 Server 写入的区域和 Claims.RegionName，都没有时返回空字符串
+func FromContext(ctx context.Context) string {
+	if region, ok := ctx.Value(regionKey{}).(string); ok && region != "" {
+		return region
+	}
+	if claims, ok := authWare.FromContext(ctx); ok && claims != nil {
+		return claims.RegionName
+	}
+	return ""
+}
+
+type options struct {
+	required bool
+}
+
+// Option 区域校验选项
+type Option func(*options)
+
+// WithRequired 要求请求必须带有区域，默认没有区域的请求直接放行
+func WithRequired() Option {
+	return func(o *options) {
+		o.required = true
+	}
+}
+
+// Server 区域校验中间件
+//
+// 请求区域优先使用 Claims.RegionName，没有时读取 X-Region-Name 请求头，比较时不区分大小写。
+// 区域不在 regions 中时返回 403 ACCESS_FORBIDDEN，metadata 的 region 为请求的区域，
+// 网关可以据此把请求转发到正确的区域。校验通过后区域写入 context，供 NodeFilter 和 Client 使用。
+func Server(regions []string, opts ...Option) middleware.Middleware {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			region := FromContext(ctx)
+			if region == "" {
+				if tr, ok := transport.FromServerContext(ctx); ok {
+					region = strings.TrimSpace(tr.RequestHeader().Get(common.REGIONNAME))
+				}
+			}
+
+			if region == "" {
+				if o.required {
+					return nil, errors.New(
+						int(businessErrors.ErrAccessForbidden.HttpCode),
+						businessErrors.ErrAccessForbidden.Type,
+						"缺少区域信息",
+					)
+				}
+				return handler(ctx, req)
+			}
+			if !contains(regions, region) {
+				return nil, errors.New(
+					int(businessErrors.ErrAccessForbidden.HttpCode),
+					businessErrors.ErrAccessForbidden.Type,
+					"请求的区域不由当前服务处理",
+				).WithMetadata(map[string]string{"region": region})
+			}
+			return handler(NewContext(ctx, region), req)
+		}
+	}
+}
+
+// Client 把请求区域通过 X-Region-Name 请求头传递给下游服务，context 中没有区域时原样调用
+func Client() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			if region := FromContext(ctx); region != "" {
+				if tr, ok := transport.FromClientContext(ctx); ok {
+					tr.RequestHeader().Set(common.REGIONNAME, region)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// NodeFilter 就近路由的服务发现节点过滤器
+//
+// 只保留元数据 region 与请求区域相同的实例；请求没有区域时使用 defaultRegion。
+// 没有同区域实例时返回全部实例，避免单个区域故障导致调用失败。
+func NodeFilter(defaultRegion string) selector.NodeFilter {
+	return func(ctx context.Context, nodes []selector.Node) []selector.Node {
+		region := FromContext(ctx)
+		if region == "" {
+			region = defaultRegion
+		}
+		if region == "" {
+			return nodes
+		}
+
+		filtered := make([]selector.Node, 0, len(nodes))
+		for _, n := range nodes {
+			if strings.EqualFold(n.Metadata()[MetadataKey], region) {
+				filtered = append(filtered, n)
+			}
+		}
+		if len(filtered) == 0 {
+			return nodes
+		}
+		return filtered
+	}
+}
+
+// contains 不区分大小写地判断 regions 是否包含 region
+func contains(regions []string, region string) bool {
+	for _, r := range regions {
+		if strings.EqualFold(r, region) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package region
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/go-kratos/kratos/v2/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+	authWare "github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/middleware/internal/mwtest"
+	"github.com/stretchr/testify/assert"
+)
+
+// regionHandler 返回 context 中的区域
+func regionHandler(ctx context.Context, _ interface{}) (interface{}, error) {
+	return FromContext(ctx), nil
+}
+
+func serverContext(region string) context.Context {
+	tr := mwtest.NewTransport(transport.KindGRPC, mwtest.DefaultOperation)
+	if region != "" {
+		tr.Request.Set("X-Region-Name", region)
+	}
+	return transport.NewServerContext(context.Background(), tr)
+}
+
+func TestServer(t *testing.T) {
+	handler := Server([]string{"cn-east", "cn-north"})(regionHandler)
+
+	reply, err := handler(serverContext("CN-East"), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "CN-East", reply)
+
+	_, err = handler(serverContext("us-west"), nil)
+	assert.Equal(t, 403, errors.Code(err))
+	assert.Equal(t, "ACCESS_FORBIDDEN", errors.Reason(err))
+	assert.Equal(t, "us-west", errors.FromError(err).Metadata["region"])
+
+	// Claims 中的区域优先于请求头
+	ctx := authWare.NewContext(serverContext("cn-east"), &authWare.Claims{UserID: 1, RegionName: "us-west"})
+	_, err = handler(ctx, nil)
+	assert.Equal(t, 403, errors.Code(err))
+
+	// 没有区域时默认放行
+	reply, err = handler(serverContext(""), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "", reply)
+
+	_, err = Server([]string{"cn-east"}, WithRequired())(regionHandler)(serverContext(""), nil)
+	assert.Equal(t, 403, errors.Code(err))
+}
+
+func TestClient(t *testing.T) {
+	tr := mwtest.NewTransport(transport.KindGRPC, mwtest.DefaultOperation)
+	ctx := transport.NewClientContext(NewContext(context.Background(), "cn-east"), tr)
+	_, err := Client()(regionHandler)(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "cn-east", tr.Request.Get("X-Region-Name"))
+
+	tr = mwtest.NewTransport(transport.KindGRPC, mwtest.DefaultOperation)
+	_, err = Client()(regionHandler)(transport.NewClientContext(context.Background(), tr), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, tr.Request)
+}
+
+func TestNodeFilter(t *testing.T) {
+	node := func(addr, region string) selector.Node {
+		return selector.NewNode("grpc", addr, &registry.ServiceInstance{
+			ID:       addr,
+			Metadata: map[string]string{MetadataKey: region},
+		})
+	}
+	nodes := []selector.Node{node("a", "cn-east"), node("b", "cn-north"), node("c", "cn-east")}
+	addrs := func(nodes []selector.Node) []string {
+		var out []string
+		for _, n := range nodes {
+			out = append(out, n.Address())
+		}
+		return out
+	}
+	filter := NodeFilter("cn-north")
+
+	assert.Equal(t, []string{"a", "c"}, addrs(filter(NewContext(context.Background(), "cn-east"), nodes)))
+	assert.Equal(t, []string{"a", "c"}, addrs(filter(
+		authWare.NewContext(context.Background(), &authWare.Claims{RegionName: "cn-east"}), nodes)))
+
+	// 没有请求区域时使用默认区域
+	assert.Equal(t, []string{"b"}, addrs(filter(context.Background(), nodes)))
+
+	// 没有同区域实例时不过滤
+	assert.Equal(t, []string{"a", "b", "c"}, addrs(filter(NewContext(context.Background(), "us-west"), nodes)))
+	assert.Equal(t, []string{"a", "b", "c"}, addrs(NodeFilter("")(context.Background(), nodes)))
+}
@@ -0,0 +1,86 @@
+// Package requestid 生成和传递请求ID，作为跨服务排查问题的关联标识
+//
+// 服务端中间件读取上游的 X-Request-ID，没有时生成 UUID，写入 context 和响应头；
+// 客户端中间件把 context 中的请求ID写入下游请求的请求头或 gRPC metadata；
+// Valuer 把请求ID加入日志字段。
+//
+// 使用示例:
+//
+//	logger = log.With(logger, "request.id", requestid.Valuer())
+//	http.Middleware(requestid.Server(), logging.Server(logger))
+//	conn, err := grpc.DialInsecure(ctx, grpc.WithMiddleware(requestid.Client()))
+package requestid
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/google/uuid"
+	"github.com/heyinLab/common/pkg/middleware/common"
+	"google.golang.org/grpc/metadata"
+)
+
+// maxLength 接受的上游请求ID最大长度，过长时重新生成，避免日志被恶意请求头撑大
+const maxLength = 128
+
+// requestIDKey 在 context 中传递请求ID的 key
+type requestIDKey struct{}
+
+// NewContext 将请求ID存入 context
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// FromContext 从 context 中获取请求ID
+func FromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// Valuer 返回 context 中请求ID的日志字段值，没有时为空字符串
+func Valuer() log.Valuer {
+	return func(ctx context.Context) interface{} {
+		id, _ := FromContext(ctx)
+		return id
+	}
+}
+
+// Server 服务端请求ID中间件
+//
+// 读取 X-Request-ID 请求头，缺失或超过 128 个字符时生成 UUID，写入 context 和 X-Request-ID 响应头
+func Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(NewContext(ctx, uuid.NewString()), req)
+			}
+
+			id := tr.RequestHeader().Get(common.REQUESTID)
+			if id == "" || len(id) > maxLength {
+				id = uuid.NewString()
+			}
+			tr.ReplyHeader().Set(common.REQUESTID, id)
+			return handler(NewContext(ctx, id), req)
+		}
+	}
+}
+
+// Client 客户端请求ID中间件，把 context 中的请求ID转发给下游服务
+func Client() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			id, ok := FromContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			if tr, ok := transport.FromClientContext(ctx); ok {
+				tr.RequestHeader().Set(common.REQUESTID, id)
+				return handler(ctx, req)
+			}
+			return handler(metadata.AppendToOutgoingContext(ctx, common.REQUESTID, id), req)
+		}
+	}
+}
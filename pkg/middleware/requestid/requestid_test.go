@@ -0,0 +1,47 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/google/uuid"
+	"github.com/heyinLab/common/pkg/middleware/internal/mwtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerAndClient(t *testing.T) {
+	serve := func(incoming string) (*mwtest.Transport, string) {
+		tr := mwtest.NewTransport(transport.KindHTTP, mwtest.DefaultOperation)
+		if incoming != "" {
+			tr.Request.Set("X-Request-ID", incoming)
+		}
+		var seen string
+		_, err := Server()(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			seen, _ = FromContext(ctx)
+			return nil, nil
+		})(transport.NewServerContext(context.Background(), tr), nil)
+		assert.NoError(t, err)
+		return tr, seen
+	}
+
+	tr, id := serve("req-1")
+	assert.Equal(t, "req-1", id)
+	assert.Equal(t, "req-1", tr.Reply.Get("X-Request-ID"))
+
+	tr, id = serve("")
+	assert.NoError(t, uuid.Validate(id))
+	assert.Equal(t, id, tr.Reply.Get("X-Request-ID"))
+
+	_, id = serve(strings.Repeat("x", maxLength+1))
+	assert.NoError(t, uuid.Validate(id))
+
+	out := mwtest.NewTransport(transport.KindHTTP, mwtest.DefaultOperation)
+	ctx := transport.NewClientContext(NewContext(context.Background(), "req-1"), out)
+	_, err := Client()(func(context.Context, interface{}) (interface{}, error) { return nil, nil })(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "req-1", out.Request.Get("X-Request-ID"))
+
+	assert.Equal(t, "req-1", Valuer()(NewContext(context.Background(), "req-1")))
+}
@@ -0,0 +1,90 @@
+// Package tenant 校验请求中的租户与当前用户所属租户一致，防止越权访问其他租户的数据
+package tenant
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+	authWare "github.com/heyinLab/common/pkg/middleware/auth"
+)
+
+// Resolver 从请求中取出目标租户ID，请求不涉及租户时返回 false
+type Resolver func(ctx context.Context, req interface{}) (tenantID uint32, ok bool)
+
+// tenantGetter 带 tenant_id 字段的 protobuf 消息
+type tenantGetter interface {
+	GetTenantId() uint32
+}
+
+// FromRequest 读取请求消息的 tenant_id 字段，字段为 0 或不存在时视为不涉及租户
+func FromRequest(_ context.Context, req interface{}) (uint32, bool) {
+	if g, ok := req.(tenantGetter); ok && g.GetTenantId() != 0 {
+		return g.GetTenantId(), true
+	}
+	return 0, false
+}
+
+type options struct {
+	resolver Resolver
+	bypass   func(ctx context.Context, claims *authWare.Claims) bool
+}
+
+// Option 租户校验选项
+type Option func(*options)
+
+// WithResolver 设置目标租户的解析方式，默认 FromRequest
+func WithResolver(resolver Resolver) Option {
+	return func(o *options) {
+		o.resolver = resolver
+	}
+}
+
+// WithBypass 设置允许跨租户访问的判断，如平台管理员，返回 true 时跳过校验
+func WithBypass(fn func(ctx context.Context, claims *authWare.Claims) bool) Option {
+	return func(o *options) {
+		o.bypass = fn
+	}
+}
+
+// Server 租户隔离中间件
+//
+// 比较请求中的租户ID与 Claims.TenantID，不一致时返回 403 ACCESS_FORBIDDEN。
+// 请求不涉及租户时直接放行；请求涉及租户但 context 中没有 Claims 或 Claims 没有租户时拒绝。
+// 需要放在认证中间件之后。
+//
+// 使用示例:
+//
+//	grpc.Middleware(
+//	    auth.Server(true),
+//	    tenant.Server(),
+//	)
+func Server(opts ...Option) middleware.Middleware {
+	o := &options{resolver: FromRequest}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			tenantID, ok := o.resolver(ctx, req)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			claims, _ := authWare.FromContext(ctx)
+			if claims != nil && o.bypass != nil && o.bypass(ctx, claims) {
+				return handler(ctx, req)
+			}
+			if claims == nil || claims.TenantID != tenantID {
+				return nil, errors.New(
+					int(businessErrors.ErrAccessForbidden.HttpCode),
+					businessErrors.ErrAccessForbidden.Type,
+					"无权访问其他租户的数据",
+				)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
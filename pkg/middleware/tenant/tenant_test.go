@@ -0,0 +1,47 @@
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	authWare "github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+func okHandler(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+
+func TestServer(t *testing.T) {
+	handler := Server()(okHandler)
+	ctx := authWare.NewContext(context.Background(), &authWare.Claims{UserID: 1, TenantID: 7})
+
+	_, err := handler(ctx, &v1.InternalGetFileRequest{TenantId: 7})
+	assert.NoError(t, err)
+
+	_, err = handler(ctx, &v1.InternalGetFileRequest{TenantId: 8})
+	assert.Equal(t, 403, errors.Code(err))
+	assert.Equal(t, "ACCESS_FORBIDDEN", errors.Reason(err))
+
+	// 请求不涉及租户
+	_, err = handler(ctx, &v1.InternalGetFileRequest{})
+	assert.NoError(t, err)
+	_, err = handler(ctx, "plain")
+	assert.NoError(t, err)
+
+	// 没有 Claims
+	_, err = handler(context.Background(), &v1.InternalGetFileRequest{TenantId: 7})
+	assert.Equal(t, 403, errors.Code(err))
+}
+
+func TestServer_ResolverAndBypass(t *testing.T) {
+	handler := Server(
+		WithResolver(func(context.Context, interface{}) (uint32, bool) { return 9, true }),
+		WithBypass(func(_ context.Context, claims *authWare.Claims) bool { return claims.UserID == 1 }),
+	)(okHandler)
+
+	_, err := handler(authWare.NewContext(context.Background(), &authWare.Claims{UserID: 1}), nil)
+	assert.NoError(t, err)
+	_, err = handler(authWare.NewContext(context.Background(), &authWare.Claims{UserID: 2, TenantID: 7}), nil)
+	assert.Equal(t, 403, errors.Code(err))
+}
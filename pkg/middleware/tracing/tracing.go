@@ -0,0 +1,134 @@
+// Package tracing 链路追踪的初始化和中间件
+//
+// 在 kratos tracing 中间件的基础上，把 Claims 中的用户和租户写入 span 属性，
+// 便于在链路追踪系统中按用户或租户检索请求。
+//
+// 使用示例:
+//
+//	shutdown, err := tracing.Setup(ctx, tracing.Config{
+//	    ServiceName: "user-server",
+//	    Endpoint:    "otel-collector:4317",
+//	    Insecure:    true,
+//	    SampleRatio: 0.1,
+//	})
+//	if err != nil {
+//	    panic(err)
+//	}
+//	defer shutdown(context.Background())
+//
+//	http.Middleware(tracing.Server(), auth.Server(true), tracing.Annotate())
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	authWare "github.com/heyinLab/common/pkg/middleware/auth"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 写入 span 的 Claims 属性
+const (
+	AttrUserID   = attribute.Key("user_id")
+	AttrTenantID = attribute.Key("tenant_id")
+	AttrRegion   = attribute.Key("region_name")
+)
+
+// Config 链路追踪配置
+type Config struct {
+	// 服务名称（必填）
+	ServiceName string
+	// 服务版本
+	ServiceVersion string
+	// 实例ID
+	InstanceID string
+	// OTLP gRPC 地址，为空时只传播上下文不上报
+	Endpoint string
+	// 不使用 TLS 连接
+	Insecure bool
+	// 采样率 0~1，0 表示默认值 1；已被上游采样的请求始终采样
+	SampleRatio float64
+}
+
+// Setup 设置全局的上下文传播方式和 TracerProvider
+//
+// 返回的 shutdown 在服务退出时调用，刷新尚未上报的 span
+func Setup(ctx context.Context, c Config) (shutdown func(context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if c.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return nil, fmt.Errorf("采样率必须在 0~1 之间")
+	}
+	ratio := c.SampleRatio
+	if ratio == 0 {
+		ratio = 1
+	}
+
+	exporterOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(c.Endpoint)}
+	if c.Insecure {
+		exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, exporterOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("创建链路追踪导出器失败: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", c.ServiceName),
+			attribute.String("service.version", c.ServiceVersion),
+			attribute.String("service.instance.id", c.InstanceID),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Server 服务端链路追踪中间件
+//
+// 创建服务端 span，context 中已有 Claims（如经过 ExtractClaims）时写入用户和租户属性。
+// Claims 由之后的认证中间件写入时，在认证中间件之后再加上 Annotate。
+func Server(opts ...tracing.Option) middleware.Middleware {
+	return middleware.Chain(tracing.Server(opts...), Annotate())
+}
+
+// Client 客户端链路追踪中间件，创建客户端 span 并写入 context 中 Claims 的用户和租户属性
+func Client(opts ...tracing.Option) middleware.Middleware {
+	return middleware.Chain(tracing.Client(opts...), Annotate())
+}
+
+// Annotate 把 context 中 Claims 的用户、租户和区域写入当前 span
+func Annotate() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			span := trace.SpanFromContext(ctx)
+			if claims, ok := authWare.FromContext(ctx); ok && claims != nil && span.IsRecording() {
+				attrs := []attribute.KeyValue{
+					AttrUserID.Int64(int64(claims.UserID)),
+					AttrTenantID.Int64(int64(claims.TenantID)),
+				}
+				if claims.RegionName != "" {
+					attrs = append(attrs, AttrRegion.String(claims.RegionName))
+				}
+				span.SetAttributes(attrs...)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	authWare "github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestAnnotate(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	ctx, span := provider.Tracer("test").Start(context.Background(), "op")
+	ctx = authWare.NewContext(ctx, &authWare.Claims{UserID: 42, TenantID: 7, RegionName: "cn"})
+	_, err := Annotate()(func(context.Context, interface{}) (interface{}, error) { return nil, nil })(ctx, nil)
+	assert.NoError(t, err)
+	span.End()
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 1)
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		AttrUserID.Int64(42),
+		AttrTenantID.Int64(7),
+		AttrRegion.String("cn"),
+	}, spans[0].Attributes())
+}
+
+func TestSetup_NoEndpoint(t *testing.T) {
+	shutdown, err := Setup(context.Background(), Config{ServiceName: "demo"})
+	assert.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+
+	_, err = Setup(context.Background(), Config{ServiceName: "demo", Endpoint: "localhost:4317", SampleRatio: 2})
+	assert.Error(t, err)
+}
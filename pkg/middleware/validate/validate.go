@@ -0,0 +1,119 @@
+// Package validate 使用 protoc-gen-validate 生成的校验方法校验请求参数
+//
+// 请求消息实现了 ValidateAll 或 Validate 时自动校验，校验失败返回 400 INVALID_PARAMETER，
+// 错误 metadata 中按字段路径给出每个字段的失败原因，业务代码不再需要手写非空检查。
+//
+// 使用示例:
+//
+//	grpc.Middleware(validate.Server())
+package validate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+)
+
+// validator protoc-gen-validate 生成的校验方法，遇到第一个错误即返回
+type validator interface {
+	Validate() error
+}
+
+// allValidator protoc-gen-validate 生成的校验方法，返回所有字段的错误
+type allValidator interface {
+	ValidateAll() error
+}
+
+// fieldError protoc-gen-validate 生成的字段校验错误
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiError protoc-gen-validate 生成的多字段校验错误
+type multiError interface {
+	AllErrors() []error
+}
+
+// Server 参数校验中间件
+//
+// 优先使用 ValidateAll 一次返回所有字段的错误，没有时使用 Validate
+func Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			var verr error
+			switch v := req.(type) {
+			case allValidator:
+				verr = v.ValidateAll()
+			case validator:
+				verr = v.Validate()
+			}
+			if verr != nil {
+				return nil, FromError(verr)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// FromError 把校验错误转换为 400 INVALID_PARAMETER，metadata 为字段路径到失败原因的映射
+func FromError(err error) *errors.Error {
+	details := map[string]string{}
+	collect(err, "", details)
+
+	message := businessErrors.ErrInvalidParameter.Message
+	if len(details) > 0 {
+		fields := make([]string, 0, len(details))
+		for field := range details {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		message = fmt.Sprintf("%s: %s %s", message, fields[0], details[fields[0]])
+	}
+
+	return errors.New(
+		int(businessErrors.ErrInvalidParameter.HttpCode),
+		businessErrors.ErrInvalidParameter.Type,
+		message,
+	).WithMetadata(details)
+}
+
+// collect 展开多字段错误和嵌套消息错误，prefix 为上层字段路径
+func collect(err error, prefix string, details map[string]string) {
+	if m, ok := err.(multiError); ok {
+		for _, e := range m.AllErrors() {
+			collect(e, prefix, details)
+		}
+		return
+	}
+
+	fe, ok := err.(fieldError)
+	if !ok {
+		if err != nil && prefix != "" {
+			details[prefix] = err.Error()
+		}
+		return
+	}
+
+	path := fe.Field()
+	if prefix != "" {
+		path = prefix + "." + path
+	}
+	// 嵌套消息校验失败时原因在 Cause 中
+	if cause := fe.Cause(); cause != nil {
+		if _, nested := cause.(fieldError); nested {
+			collect(cause, path, details)
+			return
+		}
+		if _, nested := cause.(multiError); nested {
+			collect(cause, path, details)
+			return
+		}
+	}
+	details[path] = fe.Reason()
+}
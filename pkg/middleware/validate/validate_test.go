@@ -0,0 +1,60 @@
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// testFieldError 模拟 protoc-gen-validate 生成的字段错误
+type testFieldError struct {
+	field  string
+	reason string
+	cause  error
+}
+
+func (e testFieldError) Field() string  { return e.field }
+func (e testFieldError) Reason() string { return e.reason }
+func (e testFieldError) Cause() error   { return e.cause }
+func (e testFieldError) Error() string  { return e.field + ": " + e.reason }
+
+// testMultiError 模拟 protoc-gen-validate 生成的多字段错误
+type testMultiError []error
+
+func (m testMultiError) Error() string      { return "multiple errors" }
+func (m testMultiError) AllErrors() []error { return m }
+
+type createRequest struct {
+	err error
+}
+
+func (r *createRequest) ValidateAll() error { return r.err }
+
+func TestServer(t *testing.T) {
+	handler := Server()(func(context.Context, interface{}) (interface{}, error) { return "ok", nil })
+
+	reply, err := handler(context.Background(), &createRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", reply)
+
+	_, err = handler(context.Background(), &createRequest{err: testMultiError{
+		testFieldError{field: "Name", reason: "value length must be at least 1 runes"},
+		testFieldError{field: "Address", reason: "embedded message failed validation", cause: testMultiError{
+			testFieldError{field: "City", reason: "value is required"},
+		}},
+	}})
+	se := errors.FromError(err)
+	assert.Equal(t, int32(400), se.Code)
+	assert.Equal(t, "INVALID_PARAMETER", se.Reason)
+	assert.Equal(t, map[string]string{
+		"Name":         "value length must be at least 1 runes",
+		"Address.City": "value is required",
+	}, se.Metadata)
+	assert.Equal(t, "参数错误: Address.City value is required", se.Message)
+
+	// 没有校验方法的请求直接放行
+	_, err = handler(context.Background(), "plain")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,180 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+)
+
+const (
+	// maxArchiveFiles 单个打包任务最多包含的文件数
+	maxArchiveFiles = 1000
+
+	// defaultArchivePollInterval 等待打包完成时默认的查询间隔
+	defaultArchivePollInterval = time.Second
+)
+
+// ArchiveFormat 打包格式
+type ArchiveFormat string
+
+const (
+	// ArchiveZip zip 格式（默认）
+	ArchiveZip ArchiveFormat = "zip"
+	// ArchiveTarGz tar.gz 格式
+	ArchiveTarGz ArchiveFormat = "tar.gz"
+)
+
+// 打包任务状态
+const (
+	ArchiveStatusPending    = "pending"
+	ArchiveStatusProcessing = "processing"
+	ArchiveStatusCompleted  = "completed"
+	ArchiveStatusFailed     = "failed"
+)
+
+// CreateArchiveOptions 创建打包下载任务的选项
+type CreateArchiveOptions struct {
+	// 打包文件名（不含扩展名），默认为任务ID
+	Filename string
+	// URL有效期（秒），默认3600
+	ExpiresIn int64
+}
+
+// CreateArchive 创建打包下载任务，将多个文件打包为一个压缩包
+//
+// 资源服务异步打包，返回的任务ID用于 GetArchive 和 WaitForArchive 查询；
+// 文件较少时可能直接完成（Status 为 completed），此时 DownloadUrl 即可使用。
+// 不存在或无权访问的文件不会打包，记录在 FailedIds 中
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - fileIDs: 文件ID列表（最多1000个）
+//   - format: 打包格式，为空时使用 zip
+//   - opts: 可选参数
+//
+// 返回:
+//   - *v1.InternalArchiveInfo: 任务信息
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	archive, err := client.CreateArchive(ctx, tenantID, attachmentIDs, resource.ArchiveZip,
+//	    &resource.CreateArchiveOptions{Filename: "工单-10086-附件"})
+//	if err != nil {
+//	    return err
+//	}
+//	archive, err = client.WaitForArchive(ctx, tenantID, archive.JobId, 0)
+//	if err != nil {
+//	    return err
+//	}
+//	return archive.DownloadUrl, nil
+func (c *ResourceClient) CreateArchive(ctx context.Context, tenantID uint32, fileIDs []string, format ArchiveFormat, opts *CreateArchiveOptions) (*v1.InternalArchiveInfo, error) {
+	if len(fileIDs) == 0 {
+		return nil, fmt.Errorf("文件ID列表不能为空")
+	}
+	if len(fileIDs) > maxArchiveFiles {
+		return nil, fmt.Errorf("文件数量不能超过%d个，当前: %d", maxArchiveFiles, len(fileIDs))
+	}
+	switch format {
+	case "", ArchiveZip, ArchiveTarGz:
+	default:
+		return nil, fmt.Errorf("不支持的打包格式: %s", format)
+	}
+
+	req := &v1.InternalCreateArchiveRequest{
+		TenantId: tenantID,
+		FileIds:  fileIDs,
+		Format:   string(format),
+	}
+	if opts != nil {
+		req.Filename = opts.Filename
+		req.ExpiresIn = opts.ExpiresIn
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.InternalCreateArchive(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("创建打包下载任务失败: tenant_id=%d, count=%d, format=%s, error=%v", tenantID, len(fileIDs), format, err)
+		return nil, err
+	}
+
+	return resp.Archive, nil
+}
+
+// GetArchive 查询打包下载任务
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - jobID: 任务ID
+//   - expiresIn: 下载URL有效期（秒），0 表示默认3600
+//
+// 返回:
+//   - *v1.InternalArchiveInfo: 任务信息，完成后包含新生成的下载URL
+//   - error: 错误信息
+func (c *ResourceClient) GetArchive(ctx context.Context, tenantID uint32, jobID string, expiresIn int64) (*v1.InternalArchiveInfo, error) {
+	if jobID == "" {
+		return nil, fmt.Errorf("任务ID不能为空")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.InternalGetArchive(ctx, &v1.InternalGetArchiveRequest{
+		TenantId:  tenantID,
+		JobId:     jobID,
+		ExpiresIn: expiresIn,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("查询打包下载任务失败: tenant_id=%d, job_id=%s, error=%v", tenantID, jobID, err)
+		return nil, err
+	}
+
+	return resp.Archive, nil
+}
+
+// WaitForArchive 定期查询打包下载任务直到完成、失败或 ctx 结束
+//
+// 参数:
+//   - ctx: 上下文，用于控制最长等待时间
+//   - tenantID: 租户ID
+//   - jobID: 任务ID
+//   - interval: 查询间隔，0 表示默认1秒
+//
+// 返回:
+//   - *v1.InternalArchiveInfo: 已完成的任务信息
+//   - error: 打包失败、查询失败或等待超时时的错误信息
+func (c *ResourceClient) WaitForArchive(ctx context.Context, tenantID uint32, jobID string, interval time.Duration) (*v1.InternalArchiveInfo, error) {
+	if interval <= 0 {
+		interval = defaultArchivePollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		archive, err := c.GetArchive(ctx, tenantID, jobID, 0)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("等待打包完成超时: job_id=%s: %w", jobID, ctx.Err())
+			}
+			return nil, err
+		}
+		switch archive.Status {
+		case ArchiveStatusCompleted:
+			return archive, nil
+		case ArchiveStatusFailed:
+			return nil, fmt.Errorf("打包失败: job_id=%s, error=%s", jobID, archive.Error)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("等待打包完成超时: job_id=%s, status=%s: %w", jobID, archive.Status, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
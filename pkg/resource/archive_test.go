@@ -0,0 +1,86 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// archiveServer 打包任务在查询 readyAfter 次后完成，failed 为 true 时打包失败
+type archiveServer struct {
+	v1.UnimplementedResourceInternalServiceServer
+
+	created    *v1.InternalCreateArchiveRequest
+	readyAfter int32
+	failed     bool
+	polls      atomic.Int32
+}
+
+func (s *archiveServer) InternalCreateArchive(_ context.Context, req *v1.InternalCreateArchiveRequest) (*v1.InternalCreateArchiveResponse, error) {
+	s.created = req
+	return &v1.InternalCreateArchiveResponse{Archive: &v1.InternalArchiveInfo{JobId: "job-1", Status: ArchiveStatusPending, Format: req.Format}}, nil
+}
+
+func (s *archiveServer) InternalGetArchive(_ context.Context, req *v1.InternalGetArchiveRequest) (*v1.InternalGetArchiveResponse, error) {
+	info := &v1.InternalArchiveInfo{JobId: req.JobId, Status: ArchiveStatusProcessing}
+	switch {
+	case s.polls.Add(1) < s.readyAfter:
+	case s.failed:
+		info.Status = ArchiveStatusFailed
+		info.Error = "storage error"
+	default:
+		info.Status = ArchiveStatusCompleted
+		info.DownloadUrl = "https://cdn.example.com/archives/" + req.JobId + ".zip"
+	}
+	return &v1.InternalGetArchiveResponse{Archive: info}, nil
+}
+
+func TestCreateArchive(t *testing.T) {
+	ctx := context.Background()
+	srv := &archiveServer{readyAfter: 3}
+	client := newTestClient(t, srv)
+
+	archive, err := client.CreateArchive(ctx, 7, []string{"f1", "f2"}, ArchiveTarGz, &CreateArchiveOptions{Filename: "附件", ExpiresIn: 600})
+	assert.NoError(t, err)
+	assert.Equal(t, ArchiveStatusPending, archive.Status)
+	assert.Equal(t, "tar.gz", srv.created.Format)
+	assert.Equal(t, "附件", srv.created.Filename)
+	assert.Equal(t, int64(600), srv.created.ExpiresIn)
+
+	archive, err = client.WaitForArchive(ctx, 7, archive.JobId, time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/archives/job-1.zip", archive.DownloadUrl)
+	assert.Equal(t, int32(3), srv.polls.Load())
+
+	// 打包失败
+	srv = &archiveServer{failed: true}
+	client = newTestClient(t, srv)
+	_, err = client.WaitForArchive(ctx, 7, "job-1", time.Millisecond)
+	assert.ErrorContains(t, err, "storage error")
+
+	// 等待超时
+	srv = &archiveServer{readyAfter: 1000}
+	client = newTestClient(t, srv)
+	waitCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	_, err = client.WaitForArchive(waitCtx, 7, "job-1", time.Millisecond)
+	// 查询进行中超时时返回的是 gRPC 的 DeadlineExceeded
+	assert.True(t, errors.Is(err, context.DeadlineExceeded) || status.Code(err) == codes.DeadlineExceeded, "%v", err)
+
+	// 参数校验
+	_, err = client.CreateArchive(ctx, 7, nil, ArchiveZip, nil)
+	assert.Error(t, err)
+	_, err = client.CreateArchive(ctx, 7, make([]string, maxArchiveFiles+1), ArchiveZip, nil)
+	assert.Error(t, err)
+	_, err = client.CreateArchive(ctx, 7, []string{"f1"}, "rar", nil)
+	assert.Error(t, err)
+	_, err = client.GetArchive(ctx, 7, "", 0)
+	assert.Error(t, err)
+}
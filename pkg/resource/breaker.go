@@ -0,0 +1,26 @@
+package resource
+
+import (
+	"github.com/go-kratos/aegis/circuitbreaker"
+	"github.com/heyinLab/common/pkg/common"
+	commonBreaker "github.com/heyinLab/common/pkg/middleware/circuitbreaker"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// ErrCircuitOpen 熔断器打开时本地拒绝的请求返回的错误，可以使用 errors.Is 判断
+//
+// 状态码为 503（gRPC Unavailable），调用方可以据此降级，例如返回占位图
+var ErrCircuitOpen = commonBreaker.ErrNotAllowed
+
+// newBreaker 按策略创建单个方法的熔断器，测试中替换
+var newBreaker = commonBreaker.NewBreaker
+
+// newCircuitBreaker 按方法熔断，资源服务不可用时快速失败；客户端关闭时注销熔断指标
+func newCircuitBreaker(policy *common.CircuitBreakerPolicy, mp metric.MeterProvider) *commonBreaker.Breaker {
+	return commonBreaker.New(
+		commonBreaker.WithBreaker(func() circuitbreaker.CircuitBreaker {
+			return newBreaker(policy)
+		}),
+		commonBreaker.WithMeterProvider(mp),
+	)
+}
@@ -0,0 +1,109 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/aegis/circuitbreaker"
+	"github.com/heyinLab/common/pkg/common"
+	"github.com/stretchr/testify/assert"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// countingBreaker 连续失败 threshold 次后打开
+type countingBreaker struct {
+	threshold int
+	failures  int
+}
+
+func (b *countingBreaker) Allow() error {
+	if b.failures >= b.threshold {
+		return circuitbreaker.ErrNotAllowed
+	}
+	return nil
+}
+
+func (b *countingBreaker) MarkSuccess() { b.failures = 0 }
+func (b *countingBreaker) MarkFailed()  { b.failures++ }
+
+func TestCircuitBreakerMiddleware(t *testing.T) {
+	orig := newBreaker
+	newBreaker = func(*common.CircuitBreakerPolicy) circuitbreaker.CircuitBreaker {
+		return &countingBreaker{threshold: 2}
+	}
+	t.Cleanup(func() { newBreaker = orig })
+
+	srv := &flakyResourceServer{}
+	srv.failures.Store(100)
+	config := DefaultConfig().WithRetry(&common.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+	})
+	client := newDialedTestClient(t, srv, config)
+	ctx := context.Background()
+
+	// 第一次调用的重试失败计入熔断器一次
+	_, err := client.GetFile(ctx, 7, "f1")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(3), srv.calls.Load())
+
+	_, err = client.GetFile(ctx, 7, "f1")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(6), srv.calls.Load())
+
+	// 熔断打开后不再请求资源服务，也不重试
+	_, err = client.GetFile(ctx, 7, "f1")
+	assert.True(t, errors.Is(err, ErrCircuitOpen))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(6), srv.calls.Load())
+
+	// 熔断器按方法隔离
+	srv.failures.Store(0)
+	_, _, err = client.GenerateQRCode(ctx, 7, "https://example.com", nil)
+	assert.NoError(t, err)
+}
+
+func TestCircuitBreaker_Close(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	client := newDialedTestClient(t, &flakyResourceServer{}, DefaultConfig().WithMeterProvider(provider))
+	ctx := context.Background()
+
+	_, err := client.GetFile(ctx, 7, "f1")
+	assert.NoError(t, err)
+
+	gauges := func() int {
+		var rm metricdata.ResourceMetrics
+		assert.NoError(t, reader.Collect(ctx, &rm))
+		n := 0
+		for _, sm := range rm.ScopeMetrics {
+			for _, m := range sm.Metrics {
+				if m.Name == "client_circuit_breaker_open" {
+					n++
+				}
+			}
+		}
+		return n
+	}
+	assert.Equal(t, 1, gauges())
+
+	// 关闭客户端后注销熔断指标
+	assert.NoError(t, client.Close())
+	assert.Equal(t, 0, gauges())
+}
+
+func TestDefaultInternalConfig(t *testing.T) {
+	// 兼容旧版本，不开启重试和熔断
+	config := DefaultInternalConfig()
+	assert.Nil(t, config.Retry)
+	assert.Nil(t, config.CircuitBreaker)
+
+	assert.NotNil(t, DefaultConfig().Retry)
+	assert.NotNil(t, DefaultConfig().CircuitBreaker)
+}
@@ -3,15 +3,101 @@ package resource
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/registry"
 	kratosGrpc "github.com/go-kratos/kratos/v2/transport/grpc"
 	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"github.com/heyinLab/common/pkg/common"
+	commonBreaker "github.com/heyinLab/common/pkg/middleware/circuitbreaker"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
 )
 
+// ResourceService 资源服务内部客户端接口，*ResourceClient 实现了该接口
+//
+// 业务代码依赖该接口而不是 *ResourceClient，便于依赖注入，单元测试中可以替换为
+// resourcetest.FakeClient 或自定义的 mock
+//
+// 使用示例:
+//
+//	type ProductService struct {
+//	    resources resource.ResourceService
+//	}
+//
+//	// 测试中
+//	fake := resourcetest.NewFakeClient()
+//	defer fake.Close()
+//	svc := &ProductService{resources: fake}
+type ResourceService interface {
+	// 文件查询
+	GetFile(ctx context.Context, tenantID uint32, fileID string) (*v1.InternalFileInfo, error)
+	GetFiles(ctx context.Context, tenantID uint32, fileIDs []string) (map[string]*v1.InternalFileInfo, []string, error)
+	GetFileUrls(ctx context.Context, tenantID uint32, fileIDs []string, opts *GetFileUrlsOptions) (map[string]*v1.InternalFileUrlInfo, error)
+	GetFileUrl(ctx context.Context, tenantID uint32, fileID string) (string, error)
+	GetDownloadUrls(ctx context.Context, tenantID uint32, files []DownloadFileRequest, expiresIn int64) (map[string]*v1.InternalFileDownloadInfo, error)
+	GetDownloadUrl(ctx context.Context, tenantID uint32, fileID string) (string, error)
+	GetImageDownloadUrl(ctx context.Context, tenantID uint32, fileID string, transform *ImageTransform) (string, error)
+	GetFileContent(ctx context.Context, tenantID uint32, fileID string, opts *GetFileContentOptions) ([]byte, *v1.InternalFileUrlInfo, error)
+	CheckFileExists(ctx context.Context, tenantID uint32, checksumSHA256 string, size int64) (bool, *v1.InternalFileInfo, error)
+	FilesExist(ctx context.Context, tenantID uint32, fileIDs []string) (map[string]bool, error)
+	ListFiles(ctx context.Context, tenantID uint32, opts *ListFilesOptions) ([]*v1.InternalFileInfo, string, error)
+	ListFilesByTag(ctx context.Context, tenantID uint32, tag string, opts *ListFilesOptions) ([]*v1.InternalFileInfo, string, error)
+
+	// 文件管理
+	UpdateFileMetadata(ctx context.Context, tenantID uint32, fileID string, update *FileMetadataUpdate) (*v1.InternalFileInfo, error)
+	DeleteFile(ctx context.Context, tenantID uint32, fileID string, permanent bool) error
+	BatchDeleteFiles(ctx context.Context, tenantID uint32, fileIDs []string, permanent bool) (map[string]*v1.InternalDeleteResult, error)
+
+	// 目录
+	CreateFolder(ctx context.Context, tenantID uint32, path string) (*v1.InternalFolderInfo, error)
+	ListFolders(ctx context.Context, tenantID uint32, parent string) ([]*v1.InternalFolderInfo, error)
+	DeleteFolder(ctx context.Context, tenantID uint32, path string, recursive bool) (int64, error)
+
+	// 上传
+	UploadFile(ctx context.Context, tenantID uint32, req *UploadRequest) (*v1.InternalFileInfo, string, error)
+	CreateUploadUrl(ctx context.Context, tenantID uint32, filename, contentType string, size int64, opts *CreateUploadUrlOptions) (*PresignedUpload, error)
+	ConfirmUpload(ctx context.Context, tenantID uint32, fileID string) (*v1.InternalFileInfo, string, error)
+	InitMultipartUpload(ctx context.Context, tenantID uint32, req *MultipartUploadRequest) (*MultipartUpload, error)
+	UploadPart(ctx context.Context, tenantID uint32, uploadID string, partNumber int32, data []byte) (*v1.InternalUploadedPart, error)
+	ListUploadedParts(ctx context.Context, tenantID uint32, uploadID string) (*MultipartUpload, []*v1.InternalUploadedPart, error)
+	CompleteMultipartUpload(ctx context.Context, tenantID uint32, uploadID string, parts []*v1.InternalUploadedPart) (*v1.InternalFileInfo, string, error)
+	AbortMultipartUpload(ctx context.Context, tenantID uint32, uploadID string) error
+	UploadLargeFile(ctx context.Context, tenantID uint32, req *LargeFileUploadRequest) (*v1.InternalFileInfo, string, error)
+
+	// 配额
+	GetQuota(ctx context.Context, tenantID uint32) (*v1.InternalQuotaInfo, error)
+	CheckQuota(ctx context.Context, tenantID uint32, checkType CheckQuotaType, size int64) (*CheckQuotaResult, error)
+	GetUsage(ctx context.Context, tenantID uint32) (*Usage, error)
+
+	// 生成
+	GenerateQRCode(ctx context.Context, tenantID uint32, content string, opts *GenerateQRCodeOptions) (*v1.InternalFileInfo, string, error)
+
+	// 打包下载
+	CreateArchive(ctx context.Context, tenantID uint32, fileIDs []string, format ArchiveFormat, opts *CreateArchiveOptions) (*v1.InternalArchiveInfo, error)
+	GetArchive(ctx context.Context, tenantID uint32, jobID string, expiresIn int64) (*v1.InternalArchiveInfo, error)
+	WaitForArchive(ctx context.Context, tenantID uint32, jobID string, interval time.Duration) (*v1.InternalArchiveInfo, error)
+
+	// 分享链接
+	CreateShareLink(ctx context.Context, tenantID uint32, fileID string, opts *ShareLinkOptions) (*v1.InternalShareLinkInfo, error)
+	RevokeShareLink(ctx context.Context, tenantID uint32, shareID string) error
+
+	// 安全检查
+	GetScanStatus(ctx context.Context, tenantID uint32, fileID string) (*v1.InternalScanStatus, error)
+	WaitForClean(ctx context.Context, tenantID uint32, fileID string, opts *WaitForCleanOptions) (*v1.InternalScanStatus, error)
+
+	// 健康检查
+	Ping(ctx context.Context) error
+}
+
+var _ ResourceService = (*ResourceClient)(nil)
+
 // ResourceClient 资源服务内部客户端
 //
 // 封装了 ResourceInternalService 的 gRPC 调用，供内部微服务使用
@@ -19,7 +105,7 @@ import (
 // 使用示例:
 //
 //	client, err := resource.NewResourceClientWithDiscovery(
-//	    resource.DefaultInternalConfig(),
+//	    resource.DefaultConfig(),
 //	    consulDiscovery,
 //	)
 //	if err != nil {
@@ -30,16 +116,28 @@ import (
 //	// 获取文件信息
 //	file, err := client.GetFile(ctx, tenantID, fileID)
 type ResourceClient struct {
-	config *InternalConfig
-	conn   *grpc.ClientConn
+	config *Config
+	conn   *clientConn
 	client v1.ResourceInternalServiceClient
 	logger *log.Helper
+
+	// urlFlight 合并并发的相同文件URL查询
+	urlFlight urlFlight
+
+	// hedger 批量获取文件URL的对冲请求，未配置对冲策略时为 nil
+	hedger *hedger
+
+	// fileCache 文件元数据缓存，未配置缓存策略时为 nil
+	fileCache *fileCache
 }
 
 // NewResourceClient 创建资源服务内部客户端（直连方式）
 //
+// 默认不等待连接就绪，地址错误等问题在第一次调用时才返回；需要在启动时发现问题时
+// 使用 WithWaitForReady 或在启动探针中调用 Ping，依赖的服务可能晚于当前服务启动时使用 WithLazyConnect
+//
 // 参数:
-//   - config: 客户端配置，可以使用 DefaultInternalConfig() 获取默认配置
+//   - config: 客户端配置，可以使用 DefaultConfig() 获取默认配置
 //
 // 返回:
 //   - *ResourceClient: 客户端实例
@@ -47,12 +145,12 @@ type ResourceClient struct {
 //
 // 使用示例:
 //
-//	config := resource.DefaultInternalConfig().
+//	config := resource.DefaultConfig().
 //	    WithEndpoint("localhost:9000")
 //	client, err := resource.NewResourceClient(config)
-func NewResourceClient(config *InternalConfig) (*ResourceClient, error) {
+func NewResourceClient(config *Config) (*ResourceClient, error) {
 	if config == nil {
-		config = DefaultInternalConfig()
+		config = DefaultConfig()
 	}
 
 	if err := config.Validate(); err != nil {
@@ -64,16 +162,18 @@ func NewResourceClient(config *InternalConfig) (*ResourceClient, error) {
 		"module", "resource-internal-client",
 	))
 
-	conn, err := createInternalGRPCConn(config, nil, logger)
+	conn, err := dialClientConn(config, nil, logger)
 	if err != nil {
-		return nil, fmt.Errorf("创建 gRPC 连接失败: %w", err)
+		return nil, err
 	}
 
 	return &ResourceClient{
-		config: config,
-		conn:   conn,
-		client: v1.NewResourceInternalServiceClient(conn),
-		logger: logger,
+		config:    config,
+		conn:      conn,
+		client:    v1.NewResourceInternalServiceClient(conn),
+		logger:    logger,
+		hedger:    newHedger(config.Hedge),
+		fileCache: newFileCache(config.Cache),
 	}, nil
 }
 
@@ -81,7 +181,7 @@ func NewResourceClient(config *InternalConfig) (*ResourceClient, error) {
 //
 // 参数:
 //   - config: 客户端配置
-//   - discovery: 服务发现实例（如 Consul）
+//   - discovery: 服务发现实例（Consul、etcd、Nacos 等，可通过 common.NewDiscovery 按类型创建）
 //
 // 返回:
 //   - *ResourceClient: 客户端实例
@@ -89,14 +189,17 @@ func NewResourceClient(config *InternalConfig) (*ResourceClient, error) {
 //
 // 使用示例:
 //
-//	// 创建 Consul 服务发现
-//	consulClient, _ := consul.New(consulAPI)
+//	// 按配置的注册中心类型创建服务发现，etcd、Nacos 需要先通过 common.RegisterDiscovery 注册
+//	discovery, err := common.NewDiscovery("consul", "127.0.0.1:8500")
+//	if err != nil {
+//	    return err
+//	}
 //
-//	config := resource.DefaultInternalConfig()
-//	client, err := resource.NewResourceClientWithDiscovery(config, consulClient)
-func NewResourceClientWithDiscovery(config *InternalConfig, discovery registry.Discovery) (*ResourceClient, error) {
+//	config := resource.DefaultConfig()
+//	client, err := resource.NewResourceClientWithDiscovery(config, discovery)
+func NewResourceClientWithDiscovery(config *Config, discovery registry.Discovery) (*ResourceClient, error) {
 	if config == nil {
-		config = DefaultInternalConfig()
+		config = DefaultConfig()
 	}
 
 	if discovery == nil {
@@ -112,25 +215,78 @@ func NewResourceClientWithDiscovery(config *InternalConfig, discovery registry.D
 		"module", "resource-internal-client",
 	))
 
-	conn, err := createInternalGRPCConn(config, discovery, logger)
+	conn, err := dialClientConn(config, discovery, logger)
 	if err != nil {
-		return nil, fmt.Errorf("创建 gRPC 连接失败: %w", err)
+		return nil, err
 	}
 
 	logger.Infof("资源内部服务客户端连接成功 (服务发现): endpoint=%s, timeout=%v", config.Endpoint, config.Timeout)
 
 	return &ResourceClient{
-		config: config,
-		conn:   conn,
-		client: v1.NewResourceInternalServiceClient(conn),
-		logger: logger,
+		config:    config,
+		conn:      conn,
+		client:    v1.NewResourceInternalServiceClient(conn),
+		logger:    logger,
+		hedger:    newHedger(config.Hedge),
+		fileCache: newFileCache(config.Cache),
 	}, nil
 }
 
-// Close 关闭客户端连接
+// NewResourceClientWithConn 使用已有的 gRPC 连接创建资源服务内部客户端
+//
+// 连接上的拦截器、TLS 等由调用方配置，config 中只有 Timeout、Hedge 和 Cache 生效；
+// 客户端持有该连接，Close 时关闭。主要用于测试中的内存连接（见 resourcetest 包）
+//
+// 参数:
+//   - config: 客户端配置，为 nil 时使用 DefaultConfig()
+//   - conn: gRPC 连接
+//
+// 返回:
+//   - *ResourceClient: 客户端实例
+func NewResourceClientWithConn(config *Config, conn *grpc.ClientConn) *ResourceClient {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = common.DefaultTimeout
+	}
+
+	cc := &clientConn{conn: conn}
+	return &ResourceClient{
+		config: config,
+		conn:   cc,
+		client: v1.NewResourceInternalServiceClient(cc),
+		logger: log.NewHelper(log.With(
+			log.GetLogger(),
+			"module", "resource-internal-client",
+		)),
+		hedger:    newHedger(config.Hedge),
+		fileCache: newFileCache(config.Cache),
+	}
+}
+
+// Close 立即关闭客户端连接，进行中的调用会被中断
 func (c *ResourceClient) Close() error {
-	if c.conn != nil {
-		return c.conn.Close()
+	return c.conn.Close()
+}
+
+// Shutdown 优雅关闭客户端：不再接受新的调用，等待进行中的调用（包括上传、下载等流式调用）
+// 结束后关闭连接；ctx 结束时不再等待，直接关闭连接并返回超时错误
+//
+// 参数:
+//   - ctx: 控制最长等待时间的上下文
+//
+// 返回:
+//   - error: 等待超时或关闭连接失败时的错误信息
+//
+// 使用示例:
+//
+//	// 在服务关闭时执行，ctx 的超时由 stop_timeout 控制
+//	application.OnStop(client.Shutdown)
+func (c *ResourceClient) Shutdown(ctx context.Context) error {
+	if err := c.conn.Shutdown(ctx); err != nil {
+		c.logger.WithContext(ctx).Errorf("资源服务客户端优雅关闭失败: endpoint=%s, error=%v", c.config.Endpoint, err)
+		return err
 	}
 	return nil
 }
@@ -139,6 +295,8 @@ func (c *ResourceClient) Close() error {
 
 // GetFile 获取单个文件信息
 //
+// 配置了缓存策略（Config.WithCache）时优先返回未过期的本地缓存，见 InvalidateFileCache
+//
 // 参数:
 //   - ctx: 上下文
 //   - tenantID: 租户ID
@@ -148,6 +306,10 @@ func (c *ResourceClient) Close() error {
 //   - *v1.InternalFileInfo: 文件信息
 //   - error: 错误信息
 func (c *ResourceClient) GetFile(ctx context.Context, tenantID uint32, fileID string) (*v1.InternalFileInfo, error) {
+	if info, ok := c.fileCache.get(tenantID, fileID); ok {
+		return info, nil
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
 	defer cancel()
 
@@ -160,29 +322,81 @@ func (c *ResourceClient) GetFile(ctx context.Context, tenantID uint32, fileID st
 		return nil, err
 	}
 
+	c.fileCache.set(tenantID, resp.File)
 	return resp.File, nil
 }
 
 // GetFiles 批量获取文件信息
 //
+// 超过100个文件ID时自动拆分为多批，最多同时请求4批后合并结果（与 GetFileUrls 相同），
+// 任一批请求失败时返回错误；单个文件不存在或获取失败时放入失败列表，不影响其他文件。
+// 配置了缓存策略时已缓存的文件不会重复请求
+//
 // 参数:
 //   - ctx: 上下文
 //   - tenantID: 租户ID
-//   - fileIDs: 文件ID列表（最多100个）
+//   - fileIDs: 文件ID列表
 //
 // 返回:
 //   - map[string]*v1.InternalFileInfo: 文件ID到文件信息的映射
 //   - []string: 获取失败的文件ID列表
 //   - error: 错误信息
+//
+// 使用示例:
+//
+//	files, failed, err := client.GetFiles(ctx, tenantID, attachmentIDs)
+//	if err != nil {
+//	    return err
+//	}
+//	if len(failed) > 0 {
+//	    log.Warnf("部分附件获取失败: file_ids=%v", failed)
+//	}
 func (c *ResourceClient) GetFiles(ctx context.Context, tenantID uint32, fileIDs []string) (map[string]*v1.InternalFileInfo, []string, error) {
-	if len(fileIDs) == 0 {
-		return make(map[string]*v1.InternalFileInfo), nil, nil
+	files := make(map[string]*v1.InternalFileInfo, len(fileIDs))
+	var pending []string
+	for _, id := range fileIDs {
+		if info, ok := c.fileCache.get(tenantID, id); ok {
+			files[id] = info
+		} else {
+			pending = append(pending, id)
+		}
+	}
+	if len(pending) == 0 {
+		return files, nil, nil
 	}
 
-	if len(fileIDs) > 100 {
-		return nil, nil, fmt.Errorf("文件ID数量不能超过100个，当前: %d", len(fileIDs))
+	var (
+		results map[string]*v1.InternalFileInfo
+		err     error
+	)
+	if len(pending) <= maxBatchSize {
+		results, err = c.fetchFiles(ctx, tenantID, pending)
+	} else {
+		results, err = runBatches(ctx, pending, func(ctx context.Context, batch []string) (map[string]*v1.InternalFileInfo, error) {
+			return c.fetchFiles(ctx, tenantID, batch)
+		})
+	}
+	if err != nil {
+		return nil, nil, err
 	}
 
+	var failedIDs []string
+	for _, id := range pending {
+		if _, ok := files[id]; ok || slices.Contains(failedIDs, id) {
+			continue
+		}
+		if info := results[id]; info != nil {
+			files[id] = info
+			c.fileCache.set(tenantID, info)
+		} else {
+			failedIDs = append(failedIDs, id)
+		}
+	}
+	return files, failedIDs, nil
+}
+
+// fetchFiles 请求一批（最多100个）文件信息，获取失败的文件对应的值为 nil
+func (c *ResourceClient) fetchFiles(ctx context.Context, tenantID uint32, fileIDs []string) (map[string]*v1.InternalFileInfo, error) {
 	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
 	defer cancel()
 
@@ -192,12 +406,27 @@ func (c *ResourceClient) GetFiles(ctx context.Context, tenantID uint32, fileIDs
 	})
 	if err != nil {
 		c.logger.WithContext(ctx).Errorf("批量获取文件信息失败: tenant_id=%d, count=%d, error=%v", tenantID, len(fileIDs), err)
-		return nil, nil, err
+		return nil, err
 	}
 
-	return resp.Files, resp.FailedIds, nil
+	results := make(map[string]*v1.InternalFileInfo, len(fileIDs))
+	for id, info := range resp.Files {
+		results[id] = info
+	}
+	for _, id := range resp.FailedIds {
+		results[id] = nil
+	}
+	return results, nil
 }
 
+const (
+	// maxBatchSize 资源服务单次批量请求允许的最大文件数
+	maxBatchSize = 100
+
+	// batchConcurrency 自动分批时同时进行的请求数
+	batchConcurrency = 4
+)
+
 // GetFileUrlsOptions 获取文件URL的选项
 type GetFileUrlsOptions struct {
 	// 是否包含变体URL（如缩略图）
@@ -208,10 +437,13 @@ type GetFileUrlsOptions struct {
 
 // GetFileUrls 批量获取文件URL
 //
+// 超过100个文件ID时自动拆分为多批，最多同时请求4批后合并结果，任一批失败时返回错误。
+// 与进行中的相同查询（租户、文件ID、有效期和是否包含变体URL都相同）合并，不会重复请求资源服务
+//
 // 参数:
 //   - ctx: 上下文
 //   - tenantID: 租户ID
-//   - fileIDs: 文件ID列表（最多100个）
+//   - fileIDs: 文件ID列表
 //   - opts: 可选参数
 //
 // 返回:
@@ -222,10 +454,76 @@ func (c *ResourceClient) GetFileUrls(ctx context.Context, tenantID uint32, fileI
 		return make(map[string]*v1.InternalFileUrlInfo), nil
 	}
 
-	if len(fileIDs) > 100 {
-		return nil, fmt.Errorf("文件ID数量不能超过100个，当前: %d", len(fileIDs))
+	if len(fileIDs) <= maxBatchSize {
+		return c.getFileUrls(ctx, tenantID, fileIDs, opts)
+	}
+
+	return runBatches(ctx, fileIDs, func(ctx context.Context, batch []string) (map[string]*v1.InternalFileUrlInfo, error) {
+		return c.getFileUrls(ctx, tenantID, batch, opts)
+	})
+}
+
+// runBatches 将文件ID按每批100个拆分，最多同时执行4批后合并结果
+//
+// 任一批失败时取消其余批次并返回第一个错误
+func runBatches[V any](ctx context.Context, fileIDs []string, fn func(ctx context.Context, batch []string) (map[string]V, error)) (map[string]V, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		sem      = make(chan struct{}, batchConcurrency)
+		results  = make(map[string]V, len(fileIDs))
+	)
+	for start := 0; start < len(fileIDs); start += maxBatchSize {
+		batch := fileIDs[start:min(start+maxBatchSize, len(fileIDs))]
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			batchResults, err := fn(ctx, batch)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			for id, v := range batchResults {
+				results[id] = v
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
 	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
 
+// fetchFileUrls 请求一批（最多100个）文件URL
+func (c *ResourceClient) fetchFileUrls(ctx context.Context, tenantID uint32, fileIDs []string, opts *GetFileUrlsOptions) (map[string]*v1.InternalFileUrlInfo, error) {
 	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
 	defer cancel()
 
@@ -239,7 +537,9 @@ func (c *ResourceClient) GetFileUrls(ctx context.Context, tenantID uint32, fileI
 		req.ExpiresIn = opts.ExpiresIn
 	}
 
-	resp, err := c.client.InternalGetFileUrls(ctx, req)
+	resp, err := hedgeCall(ctx, c.hedger, func(ctx context.Context) (*v1.InternalGetFileUrlsResponse, error) {
+		return c.client.InternalGetFileUrls(ctx, req)
+	})
 	if err != nil {
 		c.logger.WithContext(ctx).Errorf("批量获取文件URL失败: tenant_id=%d, count=%d, error=%v", tenantID, len(fileIDs), err)
 		return nil, err
@@ -266,11 +566,7 @@ func (c *ResourceClient) GetFileUrl(ctx context.Context, tenantID uint32, fileID
 
 	info, ok := results[fileID]
 	if !ok || !info.Success {
-		errMsg := "文件不存在"
-		if ok && info.Error != "" {
-			errMsg = info.Error
-		}
-		return "", fmt.Errorf("获取文件URL失败: %s", errMsg)
+		return "", fileResultError("获取文件URL失败", info.GetError())
 	}
 
 	return info.Url, nil
@@ -284,6 +580,8 @@ type DownloadFileRequest struct {
 	DownloadFilename string
 	// 要下载的变体ID（可选）
 	VariantID string
+	// 图片处理参数（可选），仅对图片文件有效
+	Transform *ImageTransform
 }
 
 // GetDownloadUrls 批量获取下载URL
@@ -306,19 +604,25 @@ func (c *ResourceClient) GetDownloadUrls(ctx context.Context, tenantID uint32, f
 		return nil, fmt.Errorf("文件数量不能超过50个，当前: %d", len(files))
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
-
 	// 转换请求
 	protoFiles := make([]*v1.InternalFileDownloadRequest, len(files))
 	for i, f := range files {
+		if f.Transform != nil {
+			if err := f.Transform.Validate(); err != nil {
+				return nil, fmt.Errorf("文件 %s 的图片处理参数无效: %w", f.FileID, err)
+			}
+		}
 		protoFiles[i] = &v1.InternalFileDownloadRequest{
 			FileId:           f.FileID,
 			DownloadFilename: f.DownloadFilename,
 			VariantId:        f.VariantID,
+			Transform:        f.Transform.toProto(),
 		}
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
 	resp, err := c.client.InternalGetDownloadUrls(ctx, &v1.InternalGetDownloadUrlsRequest{
 		TenantId:  tenantID,
 		Files:     protoFiles,
@@ -350,11 +654,7 @@ func (c *ResourceClient) GetDownloadUrl(ctx context.Context, tenantID uint32, fi
 
 	info, ok := results[fileID]
 	if !ok || !info.Success {
-		errMsg := "文件不存在"
-		if ok && info.Error != "" {
-			errMsg = info.Error
-		}
-		return "", fmt.Errorf("获取下载URL失败: %s", errMsg)
+		return "", fileResultError("获取下载URL失败", info.GetError())
 	}
 
 	return info.DownloadUrl, nil
@@ -389,6 +689,140 @@ func (c *ResourceClient) CheckFileExists(ctx context.Context, tenantID uint32, c
 	return resp.Exists, resp.File, nil
 }
 
+// FilesExist 批量检查文件ID是否存在
+//
+// 只检查是否存在，比 GetFiles、GetFileUrls 更轻量，适合校验用户提交的文件ID列表。
+// 已删除或属于其他租户的文件视为不存在；超过100个文件ID时自动分批
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - fileIDs: 文件ID列表
+//
+// 返回:
+//   - map[string]bool: 文件ID到是否存在的映射，包含每个传入的文件ID
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	exists, err := client.FilesExist(ctx, tenantID, req.ImageIds)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, id := range req.ImageIds {
+//	    if !exists[id] {
+//	        return fmt.Errorf("图片不存在: %s", id)
+//	    }
+//	}
+func (c *ResourceClient) FilesExist(ctx context.Context, tenantID uint32, fileIDs []string) (map[string]bool, error) {
+	if len(fileIDs) == 0 {
+		return make(map[string]bool), nil
+	}
+
+	return runBatches(ctx, fileIDs, func(ctx context.Context, batch []string) (map[string]bool, error) {
+		ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+		defer cancel()
+
+		resp, err := c.client.InternalFilesExist(ctx, &v1.InternalFilesExistRequest{
+			TenantId: tenantID,
+			FileIds:  batch,
+		})
+		if err != nil {
+			c.logger.WithContext(ctx).Errorf("批量检查文件是否存在失败: tenant_id=%d, count=%d, error=%v", tenantID, len(batch), err)
+			return nil, err
+		}
+
+		exists := make(map[string]bool, len(batch))
+		for _, id := range batch {
+			exists[id] = resp.Exists[id]
+		}
+		return exists, nil
+	})
+}
+
+// DeleteFile 删除文件
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - fileID: 文件ID
+//   - permanent: 是否永久删除，false 时为软删除（可恢复，到期后由资源服务清理）
+//
+// 返回:
+//   - error: 错误信息
+func (c *ResourceClient) DeleteFile(ctx context.Context, tenantID uint32, fileID string, permanent bool) error {
+	if fileID == "" {
+		return fmt.Errorf("文件ID不能为空")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	_, err := c.client.InternalDeleteFile(ctx, &v1.InternalDeleteFileRequest{
+		TenantId:  tenantID,
+		FileId:    fileID,
+		Permanent: permanent,
+	})
+	c.fileCache.invalidate(tenantID, fileID)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("删除文件失败: tenant_id=%d, file_id=%s, permanent=%t, error=%v", tenantID, fileID, permanent, err)
+		return err
+	}
+
+	return nil
+}
+
+// BatchDeleteFiles 批量删除文件
+//
+// 单个文件删除失败不影响其他文件，调用方需要检查每个结果的 Success
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - fileIDs: 文件ID列表（最多100个）
+//   - permanent: 是否永久删除，false 时为软删除
+//
+// 返回:
+//   - map[string]*v1.InternalDeleteResult: 文件ID到删除结果的映射
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	results, err := client.BatchDeleteFiles(ctx, tenantID, fileIDs, false)
+//	if err != nil {
+//	    return err
+//	}
+//	for fileID, result := range results {
+//	    if !result.Success {
+//	        log.Warnf("删除文件失败: file_id=%s, error=%s", fileID, result.Error)
+//	    }
+//	}
+func (c *ResourceClient) BatchDeleteFiles(ctx context.Context, tenantID uint32, fileIDs []string, permanent bool) (map[string]*v1.InternalDeleteResult, error) {
+	if len(fileIDs) == 0 {
+		return make(map[string]*v1.InternalDeleteResult), nil
+	}
+
+	if len(fileIDs) > 100 {
+		return nil, fmt.Errorf("文件ID数量不能超过100个，当前: %d", len(fileIDs))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.InternalBatchDeleteFiles(ctx, &v1.InternalBatchDeleteFilesRequest{
+		TenantId:  tenantID,
+		FileIds:   fileIDs,
+		Permanent: permanent,
+	})
+	c.fileCache.invalidate(tenantID, fileIDs...)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("批量删除文件失败: tenant_id=%d, count=%d, permanent=%t, error=%v", tenantID, len(fileIDs), permanent, err)
+		return nil, err
+	}
+
+	return resp.Results, nil
+}
+
 // ========== 配额相关接口 ==========
 
 // GetQuota 获取租户配额信息
@@ -466,16 +900,108 @@ func (c *ResourceClient) CheckQuota(ctx context.Context, tenantID uint32, checkT
 	}, nil
 }
 
+// ========== 生成类接口 ==========
+
+// QRCodeErrorCorrection 二维码容错级别
+type QRCodeErrorCorrection string
+
+const (
+	QRCodeErrorCorrectionLow      QRCodeErrorCorrection = "L" // 约7%容错
+	QRCodeErrorCorrectionMedium   QRCodeErrorCorrection = "M" // 约15%容错（默认）
+	QRCodeErrorCorrectionQuartile QRCodeErrorCorrection = "Q" // 约25%容错
+	QRCodeErrorCorrectionHigh     QRCodeErrorCorrection = "H" // 约30%容错，叠加logo时推荐
+)
+
+// GenerateQRCodeOptions 生成二维码的选项
+type GenerateQRCodeOptions struct {
+	// 图片边长（像素），默认256
+	Size int32
+	// 中心logo的文件ID（可选）
+	LogoFileID string
+	// 容错级别，默认M；设置了LogoFileID且未指定时使用H
+	ErrorCorrection QRCodeErrorCorrection
+	// URL有效期（秒），默认3600
+	ExpiresIn int64
+}
+
+// GenerateQRCode 通过资源服务生成二维码图片
+//
+// 生成的图片作为普通文件保存在租户空间中，返回值与 UploadFile、ConfirmUpload 等上传接口一致，
+// 文件信息可以用于后续的 GetFileUrl、DeleteFile 等操作。
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - content: 二维码内容（如邀请链接）
+//   - opts: 可选参数
+//
+// 返回:
+//   - *v1.InternalFileInfo: 生成的二维码文件信息
+//   - string: 二维码访问URL
+//   - error: 错误信息
+func (c *ResourceClient) GenerateQRCode(ctx context.Context, tenantID uint32, content string, opts *GenerateQRCodeOptions) (*v1.InternalFileInfo, string, error) {
+	if content == "" {
+		return nil, "", fmt.Errorf("二维码内容不能为空")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	req := &v1.InternalGenerateQRCodeRequest{
+		TenantId: tenantID,
+		Content:  content,
+	}
+
+	if opts != nil {
+		req.Size = opts.Size
+		req.LogoFileId = opts.LogoFileID
+		req.ErrorCorrection = string(opts.ErrorCorrection)
+		req.ExpiresIn = opts.ExpiresIn
+
+		// logo 会遮挡部分码点，未指定容错级别时提高到 H
+		if req.LogoFileId != "" && req.ErrorCorrection == "" {
+			req.ErrorCorrection = string(QRCodeErrorCorrectionHigh)
+		}
+	}
+
+	resp, err := c.client.InternalGenerateQRCode(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("生成二维码失败: tenant_id=%d, error=%v", tenantID, err)
+		return nil, "", err
+	}
+
+	return resp.File, resp.Url, nil
+}
+
 // ========== 内部函数 ==========
 
 // createInternalGRPCConn 创建 gRPC 连接
-func createInternalGRPCConn(config *InternalConfig, discovery registry.Discovery, logger *log.Helper) (*grpc.ClientConn, error) {
+//
+// breaker 为 nil 时不熔断；熔断器由 clientConn 持有，延迟连接重新创建连接时共用同一个熔断器
+func createInternalGRPCConn(config *Config, discovery registry.Discovery, breaker *commonBreaker.Breaker, logger *log.Helper) (*grpc.ClientConn, error) {
+	metricsMW, err := metricsMiddleware(config.MeterProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	// 指标记录包括重试在内的整个调用；熔断在重试外层：熔断打开时直接失败，不会被重试
+	middlewares := []middleware.Middleware{
+		recovery.Recovery(),
+		metricsMW,
+	}
+	if breaker != nil {
+		middlewares = append(middlewares, breaker.Middleware())
+	}
+	if config.Retry != nil && config.Retry.MaxAttempts > 1 {
+		middlewares = append(middlewares, retryMiddleware(config.Retry, logger))
+	}
+	// 自定义中间件在最内层，每次重试都会执行
+	middlewares = append(middlewares, config.Middlewares...)
+
 	opts := []kratosGrpc.ClientOption{
 		kratosGrpc.WithEndpoint(config.Endpoint),
 		kratosGrpc.WithTimeout(config.Timeout),
-		kratosGrpc.WithMiddleware(
-			recovery.Recovery(),
-		),
+		kratosGrpc.WithMiddleware(middlewares...),
 	}
 
 	// 如果有服务发现，添加服务发现选项
@@ -483,13 +1009,57 @@ func createInternalGRPCConn(config *InternalConfig, discovery registry.Discovery
 		opts = append(opts, kratosGrpc.WithDiscovery(discovery))
 	}
 
-	conn, err := kratosGrpc.DialInsecure(
-		context.Background(),
-		opts...,
-	)
+	// 自定义连接选项在内置选项之后，可以覆盖内置选项
+	if dialOpts := append(connDialOptions(config), config.DialOptions...); len(dialOpts) > 0 {
+		opts = append(opts, kratosGrpc.WithOptions(dialOpts...))
+	}
+
+	// 未配置 TLS 时使用明文连接
+	if config.TLS == nil {
+		return kratosGrpc.DialInsecure(context.Background(), opts...)
+	}
+
+	tlsConfig, err := config.TLS.Load()
 	if err != nil {
 		return nil, err
 	}
+	opts = append(opts, kratosGrpc.WithTLSConfig(tlsConfig))
+
+	return kratosGrpc.Dial(context.Background(), opts...)
+}
+
+// connDialOptions 根据配置生成保活、消息大小、压缩和窗口大小的连接选项
+func connDialOptions(config *Config) []grpc.DialOption {
+	var opts []grpc.DialOption
+
+	if config.Keepalive != nil {
+		opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                config.Keepalive.Time,
+			Timeout:             config.Keepalive.Timeout,
+			PermitWithoutStream: config.Keepalive.PermitWithoutStream,
+		}))
+	}
+
+	var callOpts []grpc.CallOption
+	if config.MaxRecvMsgSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallRecvMsgSize(config.MaxRecvMsgSize))
+	}
+	if config.MaxSendMsgSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallSendMsgSize(config.MaxSendMsgSize))
+	}
+	if config.Compression {
+		callOpts = append(callOpts, grpc.UseCompressor(gzip.Name))
+	}
+	if len(callOpts) > 0 {
+		opts = append(opts, grpc.WithDefaultCallOptions(callOpts...))
+	}
+
+	if config.InitialWindowSize > 0 {
+		opts = append(opts, grpc.WithInitialWindowSize(config.InitialWindowSize))
+	}
+	if config.InitialConnWindowSize > 0 {
+		opts = append(opts, grpc.WithInitialConnWindowSize(config.InitialConnWindowSize))
+	}
 
-	return conn, nil
+	return opts
 }
@@ -0,0 +1,1020 @@
+package resource
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"github.com/heyinLab/common/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeResourceServer 测试用的资源服务，未实现的接口返回 Unimplemented
+type fakeResourceServer struct {
+	v1.UnimplementedResourceInternalServiceServer
+
+	uploadMeta   *v1.InternalUploadFileMeta
+	uploadData   []byte
+	uploadChunks int
+	uploadErr    error
+
+	presignURL string
+	confirmed  []string
+
+	mu            sync.Mutex
+	partSize      int64
+	parts         map[int32]*v1.InternalUploadedPart
+	partData      map[int32][]byte
+	partUploads   int
+	partFailures  map[int32]int
+	completeParts []*v1.InternalUploadedPart
+
+	deleted   map[string]bool
+	permanent bool
+
+	folders     map[string]int64
+	listFiles   []*v1.InternalFileInfo
+	listTokens  []string
+	listFailAt  string
+	urlBatches  []int
+	urlIDs      [][]string
+	urlGate     chan struct{}
+	urlInFlight int
+	urlMaxConc  int
+	urlFailID   string
+
+	existBatches []int
+	fileBatches  []int
+
+	qrcodeReqs []*v1.InternalGenerateQRCodeRequest
+}
+
+func (s *fakeResourceServer) InternalListFiles(_ context.Context, req *v1.InternalListFilesRequest) (*v1.InternalListFilesResponse, error) {
+	s.listTokens = append(s.listTokens, req.PageToken)
+	if req.PageToken != "" && req.PageToken == s.listFailAt {
+		return nil, status.Error(codes.Internal, "database error")
+	}
+
+	files := s.listFiles
+	if req.Tag != "" {
+		files = nil
+		for _, f := range s.listFiles {
+			if slices.Contains(f.Tags, req.Tag) {
+				files = append(files, f)
+			}
+		}
+	}
+
+	start := 0
+	if req.PageToken != "" {
+		start, _ = strconv.Atoi(req.PageToken)
+	}
+	end := min(start+int(req.PageSize), len(files))
+	resp := &v1.InternalListFilesResponse{Files: files[start:end], Total: int64(len(files))}
+	if end < len(files) {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return resp, nil
+}
+
+func (s *fakeResourceServer) InternalUpdateFileMetadata(_ context.Context, req *v1.InternalUpdateFileMetadataRequest) (*v1.InternalUpdateFileMetadataResponse, error) {
+	idx := slices.IndexFunc(s.listFiles, func(f *v1.InternalFileInfo) bool { return f.Id == req.FileId })
+	if idx < 0 {
+		return nil, status.Error(codes.NotFound, "file not found")
+	}
+	file := s.listFiles[idx]
+	if req.Filename != "" {
+		file.Filename = req.Filename
+	}
+	for k, v := range req.Metadata {
+		if file.Metadata == nil {
+			file.Metadata = map[string]string{}
+		}
+		if v == "" {
+			delete(file.Metadata, k)
+		} else {
+			file.Metadata[k] = v
+		}
+	}
+	for _, tag := range req.AddTags {
+		if !slices.Contains(file.Tags, tag) {
+			file.Tags = append(file.Tags, tag)
+		}
+	}
+	file.Tags = slices.DeleteFunc(file.Tags, func(tag string) bool { return slices.Contains(req.RemoveTags, tag) })
+	return &v1.InternalUpdateFileMetadataResponse{File: file}, nil
+}
+
+func (s *fakeResourceServer) InternalCreateFolder(_ context.Context, req *v1.InternalCreateFolderRequest) (*v1.InternalCreateFolderResponse, error) {
+	segments := strings.Split(req.Path, "/")
+	for i := range segments {
+		path := strings.Join(segments[:i+1], "/")
+		if _, ok := s.folders[path]; !ok {
+			s.folders[path] = 0
+		}
+	}
+	return &v1.InternalCreateFolderResponse{Folder: &v1.InternalFolderInfo{Path: req.Path, Name: segments[len(segments)-1]}}, nil
+}
+
+func (s *fakeResourceServer) InternalListFolders(_ context.Context, req *v1.InternalListFoldersRequest) (*v1.InternalListFoldersResponse, error) {
+	resp := &v1.InternalListFoldersResponse{}
+	for path, files := range s.folders {
+		parent, name := "", path
+		if i := strings.LastIndex(path, "/"); i >= 0 {
+			parent, name = path[:i], path[i+1:]
+		}
+		if parent == req.Parent {
+			resp.Folders = append(resp.Folders, &v1.InternalFolderInfo{Path: path, Name: name, FileCount: files})
+		}
+	}
+	slices.SortFunc(resp.Folders, func(a, b *v1.InternalFolderInfo) int { return strings.Compare(a.Name, b.Name) })
+	return resp, nil
+}
+
+func (s *fakeResourceServer) InternalDeleteFolder(_ context.Context, req *v1.InternalDeleteFolderRequest) (*v1.InternalDeleteFolderResponse, error) {
+	files, ok := s.folders[req.Path]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "folder not found")
+	}
+	if files > 0 && !req.Recursive {
+		return nil, status.Error(codes.FailedPrecondition, "folder not empty")
+	}
+	delete(s.folders, req.Path)
+	return &v1.InternalDeleteFolderResponse{DeletedFiles: files}, nil
+}
+
+func (s *fakeResourceServer) InternalGetFileUrls(_ context.Context, req *v1.InternalGetFileUrlsRequest) (*v1.InternalGetFileUrlsResponse, error) {
+	s.mu.Lock()
+	s.urlBatches = append(s.urlBatches, len(req.FileIds))
+	s.urlIDs = append(s.urlIDs, req.FileIds)
+	s.urlInFlight++
+	s.urlMaxConc = max(s.urlMaxConc, s.urlInFlight)
+	s.mu.Unlock()
+
+	if s.urlGate != nil {
+		<-s.urlGate
+	} else {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	s.mu.Lock()
+	s.urlInFlight--
+	s.mu.Unlock()
+
+	resp := &v1.InternalGetFileUrlsResponse{Results: map[string]*v1.InternalFileUrlInfo{}}
+	for _, id := range req.FileIds {
+		if id == s.urlFailID {
+			return nil, status.Error(codes.Internal, "storage error")
+		}
+		resp.Results[id] = &v1.InternalFileUrlInfo{Url: "https://cdn.example.com/" + id, Success: true}
+	}
+	return resp, nil
+}
+
+// InternalFilesExist 以 "missing" 开头的文件ID不存在，响应中省略不存在的文件
+func (s *fakeResourceServer) InternalFilesExist(_ context.Context, req *v1.InternalFilesExistRequest) (*v1.InternalFilesExistResponse, error) {
+	s.mu.Lock()
+	s.existBatches = append(s.existBatches, len(req.FileIds))
+	s.mu.Unlock()
+
+	resp := &v1.InternalFilesExistResponse{Exists: map[string]bool{}}
+	for _, id := range req.FileIds {
+		if !strings.HasPrefix(id, "missing") {
+			resp.Exists[id] = true
+		}
+	}
+	return resp, nil
+}
+
+// InternalGetFiles 以 "missing" 开头的文件ID获取失败
+func (s *fakeResourceServer) InternalGetFiles(_ context.Context, req *v1.InternalGetFilesRequest) (*v1.InternalGetFilesResponse, error) {
+	s.mu.Lock()
+	s.fileBatches = append(s.fileBatches, len(req.FileIds))
+	s.mu.Unlock()
+
+	resp := &v1.InternalGetFilesResponse{Files: map[string]*v1.InternalFileInfo{}}
+	for _, id := range req.FileIds {
+		if strings.HasPrefix(id, "missing") {
+			resp.FailedIds = append(resp.FailedIds, id)
+		} else {
+			resp.Files[id] = &v1.InternalFileInfo{Id: id}
+		}
+	}
+	return resp, nil
+}
+
+func (s *fakeResourceServer) InternalDeleteFile(_ context.Context, req *v1.InternalDeleteFileRequest) (*v1.InternalDeleteFileResponse, error) {
+	if _, ok := s.deleted[req.FileId]; !ok {
+		return nil, status.Error(codes.NotFound, "file not found")
+	}
+	s.deleted[req.FileId] = true
+	s.permanent = req.Permanent
+	return &v1.InternalDeleteFileResponse{}, nil
+}
+
+func (s *fakeResourceServer) InternalBatchDeleteFiles(_ context.Context, req *v1.InternalBatchDeleteFilesRequest) (*v1.InternalBatchDeleteFilesResponse, error) {
+	resp := &v1.InternalBatchDeleteFilesResponse{Results: map[string]*v1.InternalDeleteResult{}}
+	for _, id := range req.FileIds {
+		if _, ok := s.deleted[id]; !ok {
+			resp.Results[id] = &v1.InternalDeleteResult{Error: "file not found"}
+			continue
+		}
+		s.deleted[id] = true
+		resp.Results[id] = &v1.InternalDeleteResult{Success: true}
+	}
+	s.permanent = req.Permanent
+	return resp, nil
+}
+
+func (s *fakeResourceServer) InternalInitMultipartUpload(_ context.Context, req *v1.InternalInitMultipartUploadRequest) (*v1.InternalInitMultipartUploadResponse, error) {
+	s.partSize = req.PartSize
+	return &v1.InternalInitMultipartUploadResponse{
+		UploadId:  "u1",
+		FileId:    "f3",
+		PartSize:  req.PartSize,
+		PartCount: int32((req.Size + req.PartSize - 1) / req.PartSize),
+	}, nil
+}
+
+func (s *fakeResourceServer) InternalUploadPart(stream grpc.ClientStreamingServer[v1.InternalUploadPartRequest, v1.InternalUploadPartResponse]) error {
+	var meta *v1.InternalUploadPartMeta
+	var data []byte
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if req.Meta != nil {
+			meta = req.Meta
+			continue
+		}
+		data = append(data, req.Chunk...)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.partUploads++
+	if s.partFailures[meta.PartNumber] > 0 {
+		s.partFailures[meta.PartNumber]--
+		return status.Error(codes.Unavailable, "storage busy")
+	}
+	if checksumSHA256(data) != meta.ChecksumSha256 {
+		return status.Error(codes.DataLoss, "checksum mismatch")
+	}
+	part := &v1.InternalUploadedPart{
+		PartNumber:     meta.PartNumber,
+		Size:           int64(len(data)),
+		ChecksumSha256: meta.ChecksumSha256,
+		Etag:           meta.ChecksumSha256[:8],
+	}
+	s.parts[meta.PartNumber] = part
+	s.partData[meta.PartNumber] = data
+	return stream.SendAndClose(&v1.InternalUploadPartResponse{Part: part})
+}
+
+func (s *fakeResourceServer) InternalListUploadedParts(_ context.Context, req *v1.InternalListUploadedPartsRequest) (*v1.InternalListUploadedPartsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := &v1.InternalListUploadedPartsResponse{FileId: "f3", PartSize: s.partSize, PartCount: 4}
+	for _, p := range s.parts {
+		resp.Parts = append(resp.Parts, p)
+	}
+	return resp, nil
+}
+
+func (s *fakeResourceServer) InternalCompleteMultipartUpload(_ context.Context, req *v1.InternalCompleteMultipartUploadRequest) (*v1.InternalCompleteMultipartUploadResponse, error) {
+	s.completeParts = req.Parts
+	return &v1.InternalCompleteMultipartUploadResponse{
+		File: &v1.InternalFileInfo{Id: "f3", Status: "completed"},
+		Url:  "https://cdn.example.com/f3",
+	}, nil
+}
+
+func (s *fakeResourceServer) InternalCreateUploadUrl(_ context.Context, req *v1.InternalCreateUploadUrlRequest) (*v1.InternalCreateUploadUrlResponse, error) {
+	return &v1.InternalCreateUploadUrlResponse{
+		FileId:    "f2",
+		UploadUrl: s.presignURL + "/" + req.Filename,
+		Method:    http.MethodPut,
+		Headers:   map[string]string{"Content-Type": req.ContentType},
+		ExpiresIn: 3600,
+	}, nil
+}
+
+func (s *fakeResourceServer) InternalConfirmUpload(_ context.Context, req *v1.InternalConfirmUploadRequest) (*v1.InternalConfirmUploadResponse, error) {
+	s.confirmed = append(s.confirmed, req.FileId)
+	return &v1.InternalConfirmUploadResponse{File: &v1.InternalFileInfo{Id: req.FileId, Status: "completed"}}, nil
+}
+
+func (s *fakeResourceServer) InternalUploadFile(stream grpc.ClientStreamingServer[v1.InternalUploadFileRequest, v1.InternalUploadFileResponse]) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if req.Meta != nil {
+			s.uploadMeta = req.Meta
+			if s.uploadErr != nil {
+				return s.uploadErr
+			}
+			continue
+		}
+		s.uploadChunks++
+		s.uploadData = append(s.uploadData, req.Chunk...)
+	}
+	return stream.SendAndClose(&v1.InternalUploadFileResponse{
+		File: &v1.InternalFileInfo{Id: "f1", Filename: s.uploadMeta.Filename, Size: int64(len(s.uploadData))},
+		Url:  "https://cdn.example.com/f1",
+	})
+}
+
+func (s *fakeResourceServer) InternalGenerateQRCode(_ context.Context, req *v1.InternalGenerateQRCodeRequest) (*v1.InternalGenerateQRCodeResponse, error) {
+	s.qrcodeReqs = append(s.qrcodeReqs, req)
+	return &v1.InternalGenerateQRCodeResponse{
+		File: &v1.InternalFileInfo{Id: "qr1", ContentType: "image/png"},
+		Url:  "https://cdn.example.com/qr1",
+	}, nil
+}
+
+// newTestClient 通过内存连接创建访问 srv 的客户端
+func newTestClient(t *testing.T, srv v1.ResourceInternalServiceServer) *ResourceClient {
+	ln := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	v1.RegisterResourceInternalServiceServer(server, srv)
+	go server.Serve(ln)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return ln.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return NewResourceClientWithConn(nil, conn)
+}
+
+func TestUploadFile(t *testing.T) {
+	srv := &fakeResourceServer{}
+	client := newTestClient(t, srv)
+
+	content := bytes.Repeat([]byte("x"), uploadChunkSize*2+10)
+	file, url, err := client.UploadFile(context.Background(), 7, &UploadRequest{
+		Filename: "report.xlsx",
+		Reader:   bytes.NewReader(content),
+		Folder:   "reports",
+		IsPublic: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "f1", file.Id)
+	assert.Equal(t, "https://cdn.example.com/f1", url)
+
+	assert.Equal(t, uint32(7), srv.uploadMeta.TenantId)
+	assert.Equal(t, "reports", srv.uploadMeta.Folder)
+	assert.True(t, srv.uploadMeta.IsPublic)
+	assert.Equal(t, 3, srv.uploadChunks)
+	assert.Equal(t, content, srv.uploadData)
+}
+
+func TestUploadFile_ServerRejects(t *testing.T) {
+	srv := &fakeResourceServer{uploadErr: status.Error(codes.ResourceExhausted, "quota exceeded")}
+	client := newTestClient(t, srv)
+
+	_, _, err := client.UploadFile(context.Background(), 7, &UploadRequest{
+		Filename: "big.mp4",
+		Reader:   strings.NewReader(strings.Repeat("x", uploadChunkSize*8)),
+	})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+
+	_, _, err = client.UploadFile(context.Background(), 7, &UploadRequest{Filename: "a.txt"})
+	assert.Error(t, err)
+}
+
+func TestUploadFile_ProgressAndRateLimit(t *testing.T) {
+	srv := &fakeResourceServer{}
+	client := newTestClient(t, srv)
+
+	content := bytes.Repeat([]byte("x"), uploadChunkSize*2+10)
+	var progress []int64
+	_, _, err := client.UploadFile(context.Background(), 7, &UploadRequest{
+		Filename: "report.xlsx",
+		Reader:   bytes.NewReader(content),
+		Size:     int64(len(content)),
+		OnProgress: func(sent, total int64) {
+			assert.Equal(t, int64(len(content)), total)
+			progress = append(progress, sent)
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{uploadChunkSize, uploadChunkSize * 2, int64(len(content))}, progress)
+
+	// 每秒一个分片，第一个分片之后需要等待，ctx 到期时立即返回
+	progress = nil
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, _, err = client.UploadFile(ctx, 7, &UploadRequest{
+		Filename:   "report.xlsx",
+		Reader:     bytes.NewReader(content),
+		RateLimit:  uploadChunkSize,
+		OnProgress: func(sent, _ int64) { progress = append(progress, sent) },
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, []int64{uploadChunkSize}, progress)
+}
+
+func TestPresignedUpload(t *testing.T) {
+	var stored []byte
+	var contentType string
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		contentType = r.Header.Get("Content-Type")
+		stored, _ = io.ReadAll(r.Body)
+	}))
+	defer storage.Close()
+
+	srv := &fakeResourceServer{presignURL: storage.URL}
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+
+	upload, err := client.CreateUploadUrl(ctx, 7, "demo.mp4", "video/mp4", 5, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "f2", upload.FileID)
+
+	req, err := upload.NewRequest(ctx, strings.NewReader("video"), 5)
+	assert.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "video", string(stored))
+	assert.Equal(t, "video/mp4", contentType)
+
+	file, _, err := client.ConfirmUpload(ctx, 7, upload.FileID)
+	assert.NoError(t, err)
+	assert.Equal(t, "completed", file.Status)
+	assert.Equal(t, []string{"f2"}, srv.confirmed)
+
+	_, err = client.CreateUploadUrl(ctx, 7, "demo.mp4", "video/mp4", 0, nil)
+	assert.Error(t, err)
+}
+
+func TestUploadLargeFile(t *testing.T) {
+	srv := &fakeResourceServer{
+		parts:        map[int32]*v1.InternalUploadedPart{},
+		partData:     map[int32][]byte{},
+		partFailures: map[int32]int{2: 1},
+	}
+	client := newTestClient(t, srv)
+
+	const partSize = uploadChunkSize + 100
+	content := make([]byte, partSize*3+17)
+	for i := range content {
+		content[i] = byte(i % 251)
+	}
+
+	var mu sync.Mutex
+	var progress []int64
+	file, url, err := client.UploadLargeFile(context.Background(), 7, &LargeFileUploadRequest{
+		MultipartUploadRequest: MultipartUploadRequest{
+			Filename: "demo.mp4",
+			Size:     int64(len(content)),
+			PartSize: partSize,
+		},
+		Reader:      bytes.NewReader(content),
+		Concurrency: 2,
+		OnProgress: func(uploaded, total int64) {
+			mu.Lock()
+			progress = append(progress, uploaded)
+			mu.Unlock()
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "f3", file.Id)
+	assert.Equal(t, "https://cdn.example.com/f3", url)
+
+	// 分片2失败一次后重试成功
+	assert.Equal(t, 5, srv.partUploads)
+	assert.Len(t, srv.completeParts, 4)
+	for i, p := range srv.completeParts {
+		assert.Equal(t, int32(i+1), p.PartNumber)
+	}
+	var joined []byte
+	for n := int32(1); n <= 4; n++ {
+		joined = append(joined, srv.partData[n]...)
+	}
+	assert.Equal(t, content, joined)
+	assert.Len(t, progress, 4)
+	assert.Contains(t, progress, int64(len(content)))
+}
+
+func TestUploadLargeFile_Resume(t *testing.T) {
+	srv := &fakeResourceServer{
+		parts:        map[int32]*v1.InternalUploadedPart{},
+		partData:     map[int32][]byte{},
+		partFailures: map[int32]int{3: 10},
+	}
+	client := newTestClient(t, srv)
+
+	const partSize = 1024
+	content := bytes.Repeat([]byte("abcdefgh"), partSize/2)
+	req := &LargeFileUploadRequest{
+		MultipartUploadRequest: MultipartUploadRequest{
+			Filename: "demo.mp4",
+			Size:     int64(len(content)),
+			PartSize: partSize,
+		},
+		Reader:      bytes.NewReader(content),
+		Concurrency: 1,
+		MaxRetries:  -1,
+	}
+
+	// 分片3持续失败，返回可续传的上传ID
+	_, _, err := client.UploadLargeFile(context.Background(), 7, req)
+	var uploadErr *MultipartUploadError
+	assert.ErrorAs(t, err, &uploadErr)
+	assert.Equal(t, "u1", uploadErr.UploadID)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// 续传时跳过已上传的分片
+	srv.partFailures = map[int32]int{}
+	uploadsBefore := srv.partUploads
+	req.UploadID = uploadErr.UploadID
+	_, _, err = client.UploadLargeFile(context.Background(), 7, req)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, srv.partUploads-uploadsBefore)
+	assert.Len(t, srv.completeParts, 4)
+}
+
+func TestDeleteFiles(t *testing.T) {
+	srv := &fakeResourceServer{deleted: map[string]bool{"f1": false, "f2": false, "f3": false}}
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+
+	assert.NoError(t, client.DeleteFile(ctx, 7, "f1", true))
+	assert.True(t, srv.deleted["f1"])
+	assert.True(t, srv.permanent)
+
+	err := client.DeleteFile(ctx, 7, "missing", false)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Error(t, client.DeleteFile(ctx, 7, "", false))
+
+	results, err := client.BatchDeleteFiles(ctx, 7, []string{"f2", "missing", "f3"}, false)
+	assert.NoError(t, err)
+	assert.False(t, srv.permanent)
+	assert.True(t, results["f2"].Success)
+	assert.True(t, results["f3"].Success)
+	assert.False(t, results["missing"].Success)
+	assert.Equal(t, "file not found", results["missing"].Error)
+
+	_, err = client.BatchDeleteFiles(ctx, 7, make([]string, 101), false)
+	assert.Error(t, err)
+}
+
+func TestGetFileUrls_Batches(t *testing.T) {
+	srv := &fakeResourceServer{}
+	client := newTestClient(t, srv)
+
+	ids := make([]string, 950)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("f%d", i)
+	}
+	results, err := client.GetFileUrls(context.Background(), 7, ids, nil)
+	assert.NoError(t, err)
+	assert.Len(t, results, 950)
+	assert.Equal(t, "https://cdn.example.com/f949", results["f949"].Url)
+
+	assert.Len(t, srv.urlBatches, 10)
+	for _, n := range srv.urlBatches {
+		assert.LessOrEqual(t, n, maxBatchSize)
+	}
+	assert.LessOrEqual(t, srv.urlMaxConc, batchConcurrency)
+
+	srv.urlFailID = "f420"
+	_, err = client.GetFileUrls(context.Background(), 7, ids, nil)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestGenerateQRCode(t *testing.T) {
+	srv := &fakeResourceServer{}
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+
+	_, _, err := client.GenerateQRCode(ctx, 7, "", nil)
+	assert.Error(t, err)
+	assert.Empty(t, srv.qrcodeReqs)
+
+	file, url, err := client.GenerateQRCode(ctx, 7, "https://example.com/invite/abc", &GenerateQRCodeOptions{
+		Size:            512,
+		ErrorCorrection: QRCodeErrorCorrectionQuartile,
+		ExpiresIn:       600,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "qr1", file.Id)
+	assert.Equal(t, "https://cdn.example.com/qr1", url)
+
+	req := srv.qrcodeReqs[0]
+	assert.Equal(t, uint32(7), req.TenantId)
+	assert.Equal(t, "https://example.com/invite/abc", req.Content)
+	assert.Equal(t, int32(512), req.Size)
+	assert.Equal(t, "Q", req.ErrorCorrection)
+	assert.Equal(t, int64(600), req.ExpiresIn)
+
+	// 叠加 logo 且未指定容错级别时使用 H
+	_, _, err = client.GenerateQRCode(ctx, 7, "https://example.com/invite/abc", &GenerateQRCodeOptions{LogoFileID: "logo1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "logo1", srv.qrcodeReqs[1].LogoFileId)
+	assert.Equal(t, "H", srv.qrcodeReqs[1].ErrorCorrection)
+
+	// 未设置选项时由服务端决定默认值
+	_, _, err = client.GenerateQRCode(ctx, 7, "https://example.com/invite/abc", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, srv.qrcodeReqs[2].ErrorCorrection)
+	assert.Zero(t, srv.qrcodeReqs[2].Size)
+}
+
+func TestConnDialOptions(t *testing.T) {
+	config := DefaultConfig().
+		WithKeepalive(&common.KeepaliveConfig{Time: 30 * time.Second, Timeout: 5 * time.Second, PermitWithoutStream: true}).
+		WithMaxMsgSize(1024, 0).
+		WithWindowSize(1<<20, 1<<21)
+	assert.Len(t, connDialOptions(config), 4)
+	assert.Empty(t, connDialOptions(DefaultConfig()))
+
+	client := newDialedTestClient(t, &flakyResourceServer{}, config.WithRetry(nil))
+	ctx := context.Background()
+
+	file, err := client.GetFile(ctx, 7, "f1")
+	assert.NoError(t, err)
+	assert.Equal(t, "f1", file.Id)
+
+	// 响应超过 MaxRecvMsgSize
+	_, err = client.GetFile(ctx, 7, strings.Repeat("x", 2048))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+// compressionStats 记录客户端发送和收到的消息头中的压缩算法
+type compressionStats struct {
+	mu       sync.Mutex
+	sent     string
+	received string
+}
+
+func (h *compressionStats) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (h *compressionStats) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (h *compressionStats) HandleConn(context.Context, stats.ConnStats) {}
+
+func (h *compressionStats) HandleRPC(_ context.Context, s stats.RPCStats) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	switch s := s.(type) {
+	case *stats.OutHeader:
+		h.sent = s.Compression
+	case *stats.InHeader:
+		h.received = s.Compression
+	}
+}
+
+func TestCompression(t *testing.T) {
+	assert.Len(t, connDialOptions(DefaultConfig().WithCompression(true)), 1)
+
+	handler := &compressionStats{}
+	config := DefaultConfig().WithCompression(true).WithDialOptions(grpc.WithStatsHandler(handler))
+	client := newDialedTestClient(t, &fakeResourceServer{}, config)
+
+	urls, err := client.GetFileUrls(context.Background(), 7, []string{"f1", "f2"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/f2", urls["f2"].Url)
+
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	assert.Equal(t, "gzip", handler.sent)
+	assert.Equal(t, "gzip", handler.received)
+}
+
+func TestCustomMiddlewareAndDialOptions(t *testing.T) {
+	var attempts, intercepted atomic.Int32
+	counter := func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			attempts.Add(1)
+			return handler(ctx, req)
+		}
+	}
+	interceptor := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		intercepted.Add(1)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	srv := &flakyResourceServer{}
+	config := DefaultConfig().
+		WithRetry(&common.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}).
+		WithMiddleware(counter).
+		WithDialOptions(grpc.WithChainUnaryInterceptor(interceptor))
+	client := newDialedTestClient(t, srv, config)
+
+	// 自定义中间件在重试内层，每次尝试都会执行
+	srv.failures.Store(2)
+	_, err := client.GetFile(context.Background(), 7, "f1")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), attempts.Load())
+	assert.Equal(t, int32(3), intercepted.Load())
+
+	cp := config.Copy()
+	cp.WithMiddleware(counter)
+	assert.Len(t, config.Middlewares, 1)
+	assert.Len(t, cp.Middlewares, 2)
+}
+
+func TestFilesExist(t *testing.T) {
+	srv := &fakeResourceServer{}
+	client := newTestClient(t, srv)
+
+	ids := []string{"missing-1"}
+	for i := 0; i < 150; i++ {
+		ids = append(ids, fmt.Sprintf("f%d", i))
+	}
+	exists, err := client.FilesExist(context.Background(), 7, ids)
+	assert.NoError(t, err)
+	assert.Len(t, exists, 151)
+	assert.True(t, exists["f149"])
+
+	// 响应中省略的文件ID也会出现在结果中
+	missing, ok := exists["missing-1"]
+	assert.True(t, ok)
+	assert.False(t, missing)
+
+	slices.Sort(srv.existBatches)
+	assert.Equal(t, []int{51, 100}, srv.existBatches)
+}
+
+func TestGetFiles(t *testing.T) {
+	srv := &fakeResourceServer{}
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+
+	ids := []string{"missing-1"}
+	for i := 0; i < 250; i++ {
+		ids = append(ids, fmt.Sprintf("f%d", i))
+	}
+	files, failed, err := client.GetFiles(ctx, 7, ids)
+	assert.NoError(t, err)
+	assert.Len(t, files, 250)
+	assert.Equal(t, "f249", files["f249"].Id)
+	assert.Equal(t, []string{"missing-1"}, failed)
+
+	slices.Sort(srv.fileBatches)
+	assert.Equal(t, []int{51, 100, 100}, srv.fileBatches)
+
+	files, failed, err = client.GetFiles(ctx, 7, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+	assert.Empty(t, failed)
+
+	// 开启缓存时只请求未缓存的文件
+	client.fileCache = newFileCache(common.DefaultCachePolicy())
+	client.fileCache.set(7, &v1.InternalFileInfo{Id: "f1"})
+	srv.fileBatches = nil
+	files, _, err = client.GetFiles(ctx, 7, []string{"f1", "f2"})
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+	assert.Equal(t, []int{1}, srv.fileBatches)
+	_, ok := client.fileCache.get(7, "f2")
+	assert.True(t, ok)
+}
+
+func TestListFilesIterator(t *testing.T) {
+	srv := &fakeResourceServer{}
+	for i := 0; i < 7; i++ {
+		srv.listFiles = append(srv.listFiles, &v1.InternalFileInfo{Id: fmt.Sprintf("f%d", i)})
+	}
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+
+	var ids []string
+	it := client.ListFilesIterator(ctx, 7, &ListFilesOptions{PageSize: 3})
+	for it.Next() {
+		ids = append(ids, it.File().Id)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []string{"f0", "f1", "f2", "f3", "f4", "f5", "f6"}, ids)
+	assert.Equal(t, []string{"", "3", "6"}, srv.listTokens)
+	assert.False(t, it.Next())
+
+	// 整页恰好结束时不会多请求一次
+	srv.listTokens = nil
+	it = client.ListFilesIterator(ctx, 7, &ListFilesOptions{PageSize: 7})
+	for it.Next() {
+	}
+	assert.Equal(t, []string{""}, srv.listTokens)
+
+	// 出错后可以从中断的页继续
+	srv.listFailAt = "6"
+	ids = nil
+	it = client.ListFilesIterator(ctx, 7, &ListFilesOptions{PageSize: 3})
+	for it.Next() {
+		ids = append(ids, it.File().Id)
+	}
+	assert.Equal(t, codes.Internal, status.Code(it.Err()))
+	assert.Len(t, ids, 6)
+	assert.Equal(t, "6", it.PageToken())
+
+	srv.listFailAt = ""
+	it = client.ListFilesIterator(ctx, 7, &ListFilesOptions{PageSize: 3, PageToken: it.PageToken()})
+	assert.True(t, it.Next())
+	assert.Equal(t, "f6", it.File().Id)
+	assert.False(t, it.Next())
+	assert.NoError(t, it.Err())
+}
+
+// pagedService 只实现 ListFiles 的 ResourceService，模拟业务代码中的 mock
+type pagedService struct {
+	ResourceService
+	pages [][]*v1.InternalFileInfo
+}
+
+func (s *pagedService) ListFiles(_ context.Context, _ uint32, opts *ListFilesOptions) ([]*v1.InternalFileInfo, string, error) {
+	page := 0
+	if opts.PageToken != "" {
+		page, _ = strconv.Atoi(opts.PageToken)
+	}
+	next := ""
+	if page+1 < len(s.pages) {
+		next = strconv.Itoa(page + 1)
+	}
+	return s.pages[page], next, nil
+}
+
+func TestNewFileIterator_Service(t *testing.T) {
+	svc := &pagedService{pages: [][]*v1.InternalFileInfo{{{Id: "a"}, {Id: "b"}}, {{Id: "c"}}}}
+
+	var ids []string
+	it := NewFileIterator(context.Background(), svc, 7, nil)
+	for it.Next() {
+		ids = append(ids, it.File().Id)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []string{"a", "b", "c"}, ids)
+}
+
+func TestUpdateFileMetadata(t *testing.T) {
+	srv := &fakeResourceServer{listFiles: []*v1.InternalFileInfo{
+		{Id: "f1", Filename: "a.png", Metadata: map[string]string{"old": "1"}},
+		{Id: "f2", Filename: "b.png", Tags: []string{"product-cover"}},
+	}}
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+
+	file, err := client.UpdateFileMetadata(ctx, 7, "f1", &FileMetadataUpdate{
+		Filename: "cover.png",
+		Metadata: map[string]string{"product_id": "10086", "old": ""},
+		AddTags:  []string{"product-cover", "banner"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "cover.png", file.Filename)
+	assert.Equal(t, map[string]string{"product_id": "10086"}, file.Metadata)
+	assert.Equal(t, []string{"product-cover", "banner"}, file.Tags)
+
+	file, err = client.UpdateFileMetadata(ctx, 7, "f1", &FileMetadataUpdate{RemoveTags: []string{"banner"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "cover.png", file.Filename)
+	assert.Equal(t, []string{"product-cover"}, file.Tags)
+
+	files, next, err := client.ListFilesByTag(ctx, 7, "product-cover", &ListFilesOptions{PageSize: 10})
+	assert.NoError(t, err)
+	assert.Empty(t, next)
+	assert.Len(t, files, 2)
+
+	_, err = client.UpdateFileMetadata(ctx, 7, "missing", &FileMetadataUpdate{Filename: "x"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, _, err = client.ListFilesByTag(ctx, 7, "", nil)
+	assert.Error(t, err)
+}
+
+func TestFolders(t *testing.T) {
+	srv := &fakeResourceServer{folders: map[string]int64{"reports": 3}}
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+
+	folder, err := client.CreateFolder(ctx, 7, "/products//10086/")
+	assert.NoError(t, err)
+	assert.Equal(t, "products/10086", folder.Path)
+	assert.Equal(t, "10086", folder.Name)
+
+	folders, err := client.ListFolders(ctx, 7, "")
+	assert.NoError(t, err)
+	assert.Len(t, folders, 2)
+	assert.Equal(t, "products", folders[0].Name)
+	assert.Equal(t, "reports", folders[1].Name)
+
+	folders, err = client.ListFolders(ctx, 7, "products/")
+	assert.NoError(t, err)
+	assert.Len(t, folders, 1)
+
+	_, err = client.DeleteFolder(ctx, 7, "reports", false)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	deleted, err := client.DeleteFolder(ctx, 7, "reports", true)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+
+	_, err = client.CreateFolder(ctx, 7, "products/../secrets")
+	assert.Error(t, err)
+	_, err = client.CreateFolder(ctx, 7, "/")
+	assert.Error(t, err)
+	_, err = client.DeleteFolder(ctx, 7, "", true)
+	assert.Error(t, err)
+}
+
+func TestGetFileUrls_Singleflight(t *testing.T) {
+	srv := &fakeResourceServer{urlGate: make(chan struct{})}
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	urls := make([]string, 10)
+	for i := range urls {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			urls[i], _ = client.GetFileUrl(ctx, 7, "f1")
+		}()
+	}
+
+	// 已在查询的 f1 不重复请求，只请求 f2；有效期不同的查询不合并
+	var batch map[string]*v1.InternalFileUrlInfo
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		time.Sleep(50 * time.Millisecond)
+		batch, _ = client.GetFileUrls(ctx, 7, []string{"f1", "f2"}, nil)
+	}()
+	go func() {
+		defer wg.Done()
+		time.Sleep(50 * time.Millisecond)
+		client.GetFileUrls(ctx, 7, []string{"f1"}, &GetFileUrlsOptions{ExpiresIn: 60})
+	}()
+
+	time.Sleep(150 * time.Millisecond)
+	close(srv.urlGate)
+	wg.Wait()
+
+	for _, url := range urls {
+		assert.Equal(t, "https://cdn.example.com/f1", url)
+	}
+	assert.Len(t, batch, 2)
+	assert.ElementsMatch(t, [][]string{{"f1"}, {"f2"}, {"f1"}}, srv.urlIDs)
+
+	// 调用方取消不影响共享的查询
+	srv.urlGate = make(chan struct{})
+	srv.urlIDs = nil
+	cancelCtx, cancel := context.WithCancel(ctx)
+	done := make(chan error)
+	go func() {
+		_, err := client.GetFileUrls(cancelCtx, 7, []string{"f3"}, nil)
+		done <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	waiter := make(chan string)
+	go func() {
+		url, _ := client.GetFileUrl(ctx, 7, "f3")
+		waiter <- url
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	close(srv.urlGate)
+	assert.Equal(t, "https://cdn.example.com/f3", <-waiter)
+	assert.Len(t, srv.urlIDs, 1)
+}
@@ -12,15 +12,44 @@ const (
 	DefaultURLExpiresIn = 3600
 )
 
+// Config 资源服务客户端配置
+//
+// 与其他服务客户端共用 common.ServiceConfig，通过 With 方法设置端点、超时、重试、熔断、
+// TLS、连接参数和自定义中间件；服务发现实例通过 NewResourceClientWithDiscovery 传入
+//
+// 使用示例:
+//
+//	config := resource.DefaultConfig().
+//	    WithTimeout(5 * time.Second).
+//	    WithTLS(&common.TLSConfig{CAFile: "/etc/certs/ca.pem"}).
+//	    WithMiddleware(authMiddleware)
+//	client, err := resource.NewResourceClientWithDiscovery(config, consulDiscovery)
+type Config = common.ServiceConfig
+
 // InternalConfig 资源内部服务客户端配置
-type InternalConfig = common.ServiceConfig
+//
+// Deprecated: 使用 Config，两者是同一类型
+type InternalConfig = Config
 
-// DefaultInternalConfig 返回默认的内部服务客户端配置
+// DefaultConfig 返回默认的资源服务客户端配置
 //
 // 默认配置:
 //   - Endpoint: "discovery:///resource-server"
 //   - ServiceName: "resource-server"
 //   - Timeout: 10s
+//   - Retry: 最多尝试3次，只重试只读方法的 Unavailable / DeadlineExceeded 错误
+//   - CircuitBreaker: 按方法自适应熔断，熔断时返回 ErrCircuitOpen
+func DefaultConfig() *Config {
+	return common.NewServiceConfig(DefaultServiceName).
+		WithRetry(common.DefaultRetryPolicy()).
+		WithCircuitBreaker(common.DefaultCircuitBreakerPolicy())
+}
+
+// DefaultInternalConfig 返回默认的资源服务客户端配置
+//
+// 保持原有行为，不开启重试和熔断；需要重试和熔断时使用 DefaultConfig
+//
+// Deprecated: 使用 DefaultConfig
 func DefaultInternalConfig() *InternalConfig {
 	return common.NewServiceConfig(DefaultServiceName)
 }
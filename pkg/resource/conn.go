@@ -0,0 +1,242 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/registry"
+	commonBreaker "github.com/heyinLab/common/pkg/middleware/circuitbreaker"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// clientConn 客户端使用的 gRPC 连接
+//
+// 开启延迟连接时第一次调用才创建连接，创建失败时下一次调用重新创建。
+// 记录进行中的调用数，Shutdown 时等待进行中的调用结束后再关闭连接
+type clientConn struct {
+	mu     sync.Mutex
+	dial   func() (*grpc.ClientConn, error)
+	conn   *grpc.ClientConn
+	closed bool
+
+	// active 进行中的调用数（包括未结束的流）
+	active int
+	// idle Shutdown 等待期间创建，进行中的调用全部结束时关闭
+	idle chan struct{}
+	// breaker 熔断中间件，未配置熔断策略时为 nil，关闭连接时注销熔断指标
+	breaker *commonBreaker.Breaker
+}
+
+var _ grpc.ClientConnInterface = (*clientConn)(nil)
+
+// dialClientConn 按配置创建连接：延迟连接、立即连接或等待连接就绪
+func dialClientConn(config *Config, discovery registry.Discovery, logger *log.Helper) (*clientConn, error) {
+	cc := &clientConn{}
+	if config.CircuitBreaker != nil {
+		cc.breaker = newCircuitBreaker(config.CircuitBreaker, config.MeterProvider)
+	}
+	cc.dial = func() (*grpc.ClientConn, error) {
+		conn, err := createInternalGRPCConn(config, discovery, cc.breaker, logger)
+		if err != nil {
+			return nil, fmt.Errorf("创建 gRPC 连接失败: %w", err)
+		}
+		return conn, nil
+	}
+	if config.LazyConnect {
+		return cc, nil
+	}
+
+	conn, err := cc.dial()
+	if err != nil {
+		cc.closeBreaker()
+		return nil, err
+	}
+	if config.WaitForReady > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), config.WaitForReady)
+		defer cancel()
+		if err := waitForReady(ctx, conn); err != nil {
+			conn.Close()
+			cc.closeBreaker()
+			return nil, fmt.Errorf("等待资源服务连接就绪失败: endpoint=%s: %w", config.Endpoint, err)
+		}
+	}
+	cc.conn = conn
+	return cc, nil
+}
+
+// waitForReady 等待连接进入 Ready 状态，连接失败后 gRPC 会按退避策略重连，直到 ctx 结束
+func waitForReady(ctx context.Context, conn *grpc.ClientConn) error {
+	conn.Connect()
+	for {
+		state := conn.GetState()
+		switch state {
+		case connectivity.Ready:
+			return nil
+		case connectivity.Shutdown:
+			return fmt.Errorf("连接已关闭")
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("连接状态为 %s: %w", state, ctx.Err())
+		}
+	}
+}
+
+// acquire 返回连接并记录一个进行中的调用，调用结束后需要调用 release；
+// 延迟连接时在第一次调用时创建连接
+func (c *clientConn) acquire() (*grpc.ClientConn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil, status.Error(codes.Canceled, "客户端已关闭")
+	}
+	if c.conn == nil {
+		conn, err := c.dial()
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		c.conn = conn
+	}
+	c.active++
+	return c.conn, nil
+}
+
+// release 结束一个进行中的调用
+func (c *clientConn) release() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.active--
+	if c.active == 0 && c.idle != nil {
+		close(c.idle)
+		c.idle = nil
+	}
+}
+
+// Invoke 实现 grpc.ClientConnInterface，返回的错误附加了错误类型（见 ErrFileNotFound 等）
+func (c *clientConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	conn, err := c.acquire()
+	if err != nil {
+		return wrapError(err)
+	}
+	defer c.release()
+	return wrapError(conn.Invoke(ctx, method, args, reply, opts...))
+}
+
+// NewStream 实现 grpc.ClientConnInterface
+func (c *clientConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	conn, err := c.acquire()
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	stream, err := conn.NewStream(ctx, desc, method, opts...)
+	if err != nil {
+		c.release()
+		return nil, wrapError(err)
+	}
+	// 流以任何方式结束（正常结束、出错或取消）时 gRPC 都会取消流的 context
+	go func() {
+		<-stream.Context().Done()
+		c.release()
+	}()
+	return errorStream{ClientStream: stream}, nil
+}
+
+// Close 立即关闭连接，进行中的调用会被中断；延迟连接尚未创建时只标记为已关闭
+func (c *clientConn) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	c.closeBreaker()
+	return c.closeConn()
+}
+
+// Shutdown 拒绝新的调用，等待进行中的调用结束或 ctx 结束后关闭连接
+func (c *clientConn) Shutdown(ctx context.Context) error {
+	c.mu.Lock()
+	c.closed = true
+	var idle chan struct{}
+	if c.active > 0 {
+		if c.idle == nil {
+			c.idle = make(chan struct{})
+		}
+		idle = c.idle
+	}
+	c.mu.Unlock()
+
+	var waitErr error
+	if idle != nil {
+		select {
+		case <-idle:
+		case <-ctx.Done():
+			c.mu.Lock()
+			waitErr = fmt.Errorf("等待进行中的调用结束超时: active=%d: %w", c.active, ctx.Err())
+			c.mu.Unlock()
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closeBreaker()
+	if err := c.closeConn(); err != nil {
+		return err
+	}
+	return waitErr
+}
+
+// closeBreaker 注销熔断指标，注销失败只影响指标，交给 otel 的错误处理器
+func (c *clientConn) closeBreaker() {
+	if c.breaker == nil {
+		return
+	}
+	if err := c.breaker.Close(); err != nil {
+		otel.Handle(err)
+	}
+}
+
+// closeConn 关闭已创建的连接，调用方持有锁
+func (c *clientConn) closeConn() error {
+	if c.conn == nil {
+		return nil
+	}
+	conn := c.conn
+	c.conn = nil
+	return conn.Close()
+}
+
+// Ping 检查资源服务是否可用，用于启动探针和就绪检查
+//
+// 通过 gRPC 健康检查协议（kratos 服务默认注册）查询服务状态，
+// 延迟连接时会先建立连接
+//
+// 参数:
+//   - ctx: 上下文
+//
+// 返回:
+//   - error: 服务不可用时的错误信息
+//
+// 使用示例:
+//
+//	if err := client.Ping(ctx); err != nil {
+//	    return fmt.Errorf("资源服务不可用: %w", err)
+//	}
+func (c *ResourceClient) Ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := grpc_health_v1.NewHealthClient(c.conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("资源服务健康检查失败: endpoint=%s, error=%v", c.config.Endpoint, err)
+		return err
+	}
+
+	if resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("%w: status=%s", ErrUnavailable, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package resource
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/heyinLab/common/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLazyConnect(t *testing.T) {
+	ctx := context.Background()
+
+	// 配置错误在第一次调用时返回，之后每次调用重新尝试创建连接
+	config := DefaultConfig().
+		WithEndpoint("127.0.0.1:1").
+		WithLazyConnect(true).
+		WithTLS(&common.TLSConfig{CAFile: "testdata/missing-ca.pem"})
+	client, err := NewResourceClient(config)
+	assert.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, err = client.GetFile(ctx, 7, "f1")
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Contains(t, err.Error(), "创建 gRPC 连接失败")
+	}
+	assert.NoError(t, client.Close())
+	_, err = client.GetFile(ctx, 7, "f1")
+	assert.Equal(t, codes.Canceled, status.Code(err))
+
+	srv := &flakyResourceServer{}
+	client = newDialedTestClient(t, srv, DefaultConfig().WithLazyConnect(true))
+	assert.NoError(t, client.Ping(ctx))
+	file, err := client.GetFile(ctx, 7, "f1")
+	assert.NoError(t, err)
+	assert.Equal(t, "f1", file.Id)
+}
+
+func TestWaitForReady(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	addr := ln.Addr().String()
+	ln.Close()
+
+	start := time.Now()
+	_, err = NewResourceClient(DefaultConfig().WithEndpoint(addr).WithWaitForReady(200 * time.Millisecond))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), addr)
+	assert.Less(t, time.Since(start), 2*time.Second)
+
+	client := newDialedTestClient(t, &flakyResourceServer{}, DefaultConfig().WithWaitForReady(time.Second))
+	assert.NoError(t, client.Ping(context.Background()))
+
+	_, err = NewResourceClient(DefaultConfig().WithEndpoint(addr).WithLazyConnect(true).WithWaitForReady(time.Second))
+	assert.Error(t, err)
+}
+
+func TestShutdown(t *testing.T) {
+	ctx := context.Background()
+
+	// 等待进行中的调用结束后关闭，之后的调用被拒绝
+	srv := &slowFirstServer{slow: 200 * time.Millisecond}
+	client := newDialedTestClient(t, srv, DefaultConfig())
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.GetFileUrls(ctx, 7, []string{"f1"}, nil)
+		done <- err
+	}()
+	assert.Eventually(t, func() bool { return srv.calls.Load() == 1 }, time.Second, time.Millisecond)
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	assert.NoError(t, client.Shutdown(shutdownCtx))
+	assert.NoError(t, <-done)
+	_, err := client.GetFile(ctx, 7, "f1")
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	assert.NoError(t, client.Close())
+
+	// 超过等待时间时中断进行中的调用
+	srv = &slowFirstServer{slow: 5 * time.Second}
+	client = newDialedTestClient(t, srv, DefaultConfig().WithRetry(nil))
+	go func() {
+		_, err := client.GetFileUrls(ctx, 7, []string{"f1"}, nil)
+		done <- err
+	}()
+	assert.Eventually(t, func() bool { return srv.calls.Load() == 1 }, time.Second, time.Millisecond)
+
+	shutdownCtx, cancel = context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = client.Shutdown(shutdownCtx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "active=1")
+	assert.Error(t, <-done)
+	assert.Less(t, time.Since(start), time.Second)
+
+	// 没有进行中的调用时立即关闭
+	client = newDialedTestClient(t, &flakyResourceServer{}, DefaultConfig().WithLazyConnect(true))
+	assert.NoError(t, client.Shutdown(ctx))
+}
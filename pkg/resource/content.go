@@ -0,0 +1,104 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+)
+
+// DefaultMaxContentSize GetFileContent 默认允许读取的最大字节数
+const DefaultMaxContentSize = 10 * 1024 * 1024
+
+// ErrContentTooLarge 文件内容超过 GetFileContent 允许的最大字节数
+var ErrContentTooLarge = errors.New("文件内容超过最大限制")
+
+// GetFileContentOptions 读取文件内容的选项
+type GetFileContentOptions struct {
+	// 允许读取的最大字节数，默认10MB
+	MaxSize int64
+	// 下载使用的HTTP客户端，默认 http.DefaultClient
+	HTTPClient *http.Client
+}
+
+// GetFileContent 读取文件内容（便捷方法）
+//
+// 获取文件URL后通过HTTP下载内容，适合在服务端读取较小的配置、JSON等附件。
+// 文件大小超过 MaxSize 时返回 ErrContentTooLarge，不会下载；
+// 下载使用配置的 Timeout，需要更长时间时使用 GetFileUrl 自行下载
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - fileID: 文件ID
+//   - opts: 可选参数
+//
+// 返回:
+//   - []byte: 文件内容
+//   - *v1.InternalFileUrlInfo: 文件URL信息（包含文件名、大小和MIME类型）
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	data, info, err := client.GetFileContent(ctx, tenantID, fileID, &resource.GetFileContentOptions{MaxSize: 1 << 20})
+//	if errors.Is(err, resource.ErrContentTooLarge) {
+//	    return fmt.Errorf("配置文件不能超过1MB")
+//	}
+func (c *ResourceClient) GetFileContent(ctx context.Context, tenantID uint32, fileID string, opts *GetFileContentOptions) ([]byte, *v1.InternalFileUrlInfo, error) {
+	maxSize := int64(DefaultMaxContentSize)
+	httpClient := http.DefaultClient
+	if opts != nil {
+		if opts.MaxSize > 0 {
+			maxSize = opts.MaxSize
+		}
+		if opts.HTTPClient != nil {
+			httpClient = opts.HTTPClient
+		}
+	}
+
+	results, err := c.GetFileUrls(ctx, tenantID, []string{fileID}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info, ok := results[fileID]
+	if !ok || !info.Success {
+		return nil, nil, fileResultError("获取文件URL失败", info.GetError())
+	}
+	if info.Size > maxSize {
+		return nil, info, fmt.Errorf("%w: size=%d, max_size=%d", ErrContentTooLarge, info.Size, maxSize)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, info.Url, nil)
+	if err != nil {
+		return nil, info, fmt.Errorf("创建下载请求失败: %w", err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("下载文件失败: tenant_id=%d, file_id=%s, error=%v", tenantID, fileID, err)
+		return nil, info, fmt.Errorf("下载文件失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		c.logger.WithContext(ctx).Errorf("下载文件失败: tenant_id=%d, file_id=%s, status=%d", tenantID, fileID, resp.StatusCode)
+		return nil, info, fmt.Errorf("下载文件失败: status=%d", resp.StatusCode)
+	}
+
+	// 多读一个字节，用于判断实际内容是否超过限制
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, info, fmt.Errorf("读取文件内容失败: %w", err)
+	}
+	if int64(len(data)) > maxSize {
+		return nil, info, fmt.Errorf("%w: max_size=%d", ErrContentTooLarge, maxSize)
+	}
+
+	return data, info, nil
+}
@@ -0,0 +1,50 @@
+package resource
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// roundTripFunc 不发起网络请求的 http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestGetFileContent(t *testing.T) {
+	client := newTestClient(t, &fakeResourceServer{})
+	ctx := context.Background()
+
+	var requested []string
+	httpClient := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requested = append(requested, req.URL.String())
+		status := http.StatusOK
+		if strings.HasSuffix(req.URL.Path, "/gone") {
+			status = http.StatusNotFound
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(`{"theme":"dark"}`)),
+			Request:    req,
+		}, nil
+	})}
+
+	data, info, err := client.GetFileContent(ctx, 7, "config", &GetFileContentOptions{HTTPClient: httpClient})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"theme":"dark"}`, string(data))
+	assert.Equal(t, "https://cdn.example.com/config", info.Url)
+	assert.Equal(t, []string{"https://cdn.example.com/config"}, requested)
+
+	// 实际内容超过限制
+	_, _, err = client.GetFileContent(ctx, 7, "config", &GetFileContentOptions{HTTPClient: httpClient, MaxSize: 8})
+	assert.ErrorIs(t, err, ErrContentTooLarge)
+
+	_, _, err = client.GetFileContent(ctx, 7, "gone", &GetFileContentOptions{HTTPClient: httpClient})
+	assert.ErrorContains(t, err, "status=404")
+}
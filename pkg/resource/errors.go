@@ -0,0 +1,106 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 资源服务调用失败的错误类型，使用 errors.Is 判断
+//
+// 使用示例:
+//
+//	file, err := client.GetFile(ctx, tenantID, fileID)
+//	switch {
+//	case errors.Is(err, resource.ErrFileNotFound):
+//	    return nil, v1.ErrorImageNotFound("图片不存在")
+//	case err != nil:
+//	    return nil, err
+//	}
+var (
+	// ErrFileNotFound 文件或目录不存在（包括已删除和属于其他租户的文件）
+	ErrFileNotFound = errors.New("文件不存在")
+	// ErrPermissionDenied 没有权限访问
+	ErrPermissionDenied = errors.New("没有权限")
+	// ErrQuotaExceeded 配额不足
+	ErrQuotaExceeded = errors.New("配额不足")
+	// ErrUnavailable 资源服务暂时不可用（包括超时和熔断打开），可以稍后重试
+	ErrUnavailable = errors.New("资源服务不可用")
+)
+
+// Error 资源服务调用失败的错误
+//
+// errors.Is 可以同时匹配错误类型和原始错误，status.Code 返回原始的 gRPC 错误码
+type Error struct {
+	// Kind 错误类型，如 ErrFileNotFound；无法归类时为 nil
+	Kind error
+	// Err 原始错误
+	Err error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap 返回错误类型和原始错误
+func (e *Error) Unwrap() []error {
+	if e.Kind == nil {
+		return []error{e.Err}
+	}
+	return []error{e.Kind, e.Err}
+}
+
+// GRPCStatus 返回原始错误的 gRPC 状态，供 status.FromError、status.Code 使用
+func (e *Error) GRPCStatus() *status.Status {
+	return status.Convert(e.Err)
+}
+
+// wrapError 按 gRPC 错误码为资源服务返回的错误附加错误类型
+func wrapError(err error) error {
+	if err == nil || err == io.EOF {
+		return err
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return err
+	}
+
+	var kind error
+	switch status.Code(err) {
+	case codes.NotFound:
+		kind = ErrFileNotFound
+	case codes.PermissionDenied, codes.Unauthenticated:
+		kind = ErrPermissionDenied
+	case codes.ResourceExhausted:
+		kind = ErrQuotaExceeded
+	case codes.Unavailable, codes.DeadlineExceeded:
+		kind = ErrUnavailable
+	default:
+		if errors.Is(err, context.DeadlineExceeded) {
+			kind = ErrUnavailable
+		}
+	}
+	return &Error{Kind: kind, Err: err}
+}
+
+// fileResultError 批量接口中单个文件失败时的错误，按文件不存在处理
+func fileResultError(op, errMsg string) error {
+	if errMsg == "" {
+		return fmt.Errorf("%s: %w", op, ErrFileNotFound)
+	}
+	return fmt.Errorf("%s: %w: %s", op, ErrFileNotFound, errMsg)
+}
+
+// errorStream 为流式调用接收到的错误附加错误类型
+type errorStream struct {
+	grpc.ClientStream
+}
+
+func (s errorStream) RecvMsg(m any) error {
+	return wrapError(s.ClientStream.RecvMsg(m))
+}
@@ -0,0 +1,58 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWrapError(t *testing.T) {
+	tests := []struct {
+		err  error
+		kind error
+	}{
+		{status.Error(codes.NotFound, "file not found"), ErrFileNotFound},
+		{status.Error(codes.PermissionDenied, "denied"), ErrPermissionDenied},
+		{status.Error(codes.Unauthenticated, "no token"), ErrPermissionDenied},
+		{status.Error(codes.ResourceExhausted, "quota exceeded"), ErrQuotaExceeded},
+		{status.Error(codes.Unavailable, "down"), ErrUnavailable},
+		{status.Error(codes.DeadlineExceeded, "timeout"), ErrUnavailable},
+		{fmt.Errorf("dial: %w", context.DeadlineExceeded), ErrUnavailable},
+		{ErrCircuitOpen, ErrUnavailable},
+	}
+	for _, tt := range tests {
+		err := wrapError(tt.err)
+		assert.ErrorIs(t, err, tt.kind, tt.err.Error())
+		assert.ErrorIs(t, err, tt.err)
+		assert.Equal(t, status.Code(tt.err), status.Code(err))
+		assert.Equal(t, tt.err.Error(), err.Error())
+	}
+
+	// 无法归类的错误仍然保留原始错误码
+	err := wrapError(status.Error(codes.Internal, "boom"))
+	var e *Error
+	assert.True(t, errors.As(err, &e))
+	assert.Nil(t, e.Kind)
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	assert.Nil(t, wrapError(nil))
+	assert.Equal(t, io.EOF, wrapError(io.EOF))
+	assert.Same(t, err, wrapError(err))
+}
+
+func TestClientErrors(t *testing.T) {
+	client := newTestClient(t, &fakeResourceServer{deleted: map[string]bool{}})
+	ctx := context.Background()
+
+	err := client.DeleteFile(ctx, 7, "missing", false)
+	assert.ErrorIs(t, err, ErrFileNotFound)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	assert.ErrorIs(t, fileResultError("获取文件URL失败", "file not found"), ErrFileNotFound)
+}
@@ -0,0 +1,133 @@
+package resource
+
+import (
+	"sync"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"github.com/heyinLab/common/pkg/common"
+	"google.golang.org/protobuf/proto"
+)
+
+// fileKey 文件元数据缓存的键
+type fileKey struct {
+	tenantID uint32
+	fileID   string
+}
+
+// fileEntry 缓存的文件元数据
+type fileEntry struct {
+	info    *v1.InternalFileInfo
+	expires time.Time
+}
+
+// fileCache GetFile 结果的本地缓存
+//
+// 文件名、大小、类型等元数据很少变化，热门商品页反复查询同一批文件时可以直接使用缓存；
+// 通过本客户端修改或删除文件时对应的缓存失效。方法可以在 nil 上调用（未开启缓存）
+type fileCache struct {
+	policy common.CachePolicy
+
+	mu      sync.Mutex
+	entries map[fileKey]fileEntry
+}
+
+// newFileCache 按策略创建缓存，policy 为 nil 时返回 nil
+func newFileCache(policy *common.CachePolicy) *fileCache {
+	if policy == nil {
+		return nil
+	}
+	return &fileCache{policy: *policy, entries: make(map[fileKey]fileEntry)}
+}
+
+// get 返回未过期的缓存副本
+func (c *fileCache) get(tenantID uint32, fileID string) (*v1.InternalFileInfo, bool) {
+	if c == nil {
+		return nil, false
+	}
+	key := fileKey{tenantID: tenantID, fileID: fileID}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return proto.Clone(entry.info).(*v1.InternalFileInfo), true
+}
+
+// set 缓存文件元数据的副本
+func (c *fileCache) set(tenantID uint32, info *v1.InternalFileInfo) {
+	if c == nil || info == nil {
+		return
+	}
+	now := time.Now()
+	key := fileKey{tenantID: tenantID, fileID: info.Id}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok && c.policy.MaxEntries > 0 && len(c.entries) >= c.policy.MaxEntries {
+		c.evict(now)
+	}
+	c.entries[key] = fileEntry{info: proto.Clone(info).(*v1.InternalFileInfo), expires: now.Add(c.policy.TTL)}
+}
+
+// evict 删除已过期的条目，没有过期条目时删除最早过期的一个，调用方持有锁
+func (c *fileCache) evict(now time.Time) {
+	var (
+		oldest    fileKey
+		oldestExp time.Time
+	)
+	for key, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, key)
+			continue
+		}
+		if oldestExp.IsZero() || entry.expires.Before(oldestExp) {
+			oldest, oldestExp = key, entry.expires
+		}
+	}
+	if len(c.entries) >= c.policy.MaxEntries {
+		delete(c.entries, oldest)
+	}
+}
+
+// invalidate 删除指定文件的缓存
+func (c *fileCache) invalidate(tenantID uint32, fileIDs ...string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, fileID := range fileIDs {
+		delete(c.entries, fileKey{tenantID: tenantID, fileID: fileID})
+	}
+}
+
+// invalidateTenant 删除租户的全部缓存，用于无法确定受影响文件的操作（如删除目录）
+func (c *fileCache) invalidateTenant(tenantID uint32) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.entries {
+		if key.tenantID == tenantID {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// InvalidateFileCache 删除文件元数据的本地缓存，未开启缓存时不做任何事
+//
+// 通过本客户端修改或删除文件时缓存会自动失效；其他服务修改了文件（如收到文件变更事件）时
+// 调用此方法，之后的 GetFile 会重新查询资源服务
+//
+// 参数:
+//   - tenantID: 租户ID
+//   - fileIDs: 文件ID列表
+func (c *ResourceClient) InvalidateFileCache(tenantID uint32, fileIDs ...string) {
+	c.fileCache.invalidate(tenantID, fileIDs...)
+}
@@ -0,0 +1,81 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"github.com/heyinLab/common/pkg/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFile_Cache(t *testing.T) {
+	ctx := context.Background()
+	srv := &flakyResourceServer{}
+	client := newDialedTestClient(t, srv, DefaultConfig().WithRetry(nil).WithCache(&common.CachePolicy{TTL: time.Minute}))
+
+	file, err := client.GetFile(ctx, 7, "f1")
+	assert.NoError(t, err)
+	file.Filename = "changed.png"
+	file, err = client.GetFile(ctx, 7, "f1")
+	assert.NoError(t, err)
+	assert.Empty(t, file.Filename, "修改返回值不影响缓存")
+	assert.Equal(t, int32(1), srv.calls.Load())
+
+	// 不同租户分别缓存
+	_, err = client.GetFile(ctx, 8, "f1")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), srv.calls.Load())
+
+	// 删除文件后缓存失效（测试服务未实现删除，失败时同样失效）
+	_ = client.DeleteFile(ctx, 7, "f1", false)
+	_, err = client.GetFile(ctx, 7, "f1")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), srv.calls.Load())
+
+	client.InvalidateFileCache(8, "f1")
+	_, err = client.GetFile(ctx, 8, "f1")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), srv.calls.Load())
+
+	// 未开启缓存时每次都查询
+	srv = &flakyResourceServer{}
+	client = newDialedTestClient(t, srv, DefaultConfig())
+	for i := 0; i < 2; i++ {
+		_, err = client.GetFile(ctx, 7, "f1")
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), srv.calls.Load())
+	client.InvalidateFileCache(7, "f1")
+
+	_, err = NewResourceClient(DefaultConfig().WithCache(&common.CachePolicy{}))
+	assert.Error(t, err)
+}
+
+func TestFileCache_Expiry(t *testing.T) {
+	cache := newFileCache(&common.CachePolicy{TTL: 20 * time.Millisecond, MaxEntries: 2})
+	cache.set(7, &v1.InternalFileInfo{Id: "f1"})
+	time.Sleep(5 * time.Millisecond)
+	cache.set(7, &v1.InternalFileInfo{Id: "f2"})
+
+	// 超出条目数时淘汰最早过期的条目
+	cache.set(7, &v1.InternalFileInfo{Id: "f3"})
+	_, ok := cache.get(7, "f1")
+	assert.False(t, ok)
+	_, ok = cache.get(7, "f2")
+	assert.True(t, ok)
+	assert.Len(t, cache.entries, 2)
+
+	time.Sleep(30 * time.Millisecond)
+	_, ok = cache.get(7, "f3")
+	assert.False(t, ok)
+
+	cache.set(7, &v1.InternalFileInfo{Id: "f1"})
+	cache.set(8, &v1.InternalFileInfo{Id: "f1"})
+	cache.invalidateTenant(7)
+	_, ok = cache.get(7, "f1")
+	assert.False(t, ok)
+	_, ok = cache.get(8, "f1")
+	assert.True(t, ok)
+}
@@ -0,0 +1,129 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+)
+
+// CreateFolder 创建目录，自动创建不存在的上级目录，目录已存在时直接返回
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - path: 目录路径，以 / 分隔，如 "products/10086"
+//
+// 返回:
+//   - *v1.InternalFolderInfo: 目录信息
+//   - error: 错误信息
+func (c *ResourceClient) CreateFolder(ctx context.Context, tenantID uint32, path string) (*v1.InternalFolderInfo, error) {
+	path, err := cleanFolderPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return nil, fmt.Errorf("目录路径不能为空")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.InternalCreateFolder(ctx, &v1.InternalCreateFolderRequest{
+		TenantId: tenantID,
+		Path:     path,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("创建目录失败: tenant_id=%d, path=%s, error=%v", tenantID, path, err)
+		return nil, err
+	}
+
+	return resp.Folder, nil
+}
+
+// ListFolders 列出子目录
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - parent: 上级目录路径，为空时列出根目录
+//
+// 返回:
+//   - []*v1.InternalFolderInfo: 子目录列表，按名称排序
+//   - error: 错误信息
+func (c *ResourceClient) ListFolders(ctx context.Context, tenantID uint32, parent string) ([]*v1.InternalFolderInfo, error) {
+	parent, err := cleanFolderPath(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.InternalListFolders(ctx, &v1.InternalListFoldersRequest{
+		TenantId: tenantID,
+		Parent:   parent,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("列出目录失败: tenant_id=%d, parent=%s, error=%v", tenantID, parent, err)
+		return nil, err
+	}
+
+	return resp.Folders, nil
+}
+
+// DeleteFolder 删除目录
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - path: 目录路径
+//   - recursive: 是否同时删除子目录和文件（软删除），为 false 且目录不为空时返回 FailedPrecondition
+//
+// 返回:
+//   - int64: 删除的文件数
+//   - error: 错误信息
+func (c *ResourceClient) DeleteFolder(ctx context.Context, tenantID uint32, path string, recursive bool) (int64, error) {
+	path, err := cleanFolderPath(path)
+	if err != nil {
+		return 0, err
+	}
+	if path == "" {
+		return 0, fmt.Errorf("不能删除根目录")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.InternalDeleteFolder(ctx, &v1.InternalDeleteFolderRequest{
+		TenantId:  tenantID,
+		Path:      path,
+		Recursive: recursive,
+	})
+	// 不知道目录下有哪些文件，使租户的全部缓存失效
+	if recursive {
+		c.fileCache.invalidateTenant(tenantID)
+	}
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("删除目录失败: tenant_id=%d, path=%s, recursive=%t, error=%v", tenantID, path, recursive, err)
+		return 0, err
+	}
+
+	return resp.DeletedFiles, nil
+}
+
+// cleanFolderPath 去掉首尾和重复的 /，拒绝 . 和 .. 路径段
+func cleanFolderPath(path string) (string, error) {
+	var segments []string
+	for _, seg := range strings.Split(path, "/") {
+		switch seg {
+		case "":
+			continue
+		case ".", "..":
+			return "", fmt.Errorf("目录路径不能包含 %s: %s", seg, path)
+		}
+		segments = append(segments, seg)
+	}
+	return strings.Join(segments, "/"), nil
+}
@@ -0,0 +1,114 @@
+package resource
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/heyinLab/common/pkg/common"
+)
+
+const (
+	// hedgeWindow 计算延迟分位数使用的最近样本数
+	hedgeWindow = 128
+
+	// hedgeMinSamples 按分位数计算等待时间所需的最少样本数
+	hedgeMinSamples = 20
+)
+
+// hedger 记录最近请求的延迟并计算发送对冲请求前的等待时间
+type hedger struct {
+	policy common.HedgePolicy
+
+	mu      sync.Mutex
+	samples [hedgeWindow]time.Duration
+	count   int
+}
+
+// newHedger 按策略创建 hedger，policy 为 nil 时返回 nil
+func newHedger(policy *common.HedgePolicy) *hedger {
+	if policy == nil {
+		return nil
+	}
+	return &hedger{policy: *policy}
+}
+
+// observe 记录一次成功请求的延迟
+func (h *hedger) observe(d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.samples[h.count%hedgeWindow] = d
+	h.count++
+}
+
+// delay 返回发送对冲请求前的等待时间
+func (h *hedger) delay() time.Duration {
+	d := h.policy.Delay
+	if h.policy.Percentile > 0 {
+		h.mu.Lock()
+		n := min(h.count, hedgeWindow)
+		if n >= hedgeMinSamples {
+			sorted := slices.Clone(h.samples[:n])
+			slices.Sort(sorted)
+			d = sorted[min(int(float64(n)*h.policy.Percentile), n-1)]
+		}
+		h.mu.Unlock()
+	}
+	return max(d, h.policy.MinDelay)
+}
+
+// hedgeCall 调用 call，超过等待时间仍未返回时再发送一次相同的请求，使用先成功的结果
+//
+// 第一个请求在发送对冲请求前失败时直接返回错误（暂时性错误由重试处理）；
+// 两个请求都失败时返回第一个错误。返回前取消仍在进行的请求
+func hedgeCall[T any](ctx context.Context, h *hedger, call func(ctx context.Context) (T, error)) (T, error) {
+	if h == nil {
+		return call(ctx)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		value T
+		err   error
+		start time.Time
+	}
+	results := make(chan result, 2)
+	launch := func() {
+		start := time.Now()
+		go func() {
+			v, err := call(ctx)
+			results <- result{value: v, err: err, start: start}
+		}()
+	}
+
+	launch()
+	timer := time.NewTimer(h.delay())
+	defer timer.Stop()
+
+	pending := 1
+	var firstErr error
+	for {
+		select {
+		case <-timer.C:
+			pending++
+			launch()
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				h.observe(time.Since(r.start))
+				return r.value, nil
+			}
+			if firstErr == nil {
+				firstErr = r.err
+			}
+			// 未发送对冲请求时 pending 为0，直接返回
+			if pending == 0 {
+				var zero T
+				return zero, firstErr
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package resource
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"github.com/heyinLab/common/pkg/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// slowFirstServer 第一次获取文件URL的请求等待 slow 或请求取消，之后的请求立即返回
+type slowFirstServer struct {
+	v1.UnimplementedResourceInternalServiceServer
+
+	slow     time.Duration
+	calls    atomic.Int32
+	canceled atomic.Int32
+}
+
+func (s *slowFirstServer) InternalGetFileUrls(ctx context.Context, req *v1.InternalGetFileUrlsRequest) (*v1.InternalGetFileUrlsResponse, error) {
+	if s.calls.Add(1) == 1 {
+		select {
+		case <-time.After(s.slow):
+		case <-ctx.Done():
+			s.canceled.Add(1)
+			return nil, ctx.Err()
+		}
+	}
+	resp := &v1.InternalGetFileUrlsResponse{Results: map[string]*v1.InternalFileUrlInfo{}}
+	for _, id := range req.FileIds {
+		resp.Results[id] = &v1.InternalFileUrlInfo{Url: "https://cdn.example.com/" + id, Success: true}
+	}
+	return resp, nil
+}
+
+func TestHedgedGetFileUrls(t *testing.T) {
+	ctx := context.Background()
+
+	srv := &slowFirstServer{slow: 2 * time.Second}
+	client := newDialedTestClient(t, srv, DefaultConfig().WithHedge(&common.HedgePolicy{Delay: 20 * time.Millisecond}))
+	start := time.Now()
+	urls, err := client.GetFileUrls(ctx, 7, []string{"f1"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/f1", urls["f1"].Url)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, int32(2), srv.calls.Load())
+	// 慢请求被取消
+	assert.Eventually(t, func() bool { return srv.canceled.Load() == 1 }, time.Second, 10*time.Millisecond)
+
+	// 未配置对冲策略时只发送一次请求
+	srv = &slowFirstServer{slow: 100 * time.Millisecond}
+	client = newDialedTestClient(t, srv, DefaultConfig())
+	_, err = client.GetFileUrls(ctx, 7, []string{"f1"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), srv.calls.Load())
+}
+
+func TestHedgerDelay(t *testing.T) {
+	h := newHedger(&common.HedgePolicy{Delay: 100 * time.Millisecond, Percentile: 0.95, MinDelay: 5 * time.Millisecond})
+
+	// 样本不足时使用固定等待时间
+	h.observe(time.Millisecond)
+	assert.Equal(t, 100*time.Millisecond, h.delay())
+
+	for i := 1; i <= hedgeMinSamples; i++ {
+		h.observe(time.Duration(i) * 10 * time.Millisecond)
+	}
+	assert.Equal(t, 190*time.Millisecond, h.delay())
+
+	// 不低于最小等待时间
+	h = newHedger(&common.HedgePolicy{Percentile: 0.5, MinDelay: 5 * time.Millisecond})
+	for range hedgeWindow {
+		h.observe(time.Millisecond)
+	}
+	assert.Equal(t, 5*time.Millisecond, h.delay())
+
+	assert.Nil(t, newHedger(nil))
+}
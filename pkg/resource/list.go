@@ -0,0 +1,182 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+)
+
+// ListFilesOptions 列出文件的选项
+type ListFilesOptions struct {
+	// 目录（可选）
+	Folder string
+	// 文件大类（可选）：image, video, document, audio, archive, other
+	FileCategory string
+	// 文件状态（可选），默认 completed
+	Status string
+	// 标签（可选），只返回带有该标签的文件
+	Tag string
+	// 每页数量，默认50，最大200
+	PageSize int32
+	// 分页游标，第一页为空
+	PageToken string
+}
+
+// ListFiles 分页列出文件
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - opts: 可选参数
+//
+// 返回:
+//   - []*v1.InternalFileInfo: 当前页的文件
+//   - string: 下一页的游标，为空时表示没有更多数据
+//   - error: 错误信息
+func (c *ResourceClient) ListFiles(ctx context.Context, tenantID uint32, opts *ListFilesOptions) ([]*v1.InternalFileInfo, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	req := &v1.InternalListFilesRequest{
+		TenantId: tenantID,
+	}
+	if opts != nil {
+		req.Folder = opts.Folder
+		req.FileCategory = opts.FileCategory
+		req.Status = opts.Status
+		req.Tag = opts.Tag
+		req.PageSize = opts.PageSize
+		req.PageToken = opts.PageToken
+	}
+
+	resp, err := c.client.InternalListFiles(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("列出文件失败: tenant_id=%d, folder=%s, page_token=%s, error=%v", tenantID, req.Folder, req.PageToken, err)
+		return nil, "", err
+	}
+
+	return resp.Files, resp.NextPageToken, nil
+}
+
+// ListFilesByTag 分页列出带有指定标签的文件
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - tag: 标签
+//   - opts: 可选参数，其中的 Tag 会被 tag 覆盖
+//
+// 返回:
+//   - []*v1.InternalFileInfo: 当前页的文件
+//   - string: 下一页的游标，为空时表示没有更多数据
+//   - error: 错误信息
+func (c *ResourceClient) ListFilesByTag(ctx context.Context, tenantID uint32, tag string, opts *ListFilesOptions) ([]*v1.InternalFileInfo, string, error) {
+	if tag == "" {
+		return nil, "", fmt.Errorf("标签不能为空")
+	}
+
+	tagged := ListFilesOptions{}
+	if opts != nil {
+		tagged = *opts
+	}
+	tagged.Tag = tag
+	return c.ListFiles(ctx, tenantID, &tagged)
+}
+
+// FileIterator 自动翻页的文件迭代器，由 ListFilesIterator 创建，不能并发使用
+type FileIterator struct {
+	ctx      context.Context
+	service  ResourceService
+	tenantID uint32
+	opts     ListFilesOptions
+
+	page []*v1.InternalFileInfo
+	file *v1.InternalFileInfo
+	done bool
+	err  error
+}
+
+// ListFilesIterator 创建自动翻页的文件迭代器
+//
+// 迭代器按需请求下一页，调用方只需循环 Next，结束后检查 Err
+//
+// 参数:
+//   - ctx: 上下文，用于所有翻页请求
+//   - tenantID: 租户ID
+//   - opts: 可选参数，PageToken 不为空时从该位置继续
+//
+// 返回:
+//   - *FileIterator: 文件迭代器
+//
+// 使用示例:
+//
+//	it := client.ListFilesIterator(ctx, tenantID, &resource.ListFilesOptions{Folder: "products"})
+//	for it.Next() {
+//	    file := it.File()
+//	    // 处理文件
+//	}
+//	if err := it.Err(); err != nil {
+//	    return err
+//	}
+func (c *ResourceClient) ListFilesIterator(ctx context.Context, tenantID uint32, opts *ListFilesOptions) *FileIterator {
+	return NewFileIterator(ctx, c, tenantID, opts)
+}
+
+// NewFileIterator 创建基于任意 ResourceService 的文件迭代器
+//
+// 依赖 ResourceService 接口的业务代码使用它代替 ListFilesIterator，参数含义相同
+func NewFileIterator(ctx context.Context, service ResourceService, tenantID uint32, opts *ListFilesOptions) *FileIterator {
+	it := &FileIterator{
+		ctx:      ctx,
+		service:  service,
+		tenantID: tenantID,
+	}
+	if opts != nil {
+		it.opts = *opts
+	}
+	return it
+}
+
+// Next 移动到下一个文件，没有更多文件或出错时返回 false
+func (it *FileIterator) Next() bool {
+	for len(it.page) == 0 {
+		if it.done || it.err != nil {
+			it.file = nil
+			return false
+		}
+		it.fetch()
+	}
+
+	it.file = it.page[0]
+	it.page = it.page[1:]
+	return true
+}
+
+// fetch 请求下一页
+func (it *FileIterator) fetch() {
+	files, next, err := it.service.ListFiles(it.ctx, it.tenantID, &it.opts)
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.page = files
+	it.opts.PageToken = next
+	it.done = next == ""
+}
+
+// File 返回当前文件，只在 Next 返回 true 后有效
+func (it *FileIterator) File() *v1.InternalFileInfo {
+	return it.file
+}
+
+// Err 返回迭代过程中的错误
+func (it *FileIterator) Err() error {
+	return it.err
+}
+
+// PageToken 返回下一页的游标，出错后可以用它创建新的迭代器从中断处继续
+func (it *FileIterator) PageToken() string {
+	return it.opts.PageToken
+}
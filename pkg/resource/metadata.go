@@ -0,0 +1,67 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+)
+
+// FileMetadataUpdate 文件元数据修改，未设置的部分保持不变
+type FileMetadataUpdate struct {
+	// 新文件名，为空时不修改
+	Filename string
+	// 合并到自定义元数据的键值，值为空字符串时删除该键
+	Metadata map[string]string
+	// 添加的标签
+	AddTags []string
+	// 删除的标签
+	RemoveTags []string
+}
+
+// UpdateFileMetadata 更新文件名、自定义元数据和标签
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - fileID: 文件ID
+//   - update: 要修改的内容
+//
+// 返回:
+//   - *v1.InternalFileInfo: 更新后的文件信息
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	file, err := client.UpdateFileMetadata(ctx, tenantID, fileID, &resource.FileMetadataUpdate{
+//	    Metadata: map[string]string{"product_id": "10086"},
+//	    AddTags:  []string{"product-cover"},
+//	})
+func (c *ResourceClient) UpdateFileMetadata(ctx context.Context, tenantID uint32, fileID string, update *FileMetadataUpdate) (*v1.InternalFileInfo, error) {
+	if fileID == "" {
+		return nil, fmt.Errorf("文件ID不能为空")
+	}
+	if update == nil {
+		return nil, fmt.Errorf("修改内容不能为空")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.InternalUpdateFileMetadata(ctx, &v1.InternalUpdateFileMetadataRequest{
+		TenantId:   tenantID,
+		FileId:     fileID,
+		Filename:   update.Filename,
+		Metadata:   update.Metadata,
+		AddTags:    update.AddTags,
+		RemoveTags: update.RemoveTags,
+	})
+	// 更新失败时无法确定是否已生效，同样使缓存失效
+	c.fileCache.invalidate(tenantID, fileID)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("更新文件元数据失败: tenant_id=%d, file_id=%s, error=%v", tenantID, fileID, err)
+		return nil, err
+	}
+
+	return resp.File, nil
+}
@@ -0,0 +1,45 @@
+package resource
+
+import (
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/metrics"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// metricsMeterName 客户端调用指标的 Meter 名称
+const metricsMeterName = "resource-internal-client"
+
+// metricsMiddleware 记录每个方法的调用次数（按错误码）和耗时
+//
+// 指标名称:
+//   - client_requests_code_total: 调用次数，标签 kind / operation / code / reason
+//   - client_requests_seconds_bucket: 调用耗时（秒），标签 kind / operation
+//
+// 使用 otel 的 Prometheus 导出器注册到已有的 Registry:
+//
+//	exporter, _ := otelprom.New(otelprom.WithRegisterer(registry))
+//	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(exporter))
+//	config := resource.DefaultConfig().WithMeterProvider(provider)
+func metricsMiddleware(mp metric.MeterProvider) (middleware.Middleware, error) {
+	if mp == nil {
+		mp = otel.GetMeterProvider()
+	}
+	meter := mp.Meter(metricsMeterName)
+
+	requests, err := metrics.DefaultRequestsCounter(meter, metrics.DefaultClientRequestsCounterName)
+	if err != nil {
+		return nil, fmt.Errorf("创建请求计数指标失败: %w", err)
+	}
+	seconds, err := metrics.DefaultSecondsHistogram(meter, metrics.DefaultClientSecondsHistogramName)
+	if err != nil {
+		return nil, fmt.Errorf("创建请求耗时指标失败: %w", err)
+	}
+
+	return metrics.Client(
+		metrics.WithRequests(requests),
+		metrics.WithSeconds(seconds),
+	), nil
+}
@@ -0,0 +1,57 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestMetricsMiddleware(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	srv := &flakyResourceServer{}
+	client := newDialedTestClient(t, srv, DefaultConfig().WithRetry(nil).WithMeterProvider(provider))
+	ctx := context.Background()
+
+	_, err := client.GetFile(ctx, 7, "f1")
+	assert.NoError(t, err)
+	srv.failures.Store(1)
+	_, err = client.GetFile(ctx, 7, "f1")
+	assert.Error(t, err)
+
+	var rm metricdata.ResourceMetrics
+	assert.NoError(t, reader.Collect(ctx, &rm))
+
+	counts := map[int64]int64{}
+	var histogramCount uint64
+	for _, sm := range rm.ScopeMetrics {
+		// 熔断器的指标在单独的 Meter 中
+		if sm.Scope.Name != metricsMeterName {
+			continue
+		}
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				assert.Equal(t, "client_requests_code_total", m.Name)
+				for _, dp := range data.DataPoints {
+					op, _ := dp.Attributes.Value(attribute.Key("operation"))
+					assert.Equal(t, v1.ResourceInternalService_InternalGetFile_FullMethodName, op.AsString())
+					code, _ := dp.Attributes.Value(attribute.Key("code"))
+					counts[code.AsInt64()] += dp.Value
+				}
+			case metricdata.Histogram[float64]:
+				for _, dp := range data.DataPoints {
+					histogramCount += dp.Count
+				}
+			}
+		}
+	}
+	assert.Equal(t, map[int64]int64{200: 1, 503: 1}, counts)
+	assert.Equal(t, uint64(2), histogramCount)
+}
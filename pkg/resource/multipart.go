@@ -0,0 +1,501 @@
+package resource
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultPartSize 默认的分片大小
+	DefaultPartSize = 8 * 1024 * 1024
+
+	// defaultPartConcurrency 默认同时上传的分片数
+	defaultPartConcurrency = 4
+
+	// defaultPartRetries 单个分片失败后的默认重试次数
+	defaultPartRetries = 3
+)
+
+// errPartChecksumMismatch 服务端确认的分片校验和与本地计算的不一致
+var errPartChecksumMismatch = errors.New("分片校验和不一致")
+
+// MultipartUploadRequest 初始化分片上传请求
+type MultipartUploadRequest struct {
+	// 原始文件名（必填）
+	Filename string
+	// MIME类型，为空时由资源服务根据文件名推断
+	ContentType string
+	// 文件大小（字节，必填）
+	Size int64
+	// 期望的分片大小（字节），默认8MB，资源服务会调整到对象存储允许的范围内
+	PartSize int64
+	// 目录（可选）
+	Folder string
+	// 是否公开访问
+	IsPublic bool
+	// 整个文件的SHA256（十六进制，可选），设置后资源服务在完成上传时校验
+	ChecksumSHA256 string
+}
+
+// MultipartUpload 分片上传信息
+type MultipartUpload struct {
+	// 上传ID，断点续传时使用
+	UploadID string
+	// 文件ID
+	FileID string
+	// 分片大小（字节），除最后一个分片外每个分片都是这个大小
+	PartSize int64
+	// 分片数量
+	PartCount int32
+}
+
+// InitMultipartUpload 初始化分片上传
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - req: 上传请求
+//
+// 返回:
+//   - *MultipartUpload: 上传ID和分片规划
+//   - error: 错误信息
+func (c *ResourceClient) InitMultipartUpload(ctx context.Context, tenantID uint32, req *MultipartUploadRequest) (*MultipartUpload, error) {
+	if req == nil || req.Filename == "" {
+		return nil, fmt.Errorf("文件名不能为空")
+	}
+	if req.Size <= 0 {
+		return nil, fmt.Errorf("文件大小必须大于0，当前: %d", req.Size)
+	}
+	partSize := req.PartSize
+	if partSize <= 0 {
+		partSize = DefaultPartSize
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.InternalInitMultipartUpload(ctx, &v1.InternalInitMultipartUploadRequest{
+		TenantId:       tenantID,
+		Filename:       req.Filename,
+		ContentType:    req.ContentType,
+		Size:           req.Size,
+		PartSize:       partSize,
+		Folder:         req.Folder,
+		IsPublic:       req.IsPublic,
+		ChecksumSha256: req.ChecksumSHA256,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("初始化分片上传失败: tenant_id=%d, filename=%s, size=%d, error=%v", tenantID, req.Filename, req.Size, err)
+		return nil, err
+	}
+
+	return &MultipartUpload{
+		UploadID:  resp.UploadId,
+		FileID:    resp.FileId,
+		PartSize:  resp.PartSize,
+		PartCount: resp.PartCount,
+	}, nil
+}
+
+// UploadPart 上传一个分片
+//
+// 客户端计算分片的 SHA256 随分片发送，资源服务校验后返回确认的分片信息；
+// 返回的校验和与本地不一致时返回错误。分片的上传耗时由调用方通过 ctx 控制。
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - uploadID: 上传ID
+//   - partNumber: 分片号，从1开始
+//   - data: 分片内容
+//
+// 返回:
+//   - *v1.InternalUploadedPart: 已上传的分片，完成上传时使用
+//   - error: 错误信息
+func (c *ResourceClient) UploadPart(ctx context.Context, tenantID uint32, uploadID string, partNumber int32, data []byte) (*v1.InternalUploadedPart, error) {
+	part, err := c.uploadPart(ctx, tenantID, uploadID, partNumber, data, checksumSHA256(data), nil)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("上传分片失败: tenant_id=%d, upload_id=%s, part_number=%d, error=%v", tenantID, uploadID, partNumber, err)
+		return nil, err
+	}
+	return part, nil
+}
+
+// uploadPart 通过客户端流发送一个分片，limiter 为 nil 时不限速
+func (c *ResourceClient) uploadPart(ctx context.Context, tenantID uint32, uploadID string, partNumber int32, data []byte, checksum string, limiter *rate.Limiter) (*v1.InternalUploadedPart, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.client.InternalUploadPart(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.Send(&v1.InternalUploadPartRequest{
+		Meta: &v1.InternalUploadPartMeta{
+			TenantId:       tenantID,
+			UploadId:       uploadID,
+			PartNumber:     partNumber,
+			Size:           int64(len(data)),
+			ChecksumSha256: checksum,
+		},
+	})
+	if err != nil {
+		return nil, closeAndRecvError(stream, err)
+	}
+
+	err = sendChunks(bytes.NewReader(data), func(chunk []byte) error {
+		if err := waitBytes(ctx, limiter, len(chunk)); err != nil {
+			return err
+		}
+		return stream.Send(&v1.InternalUploadPartRequest{Chunk: chunk})
+	})
+	if err != nil {
+		return nil, closeAndRecvError(stream, err)
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+	if resp.Part == nil || resp.Part.ChecksumSha256 != checksum {
+		return nil, fmt.Errorf("%w: part_number=%d", errPartChecksumMismatch, partNumber)
+	}
+	return resp.Part, nil
+}
+
+// ListUploadedParts 查询已上传的分片，用于断点续传
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - uploadID: 上传ID
+//
+// 返回:
+//   - *MultipartUpload: 上传的分片规划
+//   - []*v1.InternalUploadedPart: 已上传的分片
+//   - error: 错误信息
+func (c *ResourceClient) ListUploadedParts(ctx context.Context, tenantID uint32, uploadID string) (*MultipartUpload, []*v1.InternalUploadedPart, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.InternalListUploadedParts(ctx, &v1.InternalListUploadedPartsRequest{
+		TenantId: tenantID,
+		UploadId: uploadID,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("查询已上传分片失败: tenant_id=%d, upload_id=%s, error=%v", tenantID, uploadID, err)
+		return nil, nil, err
+	}
+
+	upload := &MultipartUpload{
+		UploadID:  uploadID,
+		FileID:    resp.FileId,
+		PartSize:  resp.PartSize,
+		PartCount: resp.PartCount,
+	}
+	return upload, resp.Parts, nil
+}
+
+// CompleteMultipartUpload 完成分片上传
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - uploadID: 上传ID
+//   - parts: 所有分片，顺序不限
+//
+// 返回:
+//   - *v1.InternalFileInfo: 文件信息
+//   - string: 文件访问URL
+//   - error: 错误信息
+func (c *ResourceClient) CompleteMultipartUpload(ctx context.Context, tenantID uint32, uploadID string, parts []*v1.InternalUploadedPart) (*v1.InternalFileInfo, string, error) {
+	sorted := make([]*v1.InternalUploadedPart, len(parts))
+	copy(sorted, parts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].PartNumber < sorted[j].PartNumber })
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.InternalCompleteMultipartUpload(ctx, &v1.InternalCompleteMultipartUploadRequest{
+		TenantId: tenantID,
+		UploadId: uploadID,
+		Parts:    sorted,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("完成分片上传失败: tenant_id=%d, upload_id=%s, parts=%d, error=%v", tenantID, uploadID, len(parts), err)
+		return nil, "", err
+	}
+
+	return resp.File, resp.Url, nil
+}
+
+// AbortMultipartUpload 取消分片上传并删除已上传的分片
+func (c *ResourceClient) AbortMultipartUpload(ctx context.Context, tenantID uint32, uploadID string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	_, err := c.client.InternalAbortMultipartUpload(ctx, &v1.InternalAbortMultipartUploadRequest{
+		TenantId: tenantID,
+		UploadId: uploadID,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("取消分片上传失败: tenant_id=%d, upload_id=%s, error=%v", tenantID, uploadID, err)
+		return err
+	}
+	return nil
+}
+
+// LargeFileUploadRequest 大文件上传请求
+type LargeFileUploadRequest struct {
+	MultipartUploadRequest
+
+	// 文件内容（必填），按分片并发读取，*os.File 实现了该接口
+	Reader io.ReaderAt
+	// 同时上传的分片数，默认4
+	Concurrency int
+	// 单个分片失败后的重试次数，默认3，负数表示不重试
+	MaxRetries int
+	// 断点续传的上传ID，为空时新建上传
+	UploadID string
+	// 上传进度回调（可选），参数为已上传和总字节数，每完成一个分片调用一次，会被并发调用
+	OnProgress func(uploaded, total int64)
+	// 上传限速（字节/秒，可选），所有并发分片共享，0 表示不限速
+	RateLimit int64
+}
+
+// MultipartUploadError 大文件上传失败，可以使用 UploadID 断点续传
+type MultipartUploadError struct {
+	UploadID string
+	Err      error
+}
+
+func (e *MultipartUploadError) Error() string {
+	return fmt.Sprintf("分片上传失败 (upload_id=%s): %v", e.UploadID, e.Err)
+}
+
+func (e *MultipartUploadError) Unwrap() error {
+	return e.Err
+}
+
+// UploadLargeFile 分片并发上传大文件
+//
+// 依次完成初始化、并发上传分片和合并，每个分片带 SHA256 校验，暂时性错误会自动重试。
+// 失败时返回 *MultipartUploadError，已上传的分片保留在资源服务中，
+// 把其中的 UploadID 填入请求后重新调用即可跳过已上传的分片继续上传。
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - req: 上传请求
+//
+// 返回:
+//   - *v1.InternalFileInfo: 文件信息
+//   - string: 文件访问URL
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	f, _ := os.Open("demo.mp4")
+//	defer f.Close()
+//	stat, _ := f.Stat()
+//
+//	req := &resource.LargeFileUploadRequest{
+//	    MultipartUploadRequest: resource.MultipartUploadRequest{
+//	        Filename:    "demo.mp4",
+//	        ContentType: "video/mp4",
+//	        Size:        stat.Size(),
+//	    },
+//	    Reader: f,
+//	}
+//	file, url, err := client.UploadLargeFile(ctx, tenantID, req)
+//	var uploadErr *resource.MultipartUploadError
+//	if errors.As(err, &uploadErr) {
+//	    req.UploadID = uploadErr.UploadID // 稍后断点续传
+//	}
+func (c *ResourceClient) UploadLargeFile(ctx context.Context, tenantID uint32, req *LargeFileUploadRequest) (*v1.InternalFileInfo, string, error) {
+	if req == nil || req.Reader == nil {
+		return nil, "", fmt.Errorf("文件内容不能为空")
+	}
+
+	var upload *MultipartUpload
+	var uploaded []*v1.InternalUploadedPart
+	var err error
+	if req.UploadID != "" {
+		upload, uploaded, err = c.ListUploadedParts(ctx, tenantID, req.UploadID)
+	} else {
+		upload, err = c.InitMultipartUpload(ctx, tenantID, &req.MultipartUploadRequest)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	if upload.PartSize <= 0 || upload.PartCount <= 0 ||
+		int64(upload.PartCount)*upload.PartSize < req.Size || int64(upload.PartCount-1)*upload.PartSize >= req.Size {
+		return nil, "", &MultipartUploadError{UploadID: upload.UploadID, Err: fmt.Errorf("分片规划无效: part_size=%d, part_count=%d", upload.PartSize, upload.PartCount)}
+	}
+
+	parts, err := c.uploadParts(ctx, tenantID, req, upload, uploaded)
+	if err != nil {
+		return nil, "", &MultipartUploadError{UploadID: upload.UploadID, Err: err}
+	}
+
+	file, url, err := c.CompleteMultipartUpload(ctx, tenantID, upload.UploadID, parts)
+	if err != nil {
+		return nil, "", &MultipartUploadError{UploadID: upload.UploadID, Err: err}
+	}
+	return file, url, nil
+}
+
+// uploadParts 并发上传所有未上传的分片，任一分片最终失败时取消其余分片
+func (c *ResourceClient) uploadParts(ctx context.Context, tenantID uint32, req *LargeFileUploadRequest, upload *MultipartUpload, uploaded []*v1.InternalUploadedPart) ([]*v1.InternalUploadedPart, error) {
+	concurrency := req.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultPartConcurrency
+	}
+	retries := req.MaxRetries
+	if retries < 0 {
+		retries = 0
+	} else if retries == 0 {
+		retries = defaultPartRetries
+	}
+
+	done := make(map[int32]*v1.InternalUploadedPart, len(uploaded))
+	for _, p := range uploaded {
+		done[p.PartNumber] = p
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	limiter := newByteLimiter(req.RateLimit)
+	var (
+		mu       sync.Mutex
+		parts    = make([]*v1.InternalUploadedPart, upload.PartCount)
+		firstErr error
+		progress atomic.Int64
+	)
+	fail := func(err error) {
+		mu.Lock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+		mu.Unlock()
+	}
+
+	numbers := make(chan int32)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := range numbers {
+				if ctx.Err() != nil {
+					continue
+				}
+				part, err := c.uploadPartFromReader(ctx, tenantID, req, upload, n, done[n], retries, limiter)
+				if err != nil {
+					fail(err)
+					continue
+				}
+				parts[n-1] = part
+				if req.OnProgress != nil {
+					req.OnProgress(progress.Add(part.Size), req.Size)
+				}
+			}
+		}()
+	}
+
+	for n := int32(1); n <= upload.PartCount; n++ {
+		select {
+		case numbers <- n:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(numbers)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return parts, nil
+}
+
+// uploadPartFromReader 读取并上传一个分片，已上传且校验和一致的分片直接跳过
+func (c *ResourceClient) uploadPartFromReader(ctx context.Context, tenantID uint32, req *LargeFileUploadRequest, upload *MultipartUpload, partNumber int32, existing *v1.InternalUploadedPart, retries int, limiter *rate.Limiter) (*v1.InternalUploadedPart, error) {
+	offset := int64(partNumber-1) * upload.PartSize
+	size := upload.PartSize
+	if offset+size > req.Size {
+		size = req.Size - offset
+	}
+
+	data := make([]byte, size)
+	if n, err := req.Reader.ReadAt(data, offset); err != nil && !(errors.Is(err, io.EOF) && int64(n) == size) {
+		return nil, fmt.Errorf("读取分片 %d 失败: %w", partNumber, err)
+	}
+	checksum := checksumSHA256(data)
+	if existing != nil && existing.Size == size && existing.ChecksumSha256 == checksum {
+		return existing, nil
+	}
+
+	var err error
+	for attempt := 0; ; attempt++ {
+		var part *v1.InternalUploadedPart
+		part, err = c.uploadPart(ctx, tenantID, upload.UploadID, partNumber, data, checksum, limiter)
+		if err == nil {
+			return part, nil
+		}
+		if attempt >= retries || !retryablePartError(err) {
+			break
+		}
+
+		c.logger.WithContext(ctx).Warnf("上传分片失败，准备重试: upload_id=%s, part_number=%d, attempt=%d, error=%v", upload.UploadID, partNumber, attempt+1, err)
+		timer := time.NewTimer(time.Duration(attempt+1) * 500 * time.Millisecond)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+	c.logger.WithContext(ctx).Errorf("上传分片失败: tenant_id=%d, upload_id=%s, part_number=%d, error=%v", tenantID, upload.UploadID, partNumber, err)
+	return nil, err
+}
+
+// retryablePartError 判断分片上传错误是否可以重试
+func retryablePartError(err error) bool {
+	if errors.Is(err, errPartChecksumMismatch) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Aborted, codes.DataLoss:
+		return true
+	}
+	return false
+}
+
+// checksumSHA256 计算十六进制的 SHA256
+func checksumSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
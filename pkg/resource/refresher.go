@@ -0,0 +1,239 @@
+package resource
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultRefreshBefore 默认在URL过期前多久刷新
+	defaultRefreshBefore = time.Minute
+
+	// defaultRefreshRetry 刷新失败后默认的重试间隔
+	defaultRefreshRetry = 5 * time.Second
+)
+
+// URLUpdate 一次URL刷新的结果
+type URLUpdate struct {
+	// 文件ID
+	FileID string
+	// 刷新后的URL信息，Err 不为 nil 时为空
+	Info *v1.InternalFileUrlInfo
+	// 刷新失败的原因；文件已不存在时该文件不再跟踪，其他错误会在 RetryInterval 后重试
+	Err error
+}
+
+// URLRefresherOptions URL自动刷新的选项
+type URLRefresherOptions struct {
+	// 在URL过期前多久刷新，默认1分钟；超过有效期的一半时在有效期过半时刷新
+	RefreshBefore time.Duration
+	// 刷新失败后的重试间隔，默认5秒
+	RetryInterval time.Duration
+	// 刷新URL时使用的选项（有效期、是否包含变体URL）
+	URLOptions *GetFileUrlsOptions
+	// 刷新回调，在刷新的 goroutine 中调用；为 nil 时结果发送到 Updates 返回的 channel
+	OnRefresh func(update URLUpdate)
+}
+
+// URLRefresher 在预签名URL过期前自动重新获取，适合长时间的视频播放等会话
+//
+// 公开文件的URL不会过期（ExpiresIn 为0），不会被跟踪。可以并发使用，使用后需要调用 Close
+//
+// 使用示例:
+//
+//	refresher := resource.NewURLRefresher(client, tenantID, nil)
+//	defer refresher.Close()
+//
+//	urls, _ := client.GetFileUrls(ctx, tenantID, []string{videoID}, nil)
+//	refresher.Track(videoID, urls[videoID])
+//	for update := range refresher.Updates() {
+//	    if update.Err == nil {
+//	        session.SetVideoURL(update.Info.Url)
+//	    }
+//	}
+type URLRefresher struct {
+	service  ResourceService
+	tenantID uint32
+	opts     URLRefresherOptions
+	updates  chan URLUpdate
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	entries map[string]*refreshEntry
+}
+
+// refreshEntry 一个被跟踪的文件
+type refreshEntry struct {
+	info  *v1.InternalFileUrlInfo
+	timer *time.Timer
+}
+
+// NewURLRefresher 创建URL自动刷新器
+//
+// 参数:
+//   - service: 资源服务客户端
+//   - tenantID: 租户ID
+//   - opts: 可选参数
+//
+// 返回:
+//   - *URLRefresher: URL自动刷新器
+func NewURLRefresher(service ResourceService, tenantID uint32, opts *URLRefresherOptions) *URLRefresher {
+	r := &URLRefresher{
+		service:  service,
+		tenantID: tenantID,
+		updates:  make(chan URLUpdate, 16),
+		entries:  make(map[string]*refreshEntry),
+	}
+	if opts != nil {
+		r.opts = *opts
+	}
+	if r.opts.RefreshBefore <= 0 {
+		r.opts.RefreshBefore = defaultRefreshBefore
+	}
+	if r.opts.RetryInterval <= 0 {
+		r.opts.RetryInterval = defaultRefreshRetry
+	}
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	return r
+}
+
+// Track 开始跟踪文件URL，在过期前自动刷新；已跟踪的文件会按新的URL信息重新计时
+//
+// info 应为刚获取的URL信息，公开文件或获取失败的URL信息会被忽略
+func (r *URLRefresher) Track(fileID string, info *v1.InternalFileUrlInfo) {
+	if info == nil || !info.Success || info.ExpiresIn <= 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.ctx.Err() != nil {
+		return
+	}
+	r.schedule(fileID, proto.Clone(info).(*v1.InternalFileUrlInfo), r.refreshDelay(info.ExpiresIn))
+}
+
+// Untrack 停止跟踪文件
+func (r *URLRefresher) Untrack(fileID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if entry, ok := r.entries[fileID]; ok {
+		entry.timer.Stop()
+		delete(r.entries, fileID)
+	}
+}
+
+// Get 返回文件最新的URL信息，未跟踪时返回 nil
+func (r *URLRefresher) Get(fileID string) *v1.InternalFileUrlInfo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if entry, ok := r.entries[fileID]; ok {
+		return proto.Clone(entry.info).(*v1.InternalFileUrlInfo)
+	}
+	return nil
+}
+
+// Updates 返回刷新结果的 channel，设置了 OnRefresh 时不会收到结果；Close 后关闭
+func (r *URLRefresher) Updates() <-chan URLUpdate {
+	return r.updates
+}
+
+// Close 停止所有刷新并关闭 Updates 返回的 channel
+func (r *URLRefresher) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.ctx.Err() != nil {
+		return
+	}
+	r.cancel()
+	for fileID, entry := range r.entries {
+		entry.timer.Stop()
+		delete(r.entries, fileID)
+	}
+	close(r.updates)
+}
+
+// refreshDelay 计算获取URL后多久刷新
+func (r *URLRefresher) refreshDelay(expiresIn int64) time.Duration {
+	ttl := time.Duration(expiresIn) * time.Second
+	return max(ttl-r.opts.RefreshBefore, ttl/2)
+}
+
+// schedule 在 delay 后刷新文件URL，调用方持有锁
+func (r *URLRefresher) schedule(fileID string, info *v1.InternalFileUrlInfo, delay time.Duration) {
+	entry, ok := r.entries[fileID]
+	if ok {
+		entry.timer.Stop()
+	}
+	entry = &refreshEntry{info: info}
+	entry.timer = time.AfterFunc(delay, func() { r.refresh(fileID, entry) })
+	r.entries[fileID] = entry
+}
+
+// refresh 重新获取文件URL并发送结果
+func (r *URLRefresher) refresh(fileID string, entry *refreshEntry) {
+	results, err := r.service.GetFileUrls(r.ctx, r.tenantID, []string{fileID}, r.opts.URLOptions)
+
+	update := URLUpdate{FileID: fileID}
+	removed := false
+	switch info, ok := results[fileID]; {
+	case err != nil:
+		update.Err = err
+	case !ok || !info.Success:
+		update.Err = fileResultError("获取文件URL失败", info.GetError())
+		removed = true
+	default:
+		update.Info = info
+	}
+
+	r.mu.Lock()
+	// 刷新期间文件被取消跟踪、重新跟踪或刷新器已关闭时丢弃结果
+	if r.ctx.Err() != nil || r.entries[fileID] != entry {
+		r.mu.Unlock()
+		return
+	}
+	switch {
+	case removed:
+		delete(r.entries, fileID)
+	case update.Err != nil:
+		r.schedule(fileID, entry.info, r.opts.RetryInterval)
+	case update.Info.ExpiresIn <= 0:
+		// 文件已改为公开访问，URL不再过期
+		delete(r.entries, fileID)
+	default:
+		r.schedule(fileID, proto.Clone(update.Info).(*v1.InternalFileUrlInfo), r.refreshDelay(update.Info.ExpiresIn))
+	}
+	r.mu.Unlock()
+
+	r.deliver(update)
+}
+
+// deliver 将刷新结果交给回调或 channel
+func (r *URLRefresher) deliver(update URLUpdate) {
+	if r.opts.OnRefresh != nil {
+		r.opts.OnRefresh(update)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.ctx.Err() != nil {
+		return
+	}
+	select {
+	case r.updates <- update:
+	default:
+		// 调用方没有及时读取时丢弃最旧的结果，保证最新的URL能送达
+		select {
+		case <-r.updates:
+		default:
+		}
+		r.updates <- update
+	}
+}
@@ -0,0 +1,96 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// urlService 只实现 GetFileUrls 的 ResourceService，每次返回带序号的URL
+type urlService struct {
+	ResourceService
+
+	mu      sync.Mutex
+	calls   int
+	failAt  int
+	missing bool
+}
+
+func (s *urlService) GetFileUrls(_ context.Context, _ uint32, fileIDs []string, _ *GetFileUrlsOptions) (map[string]*v1.InternalFileUrlInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	if s.calls == s.failAt {
+		return nil, status.Error(codes.Unavailable, "down")
+	}
+	results := map[string]*v1.InternalFileUrlInfo{}
+	for _, id := range fileIDs {
+		if s.missing {
+			results[id] = &v1.InternalFileUrlInfo{Error: "file not found"}
+			continue
+		}
+		results[id] = &v1.InternalFileUrlInfo{Url: fmt.Sprintf("https://cdn.example.com/%s?v=%d", id, s.calls), ExpiresIn: 1, Success: true}
+	}
+	return results, nil
+}
+
+func TestURLRefresher(t *testing.T) {
+	svc := &urlService{failAt: 2}
+	refresher := NewURLRefresher(svc, 7, &URLRefresherOptions{RetryInterval: 50 * time.Millisecond})
+	defer refresher.Close()
+
+	// 公开文件不跟踪
+	refresher.Track("public", &v1.InternalFileUrlInfo{Url: "https://cdn.example.com/public", Success: true})
+	assert.Nil(t, refresher.Get("public"))
+
+	refresher.Track("video", &v1.InternalFileUrlInfo{Url: "https://cdn.example.com/video?v=0", ExpiresIn: 1, Success: true})
+	assert.Equal(t, "https://cdn.example.com/video?v=0", refresher.Get("video").Url)
+
+	// 有效期1秒，在过半时刷新
+	update := <-refresher.Updates()
+	assert.NoError(t, update.Err)
+	assert.Equal(t, "https://cdn.example.com/video?v=1", update.Info.Url)
+	assert.Equal(t, update.Info.Url, refresher.Get("video").Url)
+
+	// 刷新失败后按 RetryInterval 重试，期间保留旧的URL
+	update = <-refresher.Updates()
+	assert.Equal(t, codes.Unavailable, status.Code(update.Err))
+	assert.Equal(t, "https://cdn.example.com/video?v=1", refresher.Get("video").Url)
+	update = <-refresher.Updates()
+	assert.NoError(t, update.Err)
+	assert.Equal(t, "https://cdn.example.com/video?v=3", update.Info.Url)
+
+	// 文件不存在时停止跟踪
+	svc.mu.Lock()
+	svc.missing = true
+	svc.mu.Unlock()
+	update = <-refresher.Updates()
+	assert.ErrorContains(t, update.Err, "file not found")
+	assert.Nil(t, refresher.Get("video"))
+
+	refresher.Close()
+	_, ok := <-refresher.Updates()
+	assert.False(t, ok)
+}
+
+func TestURLRefresher_Callback(t *testing.T) {
+	updates := make(chan URLUpdate, 1)
+	refresher := NewURLRefresher(&urlService{}, 7, &URLRefresherOptions{
+		OnRefresh: func(update URLUpdate) { updates <- update },
+	})
+	defer refresher.Close()
+
+	refresher.Track("video", &v1.InternalFileUrlInfo{ExpiresIn: 1, Success: true})
+	update := <-updates
+	assert.Equal(t, "https://cdn.example.com/video?v=1", update.Info.Url)
+
+	refresher.Untrack("video")
+	assert.Nil(t, refresher.Get("video"))
+}
@@ -0,0 +1,222 @@
+// Package resourcetest 提供资源服务的内存实现，用于业务代码的单元测试
+//
+// FakeClient 是连接到内存资源服务的真实 *resource.ResourceClient，方法、参数和返回值
+// 与生产客户端完全一致，不需要启动资源服务。文件保存在内存中，ID 按顺序生成
+// （file-1、file-2…），URL 由 FileURL 和 DownloadURL 确定地生成，测试中可以直接断言。
+//
+// 使用示例:
+//
+//	client := resourcetest.NewFakeClient()
+//	defer client.Close()
+//
+//	file := client.AddFile(tenantID, "avatar.png", []byte("png"))
+//	svc := user.NewService(repo, client) // 依赖 resource.ResourceService
+//
+//	url, err := svc.AvatarURL(ctx, tenantID, file.Id)
+//	assert.NoError(t, err)
+//	assert.Contains(t, url, resourcetest.FileURL(tenantID, file.Id))
+//
+//	// 模拟资源服务不可用
+//	client.SetError(status.Error(codes.Unavailable, "down"))
+package resourcetest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"github.com/heyinLab/common/pkg/resource"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+// FakeClient 连接到内存资源服务的客户端，可以并发使用
+//
+// 嵌入的 *resource.ResourceClient 不带重试和熔断，注入的错误会原样返回
+type FakeClient struct {
+	*resource.ResourceClient
+
+	srv  *server
+	grpc *grpc.Server
+}
+
+var _ resource.ResourceService = (*FakeClient)(nil)
+
+// NewFakeClient 创建连接到内存资源服务的客户端，使用后需要调用 Close
+func NewFakeClient() *FakeClient {
+	srv := newServer()
+	lis := bufconn.Listen(1024 * 1024)
+	gs := grpc.NewServer(
+		grpc.UnaryInterceptor(srv.unaryInterceptor),
+		grpc.StreamInterceptor(srv.streamInterceptor),
+	)
+	v1.RegisterResourceInternalServiceServer(gs, srv)
+	grpc_health_v1.RegisterHealthServer(gs, health.NewServer())
+	go func() { _ = gs.Serve(lis) }()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		// passthrough 目标和固定的选项不会解析失败
+		panic(err)
+	}
+
+	return &FakeClient{
+		ResourceClient: resource.NewResourceClientWithConn(nil, conn),
+		srv:            srv,
+		grpc:           gs,
+	}
+}
+
+// Close 关闭客户端连接并停止内存资源服务
+func (c *FakeClient) Close() error {
+	err := c.ResourceClient.Close()
+	c.grpc.Stop()
+	return err
+}
+
+// Shutdown 等待进行中的调用结束后关闭客户端连接，并停止内存资源服务
+func (c *FakeClient) Shutdown(ctx context.Context) error {
+	err := c.ResourceClient.Shutdown(ctx)
+	c.grpc.Stop()
+	return err
+}
+
+// AddFile 直接保存一个已上传完成的文件，用于准备测试数据
+//
+// 内容类型按文件扩展名推断，文件为私有文件，不在任何目录下
+func (c *FakeClient) AddFile(tenantID uint32, filename string, data []byte) *v1.InternalFileInfo {
+	c.srv.mu.Lock()
+	defer c.srv.mu.Unlock()
+	return cloneInfo(c.srv.addFile(tenantID, filename, "", "", false, data).info)
+}
+
+// File 返回文件信息和内容（包括已软删除的文件），不存在时 ok 为 false
+func (c *FakeClient) File(tenantID uint32, fileID string) (info *v1.InternalFileInfo, data []byte, ok bool) {
+	c.srv.mu.Lock()
+	defer c.srv.mu.Unlock()
+	f, ok := c.srv.files[tenantID][fileID]
+	if !ok {
+		return nil, nil, false
+	}
+	return cloneInfo(f.info), append([]byte(nil), f.data...), true
+}
+
+// SetScanStatus 设置文件的安全检查结果，默认为已通过（clean、pass）；传入 nil 恢复默认
+//
+// 用于模拟审核中（pending、review）和被拒绝（infected、block）的文件，文件不存在时 panic
+func (c *FakeClient) SetScanStatus(tenantID uint32, fileID string, scan *v1.InternalScanStatus) {
+	c.srv.mu.Lock()
+	defer c.srv.mu.Unlock()
+	f, ok := c.srv.files[tenantID][fileID]
+	if !ok {
+		panic("resourcetest: file not found: " + fileID)
+	}
+	if scan != nil {
+		scan = proto.Clone(scan).(*v1.InternalScanStatus)
+	}
+	f.scan = scan
+}
+
+// ShareLink 返回分享链接信息和访问密码，不存在或已撤销时 ok 为 false
+func (c *FakeClient) ShareLink(shareID string) (info *v1.InternalShareLinkInfo, password string, ok bool) {
+	c.srv.mu.Lock()
+	defer c.srv.mu.Unlock()
+	share, ok := c.srv.shares[shareID]
+	if !ok || share.revoked {
+		return nil, "", false
+	}
+	return proto.Clone(share.info).(*v1.InternalShareLinkInfo), share.password, true
+}
+
+// Files 返回租户的所有文件（包括已软删除的文件），按创建顺序排列
+func (c *FakeClient) Files(tenantID uint32) []*v1.InternalFileInfo {
+	c.srv.mu.Lock()
+	defer c.srv.mu.Unlock()
+	files := c.srv.sortedFiles(tenantID)
+	sort.Slice(files, func(i, j int) bool { return files[i].seq < files[j].seq })
+	infos := make([]*v1.InternalFileInfo, 0, len(files))
+	for _, f := range files {
+		infos = append(infos, cloneInfo(f.info))
+	}
+	return infos
+}
+
+// GetFileContent 从内存读取文件内容，不发送HTTP请求
+//
+// URL 查询仍经过内存资源服务，SetError 设置的错误同样生效
+func (c *FakeClient) GetFileContent(ctx context.Context, tenantID uint32, fileID string, opts *resource.GetFileContentOptions) ([]byte, *v1.InternalFileUrlInfo, error) {
+	results, err := c.GetFileUrls(ctx, tenantID, []string{fileID}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	info, ok := results[fileID]
+	if !ok || !info.Success {
+		return nil, nil, fmt.Errorf("获取文件URL失败: %w: %s", resource.ErrFileNotFound, info.GetError())
+	}
+
+	maxSize := int64(resource.DefaultMaxContentSize)
+	if opts != nil && opts.MaxSize > 0 {
+		maxSize = opts.MaxSize
+	}
+	if info.Size > maxSize {
+		return nil, info, fmt.Errorf("%w: size=%d, max_size=%d", resource.ErrContentTooLarge, info.Size, maxSize)
+	}
+
+	_, data, _ := c.File(tenantID, fileID)
+	return data, info, nil
+}
+
+// SetQuota 设置租户的配额，StorageUsed 和 FileCountUsed 按已保存的文件计算
+//
+// 未设置配额的租户不限制存储和文件数
+func (c *FakeClient) SetQuota(tenantID uint32, quota *v1.InternalQuotaInfo) {
+	c.srv.mu.Lock()
+	defer c.srv.mu.Unlock()
+	if quota == nil {
+		delete(c.srv.quotas, tenantID)
+		return
+	}
+	c.srv.quotas[tenantID] = proto.Clone(quota).(*v1.InternalQuotaInfo)
+}
+
+// SetError 设置之后所有请求返回的错误，用于测试资源服务失败的分支；nil 表示恢复正常
+//
+// 错误应使用 status.Error 构造，客户端收到的错误码与之一致
+func (c *FakeClient) SetError(err error) {
+	c.srv.mu.Lock()
+	defer c.srv.mu.Unlock()
+	c.srv.err = err
+}
+
+// SetMethodError 设置单个方法返回的错误，优先于 SetError；nil 表示恢复正常
+//
+// 参数:
+//   - fullMethod: 方法全名，如 v1.ResourceInternalService_InternalGetFile_FullMethodName
+//   - err: 返回的错误
+func (c *FakeClient) SetMethodError(fullMethod string, err error) {
+	c.srv.mu.Lock()
+	defer c.srv.mu.Unlock()
+	if err == nil {
+		delete(c.srv.methodErr, fullMethod)
+		return
+	}
+	c.srv.methodErr[fullMethod] = err
+}
+
+// Reset 清空所有文件、目录、配额和设置的错误，ID 重新从 1 开始
+func (c *FakeClient) Reset() {
+	c.srv.mu.Lock()
+	defer c.srv.mu.Unlock()
+	c.srv.reset()
+}
@@ -0,0 +1,231 @@
+package resourcetest
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"github.com/heyinLab/common/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newFake(t *testing.T) *FakeClient {
+	client := NewFakeClient()
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func TestFakeClient_Files(t *testing.T) {
+	ctx := context.Background()
+	client := newFake(t)
+
+	added := client.AddFile(7, "avatar.png", []byte("png"))
+	assert.Equal(t, "file-1", added.Id)
+	assert.Equal(t, "image/png", added.ContentType)
+	assert.Equal(t, "image", added.FileCategory)
+
+	uploaded, url, err := client.UploadFile(ctx, 7, &resource.UploadRequest{
+		Filename: "report.pdf",
+		Folder:   "docs",
+		Reader:   bytes.NewReader([]byte("%PDF")),
+		Size:     4,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "file-2", uploaded.Id)
+	assert.Equal(t, "document", uploaded.FileCategory)
+	assert.Equal(t, FileURL(7, uploaded.Id)+"?expires_in=3600", url)
+
+	file, err := client.GetFile(ctx, 7, added.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, "avatar.png", file.Filename)
+
+	_, err = client.GetFile(ctx, 8, added.Id)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.ErrorIs(t, err, resource.ErrFileNotFound)
+
+	urls, err := client.GetFileUrls(ctx, 7, []string{added.Id, "missing"}, &resource.GetFileUrlsOptions{ExpiresIn: 60})
+	assert.NoError(t, err)
+	assert.Equal(t, FileURL(7, added.Id)+"?expires_in=60", urls[added.Id].Url)
+	assert.False(t, urls["missing"].Success)
+
+	exists, err := client.FilesExist(ctx, 7, []string{added.Id, "missing"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{added.Id: true, "missing": false}, exists)
+
+	content, _, err := client.GetFileContent(ctx, 7, added.Id, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("png"), content)
+	_, _, err = client.GetFileContent(ctx, 7, added.Id, &resource.GetFileContentOptions{MaxSize: 2})
+	assert.ErrorIs(t, err, resource.ErrContentTooLarge)
+
+	files, next, err := client.ListFiles(ctx, 7, &resource.ListFilesOptions{Folder: "docs"})
+	assert.NoError(t, err)
+	assert.Empty(t, next)
+	assert.Len(t, files, 1)
+
+	assert.NoError(t, client.DeleteFile(ctx, 7, added.Id, false))
+	_, err = client.GetFile(ctx, 7, added.Id)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	info, data, ok := client.File(7, added.Id)
+	assert.True(t, ok)
+	assert.Equal(t, "deleted", info.Status)
+	assert.Equal(t, []byte("png"), data)
+	assert.Len(t, client.Files(7), 2)
+
+	client.Reset()
+	assert.Empty(t, client.Files(7))
+	assert.Equal(t, "file-1", client.AddFile(7, "a.txt", nil).Id)
+}
+
+func TestFakeClient_ImageDownloadUrl(t *testing.T) {
+	ctx := context.Background()
+	client := newFake(t)
+	image := client.AddFile(7, "banner.jpg", []byte("jpg"))
+
+	url, err := client.GetImageDownloadUrl(ctx, 7, image.Id, &resource.ImageTransform{
+		Width:         400,
+		Height:        300,
+		ResizeMode:    resource.ResizeFill,
+		Crop:          &resource.ImageCrop{X: 10, Y: 20, Width: 800, Height: 600},
+		WatermarkText: "heyin",
+		Format:        resource.ImageFormatWebP,
+		Quality:       80,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, DownloadURL(7, image.Id)+"?crop=10%2C20%2C800%2C600&format=webp&h=300&mode=fill&q=80&w=400&watermark=heyin", url)
+
+	url, err = client.GetImageDownloadUrl(ctx, 7, image.Id, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, DownloadURL(7, image.Id), url)
+}
+
+func TestFakeClient_Archive(t *testing.T) {
+	ctx := context.Background()
+	client := newFake(t)
+	a := client.AddFile(7, "a.pdf", []byte("%PDF"))
+	b := client.AddFile(7, "b.png", []byte("png"))
+
+	archive, err := client.CreateArchive(ctx, 7, []string{a.Id, b.Id, "missing"}, resource.ArchiveZip, &resource.CreateArchiveOptions{Filename: "attachments"})
+	assert.NoError(t, err)
+	assert.Equal(t, resource.ArchiveStatusCompleted, archive.Status)
+	assert.Equal(t, ArchiveURL(7, "attachments", "zip"), archive.DownloadUrl)
+	assert.Equal(t, int64(7), archive.Size)
+	assert.Equal(t, []string{"missing"}, archive.FailedIds)
+
+	waited, err := client.WaitForArchive(ctx, 7, archive.JobId, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, archive.DownloadUrl, waited.DownloadUrl)
+
+	_, err = client.GetArchive(ctx, 8, archive.JobId, 0)
+	assert.ErrorIs(t, err, resource.ErrFileNotFound)
+
+	archive, err = client.CreateArchive(ctx, 7, []string{"missing"}, "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, resource.ArchiveStatusFailed, archive.Status)
+}
+
+func TestFakeClient_ShareLink(t *testing.T) {
+	ctx := context.Background()
+	client := newFake(t)
+	file := client.AddFile(7, "contract.pdf", []byte("%PDF"))
+
+	share, err := client.CreateShareLink(ctx, 7, file.Id, &resource.ShareLinkOptions{ExpiresIn: 3600, Password: "8Rk2", MaxDownloads: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, ShareURL(share.ShareId), share.Url)
+	assert.True(t, share.HasPassword)
+	assert.Equal(t, time.Hour, share.ExpiresAt.AsTime().Sub(share.CreatedAt.AsTime()))
+
+	_, password, ok := client.ShareLink(share.ShareId)
+	assert.True(t, ok)
+	assert.Equal(t, "8Rk2", password)
+
+	assert.NoError(t, client.RevokeShareLink(ctx, 7, share.ShareId))
+	assert.NoError(t, client.RevokeShareLink(ctx, 7, share.ShareId))
+	_, _, ok = client.ShareLink(share.ShareId)
+	assert.False(t, ok)
+	assert.ErrorIs(t, client.RevokeShareLink(ctx, 8, share.ShareId), resource.ErrFileNotFound)
+
+	_, err = client.CreateShareLink(ctx, 7, "missing", nil)
+	assert.ErrorIs(t, err, resource.ErrFileNotFound)
+	_, err = client.CreateShareLink(ctx, 7, file.Id, &resource.ShareLinkOptions{ExpiresIn: 31 * 24 * 3600})
+	assert.Error(t, err)
+}
+
+func TestFakeClient_ScanStatus(t *testing.T) {
+	ctx := context.Background()
+	client := newFake(t)
+	file := client.AddFile(7, "cover.jpg", []byte("jpg"))
+
+	scan, err := client.WaitForClean(ctx, 7, file.Id, nil)
+	assert.NoError(t, err)
+	assert.True(t, resource.ScanClean(scan))
+
+	client.SetScanStatus(7, file.Id, &v1.InternalScanStatus{Antivirus: resource.AntivirusClean, Moderation: resource.ModerationBlock})
+	_, err = client.WaitForClean(ctx, 7, file.Id, nil)
+	assert.ErrorIs(t, err, resource.ErrFileRejected)
+
+	_, err = client.GetScanStatus(ctx, 7, "missing")
+	assert.ErrorIs(t, err, resource.ErrFileNotFound)
+}
+
+func TestFakeClient_Errors(t *testing.T) {
+	ctx := context.Background()
+	client := newFake(t)
+	file := client.AddFile(7, "a.txt", []byte("a"))
+
+	assert.NoError(t, client.Ping(ctx))
+
+	client.SetError(status.Error(codes.Unavailable, "down"))
+	assert.Equal(t, codes.Unavailable, status.Code(client.Ping(ctx)))
+	_, err := client.GetFile(ctx, 7, file.Id)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	_, _, err = client.UploadFile(ctx, 7, &resource.UploadRequest{Filename: "b.txt", Reader: bytes.NewReader([]byte("b"))})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	client.SetError(nil)
+	client.SetMethodError(v1.ResourceInternalService_InternalGetQuota_FullMethodName, status.Error(codes.Internal, "boom"))
+	_, err = client.GetQuota(ctx, 7)
+	assert.Equal(t, codes.Internal, status.Code(err))
+	_, err = client.GetFile(ctx, 7, file.Id)
+	assert.NoError(t, err)
+
+	client.SetMethodError(v1.ResourceInternalService_InternalGetQuota_FullMethodName, nil)
+	client.SetQuota(7, &v1.InternalQuotaInfo{StorageQuota: 2, Status: "active"})
+	quota, err := client.GetQuota(ctx, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), quota.StorageUsed)
+
+	usage, err := client.GetUsage(ctx, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), usage.StorageUsed)
+	assert.Equal(t, int64(2), usage.StorageQuota)
+	assert.Equal(t, resource.CategoryUsage{StorageUsed: 1, FileCount: 1}, usage.Categories["document"])
+
+	_, _, err = client.UploadFile(ctx, 7, &resource.UploadRequest{Filename: "big.txt", Reader: bytes.NewReader([]byte("big")), Size: 3})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestFakeClient_UploadLargeFile(t *testing.T) {
+	ctx := context.Background()
+	client := newFake(t)
+
+	data := bytes.Repeat([]byte("0123456789"), 100)
+	file, _, err := client.UploadLargeFile(ctx, 7, &resource.LargeFileUploadRequest{
+		MultipartUploadRequest: resource.MultipartUploadRequest{
+			Filename: "video.mp4",
+			Size:     int64(len(data)),
+			PartSize: 256,
+		},
+		Reader: bytes.NewReader(data),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "video", file.FileCategory)
+
+	_, stored, ok := client.File(7, file.Id)
+	assert.True(t, ok)
+	assert.Equal(t, data, stored)
+}
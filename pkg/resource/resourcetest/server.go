@@ -0,0 +1,1042 @@
+package resourcetest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/url"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// BaseURL 生成的文件URL的前缀
+	BaseURL = "https://resource.test"
+
+	// defaultExpiresIn 未指定时URL的有效期（秒）
+	defaultExpiresIn = 3600
+
+	// defaultPartSize 未指定时的分片大小
+	defaultPartSize = 8 * 1024 * 1024
+)
+
+// storedFile 内存中的文件
+type storedFile struct {
+	info     *v1.InternalFileInfo
+	data     []byte
+	folder   string
+	isPublic bool
+	seq      int
+	// scan 安全检查结果，为 nil 时视为已通过
+	scan *v1.InternalScanStatus
+}
+
+// multipartUpload 进行中的分片上传
+type multipartUpload struct {
+	tenantID uint32
+	fileID   string
+	req      *v1.InternalInitMultipartUploadRequest
+	partSize int64
+	count    int32
+	parts    map[int32]*v1.InternalUploadedPart
+	data     map[int32][]byte
+}
+
+// archiveJob 打包下载任务，创建时立即完成
+type archiveJob struct {
+	tenantID uint32
+	info     *v1.InternalArchiveInfo
+}
+
+// shareLink 分享链接
+type shareLink struct {
+	tenantID uint32
+	info     *v1.InternalShareLinkInfo
+	password string
+	revoked  bool
+}
+
+// server 内存实现的 ResourceInternalService
+type server struct {
+	v1.UnimplementedResourceInternalServiceServer
+
+	mu        sync.Mutex
+	now       func() time.Time
+	seq       int
+	files     map[uint32]map[string]*storedFile
+	folders   map[uint32]map[string]time.Time
+	uploads   map[string]*multipartUpload
+	archives  map[string]*archiveJob
+	shares    map[string]*shareLink
+	quotas    map[uint32]*v1.InternalQuotaInfo
+	err       error
+	methodErr map[string]error
+}
+
+func newServer() *server {
+	s := &server{now: time.Now}
+	s.reset()
+	return s
+}
+
+// reset 清空所有数据和设置的错误，调用方持有锁或在启动前调用
+func (s *server) reset() {
+	s.seq = 0
+	s.files = make(map[uint32]map[string]*storedFile)
+	s.folders = make(map[uint32]map[string]time.Time)
+	s.uploads = make(map[string]*multipartUpload)
+	s.archives = make(map[string]*archiveJob)
+	s.shares = make(map[string]*shareLink)
+	s.quotas = make(map[uint32]*v1.InternalQuotaInfo)
+	s.err = nil
+	s.methodErr = make(map[string]error)
+}
+
+// injectedError 返回为方法设置的错误
+func (s *server) injectedError(method string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err, ok := s.methodErr[method]; ok {
+		return err
+	}
+	return s.err
+}
+
+func (s *server) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := s.injectedError(info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *server) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.injectedError(info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// ========== 存储 ==========
+
+// nextID 生成确定的ID，如 file-1、upload-2
+func (s *server) nextID(prefix string) string {
+	s.seq++
+	return prefix + "-" + strconv.Itoa(s.seq)
+}
+
+// addFile 保存文件并返回文件信息，调用方持有锁
+func (s *server) addFile(tenantID uint32, filename, contentType, folder string, isPublic bool, data []byte) *storedFile {
+	if contentType == "" {
+		contentType = mime.TypeByExtension(path.Ext(filename))
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	now := timestamppb.New(s.now())
+	id := s.nextID("file")
+	f := &storedFile{
+		info: &v1.InternalFileInfo{
+			Id:             id,
+			TenantId:       tenantID,
+			Filename:       filename,
+			Size:           int64(len(data)),
+			ContentType:    contentType,
+			Status:         "completed",
+			FileCategory:   fileCategory(contentType),
+			ChecksumSha256: checksum(data),
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		},
+		data:     data,
+		folder:   folder,
+		isPublic: isPublic,
+		seq:      s.seq,
+	}
+	if s.files[tenantID] == nil {
+		s.files[tenantID] = make(map[string]*storedFile)
+	}
+	s.files[tenantID][id] = f
+	if folder != "" {
+		s.ensureFolder(tenantID, folder)
+	}
+	return f
+}
+
+// getFile 返回未删除的文件，调用方持有锁
+func (s *server) getFile(tenantID uint32, fileID string) (*storedFile, bool) {
+	f, ok := s.files[tenantID][fileID]
+	if !ok || f.info.Status == "deleted" {
+		return nil, false
+	}
+	return f, true
+}
+
+// checkStorage 检查存储配额，调用方持有锁
+func (s *server) checkStorage(tenantID uint32, size int64) error {
+	quota := s.quota(tenantID)
+	if quota.StorageQuota > 0 && quota.StorageUsed+size > quota.StorageQuota {
+		return status.Errorf(codes.ResourceExhausted, "storage quota exceeded: used=%d, quota=%d, size=%d", quota.StorageUsed, quota.StorageQuota, size)
+	}
+	return nil
+}
+
+// quota 返回配额信息，已用存储和文件数按当前文件计算，调用方持有锁
+func (s *server) quota(tenantID uint32) *v1.InternalQuotaInfo {
+	quota := &v1.InternalQuotaInfo{TenantId: tenantID, Status: "active"}
+	if q, ok := s.quotas[tenantID]; ok {
+		quota = proto.Clone(q).(*v1.InternalQuotaInfo)
+		quota.TenantId = tenantID
+	}
+	quota.StorageUsed, quota.FileCountUsed = 0, 0
+	for _, f := range s.files[tenantID] {
+		if f.info.Status == "completed" {
+			quota.StorageUsed += f.info.Size
+			quota.FileCountUsed++
+		}
+	}
+	if quota.StorageQuota > 0 {
+		quota.StorageUsagePercent = float32(quota.StorageUsed) * 100 / float32(quota.StorageQuota)
+	}
+	return quota
+}
+
+func (s *server) fileURL(f *storedFile, expiresIn int64) (string, int64) {
+	url := FileURL(f.info.TenantId, f.info.Id)
+	if f.isPublic {
+		return url, 0
+	}
+	if expiresIn <= 0 {
+		expiresIn = defaultExpiresIn
+	}
+	return url + "?expires_in=" + strconv.FormatInt(expiresIn, 10), expiresIn
+}
+
+// ========== 文件相关接口 ==========
+
+func (s *server) InternalGetFile(_ context.Context, req *v1.InternalGetFileRequest) (*v1.InternalGetFileResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, ok := s.getFile(req.TenantId, req.FileId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "file not found: %s", req.FileId)
+	}
+	return &v1.InternalGetFileResponse{File: cloneInfo(f.info)}, nil
+}
+
+func (s *server) InternalGetFiles(_ context.Context, req *v1.InternalGetFilesRequest) (*v1.InternalGetFilesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := &v1.InternalGetFilesResponse{Files: make(map[string]*v1.InternalFileInfo)}
+	for _, id := range req.FileIds {
+		if f, ok := s.getFile(req.TenantId, id); ok {
+			resp.Files[id] = cloneInfo(f.info)
+		} else {
+			resp.FailedIds = append(resp.FailedIds, id)
+		}
+	}
+	return resp, nil
+}
+
+func (s *server) InternalGetFileUrls(_ context.Context, req *v1.InternalGetFileUrlsRequest) (*v1.InternalGetFileUrlsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	expiresIn := req.ExpiresIn
+	if expiresIn <= 0 {
+		expiresIn = defaultExpiresIn
+	}
+	resp := &v1.InternalGetFileUrlsResponse{Results: make(map[string]*v1.InternalFileUrlInfo), ExpiresIn: expiresIn}
+	for _, id := range req.FileIds {
+		f, ok := s.getFile(req.TenantId, id)
+		if !ok {
+			resp.Results[id] = &v1.InternalFileUrlInfo{Error: "file not found"}
+			continue
+		}
+		url, expires := s.fileURL(f, expiresIn)
+		info := &v1.InternalFileUrlInfo{
+			Url:         url,
+			IsPublic:    f.isPublic,
+			ExpiresIn:   expires,
+			Filename:    f.info.Filename,
+			Size:        f.info.Size,
+			ContentType: f.info.ContentType,
+			Success:     true,
+		}
+		if req.IncludeVariants && f.info.FileCategory == "image" {
+			info.VariantUrls = map[string]string{"thumbnail": FileURL(req.TenantId, id) + "/thumbnail"}
+		}
+		resp.Results[id] = info
+	}
+	return resp, nil
+}
+
+func (s *server) InternalGetDownloadUrls(_ context.Context, req *v1.InternalGetDownloadUrlsRequest) (*v1.InternalGetDownloadUrlsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	expiresIn := req.ExpiresIn
+	if expiresIn <= 0 {
+		expiresIn = defaultExpiresIn
+	}
+	resp := &v1.InternalGetDownloadUrlsResponse{Results: make(map[string]*v1.InternalFileDownloadInfo), ExpiresIn: expiresIn}
+	for _, file := range req.Files {
+		f, ok := s.getFile(req.TenantId, file.FileId)
+		if !ok {
+			resp.Results[file.FileId] = &v1.InternalFileDownloadInfo{Error: "file not found"}
+			continue
+		}
+		filename := file.DownloadFilename
+		if filename == "" {
+			filename = f.info.Filename
+		}
+		resp.Results[file.FileId] = &v1.InternalFileDownloadInfo{
+			DownloadUrl: DownloadURL(req.TenantId, file.FileId) + transformQuery(file.Transform),
+			Filename:    filename,
+			Size:        f.info.Size,
+			ContentType: f.info.ContentType,
+			ExpiresIn:   expiresIn,
+			Success:     true,
+		}
+	}
+	return resp, nil
+}
+
+func (s *server) InternalCheckFileExists(_ context.Context, req *v1.InternalCheckFileExistsRequest) (*v1.InternalCheckFileExistsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, f := range s.sortedFiles(req.TenantId) {
+		if f.info.Status == "completed" && f.info.ChecksumSha256 == req.ChecksumSha256 && (req.Size == 0 || f.info.Size == req.Size) {
+			return &v1.InternalCheckFileExistsResponse{Exists: true, File: cloneInfo(f.info)}, nil
+		}
+	}
+	return &v1.InternalCheckFileExistsResponse{}, nil
+}
+
+func (s *server) InternalFilesExist(_ context.Context, req *v1.InternalFilesExistRequest) (*v1.InternalFilesExistResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := &v1.InternalFilesExistResponse{Exists: make(map[string]bool, len(req.FileIds))}
+	for _, id := range req.FileIds {
+		_, ok := s.getFile(req.TenantId, id)
+		resp.Exists[id] = ok
+	}
+	return resp, nil
+}
+
+// sortedFiles 按创建顺序倒序返回租户的文件，调用方持有锁
+func (s *server) sortedFiles(tenantID uint32) []*storedFile {
+	files := make([]*storedFile, 0, len(s.files[tenantID]))
+	for _, f := range s.files[tenantID] {
+		files = append(files, f)
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].seq > files[j].seq })
+	return files
+}
+
+func (s *server) InternalListFiles(_ context.Context, req *v1.InternalListFilesRequest) (*v1.InternalListFilesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wantStatus := req.Status
+	if wantStatus == "" {
+		wantStatus = "completed"
+	}
+	var matched []*storedFile
+	for _, f := range s.sortedFiles(req.TenantId) {
+		if f.info.Status != wantStatus ||
+			(req.Folder != "" && f.folder != req.Folder) ||
+			(req.FileCategory != "" && f.info.FileCategory != req.FileCategory) ||
+			(req.Tag != "" && !slices.Contains(f.info.Tags, req.Tag)) {
+			continue
+		}
+		matched = append(matched, f)
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	pageSize = min(pageSize, 200)
+	start := 0
+	if req.PageToken != "" {
+		var err error
+		if start, err = strconv.Atoi(req.PageToken); err != nil || start < 0 || start > len(matched) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %s", req.PageToken)
+		}
+	}
+	end := min(start+pageSize, len(matched))
+
+	resp := &v1.InternalListFilesResponse{Total: int64(len(matched))}
+	for _, f := range matched[start:end] {
+		resp.Files = append(resp.Files, cloneInfo(f.info))
+	}
+	if end < len(matched) {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return resp, nil
+}
+
+func (s *server) InternalUpdateFileMetadata(_ context.Context, req *v1.InternalUpdateFileMetadataRequest) (*v1.InternalUpdateFileMetadataResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, ok := s.getFile(req.TenantId, req.FileId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "file not found: %s", req.FileId)
+	}
+
+	info := f.info
+	if req.Filename != "" {
+		info.Filename = req.Filename
+	}
+	for k, v := range req.Metadata {
+		if v == "" {
+			delete(info.Metadata, k)
+			continue
+		}
+		if info.Metadata == nil {
+			info.Metadata = make(map[string]string)
+		}
+		info.Metadata[k] = v
+	}
+	for _, tag := range req.AddTags {
+		if !slices.Contains(info.Tags, tag) {
+			info.Tags = append(info.Tags, tag)
+		}
+	}
+	info.Tags = slices.DeleteFunc(info.Tags, func(tag string) bool { return slices.Contains(req.RemoveTags, tag) })
+	info.UpdatedAt = timestamppb.New(s.now())
+	return &v1.InternalUpdateFileMetadataResponse{File: cloneInfo(info)}, nil
+}
+
+// deleteFile 删除文件，调用方持有锁
+func (s *server) deleteFile(tenantID uint32, fileID string, permanent bool) error {
+	f, ok := s.files[tenantID][fileID]
+	if !ok || (f.info.Status == "deleted" && !permanent) {
+		return status.Errorf(codes.NotFound, "file not found: %s", fileID)
+	}
+	if permanent {
+		delete(s.files[tenantID], fileID)
+		return nil
+	}
+	f.info.Status = "deleted"
+	f.info.UpdatedAt = timestamppb.New(s.now())
+	return nil
+}
+
+func (s *server) InternalDeleteFile(_ context.Context, req *v1.InternalDeleteFileRequest) (*v1.InternalDeleteFileResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.deleteFile(req.TenantId, req.FileId, req.Permanent); err != nil {
+		return nil, err
+	}
+	return &v1.InternalDeleteFileResponse{}, nil
+}
+
+func (s *server) InternalBatchDeleteFiles(_ context.Context, req *v1.InternalBatchDeleteFilesRequest) (*v1.InternalBatchDeleteFilesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := &v1.InternalBatchDeleteFilesResponse{Results: make(map[string]*v1.InternalDeleteResult)}
+	for _, id := range req.FileIds {
+		if err := s.deleteFile(req.TenantId, id, req.Permanent); err != nil {
+			resp.Results[id] = &v1.InternalDeleteResult{Error: status.Convert(err).Message()}
+		} else {
+			resp.Results[id] = &v1.InternalDeleteResult{Success: true}
+		}
+	}
+	return resp, nil
+}
+
+// ========== 目录相关接口 ==========
+
+// ensureFolder 创建目录和所有上级目录，调用方持有锁
+func (s *server) ensureFolder(tenantID uint32, folder string) {
+	if s.folders[tenantID] == nil {
+		s.folders[tenantID] = make(map[string]time.Time)
+	}
+	segments := strings.Split(folder, "/")
+	for i := range segments {
+		p := strings.Join(segments[:i+1], "/")
+		if _, ok := s.folders[tenantID][p]; !ok {
+			s.folders[tenantID][p] = s.now()
+		}
+	}
+}
+
+// folderInfo 统计目录信息，调用方持有锁
+func (s *server) folderInfo(tenantID uint32, folder string) *v1.InternalFolderInfo {
+	info := &v1.InternalFolderInfo{
+		Path:      folder,
+		Name:      path.Base(folder),
+		CreatedAt: timestamppb.New(s.folders[tenantID][folder]),
+	}
+	for _, f := range s.files[tenantID] {
+		if f.folder == folder && f.info.Status == "completed" {
+			info.FileCount++
+		}
+	}
+	for p := range s.folders[tenantID] {
+		if path.Dir(p) == folder {
+			info.FolderCount++
+		}
+	}
+	return info
+}
+
+func (s *server) InternalCreateFolder(_ context.Context, req *v1.InternalCreateFolderRequest) (*v1.InternalCreateFolderResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if req.Path == "" {
+		return nil, status.Error(codes.InvalidArgument, "path is required")
+	}
+	s.ensureFolder(req.TenantId, req.Path)
+	return &v1.InternalCreateFolderResponse{Folder: s.folderInfo(req.TenantId, req.Path)}, nil
+}
+
+func (s *server) InternalListFolders(_ context.Context, req *v1.InternalListFoldersRequest) (*v1.InternalListFoldersResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	parent := req.Parent
+	if parent == "" {
+		parent = "."
+	}
+	resp := &v1.InternalListFoldersResponse{}
+	for p := range s.folders[req.TenantId] {
+		if path.Dir(p) == parent {
+			resp.Folders = append(resp.Folders, s.folderInfo(req.TenantId, p))
+		}
+	}
+	sort.Slice(resp.Folders, func(i, j int) bool { return resp.Folders[i].Name < resp.Folders[j].Name })
+	return resp, nil
+}
+
+func (s *server) InternalDeleteFolder(_ context.Context, req *v1.InternalDeleteFolderRequest) (*v1.InternalDeleteFolderResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.folders[req.TenantId][req.Path]; !ok {
+		return nil, status.Errorf(codes.NotFound, "folder not found: %s", req.Path)
+	}
+
+	inFolder := func(p string) bool { return p == req.Path || strings.HasPrefix(p, req.Path+"/") }
+	if !req.Recursive {
+		info := s.folderInfo(req.TenantId, req.Path)
+		if info.FileCount > 0 || info.FolderCount > 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "folder not empty: %s", req.Path)
+		}
+	}
+
+	resp := &v1.InternalDeleteFolderResponse{}
+	for _, f := range s.files[req.TenantId] {
+		if inFolder(f.folder) && f.info.Status == "completed" {
+			f.info.Status = "deleted"
+			resp.DeletedFiles++
+		}
+	}
+	for p := range s.folders[req.TenantId] {
+		if inFolder(p) {
+			delete(s.folders[req.TenantId], p)
+		}
+	}
+	return resp, nil
+}
+
+// ========== 配额相关接口 ==========
+
+func (s *server) InternalGetQuota(_ context.Context, req *v1.InternalGetQuotaRequest) (*v1.InternalGetQuotaResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &v1.InternalGetQuotaResponse{Quota: s.quota(req.TenantId)}, nil
+}
+
+func (s *server) InternalCheckQuota(_ context.Context, req *v1.InternalCheckQuotaRequest) (*v1.InternalCheckQuotaResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	quota := s.quota(req.TenantId)
+	resp := &v1.InternalCheckQuotaResponse{Allowed: true, Quota: quota}
+	switch {
+	case quota.Status != "active":
+		resp.Allowed, resp.Reason = false, "quota "+quota.Status
+	case req.CheckType != "download" && quota.StorageQuota > 0 && quota.StorageUsed+req.Size > quota.StorageQuota:
+		resp.Allowed, resp.Reason = false, "storage quota exceeded"
+	case req.CheckType == "upload" && quota.FileCountQuota > 0 && quota.FileCountUsed >= quota.FileCountQuota:
+		resp.Allowed, resp.Reason = false, "file count quota exceeded"
+	}
+	return resp, nil
+}
+
+func (s *server) InternalGetUsage(_ context.Context, req *v1.InternalGetUsageRequest) (*v1.InternalGetUsageResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := &v1.InternalGetUsageResponse{
+		Quota:      s.quota(req.TenantId),
+		Categories: make(map[string]*v1.InternalCategoryUsage),
+	}
+	for _, f := range s.files[req.TenantId] {
+		if f.info.Status != "completed" {
+			continue
+		}
+		cu, ok := resp.Categories[f.info.FileCategory]
+		if !ok {
+			cu = &v1.InternalCategoryUsage{}
+			resp.Categories[f.info.FileCategory] = cu
+		}
+		cu.StorageUsed += f.info.Size
+		cu.FileCount++
+	}
+	return resp, nil
+}
+
+// ========== 生成类接口 ==========
+
+func (s *server) InternalGenerateQRCode(_ context.Context, req *v1.InternalGenerateQRCodeRequest) (*v1.InternalGenerateQRCodeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if req.Content == "" {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+	// 内容为二维码文本本身，便于测试断言
+	f := s.addFile(req.TenantId, "qrcode.png", "image/png", "qrcode", false, []byte(req.Content))
+	url, expiresIn := s.fileURL(f, req.ExpiresIn)
+	return &v1.InternalGenerateQRCodeResponse{File: cloneInfo(f.info), Url: url, ExpiresIn: expiresIn}, nil
+}
+
+// ========== 打包下载接口 ==========
+
+func (s *server) InternalCreateArchive(_ context.Context, req *v1.InternalCreateArchiveRequest) (*v1.InternalCreateArchiveResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(req.FileIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "file_ids is required")
+	}
+	format := req.Format
+	if format == "" {
+		format = "zip"
+	}
+
+	jobID := s.nextID("archive")
+	info := &v1.InternalArchiveInfo{JobId: jobID, Format: format}
+	for _, id := range req.FileIds {
+		if f, ok := s.getFile(req.TenantId, id); ok {
+			info.Size += f.info.Size
+		} else {
+			info.FailedIds = append(info.FailedIds, id)
+		}
+	}
+	if len(info.FailedIds) == len(req.FileIds) {
+		info.Status = "failed"
+		info.Error = "no files to archive"
+	} else {
+		filename := req.Filename
+		if filename == "" {
+			filename = jobID
+		}
+		info.Status = "completed"
+		info.DownloadUrl = ArchiveURL(req.TenantId, filename, format)
+		info.ExpiresIn = req.ExpiresIn
+		if info.ExpiresIn <= 0 {
+			info.ExpiresIn = defaultExpiresIn
+		}
+	}
+	s.archives[jobID] = &archiveJob{tenantID: req.TenantId, info: info}
+	return &v1.InternalCreateArchiveResponse{Archive: proto.Clone(info).(*v1.InternalArchiveInfo)}, nil
+}
+
+func (s *server) InternalGetArchive(_ context.Context, req *v1.InternalGetArchiveRequest) (*v1.InternalGetArchiveResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.archives[req.JobId]
+	if !ok || job.tenantID != req.TenantId {
+		return nil, status.Errorf(codes.NotFound, "archive not found: %s", req.JobId)
+	}
+	info := proto.Clone(job.info).(*v1.InternalArchiveInfo)
+	if info.Status == "completed" && req.ExpiresIn > 0 {
+		info.ExpiresIn = req.ExpiresIn
+	}
+	return &v1.InternalGetArchiveResponse{Archive: info}, nil
+}
+
+// ========== 分享链接接口 ==========
+
+func (s *server) InternalCreateShareLink(_ context.Context, req *v1.InternalCreateShareLinkRequest) (*v1.InternalCreateShareLinkResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, ok := s.getFile(req.TenantId, req.FileId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "file not found: %s", req.FileId)
+	}
+	expiresIn := req.ExpiresIn
+	if expiresIn <= 0 {
+		expiresIn = 7 * 24 * 3600
+	}
+
+	now := s.now()
+	shareID := s.nextID("share")
+	info := &v1.InternalShareLinkInfo{
+		ShareId:      shareID,
+		FileId:       f.info.Id,
+		Url:          ShareURL(shareID),
+		HasPassword:  req.Password != "",
+		ExpiresAt:    timestamppb.New(now.Add(time.Duration(expiresIn) * time.Second)),
+		MaxDownloads: req.MaxDownloads,
+		CreatedAt:    timestamppb.New(now),
+	}
+	s.shares[shareID] = &shareLink{tenantID: req.TenantId, info: info, password: req.Password}
+	return &v1.InternalCreateShareLinkResponse{Share: proto.Clone(info).(*v1.InternalShareLinkInfo)}, nil
+}
+
+func (s *server) InternalRevokeShareLink(_ context.Context, req *v1.InternalRevokeShareLinkRequest) (*v1.InternalRevokeShareLinkResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	share, ok := s.shares[req.ShareId]
+	if !ok || share.tenantID != req.TenantId {
+		return nil, status.Errorf(codes.NotFound, "share not found: %s", req.ShareId)
+	}
+	share.revoked = true
+	return &v1.InternalRevokeShareLinkResponse{}, nil
+}
+
+// ========== 安全检查接口 ==========
+
+func (s *server) InternalGetScanStatus(_ context.Context, req *v1.InternalGetScanStatusRequest) (*v1.InternalGetScanStatusResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, ok := s.getFile(req.TenantId, req.FileId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "file not found: %s", req.FileId)
+	}
+	scan := &v1.InternalScanStatus{FileId: f.info.Id, Antivirus: "clean", Moderation: "pass"}
+	if f.scan != nil {
+		scan = proto.Clone(f.scan).(*v1.InternalScanStatus)
+		scan.FileId = f.info.Id
+	}
+	return &v1.InternalGetScanStatusResponse{Status: scan}, nil
+}
+
+// ========== 上传相关接口 ==========
+
+func (s *server) InternalUploadFile(stream grpc.ClientStreamingServer[v1.InternalUploadFileRequest, v1.InternalUploadFileResponse]) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	meta := first.Meta
+	if meta == nil || meta.Filename == "" {
+		return status.Error(codes.InvalidArgument, "first message must carry file meta")
+	}
+
+	s.mu.Lock()
+	err = s.checkStorage(meta.TenantId, meta.Size)
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		data = append(data, req.Chunk...)
+	}
+	if meta.Size > 0 && int64(len(data)) != meta.Size {
+		return status.Errorf(codes.InvalidArgument, "size mismatch: declared=%d, received=%d", meta.Size, len(data))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkStorage(meta.TenantId, int64(len(data))); err != nil {
+		return err
+	}
+	f := s.addFile(meta.TenantId, meta.Filename, meta.ContentType, meta.Folder, meta.IsPublic, data)
+	url, _ := s.fileURL(f, 0)
+	return stream.SendAndClose(&v1.InternalUploadFileResponse{File: cloneInfo(f.info), Url: url})
+}
+
+func (s *server) InternalCreateUploadUrl(_ context.Context, req *v1.InternalCreateUploadUrlRequest) (*v1.InternalCreateUploadUrlResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkStorage(req.TenantId, req.Size); err != nil {
+		return nil, err
+	}
+
+	f := s.addFile(req.TenantId, req.Filename, req.ContentType, req.Folder, req.IsPublic, nil)
+	f.info.Status = "init"
+	f.info.Size = req.Size
+	expiresIn := req.ExpiresIn
+	if expiresIn <= 0 {
+		expiresIn = defaultExpiresIn
+	}
+	return &v1.InternalCreateUploadUrlResponse{
+		FileId:    f.info.Id,
+		UploadUrl: BaseURL + "/upload/" + f.info.Id,
+		Method:    "PUT",
+		Headers:   map[string]string{"Content-Type": f.info.ContentType},
+		ExpiresIn: expiresIn,
+	}, nil
+}
+
+func (s *server) InternalConfirmUpload(_ context.Context, req *v1.InternalConfirmUploadRequest) (*v1.InternalConfirmUploadResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, ok := s.files[req.TenantId][req.FileId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "file not found: %s", req.FileId)
+	}
+	if f.info.Status != "init" && f.info.Status != "completed" {
+		return nil, status.Errorf(codes.FailedPrecondition, "file status is %s", f.info.Status)
+	}
+	f.info.Status = "completed"
+	f.info.UpdatedAt = timestamppb.New(s.now())
+	url, _ := s.fileURL(f, 0)
+	return &v1.InternalConfirmUploadResponse{File: cloneInfo(f.info), Url: url}, nil
+}
+
+// ========== 分片上传接口 ==========
+
+func (s *server) InternalInitMultipartUpload(_ context.Context, req *v1.InternalInitMultipartUploadRequest) (*v1.InternalInitMultipartUploadResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if req.Size <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "size is required")
+	}
+	if err := s.checkStorage(req.TenantId, req.Size); err != nil {
+		return nil, err
+	}
+
+	partSize := req.PartSize
+	if partSize <= 0 {
+		partSize = defaultPartSize
+	}
+	upload := &multipartUpload{
+		tenantID: req.TenantId,
+		fileID:   s.nextID("file"),
+		req:      req,
+		partSize: partSize,
+		count:    int32((req.Size + partSize - 1) / partSize),
+		parts:    make(map[int32]*v1.InternalUploadedPart),
+		data:     make(map[int32][]byte),
+	}
+	uploadID := s.nextID("upload")
+	s.uploads[uploadID] = upload
+	return &v1.InternalInitMultipartUploadResponse{
+		UploadId:  uploadID,
+		FileId:    upload.fileID,
+		PartSize:  partSize,
+		PartCount: upload.count,
+	}, nil
+}
+
+// getUpload 返回租户的分片上传，调用方持有锁
+func (s *server) getUpload(tenantID uint32, uploadID string) (*multipartUpload, error) {
+	upload, ok := s.uploads[uploadID]
+	if !ok || upload.tenantID != tenantID {
+		return nil, status.Errorf(codes.NotFound, "upload not found: %s", uploadID)
+	}
+	return upload, nil
+}
+
+func (s *server) InternalUploadPart(stream grpc.ClientStreamingServer[v1.InternalUploadPartRequest, v1.InternalUploadPartResponse]) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	meta := first.Meta
+	if meta == nil {
+		return status.Error(codes.InvalidArgument, "first message must carry part meta")
+	}
+
+	var data []byte
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		data = append(data, req.Chunk...)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	upload, err := s.getUpload(meta.TenantId, meta.UploadId)
+	if err != nil {
+		return err
+	}
+	if meta.PartNumber < 1 || meta.PartNumber > upload.count {
+		return status.Errorf(codes.InvalidArgument, "part number out of range: %d", meta.PartNumber)
+	}
+	sum := checksum(data)
+	if meta.ChecksumSha256 != "" && meta.ChecksumSha256 != sum {
+		return status.Errorf(codes.DataLoss, "part %d checksum mismatch", meta.PartNumber)
+	}
+
+	part := &v1.InternalUploadedPart{
+		PartNumber:     meta.PartNumber,
+		Size:           int64(len(data)),
+		ChecksumSha256: sum,
+		Etag:           sum[:16],
+	}
+	upload.parts[meta.PartNumber] = part
+	upload.data[meta.PartNumber] = data
+	return stream.SendAndClose(&v1.InternalUploadPartResponse{Part: proto.Clone(part).(*v1.InternalUploadedPart)})
+}
+
+func (s *server) InternalListUploadedParts(_ context.Context, req *v1.InternalListUploadedPartsRequest) (*v1.InternalListUploadedPartsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	upload, err := s.getUpload(req.TenantId, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.InternalListUploadedPartsResponse{
+		FileId:    upload.fileID,
+		PartSize:  upload.partSize,
+		PartCount: upload.count,
+	}
+	for n := int32(1); n <= upload.count; n++ {
+		if part, ok := upload.parts[n]; ok {
+			resp.Parts = append(resp.Parts, proto.Clone(part).(*v1.InternalUploadedPart))
+		}
+	}
+	return resp, nil
+}
+
+func (s *server) InternalCompleteMultipartUpload(_ context.Context, req *v1.InternalCompleteMultipartUploadRequest) (*v1.InternalCompleteMultipartUploadResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	upload, err := s.getUpload(req.TenantId, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+	if int32(len(req.Parts)) != upload.count {
+		return nil, status.Errorf(codes.FailedPrecondition, "expected %d parts, got %d", upload.count, len(req.Parts))
+	}
+
+	var data []byte
+	for i, part := range req.Parts {
+		uploaded, ok := upload.parts[int32(i+1)]
+		if !ok || part.PartNumber != int32(i+1) || part.ChecksumSha256 != uploaded.ChecksumSha256 {
+			return nil, status.Errorf(codes.FailedPrecondition, "part %d missing or mismatched", i+1)
+		}
+		data = append(data, upload.data[part.PartNumber]...)
+	}
+	if int64(len(data)) != upload.req.Size {
+		return nil, status.Errorf(codes.FailedPrecondition, "size mismatch: declared=%d, received=%d", upload.req.Size, len(data))
+	}
+	if upload.req.ChecksumSha256 != "" && upload.req.ChecksumSha256 != checksum(data) {
+		return nil, status.Error(codes.DataLoss, "file checksum mismatch")
+	}
+
+	f := s.addFile(req.TenantId, upload.req.Filename, upload.req.ContentType, upload.req.Folder, upload.req.IsPublic, data)
+	// 使用初始化时分配的文件ID
+	delete(s.files[req.TenantId], f.info.Id)
+	f.info.Id = upload.fileID
+	s.files[req.TenantId][upload.fileID] = f
+	delete(s.uploads, req.UploadId)
+
+	url, _ := s.fileURL(f, 0)
+	return &v1.InternalCompleteMultipartUploadResponse{File: cloneInfo(f.info), Url: url}, nil
+}
+
+func (s *server) InternalAbortMultipartUpload(_ context.Context, req *v1.InternalAbortMultipartUploadRequest) (*v1.InternalAbortMultipartUploadResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.getUpload(req.TenantId, req.UploadId); err != nil {
+		return nil, err
+	}
+	delete(s.uploads, req.UploadId)
+	return &v1.InternalAbortMultipartUploadResponse{}, nil
+}
+
+// ========== 辅助函数 ==========
+
+func cloneInfo(info *v1.InternalFileInfo) *v1.InternalFileInfo {
+	return proto.Clone(info).(*v1.InternalFileInfo)
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// fileCategory 按 MIME 类型判断文件大类
+func fileCategory(contentType string) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case strings.HasPrefix(mediaType, "image/"):
+		return "image"
+	case strings.HasPrefix(mediaType, "video/"):
+		return "video"
+	case strings.HasPrefix(mediaType, "audio/"):
+		return "audio"
+	case strings.HasPrefix(mediaType, "text/"), mediaType == "application/pdf",
+		strings.Contains(mediaType, "word"), strings.Contains(mediaType, "excel"),
+		strings.Contains(mediaType, "sheet"), strings.Contains(mediaType, "presentation"):
+		return "document"
+	case strings.Contains(mediaType, "zip"), strings.Contains(mediaType, "tar"),
+		strings.Contains(mediaType, "rar"), strings.Contains(mediaType, "7z"):
+		return "archive"
+	}
+	return "other"
+}
+
+// FileURL 返回假客户端生成的文件访问URL（私有文件的URL会附加 expires_in 参数）
+func FileURL(tenantID uint32, fileID string) string {
+	return fmt.Sprintf("%s/%d/%s", BaseURL, tenantID, fileID)
+}
+
+// transformQuery 将图片处理参数编码为URL查询参数，未设置时返回空字符串
+func transformQuery(t *v1.InternalImageTransform) string {
+	if t == nil {
+		return ""
+	}
+	q := url.Values{}
+	set := func(key, value string) {
+		if value != "" && value != "0" {
+			q.Set(key, value)
+		}
+	}
+	set("w", strconv.Itoa(int(t.Width)))
+	set("h", strconv.Itoa(int(t.Height)))
+	set("mode", t.ResizeMode)
+	if c := t.Crop; c != nil {
+		set("crop", fmt.Sprintf("%d,%d,%d,%d", c.X, c.Y, c.Width, c.Height))
+	}
+	set("watermark", t.WatermarkText)
+	set("format", t.Format)
+	set("q", strconv.Itoa(int(t.Quality)))
+	return "?" + q.Encode()
+}
+
+// ShareURL 返回假客户端生成的分享链接
+func ShareURL(shareID string) string {
+	return fmt.Sprintf("%s/s/%s", BaseURL, shareID)
+}
+
+// ArchiveURL 返回假客户端生成的打包文件下载URL
+func ArchiveURL(tenantID uint32, filename, format string) string {
+	return fmt.Sprintf("%s/%d/archives/%s.%s", BaseURL, tenantID, url.PathEscape(filename), format)
+}
+
+// DownloadURL 返回假客户端生成的文件下载URL（设置了图片处理参数时附加 w、h、mode、crop、watermark、format、q 查询参数）
+func DownloadURL(tenantID uint32, fileID string) string {
+	return FileURL(tenantID, fileID) + "/download"
+}
@@ -0,0 +1,73 @@
+package resource
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"github.com/heyinLab/common/pkg/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// idempotentMethods 可以安全重试的只读方法
+//
+// 上传、生成、删除等会修改数据的方法不在其中，失败时由调用方决定是否重试
+var idempotentMethods = map[string]bool{
+	v1.ResourceInternalService_InternalGetFile_FullMethodName:           true,
+	v1.ResourceInternalService_InternalGetFiles_FullMethodName:          true,
+	v1.ResourceInternalService_InternalGetFileUrls_FullMethodName:       true,
+	v1.ResourceInternalService_InternalGetDownloadUrls_FullMethodName:   true,
+	v1.ResourceInternalService_InternalCheckFileExists_FullMethodName:   true,
+	v1.ResourceInternalService_InternalFilesExist_FullMethodName:        true,
+	v1.ResourceInternalService_InternalListFiles_FullMethodName:         true,
+	v1.ResourceInternalService_InternalListFolders_FullMethodName:       true,
+	v1.ResourceInternalService_InternalGetQuota_FullMethodName:          true,
+	v1.ResourceInternalService_InternalCheckQuota_FullMethodName:        true,
+	v1.ResourceInternalService_InternalGetUsage_FullMethodName:          true,
+	v1.ResourceInternalService_InternalGetArchive_FullMethodName:        true,
+	v1.ResourceInternalService_InternalGetScanStatus_FullMethodName:     true,
+	v1.ResourceInternalService_InternalListUploadedParts_FullMethodName: true,
+}
+
+// retryMiddleware 按重试策略重试幂等方法的暂时性错误（如资源服务重启时的 Unavailable）
+func retryMiddleware(policy *common.RetryPolicy, logger *log.Helper) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			tr, ok := transport.FromClientContext(ctx)
+			if !ok || !idempotentMethods[tr.Operation()] {
+				return handler(ctx, req)
+			}
+
+			for attempt := 1; ; attempt++ {
+				reply, err := handler(ctx, req)
+				if err == nil || attempt >= policy.MaxAttempts || !retryableError(err) || ctx.Err() != nil {
+					return reply, err
+				}
+
+				backoff := policy.Backoff(attempt)
+				logger.WithContext(ctx).Warnf("请求失败，准备重试: method=%s, attempt=%d, backoff=%v, error=%v", tr.Operation(), attempt, backoff, err)
+
+				timer := time.NewTimer(backoff)
+				select {
+				case <-timer.C:
+				case <-ctx.Done():
+					timer.Stop()
+					return reply, err
+				}
+			}
+		}
+	}
+}
+
+// retryableError 只重试资源服务暂时不可用和服务端超时
+func retryableError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
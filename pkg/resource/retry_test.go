@@ -0,0 +1,103 @@
+package resource
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"github.com/heyinLab/common/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// flakyResourceServer 前 failures 次调用返回 Unavailable
+type flakyResourceServer struct {
+	v1.UnimplementedResourceInternalServiceServer
+
+	failures atomic.Int32
+	calls    atomic.Int32
+}
+
+func (s *flakyResourceServer) fail() error {
+	s.calls.Add(1)
+	if s.failures.Add(-1) >= 0 {
+		return status.Error(codes.Unavailable, "pod restarting")
+	}
+	return nil
+}
+
+func (s *flakyResourceServer) InternalGetFile(_ context.Context, req *v1.InternalGetFileRequest) (*v1.InternalGetFileResponse, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &v1.InternalGetFileResponse{File: &v1.InternalFileInfo{Id: req.FileId}}, nil
+}
+
+func (s *flakyResourceServer) InternalGenerateQRCode(context.Context, *v1.InternalGenerateQRCodeRequest) (*v1.InternalGenerateQRCodeResponse, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &v1.InternalGenerateQRCodeResponse{}, nil
+}
+
+// newDialedTestClient 通过 NewResourceClient 连接本地监听的 srv，使用完整的客户端中间件
+func newDialedTestClient(t *testing.T, srv v1.ResourceInternalServiceServer, config *Config) *ResourceClient {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	server := grpc.NewServer()
+	v1.RegisterResourceInternalServiceServer(server, srv)
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(ln)
+	t.Cleanup(server.Stop)
+
+	client, err := NewResourceClient(config.WithEndpoint(ln.Addr().String()))
+	assert.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestRetryMiddleware(t *testing.T) {
+	srv := &flakyResourceServer{}
+	config := DefaultConfig().WithRetry(&common.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+	})
+	client := newDialedTestClient(t, srv, config)
+	ctx := context.Background()
+
+	// 幂等方法重试后成功
+	srv.failures.Store(2)
+	file, err := client.GetFile(ctx, 7, "f1")
+	assert.NoError(t, err)
+	assert.Equal(t, "f1", file.Id)
+	assert.Equal(t, int32(3), srv.calls.Load())
+
+	// 超过最大尝试次数
+	srv.calls.Store(0)
+	srv.failures.Store(5)
+	_, err = client.GetFile(ctx, 7, "f1")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(3), srv.calls.Load())
+
+	// 非幂等方法不重试
+	srv.calls.Store(0)
+	srv.failures.Store(1)
+	_, _, err = client.GenerateQRCode(ctx, 7, "https://example.com", nil)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(1), srv.calls.Load())
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := &common.RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond}
+	assert.InDelta(t, 100*time.Millisecond, policy.Backoff(1), float64(20*time.Millisecond))
+	assert.InDelta(t, 200*time.Millisecond, policy.Backoff(2), float64(40*time.Millisecond))
+	assert.InDelta(t, 300*time.Millisecond, policy.Backoff(5), float64(60*time.Millisecond))
+}
@@ -0,0 +1,161 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"github.com/heyinLab/common/pkg/common"
+)
+
+// 病毒扫描结果
+const (
+	AntivirusPending  = "pending"
+	AntivirusClean    = "clean"
+	AntivirusInfected = "infected"
+	AntivirusError    = "error"
+)
+
+// 内容审核结果
+const (
+	ModerationPending = "pending"
+	ModerationPass    = "pass"
+	ModerationReview  = "review"
+	ModerationBlock   = "block"
+	ModerationSkipped = "skipped"
+)
+
+const (
+	// defaultScanPollInterval 等待安全检查时第一次重新查询前的等待时间
+	defaultScanPollInterval = time.Second
+
+	// defaultScanMaxPollInterval 等待安全检查时查询间隔的上限
+	defaultScanMaxPollInterval = 30 * time.Second
+)
+
+// ErrFileRejected 文件未通过病毒扫描或内容审核
+var ErrFileRejected = errors.New("文件未通过安全检查")
+
+// ScanClean 判断文件是否已通过病毒扫描和内容审核
+func ScanClean(s *v1.InternalScanStatus) bool {
+	if s == nil || s.Antivirus != AntivirusClean {
+		return false
+	}
+	return s.Moderation == ModerationPass || s.Moderation == ModerationSkipped
+}
+
+// ScanRejected 判断文件是否已确定不能发布（感染病毒或审核不通过）
+func ScanRejected(s *v1.InternalScanStatus) bool {
+	return s != nil && (s.Antivirus == AntivirusInfected || s.Moderation == ModerationBlock)
+}
+
+// GetScanStatus 获取文件的病毒扫描和内容审核结果
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - fileID: 文件ID
+//
+// 返回:
+//   - *v1.InternalScanStatus: 安全检查结果，可用 ScanClean、ScanRejected 判断
+//   - error: 错误信息
+func (c *ResourceClient) GetScanStatus(ctx context.Context, tenantID uint32, fileID string) (*v1.InternalScanStatus, error) {
+	if fileID == "" {
+		return nil, fmt.Errorf("文件ID不能为空")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.InternalGetScanStatus(ctx, &v1.InternalGetScanStatusRequest{
+		TenantId: tenantID,
+		FileId:   fileID,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取文件安全检查结果失败: tenant_id=%d, file_id=%s, error=%v", tenantID, fileID, err)
+		return nil, err
+	}
+
+	return resp.Status, nil
+}
+
+// WaitForCleanOptions 等待安全检查通过的选项
+type WaitForCleanOptions struct {
+	// 第一次重新查询前的等待时间，之后每次翻倍，默认1秒
+	InitialInterval time.Duration
+	// 查询间隔上限，默认30秒
+	MaxInterval time.Duration
+}
+
+// WaitForClean 等待文件通过病毒扫描和内容审核
+//
+// 按指数退避定期查询，直到文件通过检查、被拒绝或 ctx 结束；等待人工复审（review）
+// 和扫描失败（error，资源服务会重新扫描）时继续等待，最长等待时间由 ctx 控制
+//
+// 参数:
+//   - ctx: 上下文，用于控制最长等待时间
+//   - tenantID: 租户ID
+//   - fileID: 文件ID
+//   - opts: 可选参数
+//
+// 返回:
+//   - *v1.InternalScanStatus: 最后一次查询成功的结果，还没有查询成功过时为 nil
+//   - error: 文件被拒绝时为 ErrFileRejected，查询失败或等待超时时为对应的错误
+//
+// 使用示例:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+//	defer cancel()
+//	scan, err := client.WaitForClean(ctx, tenantID, imageID, nil)
+//	switch {
+//	case errors.Is(err, resource.ErrFileRejected):
+//	    return v1.ErrorImageRejected("图片未通过审核: %s", scan.Reason)
+//	case err != nil:
+//	    return err
+//	}
+//	return repo.PublishProduct(ctx, productID)
+func (c *ResourceClient) WaitForClean(ctx context.Context, tenantID uint32, fileID string, opts *WaitForCleanOptions) (*v1.InternalScanStatus, error) {
+	backoff := common.RetryPolicy{
+		InitialBackoff: defaultScanPollInterval,
+		MaxBackoff:     defaultScanMaxPollInterval,
+	}
+	if opts != nil {
+		if opts.InitialInterval > 0 {
+			backoff.InitialBackoff = opts.InitialInterval
+		}
+		if opts.MaxInterval > 0 {
+			backoff.MaxBackoff = opts.MaxInterval
+		}
+	}
+
+	// last 最后一次查询成功的结果，等待超时时返回给调用方
+	var last *v1.InternalScanStatus
+	for attempt := 1; ; attempt++ {
+		scan, err := c.GetScanStatus(ctx, tenantID, fileID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return last, fmt.Errorf("等待文件安全检查超时: file_id=%s: %w", fileID, ctx.Err())
+			}
+			return last, err
+		}
+		last = scan
+		switch {
+		case ScanRejected(scan):
+			return scan, fmt.Errorf("%w: file_id=%s, antivirus=%s, moderation=%s, reason=%s",
+				ErrFileRejected, fileID, scan.Antivirus, scan.Moderation, scan.Reason)
+		case ScanClean(scan):
+			return scan, nil
+		}
+
+		timer := time.NewTimer(backoff.Backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return scan, fmt.Errorf("等待文件安全检查超时: file_id=%s, antivirus=%s, moderation=%s: %w",
+				fileID, scan.Antivirus, scan.Moderation, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package resource
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+// scanServer 按顺序返回 results 中的检查结果，之后一直返回最后一个
+type scanServer struct {
+	v1.UnimplementedResourceInternalServiceServer
+
+	results []*v1.InternalScanStatus
+	calls   atomic.Int32
+}
+
+func (s *scanServer) InternalGetScanStatus(_ context.Context, req *v1.InternalGetScanStatusRequest) (*v1.InternalGetScanStatusResponse, error) {
+	i := min(int(s.calls.Add(1)), len(s.results)) - 1
+	return &v1.InternalGetScanStatusResponse{Status: s.results[i]}, nil
+}
+
+func TestWaitForClean(t *testing.T) {
+	ctx := context.Background()
+	opts := &WaitForCleanOptions{InitialInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+
+	srv := &scanServer{results: []*v1.InternalScanStatus{
+		{Antivirus: AntivirusPending, Moderation: ModerationPending},
+		{Antivirus: AntivirusError, Moderation: ModerationPending},
+		{Antivirus: AntivirusClean, Moderation: ModerationReview},
+		{Antivirus: AntivirusClean, Moderation: ModerationPass},
+	}}
+	client := newTestClient(t, srv)
+	scan, err := client.WaitForClean(ctx, 7, "f1", opts)
+	assert.NoError(t, err)
+	assert.True(t, ScanClean(scan))
+	assert.Equal(t, int32(4), srv.calls.Load())
+
+	// 被拒绝时返回 ErrFileRejected 和检查结果
+	srv = &scanServer{results: []*v1.InternalScanStatus{
+		{Antivirus: AntivirusClean, Moderation: ModerationPending},
+		{Antivirus: AntivirusClean, Moderation: ModerationBlock, Reason: "ad"},
+	}}
+	client = newTestClient(t, srv)
+	scan, err = client.WaitForClean(ctx, 7, "f1", opts)
+	assert.ErrorIs(t, err, ErrFileRejected)
+	assert.Equal(t, "ad", scan.Reason)
+
+	// 等待超时时返回最后一次的结果
+	srv = &scanServer{results: []*v1.InternalScanStatus{{Antivirus: AntivirusClean, Moderation: ModerationReview}}}
+	client = newTestClient(t, srv)
+	waitCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	scan, err = client.WaitForClean(waitCtx, 7, "f1", opts)
+	assert.Error(t, err)
+	assert.Equal(t, ModerationReview, scan.GetModeration())
+
+	_, err = client.GetScanStatus(ctx, 7, "")
+	assert.Error(t, err)
+}
+
+func TestScanClean(t *testing.T) {
+	assert.True(t, ScanClean(&v1.InternalScanStatus{Antivirus: AntivirusClean, Moderation: ModerationSkipped}))
+	assert.False(t, ScanClean(&v1.InternalScanStatus{Antivirus: AntivirusClean, Moderation: ModerationReview}))
+	assert.False(t, ScanClean(&v1.InternalScanStatus{Antivirus: AntivirusPending, Moderation: ModerationPass}))
+	assert.False(t, ScanClean(nil))
+	assert.True(t, ScanRejected(&v1.InternalScanStatus{Antivirus: AntivirusInfected, Moderation: ModerationPending}))
+	assert.False(t, ScanRejected(&v1.InternalScanStatus{Antivirus: AntivirusError, Moderation: ModerationReview}))
+}
@@ -0,0 +1,112 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+)
+
+const (
+	// maxShareExpiresIn 分享链接的最长有效期（秒），30天
+	maxShareExpiresIn = 30 * 24 * 3600
+)
+
+// ShareLinkOptions 创建分享链接的选项
+type ShareLinkOptions struct {
+	// 有效期（秒），默认7天，最长30天
+	ExpiresIn int64
+	// 访问密码，为空时不需要密码
+	Password string
+	// 最大下载次数，0 表示不限制
+	MaxDownloads int32
+}
+
+// CreateShareLink 创建私有文件的分享链接
+//
+// 分享链接指向资源服务的分享页面，由资源服务校验有效期、密码和下载次数后再生成下载地址，
+// 分享给外部用户时使用它代替预签名URL，需要时可以通过 RevokeShareLink 立即撤销
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - fileID: 文件ID
+//   - opts: 可选参数
+//
+// 返回:
+//   - *v1.InternalShareLinkInfo: 分享链接信息，ShareId 用于撤销
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	share, err := client.CreateShareLink(ctx, tenantID, fileID, &resource.ShareLinkOptions{
+//	    ExpiresIn: 24 * 3600,
+//	    Password:  "8Rk2",
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	// 保存 share.ShareId 用于撤销，将 share.Url 和密码发送给对方
+func (c *ResourceClient) CreateShareLink(ctx context.Context, tenantID uint32, fileID string, opts *ShareLinkOptions) (*v1.InternalShareLinkInfo, error) {
+	if fileID == "" {
+		return nil, fmt.Errorf("文件ID不能为空")
+	}
+
+	req := &v1.InternalCreateShareLinkRequest{
+		TenantId: tenantID,
+		FileId:   fileID,
+	}
+	if opts != nil {
+		if opts.ExpiresIn < 0 || opts.ExpiresIn > maxShareExpiresIn {
+			return nil, fmt.Errorf("分享链接有效期必须在0-%d秒之间，当前: %d", maxShareExpiresIn, opts.ExpiresIn)
+		}
+		if opts.MaxDownloads < 0 {
+			return nil, fmt.Errorf("最大下载次数不能为负数: %d", opts.MaxDownloads)
+		}
+		req.ExpiresIn = opts.ExpiresIn
+		req.Password = opts.Password
+		req.MaxDownloads = opts.MaxDownloads
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.InternalCreateShareLink(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("创建分享链接失败: tenant_id=%d, file_id=%s, error=%v", tenantID, fileID, err)
+		return nil, err
+	}
+
+	return resp.Share, nil
+}
+
+// RevokeShareLink 撤销分享链接，撤销后链接立即失效
+//
+// 已撤销的链接再次撤销不会返回错误，分享不存在时返回 ErrFileNotFound
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - shareID: 分享ID
+//
+// 返回:
+//   - error: 错误信息
+func (c *ResourceClient) RevokeShareLink(ctx context.Context, tenantID uint32, shareID string) error {
+	if shareID == "" {
+		return fmt.Errorf("分享ID不能为空")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	_, err := c.client.InternalRevokeShareLink(ctx, &v1.InternalRevokeShareLinkRequest{
+		TenantId: tenantID,
+		ShareId:  shareID,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("撤销分享链接失败: tenant_id=%d, share_id=%s, error=%v", tenantID, shareID, err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package resource
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"github.com/heyinLab/common/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// testCA 测试用 CA，签发服务端和客户端证书
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	dir  string
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	ca := &testCA{cert: cert, key: key, dir: t.TempDir()}
+	ca.write(t, "ca.pem", "CERTIFICATE", der)
+	return ca
+}
+
+func (ca *testCA) write(t *testing.T, name, typ string, der []byte) string {
+	path := filepath.Join(ca.dir, name)
+	assert.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0o600))
+	return path
+}
+
+// issue 签发证书，返回 tls.Certificate 以及证书和私钥文件路径
+func (ca *testCA) issue(t *testing.T, name string, usage x509.ExtKeyUsage) (tls.Certificate, string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	assert.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	certFile := ca.write(t, name+".pem", "CERTIFICATE", der)
+	keyFile := ca.write(t, name+"-key.pem", "EC PRIVATE KEY", keyDER)
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	assert.NoError(t, err)
+	return cert, certFile, keyFile
+}
+
+func TestResourceClient_MutualTLS(t *testing.T) {
+	ca := newTestCA(t)
+	serverCert, _, _ := ca.issue(t, "resource.internal", x509.ExtKeyUsageServerAuth)
+	_, clientCertFile, clientKeyFile := ca.issue(t, "order-service", x509.ExtKeyUsageClientAuth)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	})))
+	v1.RegisterResourceInternalServiceServer(server, &flakyResourceServer{})
+	go server.Serve(ln)
+	t.Cleanup(server.Stop)
+
+	dial := func(tlsConfig *common.TLSConfig) error {
+		config := DefaultConfig().
+			WithEndpoint(ln.Addr().String()).
+			WithTimeout(2 * time.Second).
+			WithRetry(nil).
+			WithTLS(tlsConfig)
+		client, err := NewResourceClient(config)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+		_, err = client.GetFile(context.Background(), 7, "f1")
+		return err
+	}
+
+	caFile := filepath.Join(ca.dir, "ca.pem")
+	assert.NoError(t, dial(&common.TLSConfig{
+		CAFile:     caFile,
+		CertFile:   clientCertFile,
+		KeyFile:    clientKeyFile,
+		ServerName: "resource.internal",
+	}))
+
+	// 缺少客户端证书时服务端拒绝握手
+	assert.Error(t, dial(&common.TLSConfig{CAFile: caFile, ServerName: "resource.internal"}))
+
+	// 证书域名与 ServerName 不一致
+	assert.Error(t, dial(&common.TLSConfig{CAFile: caFile, CertFile: clientCertFile, KeyFile: clientKeyFile}))
+
+	// 配置错误在创建客户端时返回
+	assert.Error(t, dial(&common.TLSConfig{CAFile: caFile, CertFile: clientCertFile}))
+	assert.Error(t, dial(&common.TLSConfig{CAFile: filepath.Join(ca.dir, "missing.pem")}))
+}
@@ -0,0 +1,136 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+)
+
+// ResizeMode 图片缩放模式
+type ResizeMode string
+
+const (
+	// ResizeFit 等比缩放到宽高范围内（默认）
+	ResizeFit ResizeMode = "fit"
+	// ResizeFill 等比缩放后居中裁剪为指定宽高
+	ResizeFill ResizeMode = "fill"
+)
+
+// ImageFormat 图片输出格式
+type ImageFormat string
+
+const (
+	ImageFormatWebP ImageFormat = "webp"
+	ImageFormatAVIF ImageFormat = "avif"
+	ImageFormatJPEG ImageFormat = "jpeg"
+	ImageFormatPNG  ImageFormat = "png"
+)
+
+// ImageCrop 图片裁剪区域（像素）
+type ImageCrop struct {
+	X      int32
+	Y      int32
+	Width  int32
+	Height int32
+}
+
+// ImageTransform 图片处理参数，由资源服务编码到下载URL中，前端可以按需获取合适的尺寸和格式
+type ImageTransform struct {
+	// 缩放后的宽度（像素），0 表示按高度等比缩放
+	Width int32
+	// 缩放后的高度（像素），0 表示按宽度等比缩放
+	Height int32
+	// 缩放模式，默认 ResizeFit；ResizeFill 需要同时指定宽高
+	ResizeMode ResizeMode
+	// 缩放前裁剪的区域（可选）
+	Crop *ImageCrop
+	// 文字水印（可选）
+	WatermarkText string
+	// 输出格式，为空时保持原格式
+	Format ImageFormat
+	// 输出质量（1-100），0 表示使用默认质量
+	Quality int32
+}
+
+// Validate 校验图片处理参数
+func (t *ImageTransform) Validate() error {
+	if t.Width < 0 || t.Height < 0 {
+		return fmt.Errorf("图片宽高不能为负数: width=%d, height=%d", t.Width, t.Height)
+	}
+	switch t.ResizeMode {
+	case "", ResizeFit:
+	case ResizeFill:
+		if t.Width == 0 || t.Height == 0 {
+			return fmt.Errorf("fill 缩放模式需要同时指定宽高")
+		}
+	default:
+		return fmt.Errorf("不支持的缩放模式: %s", t.ResizeMode)
+	}
+	if c := t.Crop; c != nil && (c.X < 0 || c.Y < 0 || c.Width <= 0 || c.Height <= 0) {
+		return fmt.Errorf("无效的裁剪区域: x=%d, y=%d, width=%d, height=%d", c.X, c.Y, c.Width, c.Height)
+	}
+	switch t.Format {
+	case "", ImageFormatWebP, ImageFormatAVIF, ImageFormatJPEG, ImageFormatPNG:
+	default:
+		return fmt.Errorf("不支持的图片格式: %s", t.Format)
+	}
+	if t.Quality < 0 || t.Quality > 100 {
+		return fmt.Errorf("图片质量必须在1-100之间，当前: %d", t.Quality)
+	}
+	return nil
+}
+
+// toProto 转换为请求中的图片处理参数
+func (t *ImageTransform) toProto() *v1.InternalImageTransform {
+	if t == nil {
+		return nil
+	}
+	transform := &v1.InternalImageTransform{
+		Width:         t.Width,
+		Height:        t.Height,
+		ResizeMode:    string(t.ResizeMode),
+		WatermarkText: t.WatermarkText,
+		Format:        string(t.Format),
+		Quality:       t.Quality,
+	}
+	if t.Crop != nil {
+		transform.Crop = &v1.InternalImageCrop{X: t.Crop.X, Y: t.Crop.Y, Width: t.Crop.Width, Height: t.Crop.Height}
+	}
+	return transform
+}
+
+// GetImageDownloadUrl 获取经过图片处理的下载URL（便捷方法）
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - fileID: 图片文件ID
+//   - transform: 图片处理参数，为 nil 时与 GetDownloadUrl 相同
+//
+// 返回:
+//   - string: 下载URL
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	url, err := client.GetImageDownloadUrl(ctx, tenantID, imageID, &resource.ImageTransform{
+//	    Width:      400,
+//	    Height:     400,
+//	    ResizeMode: resource.ResizeFill,
+//	    Format:     resource.ImageFormatWebP,
+//	    Quality:    80,
+//	})
+func (c *ResourceClient) GetImageDownloadUrl(ctx context.Context, tenantID uint32, fileID string, transform *ImageTransform) (string, error) {
+	results, err := c.GetDownloadUrls(ctx, tenantID, []DownloadFileRequest{{FileID: fileID, Transform: transform}}, 3600)
+	if err != nil {
+		return "", err
+	}
+
+	info, ok := results[fileID]
+	if !ok || !info.Success {
+		return "", fileResultError("获取下载URL失败", info.GetError())
+	}
+
+	return info.DownloadUrl, nil
+}
@@ -0,0 +1,41 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestImageTransform_Validate(t *testing.T) {
+	valid := []*ImageTransform{
+		{},
+		{Width: 200},
+		{Width: 200, Height: 100, ResizeMode: ResizeFill, Format: ImageFormatAVIF, Quality: 100},
+		{Crop: &ImageCrop{Width: 10, Height: 10}, WatermarkText: "heyin", Format: ImageFormatWebP},
+	}
+	for _, transform := range valid {
+		assert.NoError(t, transform.Validate(), "%+v", transform)
+	}
+
+	invalid := []*ImageTransform{
+		{Width: -1},
+		{Width: 200, ResizeMode: ResizeFill},
+		{ResizeMode: "stretch"},
+		{Crop: &ImageCrop{X: -1, Width: 10, Height: 10}},
+		{Crop: &ImageCrop{Width: 10}},
+		{Format: "gif"},
+		{Quality: 101},
+	}
+	for _, transform := range invalid {
+		assert.Error(t, transform.Validate(), "%+v", transform)
+	}
+
+	// 参数无效时不发送请求（测试服务未实现 InternalGetDownloadUrls）
+	client := newTestClient(t, &fakeResourceServer{})
+	_, err := client.GetImageDownloadUrl(context.Background(), 7, "f1", &ImageTransform{Quality: 200})
+	assert.ErrorContains(t, err, "文件 f1 的图片处理参数无效")
+	assert.NotEqual(t, codes.Unimplemented, status.Code(err))
+}
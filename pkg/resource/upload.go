@@ -0,0 +1,321 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+)
+
+// uploadChunkSize 流式上传每条消息携带的字节数，需要小于 gRPC 默认的 4MB 消息上限
+const uploadChunkSize = 256 * 1024
+
+// UploadRequest 上传文件请求
+type UploadRequest struct {
+	// 原始文件名（必填）
+	Filename string
+	// MIME类型，为空时由资源服务根据文件名推断
+	ContentType string
+	// 文件内容（必填）
+	Reader io.Reader
+	// 目录（可选），如 "reports/2025"
+	Folder string
+	// 是否公开访问
+	IsPublic bool
+	// 文件大小（字节，可选），设置后资源服务会在接收内容前检查配额
+	Size int64
+	// 上传进度回调（可选），每发送一个分片调用一次，参数为已发送字节数和 Size（未设置时为0）
+	OnProgress func(sent, total int64)
+	// 上传限速（字节/秒，可选），0 表示不限速，等待期间 ctx 取消会立即返回
+	RateLimit int64
+}
+
+// UploadFile 上传文件
+//
+// 文件内容按 256KB 分片通过客户端流发送，不会一次性读入内存。
+// 上传耗时与文件大小相关，不使用配置的 Timeout，需要限制时长时由调用方通过 ctx 控制。
+// 设置 OnProgress 可以汇报进度，设置 RateLimit 可以限制后台导入占用的带宽。
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - req: 上传请求
+//
+// 返回:
+//   - *v1.InternalFileInfo: 上传后的文件信息
+//   - string: 文件访问URL
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	f, _ := os.Open("report.xlsx")
+//	defer f.Close()
+//	file, url, err := client.UploadFile(ctx, tenantID, &resource.UploadRequest{
+//	    Filename: "report.xlsx",
+//	    Reader:   f,
+//	    Folder:   "reports/2025",
+//	})
+func (c *ResourceClient) UploadFile(ctx context.Context, tenantID uint32, req *UploadRequest) (*v1.InternalFileInfo, string, error) {
+	if req == nil || req.Filename == "" {
+		return nil, "", fmt.Errorf("文件名不能为空")
+	}
+	if req.Reader == nil {
+		return nil, "", fmt.Errorf("文件内容不能为空")
+	}
+
+	// 出错返回时取消流，资源服务不会保存不完整的文件
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	resp, err := c.uploadStream(ctx, tenantID, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("上传文件失败: tenant_id=%d, filename=%s, error=%v", tenantID, req.Filename, err)
+		return nil, "", err
+	}
+
+	return resp.File, resp.Url, nil
+}
+
+// uploadStream 发送元信息和文件分片，返回服务端响应
+func (c *ResourceClient) uploadStream(ctx context.Context, tenantID uint32, req *UploadRequest) (*v1.InternalUploadFileResponse, error) {
+	stream, err := c.client.InternalUploadFile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.Send(&v1.InternalUploadFileRequest{
+		Meta: &v1.InternalUploadFileMeta{
+			TenantId:    tenantID,
+			Filename:    req.Filename,
+			ContentType: req.ContentType,
+			Folder:      req.Folder,
+			IsPublic:    req.IsPublic,
+			Size:        req.Size,
+		},
+	})
+	if err != nil {
+		return nil, closeAndRecvError(stream, err)
+	}
+
+	limiter := newByteLimiter(req.RateLimit)
+	var sent int64
+	err = sendChunks(req.Reader, func(chunk []byte) error {
+		if err := waitBytes(ctx, limiter, len(chunk)); err != nil {
+			return err
+		}
+		if err := stream.Send(&v1.InternalUploadFileRequest{Chunk: chunk}); err != nil {
+			return err
+		}
+		sent += int64(len(chunk))
+		if req.OnProgress != nil {
+			req.OnProgress(sent, req.Size)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, closeAndRecvError(stream, err)
+	}
+
+	return stream.CloseAndRecv()
+}
+
+// sendChunks 按 uploadChunkSize 读取 r 并逐片发送
+func sendChunks(r io.Reader, send func(chunk []byte) error) error {
+	buf := make([]byte, uploadChunkSize)
+	for {
+		n, readErr := io.ReadFull(r, buf)
+		if n > 0 {
+			if err := send(buf[:n]); err != nil {
+				return err
+			}
+		}
+		if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if readErr != nil {
+			return fmt.Errorf("读取文件内容失败: %w", readErr)
+		}
+	}
+}
+
+// newByteLimiter 创建按字节计数的限速器，bytesPerSecond <= 0 时返回 nil 表示不限速
+//
+// 单次等待的字节数不超过 uploadChunkSize，因此突发容量取 uploadChunkSize
+func newByteLimiter(bytesPerSecond int64) *rate.Limiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(bytesPerSecond), uploadChunkSize)
+}
+
+// waitBytes 发送 n 字节前等待限速器放行，ctx 结束时返回错误
+//
+// 预计等待会超过 ctx 截止时间时限速器立即失败，这种情况同样按 context.DeadlineExceeded 返回
+func waitBytes(ctx context.Context, limiter *rate.Limiter, n int) error {
+	if limiter == nil {
+		return nil
+	}
+	if err := limiter.WaitN(ctx, n); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return fmt.Errorf("%w: %v", context.DeadlineExceeded, err)
+	}
+	return nil
+}
+
+// closeAndRecvError 发送失败时服务端可能已经返回了错误状态（如配额不足），
+// 通过 CloseAndRecv 取得真实原因，Send 本身只会返回 io.EOF
+func closeAndRecvError[Req, Resp any](stream grpc.ClientStreamingClient[Req, Resp], sendErr error) error {
+	if !errors.Is(sendErr, io.EOF) {
+		return sendErr
+	}
+	if _, err := stream.CloseAndRecv(); err != nil {
+		return err
+	}
+	return sendErr
+}
+
+// CreateUploadUrlOptions 创建预签名上传URL的选项
+type CreateUploadUrlOptions struct {
+	// 目录（可选）
+	Folder string
+	// 是否公开访问
+	IsPublic bool
+	// URL有效期（秒），默认3600
+	ExpiresIn int64
+}
+
+// PresignedUpload 预签名上传信息，交给浏览器直接上传到对象存储
+type PresignedUpload struct {
+	// 文件ID，上传完成后调用 ConfirmUpload 时使用
+	FileID string `json:"file_id"`
+	// 预签名上传URL
+	URL string `json:"upload_url"`
+	// 上传使用的HTTP方法，通常为 PUT
+	Method string `json:"method"`
+	// 上传时必须携带的请求头（如 Content-Type）
+	Headers map[string]string `json:"headers"`
+	// URL过期时间（秒）
+	ExpiresIn int64 `json:"expires_in"`
+}
+
+// NewRequest 创建上传到预签名URL的HTTP请求，用于服务端直接上传
+func (u *PresignedUpload) NewRequest(ctx context.Context, body io.Reader, size int64) (*http.Request, error) {
+	method := u.Method
+	if method == "" {
+		method = http.MethodPut
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.URL, body)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = size
+	for k, v := range u.Headers {
+		req.Header.Set(k, v)
+	}
+	return req, nil
+}
+
+// CreateUploadUrl 创建预签名上传URL（两阶段上传的第一步）
+//
+// 资源服务检查配额并创建待上传的文件记录，浏览器使用返回的URL、方法和请求头
+// 直接上传到对象存储，完成后由业务服务调用 ConfirmUpload。未确认的文件由资源服务定期清理。
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - filename: 原始文件名
+//   - contentType: MIME类型，浏览器上传时的 Content-Type 必须与其一致
+//   - size: 文件大小（字节）
+//   - opts: 可选参数
+//
+// 返回:
+//   - *PresignedUpload: 预签名上传信息
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	// 1. 业务服务创建上传URL并返回给前端
+//	upload, err := client.CreateUploadUrl(ctx, tenantID, "demo.mp4", "video/mp4", 52428800, nil)
+//
+//	// 2. 前端按 upload.Method / upload.URL / upload.Headers 上传文件
+//
+//	// 3. 前端通知业务服务上传完成，业务服务确认
+//	file, url, err := client.ConfirmUpload(ctx, tenantID, upload.FileID)
+func (c *ResourceClient) CreateUploadUrl(ctx context.Context, tenantID uint32, filename, contentType string, size int64, opts *CreateUploadUrlOptions) (*PresignedUpload, error) {
+	if filename == "" {
+		return nil, fmt.Errorf("文件名不能为空")
+	}
+	if size <= 0 {
+		return nil, fmt.Errorf("文件大小必须大于0，当前: %d", size)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	req := &v1.InternalCreateUploadUrlRequest{
+		TenantId:    tenantID,
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        size,
+	}
+	if opts != nil {
+		req.Folder = opts.Folder
+		req.IsPublic = opts.IsPublic
+		req.ExpiresIn = opts.ExpiresIn
+	}
+
+	resp, err := c.client.InternalCreateUploadUrl(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("创建上传URL失败: tenant_id=%d, filename=%s, size=%d, error=%v", tenantID, filename, size, err)
+		return nil, err
+	}
+
+	return &PresignedUpload{
+		FileID:    resp.FileId,
+		URL:       resp.UploadUrl,
+		Method:    resp.Method,
+		Headers:   resp.Headers,
+		ExpiresIn: resp.ExpiresIn,
+	}, nil
+}
+
+// ConfirmUpload 确认预签名上传完成（两阶段上传的第二步）
+//
+// 资源服务校验对象存储中的文件后将其标记为已完成，文件未上传或大小不一致时返回错误
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//   - fileID: CreateUploadUrl 返回的文件ID
+//
+// 返回:
+//   - *v1.InternalFileInfo: 文件信息
+//   - string: 文件访问URL
+//   - error: 错误信息
+func (c *ResourceClient) ConfirmUpload(ctx context.Context, tenantID uint32, fileID string) (*v1.InternalFileInfo, string, error) {
+	if fileID == "" {
+		return nil, "", fmt.Errorf("文件ID不能为空")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.InternalConfirmUpload(ctx, &v1.InternalConfirmUploadRequest{
+		TenantId: tenantID,
+		FileId:   fileID,
+	})
+	c.fileCache.invalidate(tenantID, fileID)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("确认上传失败: tenant_id=%d, file_id=%s, error=%v", tenantID, fileID, err)
+		return nil, "", err
+	}
+
+	return resp.File, resp.Url, nil
+}
@@ -0,0 +1,103 @@
+package resource
+
+import (
+	"context"
+	"sync"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// urlKey 进行中的文件URL查询的去重键
+type urlKey struct {
+	tenantID        uint32
+	fileID          string
+	expiresIn       int64
+	includeVariants bool
+}
+
+// urlCall 一个文件URL的查询，done 关闭后 info 和 err 可读
+type urlCall struct {
+	done chan struct{}
+	info *v1.InternalFileUrlInfo
+	err  error
+}
+
+// urlFlight 合并并发的相同文件URL查询（singleflight）
+//
+// 同一页面同时渲染同一张图片时，只有第一个请求访问资源服务，其余请求等待它的结果；
+// 批量查询中已经在查询的文件不会重复请求，只请求其余文件
+type urlFlight struct {
+	mu    sync.Mutex
+	calls map[urlKey]*urlCall
+}
+
+// getFileUrls 查询一批（最多100个）文件URL，与进行中的相同查询合并
+func (c *ResourceClient) getFileUrls(ctx context.Context, tenantID uint32, fileIDs []string, opts *GetFileUrlsOptions) (map[string]*v1.InternalFileUrlInfo, error) {
+	keyOf := func(fileID string) urlKey {
+		key := urlKey{tenantID: tenantID, fileID: fileID}
+		if opts != nil {
+			key.expiresIn = opts.ExpiresIn
+			key.includeVariants = opts.IncludeVariants
+		}
+		return key
+	}
+
+	own := make(map[string]*urlCall)
+	shared := make(map[string]*urlCall)
+	var ownIDs []string
+
+	c.urlFlight.mu.Lock()
+	if c.urlFlight.calls == nil {
+		c.urlFlight.calls = make(map[urlKey]*urlCall)
+	}
+	for _, id := range fileIDs {
+		if own[id] != nil || shared[id] != nil {
+			continue
+		}
+		key := keyOf(id)
+		if call, ok := c.urlFlight.calls[key]; ok {
+			shared[id] = call
+			continue
+		}
+		call := &urlCall{done: make(chan struct{})}
+		c.urlFlight.calls[key] = call
+		own[id] = call
+		ownIDs = append(ownIDs, id)
+	}
+	c.urlFlight.mu.Unlock()
+
+	if len(ownIDs) > 0 {
+		// 查询结果由其他调用方共享，不随当前调用方取消，超时由 fetchFileUrls 控制
+		go func() {
+			results, err := c.fetchFileUrls(context.WithoutCancel(ctx), tenantID, ownIDs, opts)
+
+			c.urlFlight.mu.Lock()
+			defer c.urlFlight.mu.Unlock()
+			for id, call := range own {
+				call.info, call.err = results[id], err
+				delete(c.urlFlight.calls, keyOf(id))
+				close(call.done)
+			}
+		}()
+	}
+
+	results := make(map[string]*v1.InternalFileUrlInfo, len(own)+len(shared))
+	for _, calls := range []map[string]*urlCall{own, shared} {
+		for id, call := range calls {
+			select {
+			case <-call.done:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			if call.err != nil {
+				return nil, call.err
+			}
+			if call.info != nil {
+				// 结果可能被多个调用方持有，复制一份避免调用方修改时互相影响
+				results[id] = proto.Clone(call.info).(*v1.InternalFileUrlInfo)
+			}
+		}
+	}
+	return results, nil
+}
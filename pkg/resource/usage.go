@@ -0,0 +1,112 @@
+package resource
+
+import (
+	"context"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+)
+
+// Usage 租户存储用量统计
+type Usage struct {
+	// 租户ID
+	TenantID uint32
+	// 已用存储（字节）
+	StorageUsed int64
+	// 存储配额（字节），0表示无限制
+	StorageQuota int64
+	// 已用文件数
+	FileCount int64
+	// 文件数配额，0表示无限制
+	FileCountQuota int64
+	// 配额状态：active, suspended, exceeded
+	Status string
+	// 按文件大类统计的用量（image, video, document, audio, archive, other）
+	Categories map[string]CategoryUsage
+}
+
+// CategoryUsage 单个文件大类的用量
+type CategoryUsage struct {
+	// 已用存储（字节）
+	StorageUsed int64
+	// 文件数
+	FileCount int64
+}
+
+// StoragePercent 返回存储使用百分比（0-100，超出配额时大于100），无限制时返回0
+func (u *Usage) StoragePercent() float64 {
+	if u.StorageQuota <= 0 {
+		return 0
+	}
+	return float64(u.StorageUsed) * 100 / float64(u.StorageQuota)
+}
+
+// StorageRemaining 返回剩余存储（字节），无限制时返回 -1，超出配额时返回0
+func (u *Usage) StorageRemaining() int64 {
+	if u.StorageQuota <= 0 {
+		return -1
+	}
+	return max(u.StorageQuota-u.StorageUsed, 0)
+}
+
+// NearQuota 存储或文件数的使用百分比是否达到 threshold（如 80 表示 80%），无限制的项不参与判断
+func (u *Usage) NearQuota(threshold float64) bool {
+	if u.StorageQuota > 0 && u.StoragePercent() >= threshold {
+		return true
+	}
+	return u.FileCountQuota > 0 && float64(u.FileCount)*100/float64(u.FileCountQuota) >= threshold
+}
+
+// GetUsage 获取租户存储用量统计
+//
+// 计费和配额告警使用同一份统计，只统计已上传完成的文件
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantID: 租户ID
+//
+// 返回:
+//   - *Usage: 用量统计
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	usage, err := client.GetUsage(ctx, tenantID)
+//	if err != nil {
+//	    return err
+//	}
+//	if usage.NearQuota(80) {
+//	    _, err = mailer.SendQuotaWarningEmail(ctx, admin.Email, admin.Name, tenant.Name,
+//	        usage.StorageUsed, usage.StorageQuota, manageLink)
+//	}
+func (c *ResourceClient) GetUsage(ctx context.Context, tenantID uint32) (*Usage, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.InternalGetUsage(ctx, &v1.InternalGetUsageRequest{
+		TenantId: tenantID,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取存储用量失败: tenant_id=%d, error=%v", tenantID, err)
+		return nil, err
+	}
+
+	usage := &Usage{
+		TenantID:   tenantID,
+		Categories: make(map[string]CategoryUsage, len(resp.Categories)),
+	}
+	if quota := resp.Quota; quota != nil {
+		usage.StorageUsed = quota.StorageUsed
+		usage.StorageQuota = quota.StorageQuota
+		usage.FileCount = quota.FileCountUsed
+		usage.FileCountQuota = quota.FileCountQuota
+		usage.Status = quota.Status
+	}
+	for category, cu := range resp.Categories {
+		usage.Categories[category] = CategoryUsage{
+			StorageUsed: cu.StorageUsed,
+			FileCount:   cu.FileCount,
+		}
+	}
+
+	return usage, nil
+}
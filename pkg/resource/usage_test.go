@@ -0,0 +1,25 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsage(t *testing.T) {
+	usage := &Usage{StorageUsed: 90, StorageQuota: 100, FileCount: 1, FileCountQuota: 10}
+	assert.InDelta(t, 90, usage.StoragePercent(), 0.001)
+	assert.Equal(t, int64(10), usage.StorageRemaining())
+	assert.True(t, usage.NearQuota(80))
+	assert.False(t, usage.NearQuota(95))
+
+	usage.StorageUsed = 120
+	assert.Equal(t, int64(0), usage.StorageRemaining())
+
+	// 无限制的存储不参与判断，文件数达到阈值
+	unlimited := &Usage{StorageUsed: 1 << 40, FileCount: 9, FileCountQuota: 10}
+	assert.Equal(t, float64(0), unlimited.StoragePercent())
+	assert.Equal(t, int64(-1), unlimited.StorageRemaining())
+	assert.True(t, unlimited.NearQuota(90))
+	assert.False(t, (&Usage{}).NearQuota(0))
+}
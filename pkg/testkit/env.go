@@ -0,0 +1,168 @@
+package testkit
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+)
+
+// Env 一组共享的集成测试依赖
+//
+// 未启用的依赖对应字段为 nil
+type Env struct {
+	MySQL  *MySQLConfig
+	Redis  *RedisConfig
+	Consul *ConsulConfig
+	MinIO  *MinIOConfig
+}
+
+type options struct {
+	mysql  bool
+	redis  bool
+	consul bool
+	minio  bool
+}
+
+// Option 环境启动选项
+type Option func(*options)
+
+// WithMySQL 启用 MySQL
+func WithMySQL() Option { return func(o *options) { o.mysql = true } }
+
+// WithRedis 启用 Redis
+func WithRedis() Option { return func(o *options) { o.redis = true } }
+
+// WithConsul 启用 Consul
+func WithConsul() Option { return func(o *options) { o.consul = true } }
+
+// WithMinIO 启用 MinIO
+func WithMinIO() Option { return func(o *options) { o.minio = true } }
+
+// Start 按选项启动依赖环境
+//
+// 任意依赖启动失败时，已启动的容器会被清理
+//
+// 参数:
+//   - ctx: 上下文
+//   - opts: 需要启用的依赖
+//
+// 返回:
+//   - *Env: 依赖环境，使用完毕后调用 Close 清理
+//   - error: 启动失败时的错误信息
+func Start(ctx context.Context, opts ...Option) (*Env, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	env := &Env{}
+	var err error
+
+	if o.mysql {
+		if env.MySQL, err = StartMySQL(ctx); err != nil {
+			env.Close()
+			return nil, err
+		}
+	}
+	if o.redis {
+		if env.Redis, err = StartRedis(ctx); err != nil {
+			env.Close()
+			return nil, err
+		}
+	}
+	if o.consul {
+		if env.Consul, err = StartConsul(ctx); err != nil {
+			env.Close()
+			return nil, err
+		}
+	}
+	if o.minio {
+		if env.MinIO, err = StartMinIO(ctx); err != nil {
+			env.Close()
+			return nil, err
+		}
+	}
+
+	return env, nil
+}
+
+// Close 清理所有由 testkit 启动的容器
+func (e *Env) Close() error {
+	var errs []error
+	if e.MySQL != nil {
+		errs = append(errs, e.MySQL.Close())
+	}
+	if e.Redis != nil {
+		errs = append(errs, e.Redis.Close())
+	}
+	if e.Consul != nil {
+		errs = append(errs, e.Consul.Close())
+	}
+	if e.MinIO != nil {
+		errs = append(errs, e.MinIO.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// ========== testing 辅助函数 ==========
+
+// skipIfUnavailable 与仓库已有集成测试保持一致：
+// 设置 SKIP_INTEGRATION=true 或本机没有 docker 时跳过测试
+func skipIfUnavailable(tb testing.TB, reuseEnv string) {
+	tb.Helper()
+	if os.Getenv("SKIP_INTEGRATION") == "true" {
+		tb.Skip("跳过集成测试")
+	}
+	if os.Getenv(reuseEnv) == "" && !DockerAvailable() {
+		tb.Skip("docker 不可用，跳过集成测试")
+	}
+}
+
+// MySQL 为单个测试启动 MySQL，测试结束时自动清理
+func MySQL(tb testing.TB) *MySQLConfig {
+	tb.Helper()
+	skipIfUnavailable(tb, EnvMySQLDSN)
+	cfg, err := StartMySQL(context.Background())
+	if err != nil {
+		tb.Fatalf("启动 MySQL 失败: %v", err)
+	}
+	tb.Cleanup(func() { _ = cfg.Close() })
+	return cfg
+}
+
+// Redis 为单个测试启动 Redis，测试结束时自动清理
+func Redis(tb testing.TB) *RedisConfig {
+	tb.Helper()
+	skipIfUnavailable(tb, EnvRedisAddr)
+	cfg, err := StartRedis(context.Background())
+	if err != nil {
+		tb.Fatalf("启动 Redis 失败: %v", err)
+	}
+	tb.Cleanup(func() { _ = cfg.Close() })
+	return cfg
+}
+
+// Consul 为单个测试启动 Consul，测试结束时自动清理
+func Consul(tb testing.TB) *ConsulConfig {
+	tb.Helper()
+	skipIfUnavailable(tb, EnvConsulAddr)
+	cfg, err := StartConsul(context.Background())
+	if err != nil {
+		tb.Fatalf("启动 Consul 失败: %v", err)
+	}
+	tb.Cleanup(func() { _ = cfg.Close() })
+	return cfg
+}
+
+// MinIO 为单个测试启动 MinIO，测试结束时自动清理
+func MinIO(tb testing.TB) *MinIOConfig {
+	tb.Helper()
+	skipIfUnavailable(tb, EnvMinIOEndpoint)
+	cfg, err := StartMinIO(context.Background())
+	if err != nil {
+		tb.Fatalf("启动 MinIO 失败: %v", err)
+	}
+	tb.Cleanup(func() { _ = cfg.Close() })
+	return cfg
+}
@@ -0,0 +1,260 @@
+package testkit
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/heyinLab/common/pkg/common"
+)
+
+// 复用外部服务的环境变量，设置后不再启动容器
+const (
+	EnvMySQLDSN       = "TESTKIT_MYSQL_DSN"
+	EnvRedisAddr      = "TESTKIT_REDIS_ADDR"
+	EnvConsulAddr     = "TESTKIT_CONSUL_ADDR"
+	EnvMinIOEndpoint  = "TESTKIT_MINIO_ENDPOINT"
+	EnvMinIOAccessKey = "TESTKIT_MINIO_ACCESS_KEY"
+	EnvMinIOSecretKey = "TESTKIT_MINIO_SECRET_KEY"
+)
+
+// 默认镜像
+const (
+	DefaultMySQLImage  = "mysql:8.0"
+	DefaultRedisImage  = "redis:7-alpine"
+	DefaultConsulImage = "hashicorp/consul:1.20"
+	DefaultMinIOImage  = "minio/minio:RELEASE.2024-12-18T13-15-44Z"
+)
+
+const (
+	mysqlRootPassword = "testkit"
+	mysqlDatabase     = "testkit"
+	minioAccessKey    = "testkit"
+	minioSecretKey    = "testkit-secret"
+)
+
+// ========== MySQL ==========
+
+// MySQLConfig MySQL 连接配置
+type MySQLConfig struct {
+	// Driver 驱动名称，可直接传给 gorm.NewClient
+	Driver string
+	// DSN 数据源名称
+	DSN string
+
+	container *Container
+}
+
+// StartMySQL 启动 MySQL 容器，设置了 TESTKIT_MYSQL_DSN 时直接复用
+func StartMySQL(ctx context.Context) (*MySQLConfig, error) {
+	if dsn := os.Getenv(EnvMySQLDSN); dsn != "" {
+		return &MySQLConfig{Driver: "mysql", DSN: dsn}, nil
+	}
+
+	c, err := RunContainer(ctx, ContainerRequest{
+		Image:        DefaultMySQLImage,
+		ExposedPorts: []string{"3306/tcp"},
+		Env: map[string]string{
+			"MYSQL_ROOT_PASSWORD": mysqlRootPassword,
+			"MYSQL_DATABASE":      mysqlDatabase,
+		},
+		WaitFor: func(ctx context.Context, c *Container) error {
+			// 端口可连接时 MySQL 可能仍在初始化，需要通过 mysqladmin 确认
+			if err := dialPort(ctx, c.Endpoint("3306")); err != nil {
+				return err
+			}
+			return c.Exec(ctx, "mysqladmin", "ping", "-h127.0.0.1", "-uroot", "-p"+mysqlRootPassword, "--silent")
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MySQLConfig{
+		Driver: "mysql",
+		DSN: fmt.Sprintf("root:%s@tcp(%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+			mysqlRootPassword, c.Endpoint("3306"), mysqlDatabase),
+		container: c,
+	}, nil
+}
+
+// Close 清理容器（复用外部服务时不做任何操作）
+func (c *MySQLConfig) Close() error {
+	return c.container.Terminate()
+}
+
+// ========== Redis ==========
+
+// RedisConfig Redis 连接配置
+type RedisConfig struct {
+	// Addr host:port 形式的地址
+	Addr string
+
+	container *Container
+}
+
+// StartRedis 启动 Redis 容器，设置了 TESTKIT_REDIS_ADDR 时直接复用
+func StartRedis(ctx context.Context) (*RedisConfig, error) {
+	if addr := os.Getenv(EnvRedisAddr); addr != "" {
+		return &RedisConfig{Addr: addr}, nil
+	}
+
+	c, err := RunContainer(ctx, ContainerRequest{
+		Image:        DefaultRedisImage,
+		ExposedPorts: []string{"6379/tcp"},
+		WaitFor: func(ctx context.Context, c *Container) error {
+			if err := dialPort(ctx, c.Endpoint("6379")); err != nil {
+				return err
+			}
+			return c.Exec(ctx, "redis-cli", "ping")
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RedisConfig{
+		Addr:      c.Endpoint("6379"),
+		container: c,
+	}, nil
+}
+
+// Close 清理容器（复用外部服务时不做任何操作）
+func (c *RedisConfig) Close() error {
+	return c.container.Terminate()
+}
+
+// ========== Consul ==========
+
+// ConsulConfig Consul 连接配置
+type ConsulConfig struct {
+	// Addr host:port 形式的地址
+	Addr string
+
+	container *Container
+}
+
+// StartConsul 启动 Consul 容器（dev 模式），设置了 TESTKIT_CONSUL_ADDR 时直接复用
+func StartConsul(ctx context.Context) (*ConsulConfig, error) {
+	if addr := os.Getenv(EnvConsulAddr); addr != "" {
+		return &ConsulConfig{Addr: addr}, nil
+	}
+
+	c, err := RunContainer(ctx, ContainerRequest{
+		Image:        DefaultConsulImage,
+		ExposedPorts: []string{"8500/tcp"},
+		Cmd:          []string{"agent", "-dev", "-client=0.0.0.0"},
+		WaitFor: func(ctx context.Context, c *Container) error {
+			// leader 选举完成后才能注册服务
+			body, err := httpGet(ctx, "http://"+c.Endpoint("8500")+"/v1/status/leader")
+			if err != nil {
+				return err
+			}
+			if strings.Trim(strings.TrimSpace(body), `"`) == "" {
+				return fmt.Errorf("consul leader 尚未选出")
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConsulConfig{
+		Addr:      c.Endpoint("8500"),
+		container: c,
+	}, nil
+}
+
+// Registrar 创建指向该 Consul 的服务注册器
+func (c *ConsulConfig) Registrar(tags []string) registry.Registrar {
+	return common.NewConsulRegistrar(c.Addr, tags)
+}
+
+// Close 清理容器（复用外部服务时不做任何操作）
+func (c *ConsulConfig) Close() error {
+	return c.container.Terminate()
+}
+
+// ========== MinIO ==========
+
+// MinIOConfig MinIO（S3 兼容对象存储）连接配置
+type MinIOConfig struct {
+	// Endpoint host:port 形式的地址
+	Endpoint string
+	// AccessKey 访问密钥
+	AccessKey string
+	// SecretKey 私有密钥
+	SecretKey string
+	// UseSSL 是否使用 HTTPS
+	UseSSL bool
+
+	container *Container
+}
+
+// StartMinIO 启动 MinIO 容器，设置了 TESTKIT_MINIO_ENDPOINT 时直接复用
+func StartMinIO(ctx context.Context) (*MinIOConfig, error) {
+	if endpoint := os.Getenv(EnvMinIOEndpoint); endpoint != "" {
+		return &MinIOConfig{
+			Endpoint:  endpoint,
+			AccessKey: os.Getenv(EnvMinIOAccessKey),
+			SecretKey: os.Getenv(EnvMinIOSecretKey),
+		}, nil
+	}
+
+	c, err := RunContainer(ctx, ContainerRequest{
+		Image:        DefaultMinIOImage,
+		ExposedPorts: []string{"9000/tcp"},
+		Env: map[string]string{
+			"MINIO_ROOT_USER":     minioAccessKey,
+			"MINIO_ROOT_PASSWORD": minioSecretKey,
+		},
+		Cmd: []string{"server", "/data"},
+		WaitFor: func(ctx context.Context, c *Container) error {
+			_, err := httpGet(ctx, "http://"+c.Endpoint("9000")+"/minio/health/ready")
+			return err
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MinIOConfig{
+		Endpoint:  c.Endpoint("9000"),
+		AccessKey: minioAccessKey,
+		SecretKey: minioSecretKey,
+		container: c,
+	}, nil
+}
+
+// Close 清理容器（复用外部服务时不做任何操作）
+func (c *MinIOConfig) Close() error {
+	return c.container.Terminate()
+}
+
+// httpGet 发送 GET 请求，非 2xx 状态码视为失败
+func httpGet(ctx context.Context, url string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return string(body), nil
+}
@@ -0,0 +1,232 @@
+// Package testkit 为服务的集成测试提供一次性的依赖环境
+//
+// 通过本机 docker 命令启动 MySQL、Redis、Consul、MinIO 容器，
+// 等待服务就绪后返回可直接使用的连接配置；测试结束时自动清理容器。
+//
+// 直接调用 docker 命令而不是 Testcontainers：common 被所有服务依赖，
+// 引入 testcontainers-go 会把 Docker SDK 及其大量间接依赖带进每个服务的 go.mod，
+// 而这里只需要启动、查询端口和删除容器，本机或 CI 中有 docker 命令即可。
+// 默认镜像都固定了版本，避免上游更新导致测试结果变化。
+//
+// 如果设置了对应的环境变量（如 TESTKIT_MYSQL_DSN），则直接复用已运行的服务，不再启动容器，
+// 便于在 CI 中使用 service containers 或在本地复用长期运行的环境。
+//
+// 使用示例:
+//
+//	func TestMain(m *testing.M) {
+//	    env, err := testkit.Start(context.Background(), testkit.WithMySQL(), testkit.WithRedis())
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    code := m.Run()
+//	    env.Close()
+//	    os.Exit(code)
+//	}
+package testkit
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultStartupTimeout 默认的容器启动超时时间
+	DefaultStartupTimeout = 2 * time.Minute
+
+	// containerLabel 标记由 testkit 启动的容器，便于手动清理残留容器
+	containerLabel = "heyinlab.testkit=true"
+)
+
+// ContainerRequest 容器启动请求
+type ContainerRequest struct {
+	// Image 镜像名称
+	Image string
+	// ExposedPorts 需要映射到宿主机的容器端口，如 "3306/tcp"
+	ExposedPorts []string
+	// Env 容器环境变量
+	Env map[string]string
+	// Cmd 覆盖镜像默认的启动命令（可选）
+	Cmd []string
+	// WaitFor 就绪检查，返回 nil 表示服务可用（可选，默认检查端口可连接）
+	WaitFor func(ctx context.Context, c *Container) error
+	// StartupTimeout 启动超时时间，默认 DefaultStartupTimeout
+	StartupTimeout time.Duration
+}
+
+// Container 由 testkit 启动的容器
+type Container struct {
+	// ID 容器ID
+	ID string
+	// Host 宿主机地址
+	Host string
+	// ports 容器端口 -> 宿主机端口
+	ports map[string]string
+}
+
+// Port 返回容器端口映射到宿主机的端口
+func (c *Container) Port(containerPort string) string {
+	return c.ports[normalizePort(containerPort)]
+}
+
+// Endpoint 返回 host:port 形式的访问地址
+func (c *Container) Endpoint(containerPort string) string {
+	return net.JoinHostPort(c.Host, c.Port(containerPort))
+}
+
+// Exec 在容器内执行命令
+func (c *Container) Exec(ctx context.Context, cmd ...string) error {
+	_, err := docker(ctx, append([]string{"exec", c.ID}, cmd...)...)
+	return err
+}
+
+// Terminate 停止并删除容器
+func (c *Container) Terminate() error {
+	if c == nil || c.ID == "" {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	_, err := docker(ctx, "rm", "-f", "-v", c.ID)
+	return err
+}
+
+// DockerAvailable 检查本机 docker 是否可用
+func DockerAvailable() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := docker(ctx, "info", "--format", "{{.ServerVersion}}")
+	return err == nil
+}
+
+// RunContainer 启动容器并等待就绪
+//
+// 参数:
+//   - ctx: 上下文
+//   - req: 容器启动请求
+//
+// 返回:
+//   - *Container: 已就绪的容器
+//   - error: 启动或就绪检查失败时的错误信息，此时容器已被清理
+func RunContainer(ctx context.Context, req ContainerRequest) (*Container, error) {
+	if req.Image == "" {
+		return nil, fmt.Errorf("镜像名称不能为空")
+	}
+	if req.StartupTimeout <= 0 {
+		req.StartupTimeout = DefaultStartupTimeout
+	}
+
+	args := []string{"run", "-d", "--label", containerLabel}
+	for _, p := range req.ExposedPorts {
+		args = append(args, "-p", "127.0.0.1::"+normalizePort(p))
+	}
+	for k, v := range req.Env {
+		args = append(args, "-e", k+"="+v)
+	}
+	args = append(args, req.Image)
+	args = append(args, req.Cmd...)
+
+	out, err := docker(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("启动容器 %s 失败: %w", req.Image, err)
+	}
+
+	c := &Container{
+		ID:    strings.TrimSpace(out),
+		Host:  "127.0.0.1",
+		ports: make(map[string]string),
+	}
+
+	for _, p := range req.ExposedPorts {
+		port := normalizePort(p)
+		out, err := docker(ctx, "port", c.ID, port)
+		if err != nil {
+			_ = c.Terminate()
+			return nil, fmt.Errorf("获取容器端口 %s 失败: %w", port, err)
+		}
+		// 输出格式: 127.0.0.1:49153
+		line := strings.TrimSpace(strings.SplitN(out, "\n", 2)[0])
+		_, hostPort, err := net.SplitHostPort(line)
+		if err != nil {
+			_ = c.Terminate()
+			return nil, fmt.Errorf("解析容器端口映射失败: %s", line)
+		}
+		c.ports[port] = hostPort
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, req.StartupTimeout)
+	defer cancel()
+
+	wait := req.WaitFor
+	if wait == nil {
+		wait = func(ctx context.Context, c *Container) error {
+			for _, p := range req.ExposedPorts {
+				if err := dialPort(ctx, c.Endpoint(p)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	if err := waitUntil(waitCtx, func() error { return wait(waitCtx, c) }); err != nil {
+		_ = c.Terminate()
+		return nil, fmt.Errorf("等待容器 %s 就绪超时: %w", req.Image, err)
+	}
+
+	return c, nil
+}
+
+// ========== 内部函数 ==========
+
+// docker 执行 docker 命令并返回标准输出
+func docker(ctx context.Context, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "docker", args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("docker %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
+
+// waitUntil 按固定间隔重试直到成功或上下文结束
+func waitUntil(ctx context.Context, check func() error) error {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	var lastErr error
+	for {
+		if lastErr = check(); lastErr == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w (最后一次错误: %v)", ctx.Err(), lastErr)
+		case <-ticker.C:
+		}
+	}
+}
+
+// dialPort 检查端口是否可连接
+func dialPort(ctx context.Context, addr string) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// normalizePort 补全端口协议，"3306" -> "3306/tcp"
+func normalizePort(port string) string {
+	if strings.Contains(port, "/") {
+		return port
+	}
+	return port + "/tcp"
+}
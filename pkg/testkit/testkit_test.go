@@ -0,0 +1,37 @@
+package testkit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizePort(t *testing.T) {
+	assert.Equal(t, "3306/tcp", normalizePort("3306"))
+	assert.Equal(t, "53/udp", normalizePort("53/udp"))
+}
+
+func TestStartReusesExternalServices(t *testing.T) {
+	t.Setenv(EnvMySQLDSN, "root:pw@tcp(db:3306)/app")
+	t.Setenv(EnvRedisAddr, "redis:6379")
+	t.Setenv(EnvConsulAddr, "consul:8500")
+	t.Setenv(EnvMinIOEndpoint, "minio:9000")
+	t.Setenv(EnvMinIOAccessKey, "ak")
+	t.Setenv(EnvMinIOSecretKey, "sk")
+
+	env, err := Start(context.Background(), WithMySQL(), WithRedis(), WithConsul(), WithMinIO())
+	assert.NoError(t, err)
+	defer env.Close()
+
+	assert.Equal(t, "mysql", env.MySQL.Driver)
+	assert.Equal(t, "root:pw@tcp(db:3306)/app", env.MySQL.DSN)
+	assert.Equal(t, "redis:6379", env.Redis.Addr)
+	assert.Equal(t, "consul:8500", env.Consul.Addr)
+	assert.Equal(t, "minio:9000", env.MinIO.Endpoint)
+	assert.Equal(t, "ak", env.MinIO.AccessKey)
+	assert.Equal(t, "sk", env.MinIO.SecretKey)
+
+	// 复用外部服务时 Close 不应报错
+	assert.NoError(t, env.Close())
+}